@@ -23,6 +23,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core/policies"
 	"github.com/dolthub/doltgresql/core/sequences"
 	"github.com/dolthub/doltgresql/core/typecollection"
 )
@@ -31,6 +32,7 @@ import (
 type contextValues struct {
 	collection     *sequences.Collection
 	types          *typecollection.TypeCollection
+	policies       *policies.Collection
 	pgCatalogCache any
 }
 
@@ -52,9 +54,14 @@ func getContextValues(ctx *sql.Context) (*contextValues, error) {
 
 // getRootFromContext returns the working session's root from the context, along with the session.
 func getRootFromContext(ctx *sql.Context) (*dsess.DoltSession, *RootValue, error) {
+	return getRootFromDatabase(ctx, ctx.GetCurrentDatabase())
+}
+
+// getRootFromDatabase returns the working session's root for the given database, along with the session.
+func getRootFromDatabase(ctx *sql.Context, database string) (*dsess.DoltSession, *RootValue, error) {
 	session := dsess.DSessFromSess(ctx.Session)
 	// Does this handle the current schema as well?
-	state, ok, err := session.LookupDbState(ctx, ctx.GetCurrentDatabase())
+	state, ok, err := session.LookupDbState(ctx, database)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -206,8 +213,19 @@ func GetSequencesCollectionFromContext(ctx *sql.Context) (*sequences.Collection,
 	return cv.collection, nil
 }
 
-// GetTypesCollectionFromContext returns the given type collection from the context.
-// Will always return a collection if no error is returned.
+// GetSequencesFromDatabase returns the sequence collection for the given database, which should only be used when
+// reading sequences. Unlike GetSequencesCollectionFromContext, this does not return any sequences that were modified by
+// the current statement.
+func GetSequencesFromDatabase(ctx *sql.Context, database string) (*sequences.Collection, error) {
+	_, root, err := getRootFromDatabase(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetSequences(ctx)
+}
+
+// GetTypesCollectionFromContext returns the given type collection from the context. Changes are written to the working
+// root by CloseContextRootFinalizer. Will always return a collection if no error is returned.
 func GetTypesCollectionFromContext(ctx *sql.Context) (*typecollection.TypeCollection, error) {
 	cv, err := getContextValues(ctx)
 	if err != nil {
@@ -226,6 +244,48 @@ func GetTypesCollectionFromContext(ctx *sql.Context) (*typecollection.TypeCollec
 	return cv.types, nil
 }
 
+// GetPoliciesCollectionFromContext returns the policy collection from the context, which should be used when modifying
+// policies. Changes are written to the working root by CloseContextRootFinalizer. Will always return a collection if no
+// error is returned.
+func GetPoliciesCollectionFromContext(ctx *sql.Context) (*policies.Collection, error) {
+	cv, err := getContextValues(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cv.policies == nil {
+		_, root, err := getRootFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		cv.policies, err = root.GetPolicies(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cv.policies, nil
+}
+
+// GetPoliciesFromDatabase returns the policy collection for the given database, which should only be used when reading
+// policies. If the policies of the current database have been modified by the current statement, then the modified
+// collection is returned. Will always return a collection if no error is returned.
+func GetPoliciesFromDatabase(ctx *sql.Context, database string) (*policies.Collection, error) {
+	if len(database) == 0 || database == ctx.GetCurrentDatabase() {
+		cv, err := getContextValues(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if cv.policies != nil {
+			return cv.policies, nil
+		}
+		database = ctx.GetCurrentDatabase()
+	}
+	_, root, err := getRootFromDatabase(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetPolicies(ctx)
+}
+
 // CloseContextRootFinalizer finalizes any changes persisted within the context by writing them to the working root.
 // This should ONLY be called by the ContextRootFinalizer node.
 func CloseContextRootFinalizer(ctx *sql.Context) error {
@@ -237,16 +297,34 @@ func CloseContextRootFinalizer(ctx *sql.Context) error {
 	if !ok {
 		return nil
 	}
-	if cv.collection == nil {
+	if cv.collection == nil && cv.types == nil && cv.policies == nil {
 		return nil
 	}
-	session, root, err := getRootFromContext(ctx)
+	session, newRoot, err := getRootFromContext(ctx)
 	if err != nil {
 		return err
 	}
-	newRoot, err := root.PutSequences(ctx, cv.collection)
-	if err != nil {
-		return err
+	if cv.collection != nil {
+		newRoot, err = newRoot.PutSequences(ctx, cv.collection)
+		if err != nil {
+			return err
+		}
+	}
+	if cv.types != nil {
+		newRoot, err = newRoot.PutTypes(ctx, cv.types)
+		if err != nil {
+			return err
+		}
+		// Types may be modified by other sessions or on other branches, so they're always reloaded after a statement
+		cv.types = nil
+	}
+	if cv.policies != nil {
+		newRoot, err = newRoot.PutPolicies(ctx, cv.policies)
+		if err != nil {
+			return err
+		}
+		// Policies may be modified by other sessions or on other branches, so they're always reloaded after a statement
+		cv.policies = nil
 	}
 	if newRoot != nil {
 		if err = session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot); err != nil {
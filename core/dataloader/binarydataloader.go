@@ -0,0 +1,233 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataloader
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+)
+
+// binarySignature is the signature that begins the header of all data in the binary COPY format.
+var binarySignature = []byte("PGCOPY\n\377\r\n\x00")
+
+// binaryHeaderLength is the length of the fixed portion of the header, which is the signature followed by the flags
+// field and the header extension length.
+var binaryHeaderLength = len(binarySignature) + 8
+
+// binaryFlagHasOids is the bit in the header's flags field that states that each tuple includes an OID.
+const binaryFlagHasOids = 1 << 16
+
+// binaryFlagCriticalMask contains the bits in the header's flags field that must be understood by the reader. The lower
+// 16 bits are reserved for flags that may safely be ignored.
+const binaryFlagCriticalMask = 0xFFFF0000
+
+// BinaryDataLoader is an implementation of DataLoader that reads data in the binary COPY format and inserts it into a
+// table. The data begins with a header, which is followed by tuples that contain the length-prefixed binary
+// representation of each value, and ends with a trailer.
+type BinaryDataLoader struct {
+	results      LoadDataResults
+	partialData  []byte
+	writer       *rowWriter
+	headerRead   bool
+	trailerFound bool
+}
+
+var _ DataLoader = (*BinaryDataLoader)(nil)
+
+// NewBinaryDataLoader creates a new DataLoader instance that will insert records from chunks of binary COPY data into
+// |target|.
+func NewBinaryDataLoader(ctx *sql.Context, target LoadTarget) (*BinaryDataLoader, error) {
+	writer, err := newRowWriter(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BinaryDataLoader{
+		writer: writer,
+	}, nil
+}
+
+// LoadChunk implements the DataLoader interface. Tuples do not need to end on a chunk boundary, as any trailing
+// incomplete tuple is saved and prepended to the next chunk.
+func (bdl *BinaryDataLoader) LoadChunk(ctx *sql.Context, data *bufio.Reader) error {
+	chunk, err := io.ReadAll(data)
+	if err != nil {
+		return err
+	}
+	if bdl.trailerFound {
+		if len(chunk) > 0 {
+			return pgerror.New(pgcode.BadCopyFileFormat, "received copy data after EOF marker")
+		}
+		return nil
+	}
+	if len(bdl.partialData) > 0 {
+		chunk = append(bdl.partialData, chunk...)
+		bdl.partialData = nil
+	}
+
+	if !bdl.headerRead {
+		headerLength, err := bdl.readHeader(chunk)
+		if err != nil {
+			return err
+		}
+		if headerLength == 0 {
+			bdl.partialData = chunk
+			return nil
+		}
+		chunk = chunk[headerLength:]
+		bdl.headerRead = true
+	}
+
+	for len(chunk) > 0 {
+		row, tupleLength, err := bdl.readTuple(ctx, chunk)
+		if err != nil {
+			return err
+		}
+		if tupleLength == 0 {
+			// The tuple is incomplete, so we save it for the next chunk
+			bdl.partialData = chunk
+			return nil
+		}
+		chunk = chunk[tupleLength:]
+		if row == nil {
+			bdl.trailerFound = true
+			if len(chunk) > 0 {
+				return pgerror.New(pgcode.BadCopyFileFormat, "received copy data after EOF marker")
+			}
+			return nil
+		}
+
+		inserted, err := bdl.writer.insert(ctx, row)
+		if err != nil {
+			return err
+		}
+		if inserted {
+			bdl.results.RowsLoaded += 1
+		}
+	}
+
+	return nil
+}
+
+// readHeader reads the header from the beginning of |data|, returning the length of the header. Returns a length of
+// zero if |data| does not yet contain the entire header.
+func (bdl *BinaryDataLoader) readHeader(data []byte) (int, error) {
+	if !bytes.HasPrefix(data, binarySignature[:min(len(data), len(binarySignature))]) {
+		return 0, pgerror.New(pgcode.BadCopyFileFormat, "COPY file signature not recognized")
+	}
+	if len(data) < binaryHeaderLength {
+		return 0, nil
+	}
+	flags := binary.BigEndian.Uint32(data[len(binarySignature):])
+	if flags&binaryFlagHasOids != 0 {
+		return 0, pgerror.New(pgcode.BadCopyFileFormat, "invalid COPY file header (WITH OIDS)")
+	}
+	if flags&binaryFlagCriticalMask != 0 {
+		return 0, pgerror.New(pgcode.BadCopyFileFormat, "unrecognized critical flags in COPY file header")
+	}
+	extensionLength := int32(binary.BigEndian.Uint32(data[len(binarySignature)+4:]))
+	if extensionLength < 0 {
+		return 0, pgerror.New(pgcode.BadCopyFileFormat, "invalid COPY file header (wrong length)")
+	}
+	// The contents of the header extension are skipped, as no extensions are currently defined
+	headerLength := binaryHeaderLength + int(extensionLength)
+	if len(data) < headerLength {
+		return 0, nil
+	}
+	return headerLength, nil
+}
+
+// readTuple reads the tuple from the beginning of |data|, returning the row along with the length of the tuple. Returns
+// a length of zero if |data| does not yet contain the entire tuple, and a nil row if the tuple is the trailer.
+func (bdl *BinaryDataLoader) readTuple(ctx *sql.Context, data []byte) (sql.Row, int, error) {
+	if len(data) < 2 {
+		return nil, 0, nil
+	}
+	fieldCount := int16(binary.BigEndian.Uint16(data))
+	if fieldCount == -1 {
+		return nil, 2, nil
+	}
+	if int(fieldCount) != len(bdl.writer.colTypes) {
+		return nil, 0, pgerror.Newf(pgcode.BadCopyFileFormat, "row field count is %d, expected %d", fieldCount, len(bdl.writer.colTypes))
+	}
+
+	// We make sure that the entire tuple is available before decoding any values
+	offset := 2
+	fields := make([][]byte, fieldCount)
+	for i := range fields {
+		if len(data) < offset+4 {
+			return nil, 0, nil
+		}
+		fieldLength := int32(binary.BigEndian.Uint32(data[offset:]))
+		offset += 4
+		if fieldLength == -1 {
+			continue
+		} else if fieldLength < 0 {
+			return nil, 0, pgerror.New(pgcode.BadCopyFileFormat, "invalid field size")
+		}
+		if len(data) < offset+int(fieldLength) {
+			return nil, 0, nil
+		}
+		fields[i] = data[offset : offset+int(fieldLength)]
+		offset += int(fieldLength)
+	}
+
+	// Cast the values using binary input
+	row := make(sql.Row, len(bdl.writer.colTypes))
+	for i, field := range fields {
+		if field == nil {
+			continue
+		}
+		var err error
+		row[i], err = bdl.writer.colTypes[i].IoReceive(ctx, field)
+		if err != nil {
+			return nil, 0, fmt.Errorf(`invalid binary data for column "%s": %s`, bdl.writer.columnName(i), err.Error())
+		}
+	}
+	return row, offset, nil
+}
+
+// Abort implements the DataLoader interface
+func (bdl *BinaryDataLoader) Abort(ctx *sql.Context) error {
+	return bdl.writer.abort(ctx)
+}
+
+// Finish implements the DataLoader interface
+func (bdl *BinaryDataLoader) Finish(ctx *sql.Context) (*LoadDataResults, error) {
+	// If the header was never completed, or there is partial data from the last chunk that hasn't been inserted, then
+	// we return an error.
+	if !bdl.headerRead {
+		_ = bdl.writer.abort(ctx)
+		return nil, pgerror.New(pgcode.BadCopyFileFormat, "COPY file signature not recognized")
+	}
+	if len(bdl.partialData) > 0 {
+		_ = bdl.writer.abort(ctx)
+		return nil, pgerror.New(pgcode.BadCopyFileFormat, "unexpected EOF in COPY data")
+	}
+
+	if err := bdl.writer.finish(ctx); err != nil {
+		return nil, err
+	}
+
+	return &bdl.results, nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataloader
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/server/types"
+)
+
+func TestBinaryDataLoader(t *testing.T) {
+	db := memory.NewDatabase("mydb")
+	provider := memory.NewDBProvider(db)
+
+	ctx := &sql.Context{
+		Context: context.Background(),
+		Session: memory.NewSession(sql.NewBaseSession(), provider),
+	}
+
+	pkSchema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Type: types.Int64, Source: "source1"},
+		{Name: "c1", Type: types.Int64, Source: "source1", Nullable: true},
+		{Name: "c2", Type: types.VarChar, Source: "source1", Nullable: true},
+	}, 0)
+
+	header := binaryCopyHeader(0, nil)
+	data := bytes.Join([][]byte{
+		header,
+		binaryCopyTuple(binaryInt64(1), binaryInt64(100), []byte("bar")),
+		binaryCopyTuple(binaryInt64(2), nil, []byte("")),
+		binaryCopyTuple(binaryInt64(3), binaryInt64(300), nil),
+		binaryCopyTrailer(),
+	}, nil)
+	expectedRows := [][]any{
+		{int64(1), int64(100), "bar"},
+		{int64(2), nil, ""},
+		{int64(3), int64(300), nil},
+	}
+
+	// Tests that a basic binary doc can be loaded as a single chunk.
+	t.Run("basic case", func(t *testing.T) {
+		table := memory.NewTable(db, "myTable", pkSchema, nil)
+		dataLoader, err := NewBinaryDataLoader(ctx, LoadTarget{Table: table})
+		require.NoError(t, err)
+
+		err = dataLoader.LoadChunk(ctx, bufio.NewReader(bytes.NewReader(data)))
+		require.NoError(t, err)
+		results, err := dataLoader.Finish(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, results.RowsLoaded)
+
+		assertRows(t, ctx, table, expectedRows)
+	})
+
+	// Tests that the data may be split at every possible point, including within the header, and that the partial
+	// data is buffered and prepended to the next chunk.
+	t.Run("data split across chunks", func(t *testing.T) {
+		for split := 1; split < len(data); split++ {
+			table := memory.NewTable(db, "myTable", pkSchema, nil)
+			dataLoader, err := NewBinaryDataLoader(ctx, LoadTarget{Table: table})
+			require.NoError(t, err)
+
+			err = dataLoader.LoadChunk(ctx, bufio.NewReader(bytes.NewReader(data[:split])))
+			require.NoError(t, err)
+			err = dataLoader.LoadChunk(ctx, bufio.NewReader(bytes.NewReader(data[split:])))
+			require.NoError(t, err)
+			results, err := dataLoader.Finish(ctx)
+			require.NoError(t, err)
+			require.EqualValues(t, 3, results.RowsLoaded)
+
+			assertRows(t, ctx, table, expectedRows)
+		}
+	})
+
+	// Tests that the header extension is skipped, and that the trailer is optional.
+	t.Run("header extension without trailer", func(t *testing.T) {
+		table := memory.NewTable(db, "myTable", pkSchema, nil)
+		dataLoader, err := NewBinaryDataLoader(ctx, LoadTarget{Table: table})
+		require.NoError(t, err)
+
+		err = dataLoader.LoadChunk(ctx, bufio.NewReader(bytes.NewReader(bytes.Join([][]byte{
+			binaryCopyHeader(0x0001, []byte("ignored")),
+			binaryCopyTuple(binaryInt64(1), binaryInt64(100), []byte("bar")),
+		}, nil))))
+		require.NoError(t, err)
+		results, err := dataLoader.Finish(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, results.RowsLoaded)
+
+		assertRows(t, ctx, table, [][]any{
+			{int64(1), int64(100), "bar"},
+		})
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		for _, test := range []struct {
+			name        string
+			data        []byte
+			expectedErr string
+		}{
+			{
+				name:        "bad signature",
+				data:        []byte("1\t100\tbar\n"),
+				expectedErr: "COPY file signature not recognized",
+			},
+			{
+				name:        "oids",
+				data:        binaryCopyHeader(1<<16, nil),
+				expectedErr: "invalid COPY file header (WITH OIDS)",
+			},
+			{
+				name:        "critical flags",
+				data:        binaryCopyHeader(1<<17, nil),
+				expectedErr: "unrecognized critical flags in COPY file header",
+			},
+			{
+				name:        "wrong field count",
+				data:        append(binaryCopyHeader(0, nil), binaryCopyTuple(binaryInt64(1))...),
+				expectedErr: "row field count is 1, expected 3",
+			},
+			{
+				name:        "wrong field length",
+				data:        append(binaryCopyHeader(0, nil), binaryCopyTuple([]byte{1}, binaryInt64(1), nil)...),
+				expectedErr: `invalid binary data for column "pk"`,
+			},
+			{
+				name:        "data after trailer",
+				data:        append(append(binaryCopyHeader(0, nil), binaryCopyTrailer()...), binaryCopyTrailer()...),
+				expectedErr: "received copy data after EOF marker",
+			},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				table := memory.NewTable(db, "myTable", pkSchema, nil)
+				dataLoader, err := NewBinaryDataLoader(ctx, LoadTarget{Table: table})
+				require.NoError(t, err)
+
+				err = dataLoader.LoadChunk(ctx, bufio.NewReader(bytes.NewReader(test.data)))
+				require.ErrorContains(t, err, test.expectedErr)
+				require.NoError(t, dataLoader.Abort(ctx))
+			})
+		}
+	})
+
+	// Tests that Finish returns an error when the last chunk ended with an incomplete tuple.
+	t.Run("incomplete tuple", func(t *testing.T) {
+		table := memory.NewTable(db, "myTable", pkSchema, nil)
+		dataLoader, err := NewBinaryDataLoader(ctx, LoadTarget{Table: table})
+		require.NoError(t, err)
+
+		err = dataLoader.LoadChunk(ctx, bufio.NewReader(bytes.NewReader(data[:len(header)+5])))
+		require.NoError(t, err)
+		_, err = dataLoader.Finish(ctx)
+		require.ErrorContains(t, err, "unexpected EOF in COPY data")
+	})
+
+	// Test that calling Abort() does not insert any data into the table.
+	t.Run("abort cancels data load", func(t *testing.T) {
+		table := memory.NewTable(db, "myTable", pkSchema, nil)
+		dataLoader, err := NewBinaryDataLoader(ctx, LoadTarget{Table: table})
+		require.NoError(t, err)
+
+		err = dataLoader.LoadChunk(ctx, bufio.NewReader(bytes.NewReader(data)))
+		require.NoError(t, err)
+		err = dataLoader.Abort(ctx)
+		require.NoError(t, err)
+
+		assertRows(t, ctx, table, [][]any{})
+	})
+}
+
+// binaryCopyHeader returns the header of the binary COPY format, using the given flags and header extension.
+func binaryCopyHeader(flags uint32, extension []byte) []byte {
+	header := append([]byte{}, binarySignature...)
+	header = binary.BigEndian.AppendUint32(header, flags)
+	header = binary.BigEndian.AppendUint32(header, uint32(len(extension)))
+	return append(header, extension...)
+}
+
+// binaryCopyTuple returns a tuple of the binary COPY format containing the given fields. A nil field represents NULL.
+func binaryCopyTuple(fields ...[]byte) []byte {
+	tuple := binary.BigEndian.AppendUint16(nil, uint16(len(fields)))
+	for _, field := range fields {
+		if field == nil {
+			tuple = binary.BigEndian.AppendUint32(tuple, 0xFFFFFFFF)
+			continue
+		}
+		tuple = binary.BigEndian.AppendUint32(tuple, uint32(len(field)))
+		tuple = append(tuple, field...)
+	}
+	return tuple
+}
+
+// binaryCopyTrailer returns the trailer of the binary COPY format.
+func binaryCopyTrailer() []byte {
+	return []byte{0xFF, 0xFF}
+}
+
+// binaryInt64 returns the binary representation of an int8 value.
+func binaryInt64(val int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(val))
+}
@@ -22,57 +22,96 @@ import (
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/table"
 	"github.com/dolthub/go-mysql-server/sql"
-	"github.com/sirupsen/logrus"
-
-	"github.com/dolthub/doltgresql/server/types"
 )
 
 // CsvDataLoader is an implementation of DataLoader that reads data from chunks of CSV files and inserts them into a table.
 type CsvDataLoader struct {
 	results       LoadDataResults
 	partialRecord string
-	rowInserter   sql.RowInserter
-	colTypes      []types.DoltgresType
-	sch           sql.Schema
+	writer        *rowWriter
 	removeHeader  bool
-	delimiter     string
+	readerOptions csvReaderOptions
 }
 
 var _ DataLoader = (*CsvDataLoader)(nil)
 
-const defaultCsvDelimiter = ","
+// CsvOptions contains the options for loading CSV data. Options with an empty value use the default of the CSV format.
+type CsvOptions struct {
+	// Delimiter separates the values of a record.
+	Delimiter string
+	// Null is the string that represents NULL. Defaults to the empty string.
+	Null string
+	// Quote is the character that begins and ends a quoted value.
+	Quote string
+	// Escape is the character that escapes a quote character within a quoted value. Defaults to the Quote character.
+	Escape string
+	// Header states whether the first line of the data is a header, which is ignored.
+	Header bool
+	// ForceNotNull contains the names of the columns whose values are never NULL, even when they match the Null string.
+	ForceNotNull []string
+	// ForceNull contains the names of the columns whose values are NULL when they match the Null string, even when
+	// the values are quoted.
+	ForceNull []string
+}
 
-// NewCsvDataLoader creates a new DataLoader instance that will insert records from chunks of CSV data into |table|. If
-// |header| is true, the first line of the data will be treated as a header and ignored. If |delimiter| is not the empty
-// string, it will be used as the delimiter separating value.
-func NewCsvDataLoader(ctx *sql.Context, table sql.InsertableTable, delimiter string, header bool) (*CsvDataLoader, error) {
-	colTypes, err := getColumnTypes(table.Schema())
+// NewCsvDataLoader creates a new DataLoader instance that will insert records from chunks of CSV data into |target|,
+// using the given |options| to parse the data.
+func NewCsvDataLoader(ctx *sql.Context, target LoadTarget, options CsvOptions) (*CsvDataLoader, error) {
+	writer, err := newRowWriter(ctx, target)
 	if err != nil {
 		return nil, err
 	}
 
-	rowInserter := table.Inserter(ctx)
-	rowInserter.StatementBegin(ctx)
-
-	if delimiter == "" {
-		delimiter = defaultCsvDelimiter
+	readerOptions := defaultCsvReaderOptions()
+	readerOptions.null = options.Null
+	if options.Delimiter != "" {
+		readerOptions.delimiter = options.Delimiter
+	}
+	if options.Quote != "" {
+		readerOptions.quote = options.Quote[0]
+		readerOptions.escape = options.Quote[0]
+	}
+	if options.Escape != "" {
+		readerOptions.escape = options.Escape[0]
+	}
+	readerOptions.forceNotNull, err = csvColumnIndexes(writer, "FORCE_NOT_NULL", options.ForceNotNull)
+	if err != nil {
+		_ = writer.abort(ctx)
+		return nil, err
+	}
+	readerOptions.forceNull, err = csvColumnIndexes(writer, "FORCE_NULL", options.ForceNull)
+	if err != nil {
+		_ = writer.abort(ctx)
+		return nil, err
 	}
 
 	return &CsvDataLoader{
-		rowInserter:  rowInserter,
-		colTypes:     colTypes,
-		sch:          table.Schema(),
-		removeHeader: header,
-		delimiter:    delimiter,
+		writer:        writer,
+		removeHeader:  options.Header,
+		readerOptions: readerOptions,
 	}, nil
 }
 
+// csvColumnIndexes returns the indexes of the record values that belong to the given columns. Returns an error if any
+// of the columns are not loaded.
+func csvColumnIndexes(writer *rowWriter, option string, columnNames []string) (map[int]struct{}, error) {
+	indexes := make(map[int]struct{}, len(columnNames))
+	for _, columnName := range columnNames {
+		idx := writer.columnIndex(columnName)
+		if idx == -1 {
+			return nil, fmt.Errorf(`%s column "%s" not referenced by COPY`, option, columnName)
+		}
+		indexes[idx] = struct{}{}
+	}
+	return indexes, nil
+}
+
 // LoadChunk implements the DataLoader interface
 func (cdl *CsvDataLoader) LoadChunk(ctx *sql.Context, data *bufio.Reader) error {
 	combinedReader := newStringPrefixReader(cdl.partialRecord, data)
 	cdl.partialRecord = ""
 
-	reader, err := newCsvReaderWithDelimiter(combinedReader, cdl.delimiter)
+	reader, err := newCsvReaderWithOptions(combinedReader, cdl.readerOptions)
 	if err != nil {
 		return err
 	}
@@ -122,19 +161,19 @@ func (cdl *CsvDataLoader) LoadChunk(ctx *sql.Context, data *bufio.Reader) error
 			break
 		}
 
-		if len(record) > len(cdl.colTypes) {
+		if len(record) > len(cdl.writer.colTypes) {
 			return fmt.Errorf("extra data after last expected column")
-		} else if len(record) < len(cdl.colTypes) {
-			return fmt.Errorf(`missing data for column "%s"`, cdl.sch[len(record)].Name)
+		} else if len(record) < len(cdl.writer.colTypes) {
+			return fmt.Errorf(`missing data for column "%s"`, cdl.writer.columnName(len(record)))
 		}
 
 		// Cast the values using I/O input
-		row := make(sql.Row, len(cdl.colTypes))
-		for i := range cdl.colTypes {
+		row := make(sql.Row, len(cdl.writer.colTypes))
+		for i, colType := range cdl.writer.colTypes {
 			if record[i] == nil {
 				row[i] = nil
 			} else {
-				row[i], err = cdl.colTypes[i].IoInput(ctx, fmt.Sprintf("%v", record[i]))
+				row[i], err = colType.IoInput(ctx, fmt.Sprintf("%v", record[i]))
 				if err != nil {
 					return err
 				}
@@ -142,10 +181,13 @@ func (cdl *CsvDataLoader) LoadChunk(ctx *sql.Context, data *bufio.Reader) error
 		}
 
 		// Insert the row
-		if err = cdl.rowInserter.Insert(ctx, row); err != nil {
+		inserted, err := cdl.writer.insert(ctx, row)
+		if err != nil {
 			return err
 		}
-		cdl.results.RowsLoaded += 1
+		if inserted {
+			cdl.results.RowsLoaded += 1
+		}
 	}
 
 	return nil
@@ -153,31 +195,18 @@ func (cdl *CsvDataLoader) LoadChunk(ctx *sql.Context, data *bufio.Reader) error
 
 // Abort implements the DataLoader interface
 func (cdl *CsvDataLoader) Abort(ctx *sql.Context) error {
-	defer func() {
-		if closeErr := cdl.rowInserter.Close(ctx); closeErr != nil {
-			logrus.Warnf("error closing rowInserter: %v", closeErr)
-		}
-	}()
-
-	return cdl.rowInserter.DiscardChanges(ctx, nil)
+	return cdl.writer.abort(ctx)
 }
 
 // Finish implements the DataLoader interface
 func (cdl *CsvDataLoader) Finish(ctx *sql.Context) (*LoadDataResults, error) {
-	defer func() {
-		if closeErr := cdl.rowInserter.Close(ctx); closeErr != nil {
-			logrus.Warnf("error closing rowInserter: %v", closeErr)
-		}
-	}()
-
 	// If there is partial data from the last chunk that hasn't been inserted, return an error.
 	if cdl.partialRecord != "" {
+		_ = cdl.writer.abort(ctx)
 		return nil, fmt.Errorf("partial record (%s) found at end of data load", cdl.partialRecord)
 	}
 
-	err := cdl.rowInserter.StatementComplete(ctx)
-	if err != nil {
-		err = cdl.rowInserter.DiscardChanges(ctx, err)
+	if err := cdl.writer.finish(ctx); err != nil {
 		return nil, err
 	}
 
@@ -47,7 +47,7 @@ func TestCsvDataLoader(t *testing.T) {
 	// Tests that a basic CSV document can be loaded as a single chunk.
 	t.Run("basic case", func(t *testing.T) {
 		table := memory.NewTable(db, "myTable", pkSchema, nil)
-		dataLoader, err := NewCsvDataLoader(ctx, table, ",", false)
+		dataLoader, err := NewCsvDataLoader(ctx, LoadTarget{Table: table}, CsvOptions{Delimiter: ",", Header: false})
 		require.NoError(t, err)
 
 		// Load all the data as a single chunk
@@ -69,7 +69,7 @@ func TestCsvDataLoader(t *testing.T) {
 	// partial record must be buffered and prepended to the next chunk.
 	t.Run("record split across two chunks", func(t *testing.T) {
 		table := memory.NewTable(db, "myTable", pkSchema, nil)
-		dataLoader, err := NewCsvDataLoader(ctx, table, ",", false)
+		dataLoader, err := NewCsvDataLoader(ctx, LoadTarget{Table: table}, CsvOptions{Delimiter: ",", Header: false})
 		require.NoError(t, err)
 
 		// Load the first chunk
@@ -98,7 +98,7 @@ func TestCsvDataLoader(t *testing.T) {
 	// header row is present.
 	t.Run("record split across two chunks, with header", func(t *testing.T) {
 		table := memory.NewTable(db, "myTable", pkSchema, nil)
-		dataLoader, err := NewCsvDataLoader(ctx, table, ",", true)
+		dataLoader, err := NewCsvDataLoader(ctx, LoadTarget{Table: table}, CsvOptions{Delimiter: ",", Header: true})
 		require.NoError(t, err)
 
 		// Load the first chunk
@@ -127,7 +127,7 @@ func TestCsvDataLoader(t *testing.T) {
 	// across two chunks.
 	t.Run("quoted newlines across two chunks", func(t *testing.T) {
 		table := memory.NewTable(db, "myTable", pkSchema, nil)
-		dataLoader, err := NewCsvDataLoader(ctx, table, ",", false)
+		dataLoader, err := NewCsvDataLoader(ctx, LoadTarget{Table: table}, CsvOptions{Delimiter: ",", Header: false})
 		require.NoError(t, err)
 
 		// Load the first chunk
@@ -155,7 +155,7 @@ func TestCsvDataLoader(t *testing.T) {
 	// Test that calling Abort() does not insert any data into the table.
 	t.Run("abort cancels data load", func(t *testing.T) {
 		table := memory.NewTable(db, "myTable", pkSchema, nil)
-		dataLoader, err := NewCsvDataLoader(ctx, table, ",", false)
+		dataLoader, err := NewCsvDataLoader(ctx, LoadTarget{Table: table}, CsvOptions{Delimiter: ",", Header: false})
 		require.NoError(t, err)
 
 		// Load the first chunk
@@ -180,7 +180,7 @@ func TestCsvDataLoader(t *testing.T) {
 	// and a header row is present.
 	t.Run("delimiter='|', record split across two chunks, with header", func(t *testing.T) {
 		table := memory.NewTable(db, "myTable", pkSchema, nil)
-		dataLoader, err := NewCsvDataLoader(ctx, table, "|", true)
+		dataLoader, err := NewCsvDataLoader(ctx, LoadTarget{Table: table}, CsvOptions{Delimiter: "|", Header: true})
 		require.NoError(t, err)
 
 		// Load the first chunk
@@ -204,6 +204,45 @@ func TestCsvDataLoader(t *testing.T) {
 			{int64(2), int64(200), "bash"},
 		})
 	})
+
+	// Tests the QUOTE, ESCAPE, NULL, FORCE_NOT_NULL, and FORCE_NULL options.
+	t.Run("quote, escape, and null options", func(t *testing.T) {
+		nullableSchema := sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "pk", Type: types.Int64, Source: "source1"},
+			{Name: "c1", Type: types.VarChar, Source: "source1", Nullable: true},
+			{Name: "c2", Type: types.VarChar, Source: "source1", Nullable: true},
+		}, 0)
+		table := memory.NewTable(db, "nullableTable", nullableSchema, nil)
+		dataLoader, err := NewCsvDataLoader(ctx, LoadTarget{Table: table}, CsvOptions{
+			Delimiter:    ";",
+			Null:         "N",
+			Quote:        "'",
+			Escape:       "\\",
+			ForceNotNull: []string{"c1"},
+			ForceNull:    []string{"c2"},
+		})
+		require.NoError(t, err)
+
+		reader := bytes.NewReader([]byte("1;N;'N'\n2;'a;\\'b';'\\\\'\n3;'N';N\n"))
+		err = dataLoader.LoadChunk(ctx, bufio.NewReader(reader))
+		require.NoError(t, err)
+		results, err := dataLoader.Finish(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, results.RowsLoaded)
+
+		assertRows(t, ctx, table, [][]any{
+			{int64(1), "N", nil},
+			{int64(2), "a;'b", "\\"},
+			{int64(3), "N", nil},
+		})
+	})
+
+	// Tests that the FORCE_NOT_NULL and FORCE_NULL options must reference loaded columns.
+	t.Run("force column not loaded", func(t *testing.T) {
+		table := memory.NewTable(db, "myTable", pkSchema, nil)
+		_, err := NewCsvDataLoader(ctx, LoadTarget{Table: table, Columns: []int{0, 1}}, CsvOptions{ForceNull: []string{"c2"}})
+		require.ErrorContains(t, err, `FORCE_NULL column "c2" not referenced by COPY`)
+	})
 }
 
 // assertRows asserts that the rows in |table| match |expectedRows| and fails the test if the
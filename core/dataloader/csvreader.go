@@ -63,10 +63,41 @@ type csvReader struct {
 	bRd             *bufio.Reader
 	isDone          bool
 	delim           []byte
+	quote           byte
+	escape          byte
+	null            string
+	forceNotNull    map[int]struct{}
+	forceNull       map[int]struct{}
 	numLine         int
 	fieldsPerRecord int
 }
 
+// csvReaderOptions contains the options that control how a csvReader parses CSV data.
+type csvReaderOptions struct {
+	// delimiter separates the fields of a record.
+	delimiter string
+	// quote is the character that begins and ends a quoted field.
+	quote byte
+	// escape is the character that escapes a quote character within a quoted field.
+	escape byte
+	// null is the string that represents NULL when it is not quoted.
+	null string
+	// forceNotNull contains the indexes of the fields that are never NULL, even when they match the null string.
+	forceNotNull map[int]struct{}
+	// forceNull contains the indexes of the fields that are NULL when they match the null string, even when quoted.
+	forceNull map[int]struct{}
+}
+
+// defaultCsvReaderOptions returns the default options of the CSV format.
+func defaultCsvReaderOptions() csvReaderOptions {
+	return csvReaderOptions{
+		delimiter: ",",
+		quote:     '"',
+		escape:    '"',
+		null:      "",
+	}
+}
+
 // newCsvReader creates a csvReader from a given ReadCloser.
 //
 // The interpretation of the bytes of the supplied reader is a little murky. If
@@ -76,20 +107,25 @@ type csvReader struct {
 // bytes go uninterpreted until we get to the SQL layer. It is currently the
 // case that newlines must be encoded as a '0xa' byte.
 func newCsvReader(r io.ReadCloser) (*csvReader, error) {
-	return newCsvReaderWithDelimiter(r, ",")
+	return newCsvReaderWithOptions(r, defaultCsvReaderOptions())
 }
 
-// newCsvReaderWithDelimiter creates a csvReader from a given ReadCloser, |r|, using
-// the |delimiter| as the field delimiter in the parsed data.
-func newCsvReaderWithDelimiter(r io.ReadCloser, delimiter string) (*csvReader, error) {
+// newCsvReaderWithOptions creates a csvReader from a given ReadCloser, |r|, using
+// the given |options| to parse the data.
+func newCsvReaderWithOptions(r io.ReadCloser, options csvReaderOptions) (*csvReader, error) {
 	textReader := transform.NewReader(r, textunicode.BOMOverride(transform.Nop))
 	br := bufio.NewReaderSize(textReader, csvReadBufSize)
 
 	return &csvReader{
-		closer: r,
-		bRd:    br,
-		isDone: false,
-		delim:  []byte(delimiter),
+		closer:       r,
+		bRd:          br,
+		isDone:       false,
+		delim:        []byte(options.delimiter),
+		quote:        options.quote,
+		escape:       options.escape,
+		null:         options.null,
+		forceNotNull: options.forceNotNull,
+		forceNull:    options.forceNull,
 	}, nil
 }
 
@@ -217,21 +253,18 @@ func (csvr *csvReader) csvReadRecords(dst []*string) ([]*string, error) {
 		return nil, err
 	}
 
-	// nullString indicates whether to interpret an empty string as a NULL
-	// only empty strings escaped with double quotes will be non-null
-	nullString := make(map[int]bool)
+	// quotedFields records which fields were quoted, since only unquoted fields that match the null string are
+	// interpreted as NULL by default
+	quotedFields := make(map[int]bool)
 	fieldIdx := 0
 
 	kontinue := true
 	for kontinue {
 		// Parse each field in the record.
-		keep := true
-		if len(rs.line) == 0 || rs.line[0] != '"' {
-			kontinue, keep, err = csvr.parseField(&rs)
-			if !keep {
-				nullString[fieldIdx] = true
-			}
+		if len(rs.line) == 0 || rs.line[0] != csvr.quote {
+			kontinue, err = csvr.parseField(&rs)
 		} else {
+			quotedFields[fieldIdx] = true
 			kontinue, err = csvr.parseQuotedField(&rs)
 			if err != nil {
 				return nil, err
@@ -250,11 +283,10 @@ func (csvr *csvReader) csvReadRecords(dst []*string) ([]*string, error) {
 	dst = dst[:len(rs.fieldIndexes)]
 	var preIdx int
 	for i, idx := range rs.fieldIndexes {
-		_, ok := nullString[i]
-		if ok {
+		s := str[preIdx:idx]
+		if csvr.isNull(i, s, quotedFields[i]) {
 			dst[i] = nil
 		} else {
-			s := str[preIdx:idx]
 			dst[i] = &s
 		}
 		preIdx = idx
@@ -272,7 +304,22 @@ func (csvr *csvReader) csvReadRecords(dst []*string) ([]*string, error) {
 	return dst, err
 }
 
-func (csvr *csvReader) parseField(rs *recordState) (kontinue bool, keep bool, err error) {
+// isNull returns whether the field at |idx| with the value |s| represents NULL. By default, only unquoted values that
+// match the null string are NULL, which may be changed for specific fields using the FORCE_NOT_NULL and FORCE_NULL
+// options.
+func (csvr *csvReader) isNull(idx int, s string, quoted bool) bool {
+	if s != csvr.null {
+		return false
+	}
+	if quoted {
+		_, ok := csvr.forceNull[idx]
+		return ok
+	}
+	_, ok := csvr.forceNotNull[idx]
+	return !ok
+}
+
+func (csvr *csvReader) parseField(rs *recordState) (kontinue bool, err error) {
 	i := bytes.Index(rs.line, csvr.delim)
 	field := rs.line
 	if i >= 0 {
@@ -282,17 +329,16 @@ func (csvr *csvReader) parseField(rs *recordState) (kontinue bool, keep bool, er
 	}
 	rs.recordBuffer = append(rs.recordBuffer, field...)
 	rs.fieldIndexes = append(rs.fieldIndexes, len(rs.recordBuffer))
-	keep = len(field) != 0 // discard unquoted empty strings
 	if i >= 0 {
 		dl := len(csvr.delim)
 		rs.line = rs.line[i+dl:]
-		return true, keep, err
+		return true, err
 	}
-	return false, keep, err
+	return false, err
 }
 
 func (csvr *csvReader) parseQuotedField(rs *recordState) (kontinue bool, err error) {
-	const quoteLen = len(`"`)
+	const quoteLen = 1
 	dl := len(csvr.delim)
 	recordStartLine := csvr.numLine
 	fullField := rs.line
@@ -300,14 +346,23 @@ func (csvr *csvReader) parseQuotedField(rs *recordState) (kontinue bool, err err
 	// Quoted string field
 	rs.line = rs.line[quoteLen:]
 	for {
-		i := bytes.IndexByte(rs.line, '"')
-		if i >= 0 {
+		i := csvr.indexQuoteOrEscape(rs.line)
+		if i >= 0 && rs.line[i] != csvr.quote {
+			// Hit an escape character, which only escapes a following quote or escape character
+			rs.recordBuffer = append(rs.recordBuffer, rs.line[:i]...)
+			rs.line = rs.line[i+1:]
+			if len(rs.line) > 0 && (rs.line[0] == csvr.quote || rs.line[0] == csvr.escape) {
+				rs.recordBuffer = append(rs.recordBuffer, rs.line[0])
+				rs.line = rs.line[1:]
+			} else {
+				rs.recordBuffer = append(rs.recordBuffer, csvr.escape)
+			}
+		} else if i >= 0 {
 			// Hit next quote.
 			rs.recordBuffer = append(rs.recordBuffer, rs.line[:i]...)
 			rs.line = rs.line[i+quoteLen:]
 
 			atDelimiter := len(rs.line) >= dl && bytes.Equal(rs.line[:dl], csvr.delim)
-			nextRune, _ := utf8.DecodeRune(rs.line)
 
 			switch {
 			case atDelimiter:
@@ -315,9 +370,9 @@ func (csvr *csvReader) parseQuotedField(rs *recordState) (kontinue bool, err err
 				rs.line = rs.line[dl:]
 				rs.fieldIndexes = append(rs.fieldIndexes, len(rs.recordBuffer))
 				return true, err
-			case nextRune == '"':
+			case csvr.escape == csvr.quote && len(rs.line) > 0 && rs.line[0] == csvr.quote:
 				// `""` sequence (append quote).
-				rs.recordBuffer = append(rs.recordBuffer, '"')
+				rs.recordBuffer = append(rs.recordBuffer, csvr.quote)
 				rs.line = rs.line[quoteLen:]
 			case lengthNL(rs.line) == len(rs.line):
 				// `"\n` sequence (end of line).
@@ -359,3 +414,13 @@ func (csvr *csvReader) parseQuotedField(rs *recordState) (kontinue bool, err err
 		}
 	}
 }
+
+// indexQuoteOrEscape returns the index of the first quote or escape character in |line|, or -1 if neither are present.
+func (csvr *csvReader) indexQuoteOrEscape(line []byte) int {
+	for i, c := range line {
+		if c == csvr.quote || c == csvr.escape {
+			return i
+		}
+	}
+	return -1
+}
@@ -19,7 +19,10 @@ import (
 	"fmt"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/sirupsen/logrus"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
 	"github.com/dolthub/doltgresql/server/types"
 )
 
@@ -49,6 +52,146 @@ type LoadDataResults struct {
 	RowsLoaded int32
 }
 
+// LoadTarget describes how the records read by a DataLoader are inserted into a table.
+type LoadTarget struct {
+	// Table is the table that rows are inserted into.
+	Table sql.InsertableTable
+	// Schema is the schema of the Table, with its column defaults resolved so that they may be evaluated. If nil, the
+	// Table's schema is used as-is.
+	Schema sql.Schema
+	// Columns contains the index within the Schema of each value in a record. If nil, records contain a value for every
+	// column in the Schema. Columns that are not loaded are set to their default value.
+	Columns []int
+	// Where filters the rows that are inserted, as only rows for which it evaluates to true are inserted. It is
+	// evaluated against the full row, after the defaults have been applied. If nil, every row is inserted.
+	Where sql.Expression
+}
+
+// rowWriter handles inserting the records read by a DataLoader into the table described by a LoadTarget.
+type rowWriter struct {
+	rowInserter sql.RowInserter
+	sch         sql.Schema
+	columns     []int
+	colTypes    []types.DoltgresType
+	where       sql.Expression
+	tableName   string
+}
+
+// newRowWriter creates a new rowWriter for the given |target|, and begins the insert statement.
+func newRowWriter(ctx *sql.Context, target LoadTarget) (*rowWriter, error) {
+	sch := target.Schema
+	if sch == nil {
+		sch = target.Table.Schema()
+	}
+	columns := target.Columns
+	if columns == nil {
+		columns = make([]int, len(sch))
+		for i := range columns {
+			columns[i] = i
+		}
+	}
+	loadedSch := make(sql.Schema, len(columns))
+	for i, columnIdx := range columns {
+		loadedSch[i] = sch[columnIdx]
+	}
+	colTypes, err := getColumnTypes(loadedSch)
+	if err != nil {
+		return nil, err
+	}
+
+	rowInserter := target.Table.Inserter(ctx)
+	rowInserter.StatementBegin(ctx)
+
+	return &rowWriter{
+		rowInserter: rowInserter,
+		sch:         sch,
+		columns:     columns,
+		colTypes:    colTypes,
+		where:       target.Where,
+		tableName:   target.Table.Name(),
+	}, nil
+}
+
+// columnName returns the name of the column that the record value at |idx| belongs to.
+func (rw *rowWriter) columnName(idx int) string {
+	return rw.sch[rw.columns[idx]].Name
+}
+
+// columnIndex returns the index of the record value that belongs to the column with the given name. Returns -1 if the
+// column is not loaded.
+func (rw *rowWriter) columnIndex(name string) int {
+	for i := range rw.columns {
+		if rw.columnName(i) == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// insert inserts the row containing the record's |values|, which are in the order of the loaded columns. Returns
+// whether the row was inserted, as rows that do not match the WHERE expression are skipped.
+func (rw *rowWriter) insert(ctx *sql.Context, values sql.Row) (bool, error) {
+	row := make(sql.Row, len(rw.sch))
+	loaded := make([]bool, len(rw.sch))
+	for i, columnIdx := range rw.columns {
+		row[columnIdx] = values[i]
+		loaded[columnIdx] = true
+	}
+	for i, col := range rw.sch {
+		if loaded[i] || col.Default == nil {
+			continue
+		}
+		var err error
+		row[i], err = col.Default.Eval(ctx, row)
+		if err != nil {
+			return false, err
+		}
+	}
+	if rw.where != nil {
+		matches, err := sql.EvaluateCondition(ctx, rw.where, row)
+		if err != nil {
+			return false, err
+		}
+		if !sql.IsTrue(matches) {
+			return false, nil
+		}
+	}
+	for i, col := range rw.sch {
+		if row[i] == nil && !col.Nullable {
+			return false, pgerror.Newf(pgcode.NotNullViolation, `null value in column "%s" of relation "%s" violates not-null constraint`, col.Name, rw.tableName)
+		}
+	}
+	return true, rw.rowInserter.Insert(ctx, row)
+}
+
+// abort discards all inserted rows.
+func (rw *rowWriter) abort(ctx *sql.Context) error {
+	defer func() {
+		if closeErr := rw.rowInserter.Close(ctx); closeErr != nil {
+			logrus.Warnf("error closing rowInserter: %v", closeErr)
+		}
+	}()
+
+	return rw.rowInserter.DiscardChanges(ctx, nil)
+}
+
+// finish completes the insert statement, discarding all inserted rows if the statement could not be completed.
+func (rw *rowWriter) finish(ctx *sql.Context) error {
+	defer func() {
+		if closeErr := rw.rowInserter.Close(ctx); closeErr != nil {
+			logrus.Warnf("error closing rowInserter: %v", closeErr)
+		}
+	}()
+
+	if err := rw.rowInserter.StatementComplete(ctx); err != nil {
+		if discardErr := rw.rowInserter.DiscardChanges(ctx, err); discardErr != nil {
+			logrus.Warnf("error discarding changes: %v", discardErr)
+		}
+		return err
+	}
+	return nil
+}
+
 // getColumnTypes examines |sch| and returns a slice of DoltgresTypes in the order of the schema's columns. If any
 // columns in the schema are not DoltgresType instances, an error is returned.
 func getColumnTypes(sch sql.Schema) ([]types.DoltgresType, error) {
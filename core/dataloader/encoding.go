@@ -0,0 +1,163 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataloader
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	"golang.org/x/text/transform"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+)
+
+// encodings maps the names of the Postgres client encodings to their implementation. A nil encoding means that no
+// conversion is needed, as the data is already compatible with the server's UTF8 encoding.
+var encodings = map[string]encoding.Encoding{
+	"UTF8":       nil,
+	"UNICODE":    nil,
+	"SQL_ASCII":  nil,
+	"LATIN1":     charmap.ISO8859_1,
+	"LATIN2":     charmap.ISO8859_2,
+	"LATIN3":     charmap.ISO8859_3,
+	"LATIN4":     charmap.ISO8859_4,
+	"LATIN5":     charmap.ISO8859_9,
+	"LATIN6":     charmap.ISO8859_10,
+	"LATIN7":     charmap.ISO8859_13,
+	"LATIN8":     charmap.ISO8859_14,
+	"LATIN9":     charmap.ISO8859_15,
+	"LATIN10":    charmap.ISO8859_16,
+	"ISO_8859_5": charmap.ISO8859_5,
+	"ISO_8859_6": charmap.ISO8859_6,
+	"ISO_8859_7": charmap.ISO8859_7,
+	"ISO_8859_8": charmap.ISO8859_8,
+	"KOI8R":      charmap.KOI8R,
+	"KOI8U":      charmap.KOI8U,
+	"WIN866":     charmap.CodePage866,
+	"WIN874":     charmap.Windows874,
+	"WIN1250":    charmap.Windows1250,
+	"WIN1251":    charmap.Windows1251,
+	"WIN1252":    charmap.Windows1252,
+	"WIN1253":    charmap.Windows1253,
+	"WIN1254":    charmap.Windows1254,
+	"WIN1255":    charmap.Windows1255,
+	"WIN1256":    charmap.Windows1256,
+	"WIN1257":    charmap.Windows1257,
+	"WIN1258":    charmap.Windows1258,
+	"EUC_JP":     japanese.EUCJP,
+	"SJIS":       japanese.ShiftJIS,
+	"EUC_KR":     korean.EUCKR,
+	"GBK":        simplifiedchinese.GBK,
+	"GB18030":    simplifiedchinese.GB18030,
+	"BIG5":       traditionalchinese.Big5,
+}
+
+// LookupEncoding returns the encoding with the given name. Names are matched the same way as Postgres, so case,
+// dashes, and underscores are ignored (e.g. "utf-8" matches "UTF8"). Returns a nil encoding when the data does not
+// need to be converted.
+func LookupEncoding(name string) (encoding.Encoding, error) {
+	normalize := func(s string) string {
+		return strings.NewReplacer("-", "", "_", "").Replace(strings.ToUpper(s))
+	}
+	normalizedName := normalize(name)
+	for encodingName, enc := range encodings {
+		if normalize(encodingName) == normalizedName {
+			return enc, nil
+		}
+	}
+	return nil, pgerror.New(pgcode.InvalidParameterValue, `argument to option "encoding" must be a valid encoding name`)
+}
+
+// encodingDataLoader is a DataLoader that converts data from another encoding to UTF8, before passing it to the
+// wrapped DataLoader.
+type encodingDataLoader struct {
+	loader      DataLoader
+	decoder     transform.Transformer
+	partialData []byte
+}
+
+var _ DataLoader = (*encodingDataLoader)(nil)
+
+// NewEncodingDataLoader returns a DataLoader that converts all data from the encoding with the given name into UTF8
+// before loading it with |loader|. Returns |loader| if the data does not need to be converted.
+func NewEncodingDataLoader(loader DataLoader, encodingName string) (DataLoader, error) {
+	enc, err := LookupEncoding(encodingName)
+	if err != nil {
+		return nil, err
+	}
+	if enc == nil {
+		return loader, nil
+	}
+	return &encodingDataLoader{
+		loader:  loader,
+		decoder: enc.NewDecoder(),
+	}, nil
+}
+
+// LoadChunk implements the DataLoader interface. Multibyte characters that are split across chunks are saved and
+// prepended to the next chunk.
+func (edl *encodingDataLoader) LoadChunk(ctx *sql.Context, data *bufio.Reader) error {
+	chunk, err := io.ReadAll(data)
+	if err != nil {
+		return err
+	}
+	src := append(edl.partialData, chunk...)
+	edl.partialData = nil
+
+	dst := make([]byte, len(src)*2+utf8BufferPadding)
+	nDst := 0
+	for len(src) > 0 {
+		n, nSrc, err := edl.decoder.Transform(dst[nDst:], src, false)
+		nDst += n
+		src = src[nSrc:]
+		if err == transform.ErrShortDst {
+			dst = append(dst, make([]byte, len(dst))...)
+		} else if err == transform.ErrShortSrc {
+			edl.partialData = append([]byte{}, src...)
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	return edl.loader.LoadChunk(ctx, bufio.NewReader(bytes.NewReader(dst[:nDst])))
+}
+
+// Abort implements the DataLoader interface.
+func (edl *encodingDataLoader) Abort(ctx *sql.Context) error {
+	return edl.loader.Abort(ctx)
+}
+
+// Finish implements the DataLoader interface.
+func (edl *encodingDataLoader) Finish(ctx *sql.Context) (*LoadDataResults, error) {
+	if len(edl.partialData) > 0 {
+		_ = edl.loader.Abort(ctx)
+		return nil, pgerror.New(pgcode.CharacterNotInRepertoire, "incomplete multibyte character at end of data")
+	}
+	return edl.loader.Finish(ctx)
+}
+
+// utf8BufferPadding is added to the size of the buffer that converted data is written to, so that small chunks always
+// have room for at least one converted character.
+const utf8BufferPadding = 16
@@ -21,9 +21,6 @@ import (
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
-	"github.com/sirupsen/logrus"
-
-	"github.com/dolthub/doltgresql/server/types"
 )
 
 const defaultTextDelimiter = "\t"
@@ -33,9 +30,7 @@ const defaultNullChar = "\\N"
 type TabularDataLoader struct {
 	results       LoadDataResults
 	partialLine   string
-	rowInserter   sql.RowInserter
-	colTypes      []types.DoltgresType
-	sch           sql.Schema
+	writer        *rowWriter
 	delimiterChar string
 	nullChar      string
 	removeHeader  bool
@@ -43,32 +38,28 @@ type TabularDataLoader struct {
 
 var _ DataLoader = (*TabularDataLoader)(nil)
 
-// NewTabularDataLoader creates a new TabularDataLoader to insert into the specifeid |table| using the specified
-// |delimiterChar| and |nullChar|. If |header| is true, the first line of the data will be treated as a header and
-// ignored.
-func NewTabularDataLoader(ctx *sql.Context, table sql.InsertableTable, delimiterChar, nullChar string, header bool) (*TabularDataLoader, error) {
-	colTypes, err := getColumnTypes(table.Schema())
+// NewTabularDataLoader creates a new TabularDataLoader to insert into the specified |target| using the specified
+// |delimiterChar| and |nullChar|. A nil |nullChar| uses the default of \N, while an empty one means that empty values
+// are NULL. If |header| is true, the first line of the data will be treated as a header and ignored.
+func NewTabularDataLoader(ctx *sql.Context, target LoadTarget, delimiterChar string, nullChar *string, header bool) (*TabularDataLoader, error) {
+	writer, err := newRowWriter(ctx, target)
 	if err != nil {
 		return nil, err
 	}
 
-	rowInserter := table.Inserter(ctx)
-	rowInserter.StatementBegin(ctx)
-
 	if delimiterChar == "" {
 		delimiterChar = defaultTextDelimiter
 	}
 
-	if nullChar == "" {
-		nullChar = defaultNullChar
+	null := defaultNullChar
+	if nullChar != nil {
+		null = *nullChar
 	}
 
 	return &TabularDataLoader{
-		rowInserter:   rowInserter,
-		colTypes:      colTypes,
-		sch:           table.Schema(),
+		writer:        writer,
 		delimiterChar: delimiterChar,
-		nullChar:      nullChar,
+		nullChar:      null,
 		removeHeader:  header,
 	}, nil
 }
@@ -78,7 +69,7 @@ func NewTabularDataLoader(ctx *sql.Context, table sql.InsertableTable, delimiter
 // by saving them for the next chunk.
 func (tdl *TabularDataLoader) LoadChunk(ctx *sql.Context, data *bufio.Reader) error {
 	for {
-		row := make(sql.Row, len(tdl.colTypes))
+		row := make(sql.Row, len(tdl.writer.colTypes))
 
 		// Read the next line from the file
 		line, err := data.ReadString('\n')
@@ -122,28 +113,30 @@ func (tdl *TabularDataLoader) LoadChunk(ctx *sql.Context, data *bufio.Reader) er
 		}
 		// Split the values by the delimiter, ensuring the correct number of values have been read
 		values := strings.Split(line, tdl.delimiterChar)
-		if len(values) > len(tdl.colTypes) {
+		if len(values) > len(tdl.writer.colTypes) {
 			return fmt.Errorf("extra data after last expected column")
-		} else if len(values) < len(tdl.colTypes) {
-			return fmt.Errorf(`missing data for column "%s"`, tdl.sch[len(values)].Name)
+		} else if len(values) < len(tdl.writer.colTypes) {
+			return fmt.Errorf(`missing data for column "%s"`, tdl.writer.columnName(len(values)))
 		}
 		// Cast the values using I/O input
-		for i := range tdl.colTypes {
+		for i, colType := range tdl.writer.colTypes {
 			if values[i] == tdl.nullChar {
 				row[i] = nil
 			} else {
-				row[i], err = tdl.colTypes[i].IoInput(ctx, values[i])
+				row[i], err = colType.IoInput(ctx, values[i])
 				if err != nil {
 					return err
 				}
 			}
 		}
 		// Insert the read row
-		err = tdl.rowInserter.Insert(ctx, row)
+		inserted, err := tdl.writer.insert(ctx, row)
 		if err != nil {
 			return err
 		}
-		tdl.results.RowsLoaded += 1
+		if inserted {
+			tdl.results.RowsLoaded += 1
+		}
 	}
 
 	return nil
@@ -151,31 +144,18 @@ func (tdl *TabularDataLoader) LoadChunk(ctx *sql.Context, data *bufio.Reader) er
 
 // Abort ends the current load data operation and discards any changes that have been made.
 func (tdl *TabularDataLoader) Abort(ctx *sql.Context) error {
-	defer func() {
-		if closeErr := tdl.rowInserter.Close(ctx); closeErr != nil {
-			logrus.Warnf("error closing rowInserter: %v", closeErr)
-		}
-	}()
-
-	return tdl.rowInserter.DiscardChanges(ctx, nil)
+	return tdl.writer.abort(ctx)
 }
 
 // Finish completes the current load data operation and finalizes the data that has been inserted.
 func (tdl *TabularDataLoader) Finish(ctx *sql.Context) (*LoadDataResults, error) {
-	defer func() {
-		if closeErr := tdl.rowInserter.Close(ctx); closeErr != nil {
-			logrus.Warnf("error closing rowInserter: %v", closeErr)
-		}
-	}()
-
 	// If there is partial data from the last chunk that hasn't been inserted, return an error.
 	if tdl.partialLine != "" {
+		_ = tdl.writer.abort(ctx)
 		return nil, fmt.Errorf("partial line found at end of data load")
 	}
 
-	err := tdl.rowInserter.StatementComplete(ctx)
-	if err != nil {
-		err = tdl.rowInserter.DiscardChanges(ctx, err)
+	if err := tdl.writer.finish(ctx); err != nil {
 		return nil, err
 	}
 
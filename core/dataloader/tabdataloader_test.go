@@ -22,6 +22,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/doltgresql/server/types"
@@ -45,7 +46,7 @@ func TestTabDataLoader(t *testing.T) {
 	// Tests that a basic tab delimited doc can be loaded as a single chunk.
 	t.Run("basic case", func(t *testing.T) {
 		table := memory.NewTable(db, "myTable", pkSchema, nil)
-		dataLoader, err := NewTabularDataLoader(ctx, table, "\t", "\\N", false)
+		dataLoader, err := NewTabularDataLoader(ctx, LoadTarget{Table: table}, "\t", nil, false)
 		require.NoError(t, err)
 
 		// Load all the data as a single chunk
@@ -67,7 +68,7 @@ func TestTabDataLoader(t *testing.T) {
 	// partial record must be buffered and prepended to the next chunk.
 	t.Run("record split across two chunks", func(t *testing.T) {
 		table := memory.NewTable(db, "myTable", pkSchema, nil)
-		dataLoader, err := NewTabularDataLoader(ctx, table, "\t", "\\N", false)
+		dataLoader, err := NewTabularDataLoader(ctx, LoadTarget{Table: table}, "\t", nil, false)
 		require.NoError(t, err)
 
 		// Load the first chunk
@@ -96,7 +97,7 @@ func TestTabDataLoader(t *testing.T) {
 	// header row is present.
 	t.Run("record split across two chunks, with header", func(t *testing.T) {
 		table := memory.NewTable(db, "myTable", pkSchema, nil)
-		dataLoader, err := NewTabularDataLoader(ctx, table, "\t", "\\N", true)
+		dataLoader, err := NewTabularDataLoader(ctx, LoadTarget{Table: table}, "\t", nil, true)
 		require.NoError(t, err)
 
 		// Load the first chunk
@@ -125,7 +126,7 @@ func TestTabDataLoader(t *testing.T) {
 	// across two chunks.
 	t.Run("quoted newlines across two chunks", func(t *testing.T) {
 		table := memory.NewTable(db, "myTable", pkSchema, nil)
-		dataLoader, err := NewTabularDataLoader(ctx, table, "\t", "\\N", false)
+		dataLoader, err := NewTabularDataLoader(ctx, LoadTarget{Table: table}, "\t", nil, false)
 		require.NoError(t, err)
 
 		// Load the first chunk
@@ -154,7 +155,7 @@ func TestTabDataLoader(t *testing.T) {
 	// header row is present.
 	t.Run("delimiter='|', record split across two chunks, with header", func(t *testing.T) {
 		table := memory.NewTable(db, "myTable", pkSchema, nil)
-		dataLoader, err := NewTabularDataLoader(ctx, table, "|", "\\N", true)
+		dataLoader, err := NewTabularDataLoader(ctx, LoadTarget{Table: table}, "|", nil, true)
 		require.NoError(t, err)
 
 		// Load the first chunk
@@ -182,7 +183,7 @@ func TestTabDataLoader(t *testing.T) {
 	// Test that calling Abort() does not insert any data into the table.
 	t.Run("abort cancels data load", func(t *testing.T) {
 		table := memory.NewTable(db, "myTable", pkSchema, nil)
-		dataLoader, err := NewTabularDataLoader(ctx, table, "\t", "\\N", false)
+		dataLoader, err := NewTabularDataLoader(ctx, LoadTarget{Table: table}, "\t", nil, false)
 		require.NoError(t, err)
 
 		// Load the first chunk
@@ -202,4 +203,46 @@ func TestTabDataLoader(t *testing.T) {
 		// Assert that the table does not contain any of the data from the CSV load
 		assertRows(t, ctx, table, [][]any{})
 	})
+
+	// Tests that a subset of the columns may be loaded, with the remaining columns set to their default, and that rows
+	// that do not match the WHERE expression are skipped.
+	t.Run("column subset with where", func(t *testing.T) {
+		c1Default, err := sql.NewColumnDefaultValue(expression.NewLiteral(int64(7), types.Int64), types.Int64, true, false, false)
+		require.NoError(t, err)
+		sch := pkSchema.Schema.Copy()
+		sch[1].Default = c1Default
+		table := memory.NewTable(db, "myTable", pkSchema, nil)
+		null := "null"
+		dataLoader, err := NewTabularDataLoader(ctx, LoadTarget{
+			Table:   table,
+			Schema:  sch,
+			Columns: []int{2, 0},
+			Where:   expression.NewGreaterThan(expression.NewGetField(0, types.Int64, "pk", false), expression.NewLiteral(int64(1), types.Int64)),
+		}, "|", &null, false)
+		require.NoError(t, err)
+
+		reader := bytes.NewReader([]byte("foo|1\nbar|2\nbaz|3\n"))
+		err = dataLoader.LoadChunk(ctx, bufio.NewReader(reader))
+		require.NoError(t, err)
+		results, err := dataLoader.Finish(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, results.RowsLoaded)
+
+		assertRows(t, ctx, table, [][]any{
+			{int64(2), int64(7), "bar"},
+			{int64(3), int64(7), "baz"},
+		})
+	})
+
+	// Tests that a NULL value in a column that does not allow NULL values returns an error.
+	t.Run("null value in non-null column", func(t *testing.T) {
+		table := memory.NewTable(db, "myTable", pkSchema, nil)
+		dataLoader, err := NewTabularDataLoader(ctx, LoadTarget{Table: table}, "\t", nil, false)
+		require.NoError(t, err)
+
+		reader := bytes.NewReader([]byte("1\t\\N\tbar\n"))
+		err = dataLoader.LoadChunk(ctx, bufio.NewReader(reader))
+		require.ErrorContains(t, err, `null value in column "c1" of relation "myTable" violates not-null constraint`)
+		require.NoError(t, dataLoader.Abort(ctx))
+	})
 }
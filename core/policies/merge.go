@@ -0,0 +1,118 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// Merge handles merging policies on our root and their root. The ancestor is used to determine which side made each
+// change, so that settings and policies that were only changed (or removed) on their side are taken from their side.
+// Policies that were changed differently on both sides are reported as conflicts.
+func Merge(ctx context.Context, ourCollection, theirCollection, ancCollection *Collection) (*Collection, error) {
+	mergedCollection := ourCollection.Clone()
+	tableNames := make(map[doltdb.TableName]struct{})
+	for _, collection := range []*Collection{ourCollection, theirCollection, ancCollection} {
+		if collection == nil {
+			continue
+		}
+		_ = collection.IterateTables(func(schema string, table *Table) error {
+			tableNames[doltdb.TableName{Name: table.Name, Schema: schema}] = struct{}{}
+			return nil
+		})
+	}
+	for tableName := range tableNames {
+		ourTable := ourCollection.GetTable(tableName)
+		theirTable := theirCollection.GetTable(tableName)
+		var ancTable *Table
+		if ancCollection != nil {
+			ancTable = ancCollection.GetTable(tableName)
+		}
+		mergedTable := mergedCollection.getOrCreateTable(tableName)
+		// Settings are booleans, so whenever their side differs from the ancestor, either both sides made the same
+		// change or only their side made the change
+		if theirTable.rowLevelSecurity() != ancTable.rowLevelSecurity() {
+			mergedTable.RowLevelSecurity = theirTable.rowLevelSecurity()
+		}
+		if theirTable.forceRowLevelSecurity() != ancTable.forceRowLevelSecurity() {
+			mergedTable.ForceRowLevelSecurity = theirTable.forceRowLevelSecurity()
+		}
+		policyNames := make(map[string]struct{})
+		for _, table := range []*Table{ourTable, theirTable, ancTable} {
+			if table != nil {
+				for policyName := range table.Policies {
+					policyNames[policyName] = struct{}{}
+				}
+			}
+		}
+		for policyName := range policyNames {
+			ourPolicy := ourTable.policy(policyName)
+			theirPolicy := theirTable.policy(policyName)
+			ancPolicy := ancTable.policy(policyName)
+			if ourPolicy.equals(theirPolicy) || theirPolicy.equals(ancPolicy) {
+				continue
+			}
+			if !ourPolicy.equals(ancPolicy) {
+				return nil, fmt.Errorf(`cannot merge policy "%s" for table "%s" as it was changed on both sides`,
+					policyName, tableName.Name)
+			}
+			if theirPolicy == nil {
+				delete(mergedTable.Policies, policyName)
+			} else {
+				mergedTable.Policies[policyName] = theirPolicy.Clone()
+			}
+		}
+		// Tables without any settings or policies do not need to be stored
+		if !mergedTable.RowLevelSecurity && !mergedTable.ForceRowLevelSecurity && len(mergedTable.Policies) == 0 {
+			mergedCollection.DropTable(tableName)
+		}
+	}
+	return mergedCollection, nil
+}
+
+// rowLevelSecurity returns whether row-level security is enabled. A nil table does not have it enabled.
+func (table *Table) rowLevelSecurity() bool {
+	return table != nil && table.RowLevelSecurity
+}
+
+// forceRowLevelSecurity returns whether row-level security is forced. A nil table does not have it forced.
+func (table *Table) forceRowLevelSecurity() bool {
+	return table != nil && table.ForceRowLevelSecurity
+}
+
+// policy returns the policy with the given name. Returns nil if the table is nil or does not have the policy.
+func (table *Table) policy(policyName string) *Policy {
+	if table == nil {
+		return nil
+	}
+	return table.Policies[policyName]
+}
+
+// equals returns whether both policies have the same definition. Two nil policies are considered equal.
+func (policy *Policy) equals(other *Policy) bool {
+	if policy == nil || other == nil {
+		return policy == other
+	}
+	return policy.Name == other.Name &&
+		policy.Command == other.Command &&
+		policy.Restrictive == other.Restrictive &&
+		slices.Equal(policy.Roles, other.Roles) &&
+		policy.Using == other.Using &&
+		policy.WithCheck == other.WithCheck
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mergeTable = doltdb.TableName{Name: "docs", Schema: "public"}
+
+// newPolicyCollection returns a collection containing the "docs" table, with row-level security set as given, along
+// with a policy for each given name and USING expression.
+func newPolicyCollection(t *testing.T, rowLevelSecurity bool, usingExprs map[string]string) *Collection {
+	collection, err := Deserialize(context.Background(), nil)
+	require.NoError(t, err)
+	collection.SetRowLevelSecurity(mergeTable, rowLevelSecurity)
+	for name, using := range usingExprs {
+		require.NoError(t, collection.CreatePolicy(mergeTable, &Policy{
+			Name:    name,
+			Command: Command_All,
+			Roles:   []string{"public"},
+			Using:   using,
+		}))
+	}
+	return collection
+}
+
+// mergedUsingExprs returns the USING expression of each policy on the "docs" table, keyed by the policy name.
+func mergedUsingExprs(collection *Collection) map[string]string {
+	usingExprs := make(map[string]string)
+	if table := collection.GetTable(mergeTable); table != nil {
+		for name, policy := range table.Policies {
+			usingExprs[name] = policy.Using
+		}
+	}
+	return usingExprs
+}
+
+func TestMergePolicies(t *testing.T) {
+	ctx := context.Background()
+	anc := newPolicyCollection(t, true, map[string]string{"p1": "pk = 1", "p2": "pk = 2", "p3": "pk = 3"})
+
+	t.Run("changes on both sides", func(t *testing.T) {
+		ours := newPolicyCollection(t, true, map[string]string{"p1": "pk = 1", "p2": "pk = 2", "p3": "pk = 30", "p4": "pk = 4"})
+		theirs := newPolicyCollection(t, false, map[string]string{"p2": "pk = 20", "p3": "pk = 3"})
+		merged, err := Merge(ctx, ours, theirs, anc)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"p2": "pk = 20", "p3": "pk = 30", "p4": "pk = 4"}, mergedUsingExprs(merged))
+		assert.False(t, merged.GetTable(mergeTable).RowLevelSecurity)
+	})
+	t.Run("deleted on our side", func(t *testing.T) {
+		ours := newPolicyCollection(t, true, map[string]string{"p2": "pk = 2", "p3": "pk = 3"})
+		theirs := newPolicyCollection(t, true, map[string]string{"p1": "pk = 1", "p2": "pk = 2", "p3": "pk = 3"})
+		merged, err := Merge(ctx, ours, theirs, anc)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"p2": "pk = 2", "p3": "pk = 3"}, mergedUsingExprs(merged))
+		assert.True(t, merged.GetTable(mergeTable).RowLevelSecurity)
+	})
+	t.Run("same change on both sides", func(t *testing.T) {
+		ours := newPolicyCollection(t, false, map[string]string{"p1": "pk = 10", "p2": "pk = 2", "p3": "pk = 3"})
+		theirs := newPolicyCollection(t, false, map[string]string{"p1": "pk = 10", "p2": "pk = 2", "p3": "pk = 3"})
+		merged, err := Merge(ctx, ours, theirs, anc)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"p1": "pk = 10", "p2": "pk = 2", "p3": "pk = 3"}, mergedUsingExprs(merged))
+		assert.False(t, merged.GetTable(mergeTable).RowLevelSecurity)
+	})
+	t.Run("everything removed on their side", func(t *testing.T) {
+		ours := newPolicyCollection(t, true, map[string]string{"p1": "pk = 1", "p2": "pk = 2", "p3": "pk = 3"})
+		theirs := newPolicyCollection(t, false, nil)
+		merged, err := Merge(ctx, ours, theirs, anc)
+		require.NoError(t, err)
+		assert.Nil(t, merged.GetTable(mergeTable))
+	})
+	t.Run("changed on both sides", func(t *testing.T) {
+		ours := newPolicyCollection(t, true, map[string]string{"p1": "pk = 10", "p2": "pk = 2", "p3": "pk = 3"})
+		theirs := newPolicyCollection(t, true, map[string]string{"p1": "pk = 100", "p2": "pk = 2", "p3": "pk = 3"})
+		_, err := Merge(ctx, ours, theirs, anc)
+		require.ErrorContains(t, err, `cannot merge policy "p1" for table "docs" as it was changed on both sides`)
+	})
+	t.Run("changed on one side and deleted on the other", func(t *testing.T) {
+		ours := newPolicyCollection(t, true, map[string]string{"p1": "pk = 10", "p2": "pk = 2", "p3": "pk = 3"})
+		theirs := newPolicyCollection(t, true, map[string]string{"p2": "pk = 2", "p3": "pk = 3"})
+		_, err := Merge(ctx, ours, theirs, anc)
+		require.ErrorContains(t, err, `cannot merge policy "p1" for table "docs" as it was changed on both sides`)
+	})
+}
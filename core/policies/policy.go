@@ -0,0 +1,294 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// Collection contains the row-level security settings and policies for all tables.
+type Collection struct {
+	schemaMap map[string]map[string]*Table
+	mutex     *sync.Mutex
+}
+
+// Command is the command that a Policy applies to.
+type Command uint8
+
+const (
+	Command_All    Command = 0
+	Command_Select Command = 1
+	Command_Insert Command = 2
+	Command_Update Command = 3
+	Command_Delete Command = 4
+)
+
+// Table contains the row-level security settings and policies for a single table.
+type Table struct {
+	Name                  string
+	RowLevelSecurity      bool
+	ForceRowLevelSecurity bool
+	Policies              map[string]*Policy
+}
+
+// Policy represents a single row-level security policy, which is found within the pg_policy table.
+type Policy struct {
+	Name        string
+	Command     Command
+	Restrictive bool
+	Roles       []string
+	Using       string
+	WithCheck   string
+}
+
+// GetTable returns the row-level security settings for the given table. Returns nil if the table has no settings or
+// policies.
+func (pc *Collection) GetTable(name doltdb.TableName) *Table {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+
+	if nameMap, ok := pc.schemaMap[name.Schema]; ok {
+		if table, ok := nameMap[name.Name]; ok {
+			return table
+		}
+	}
+	return nil
+}
+
+// SetRowLevelSecurity sets whether row-level security is enabled for the given table.
+func (pc *Collection) SetRowLevelSecurity(name doltdb.TableName, enabled bool) {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+
+	pc.getOrCreateTable(name).RowLevelSecurity = enabled
+}
+
+// SetForceRowLevelSecurity sets whether row-level security also applies to the owner of the given table.
+func (pc *Collection) SetForceRowLevelSecurity(name doltdb.TableName, forced bool) {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+
+	pc.getOrCreateTable(name).ForceRowLevelSecurity = forced
+}
+
+// GetPolicy returns the policy with the given name on the given table. Returns nil if the policy cannot be found.
+func (pc *Collection) GetPolicy(name doltdb.TableName, policyName string) *Policy {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+
+	if nameMap, ok := pc.schemaMap[name.Schema]; ok {
+		if table, ok := nameMap[name.Name]; ok {
+			return table.Policies[policyName]
+		}
+	}
+	return nil
+}
+
+// CreatePolicy creates a new policy on the given table.
+func (pc *Collection) CreatePolicy(name doltdb.TableName, policy *Policy) error {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+
+	table := pc.getOrCreateTable(name)
+	if _, ok := table.Policies[policy.Name]; ok {
+		return fmt.Errorf(`policy "%s" for table "%s" already exists`, policy.Name, name.Name)
+	}
+	table.Policies[policy.Name] = policy
+	return nil
+}
+
+// RenamePolicy renames an existing policy on the given table.
+func (pc *Collection) RenamePolicy(name doltdb.TableName, oldName string, newName string) error {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+
+	table := pc.getOrCreateTable(name)
+	policy, ok := table.Policies[oldName]
+	if !ok {
+		return fmt.Errorf(`policy "%s" for table "%s" does not exist`, oldName, name.Name)
+	}
+	if _, ok = table.Policies[newName]; ok {
+		return fmt.Errorf(`policy "%s" for table "%s" already exists`, newName, name.Name)
+	}
+	delete(table.Policies, oldName)
+	policy.Name = newName
+	table.Policies[newName] = policy
+	return nil
+}
+
+// DropPolicy drops an existing policy from the given table.
+func (pc *Collection) DropPolicy(name doltdb.TableName, policyName string) error {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+
+	if nameMap, ok := pc.schemaMap[name.Schema]; ok {
+		if table, ok := nameMap[name.Name]; ok {
+			if _, ok = table.Policies[policyName]; ok {
+				delete(table.Policies, policyName)
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf(`policy "%s" for table "%s" does not exist`, policyName, name.Name)
+}
+
+// DropTable removes the settings and policies for the given table. Returns whether the table had been present.
+func (pc *Collection) DropTable(name doltdb.TableName) bool {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+
+	if nameMap, ok := pc.schemaMap[name.Schema]; ok {
+		if _, ok = nameMap[name.Name]; ok {
+			delete(nameMap, name.Name)
+			return true
+		}
+	}
+	return false
+}
+
+// RenameTable moves the settings and policies from the old table name to the new table name. Returns whether the old
+// table had been present.
+func (pc *Collection) RenameTable(oldName doltdb.TableName, newName doltdb.TableName) bool {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+
+	nameMap, ok := pc.schemaMap[oldName.Schema]
+	if !ok {
+		return false
+	}
+	table, ok := nameMap[oldName.Name]
+	if !ok {
+		return false
+	}
+	delete(nameMap, oldName.Name)
+	table.Name = newName.Name
+	newNameMap, ok := pc.schemaMap[newName.Schema]
+	if !ok {
+		newNameMap = make(map[string]*Table)
+		pc.schemaMap[newName.Schema] = newNameMap
+	}
+	newNameMap[newName.Name] = table
+	return true
+}
+
+// IterateTables iterates over all tables in the collection.
+func (pc *Collection) IterateTables(f func(schema string, table *Table) error) error {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+
+	for schema, nameMap := range pc.schemaMap {
+		for _, table := range nameMap {
+			if err := f(schema, table); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Clone returns a new *Collection with the same contents as the original.
+func (pc *Collection) Clone() *Collection {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+
+	newCollection := &Collection{
+		schemaMap: make(map[string]map[string]*Table),
+		mutex:     &sync.Mutex{},
+	}
+	for schema, nameMap := range pc.schemaMap {
+		if len(nameMap) == 0 {
+			continue
+		}
+		clonedNameMap := make(map[string]*Table)
+		for key, table := range nameMap {
+			clonedNameMap[key] = table.clone()
+		}
+		newCollection.schemaMap[schema] = clonedNameMap
+	}
+	return newCollection
+}
+
+// getOrCreateTable returns the table with the given name, creating it if it does not yet exist. Called from other
+// functions that hold locks.
+func (pc *Collection) getOrCreateTable(name doltdb.TableName) *Table {
+	nameMap, ok := pc.schemaMap[name.Schema]
+	if !ok {
+		nameMap = make(map[string]*Table)
+		pc.schemaMap[name.Schema] = nameMap
+	}
+	table, ok := nameMap[name.Name]
+	if !ok {
+		table = &Table{
+			Name:     name.Name,
+			Policies: make(map[string]*Policy),
+		}
+		nameMap[name.Name] = table
+	}
+	return table
+}
+
+// SortedPolicies returns the table's policies, sorted by name. Postgres applies policies in name order.
+func (table *Table) SortedPolicies() []*Policy {
+	policies := make([]*Policy, 0, len(table.Policies))
+	for _, policy := range table.Policies {
+		policies = append(policies, policy)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+	return policies
+}
+
+// clone returns a deep copy of the table.
+func (table *Table) clone() *Table {
+	newTable := *table
+	newTable.Policies = make(map[string]*Policy, len(table.Policies))
+	for key, policy := range table.Policies {
+		newTable.Policies[key] = policy.Clone()
+	}
+	return &newTable
+}
+
+// AppliesTo returns whether the policy applies to the given command.
+func (policy *Policy) AppliesTo(command Command) bool {
+	return policy.Command == Command_All || policy.Command == command
+}
+
+// Clone returns a deep copy of the policy.
+func (policy *Policy) Clone() *Policy {
+	newPolicy := *policy
+	newPolicy.Roles = append([]string(nil), policy.Roles...)
+	return &newPolicy
+}
+
+// String returns the command as it would appear within pg_policies.
+func (command Command) String() string {
+	switch command {
+	case Command_Select:
+		return "SELECT"
+	case Command_Insert:
+		return "INSERT"
+	case Command_Update:
+		return "UPDATE"
+	case Command_Delete:
+		return "DELETE"
+	default:
+		return "ALL"
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Serialize returns the Collection as a byte slice. If the Collection is nil, then this returns a nil slice.
+func (pc *Collection) Serialize(ctx context.Context) ([]byte, error) {
+	if pc == nil {
+		return nil, nil
+	}
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+
+	// Write all of the tables to the writer
+	writer := utils.NewWriter(256)
+	writer.VariableUint(0) // Version
+	schemaMapKeys := utils.GetMapKeysSorted(pc.schemaMap)
+	writer.VariableUint(uint64(len(schemaMapKeys)))
+	for _, schemaMapKey := range schemaMapKeys {
+		nameMap := pc.schemaMap[schemaMapKey]
+		writer.String(schemaMapKey)
+		nameMapKeys := utils.GetMapKeysSorted(nameMap)
+		writer.VariableUint(uint64(len(nameMapKeys)))
+		for _, nameMapKey := range nameMapKeys {
+			table := nameMap[nameMapKey]
+			writer.String(table.Name)
+			writer.Bool(table.RowLevelSecurity)
+			writer.Bool(table.ForceRowLevelSecurity)
+			policyKeys := utils.GetMapKeysSorted(table.Policies)
+			writer.VariableUint(uint64(len(policyKeys)))
+			for _, policyKey := range policyKeys {
+				policy := table.Policies[policyKey]
+				writer.String(policy.Name)
+				writer.Uint8(uint8(policy.Command))
+				writer.Bool(policy.Restrictive)
+				writer.StringSlice(policy.Roles)
+				writer.String(policy.Using)
+				writer.String(policy.WithCheck)
+			}
+		}
+	}
+
+	return writer.Data(), nil
+}
+
+// Deserialize returns the Collection that was serialized in the byte slice. Returns an empty Collection if data is nil
+// or empty.
+func Deserialize(ctx context.Context, data []byte) (*Collection, error) {
+	if len(data) == 0 {
+		return &Collection{
+			schemaMap: make(map[string]map[string]*Table),
+			mutex:     &sync.Mutex{},
+		}, nil
+	}
+	schemaMap := make(map[string]map[string]*Table)
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version != 0 {
+		return nil, fmt.Errorf("version %d of policies is not supported, please upgrade the server", version)
+	}
+
+	// Read from the reader
+	numOfSchemas := reader.VariableUint()
+	for i := uint64(0); i < numOfSchemas; i++ {
+		schemaName := reader.String()
+		numOfTables := reader.VariableUint()
+		nameMap := make(map[string]*Table)
+		for j := uint64(0); j < numOfTables; j++ {
+			table := &Table{}
+			table.Name = reader.String()
+			table.RowLevelSecurity = reader.Bool()
+			table.ForceRowLevelSecurity = reader.Bool()
+			numOfPolicies := reader.VariableUint()
+			table.Policies = make(map[string]*Policy, numOfPolicies)
+			for k := uint64(0); k < numOfPolicies; k++ {
+				policy := &Policy{}
+				policy.Name = reader.String()
+				policy.Command = Command(reader.Uint8())
+				policy.Restrictive = reader.Bool()
+				policy.Roles = reader.StringSlice()
+				policy.Using = reader.String()
+				policy.WithCheck = reader.String()
+				table.Policies[policy.Name] = policy
+			}
+			nameMap[table.Name] = table
+		}
+		schemaMap[schemaName] = nameMap
+	}
+	if !reader.IsEmpty() {
+		return nil, fmt.Errorf("extra data found while deserializing policies")
+	}
+
+	// Return the deserialized object
+	return &Collection{
+		schemaMap: schemaMap,
+		mutex:     &sync.Mutex{},
+	}, nil
+}
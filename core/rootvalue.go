@@ -30,6 +30,7 @@ import (
 	"github.com/dolthub/dolt/go/store/prolly/tree"
 	"github.com/dolthub/dolt/go/store/types"
 
+	"github.com/dolthub/doltgresql/core/policies"
 	"github.com/dolthub/doltgresql/core/sequences"
 	"github.com/dolthub/doltgresql/core/typecollection"
 )
@@ -228,6 +229,29 @@ func (root *RootValue) GetSequences(ctx context.Context) (*sequences.Collection,
 	return sequences.Deserialize(ctx, data)
 }
 
+// GetPolicies returns all row-level security settings and policies that are on the root.
+func (root *RootValue) GetPolicies(ctx context.Context) (*policies.Collection, error) {
+	h := root.st.GetPolicies()
+	if h.IsEmpty() {
+		return policies.Deserialize(ctx, nil)
+	}
+	dataValue, err := root.vrw.ReadValue(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob := dataValue.(types.Blob)
+	dataBlobLength := dataBlob.Len()
+	data := make([]byte, dataBlobLength)
+	n, err := dataBlob.ReadAt(context.Background(), data, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if uint64(n) != dataBlobLength {
+		return nil, fmt.Errorf("wanted %d bytes from blob for policies, got %d", dataBlobLength, n)
+	}
+	return policies.Deserialize(ctx, data)
+}
+
 // GetTypes returns all types that are on the root.
 func (root *RootValue) GetTypes(ctx context.Context) (*typecollection.TypeCollection, error) {
 	h := root.st.GetTypes()
@@ -303,12 +327,17 @@ func (root *RootValue) GetTableNames(ctx context.Context, schemaName string) ([]
 // HandlePostMerge implements the interface doltdb.RootValue.
 func (root *RootValue) HandlePostMerge(ctx context.Context, ourRoot, theirRoot, ancRoot doltdb.RootValue) (doltdb.RootValue, error) {
 	// Handle sequences
-	_, err := root.handlePostSequencesMerge(ctx, ourRoot, theirRoot, ancRoot)
+	newRoot, err := root.handlePostSequencesMerge(ctx, ourRoot, theirRoot, ancRoot)
 	if err != nil {
 		return nil, err
 	}
 	// Handle types
-	return root.handlePostTypesMerge(ctx, ourRoot, theirRoot, ancRoot)
+	newRoot, err = newRoot.(*RootValue).handlePostTypesMerge(ctx, ourRoot, theirRoot, ancRoot)
+	if err != nil {
+		return nil, err
+	}
+	// Handle policies
+	return newRoot.(*RootValue).handlePostPoliciesMerge(ctx, ourRoot, theirRoot, ancRoot)
 }
 
 // handlePostSequencesMerge merges sequences.
@@ -353,6 +382,27 @@ func (root *RootValue) handlePostTypesMerge(ctx context.Context, ourRoot, theirR
 	return root.PutTypes(ctx, mergedTypes)
 }
 
+// handlePostPoliciesMerge merges policies.
+func (root *RootValue) handlePostPoliciesMerge(ctx context.Context, ourRoot, theirRoot, ancRoot doltdb.RootValue) (doltdb.RootValue, error) {
+	ourPolicies, err := ourRoot.(*RootValue).GetPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	theirPolicies, err := theirRoot.(*RootValue).GetPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ancPolicies, err := ancRoot.(*RootValue).GetPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mergedPolicies, err := policies.Merge(ctx, ourPolicies, theirPolicies, ancPolicies)
+	if err != nil {
+		return nil, err
+	}
+	return root.PutPolicies(ctx, mergedPolicies)
+}
+
 // HashOf implements the interface doltdb.RootValue.
 func (root *RootValue) HashOf() (hash.Hash, error) {
 	if root.hash.IsEmpty() {
@@ -474,6 +524,27 @@ func (root *RootValue) PutForeignKeyCollection(ctx context.Context, fkc *doltdb.
 	return root.withStorage(newStorage), nil
 }
 
+// PutPolicies writes the given policies to the returned root value.
+func (root *RootValue) PutPolicies(ctx context.Context, pol *policies.Collection) (*RootValue, error) {
+	data, err := pol.Serialize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob, err := types.NewBlob(ctx, root.vrw, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	ref, err := root.vrw.WriteValue(ctx, dataBlob)
+	if err != nil {
+		return nil, err
+	}
+	newStorage, err := root.st.SetPolicies(ctx, ref.TargetHash())
+	if err != nil {
+		return nil, err
+	}
+	return root.withStorage(newStorage), nil
+}
+
 // PutSequences writes the given sequences to the returned root value.
 func (root *RootValue) PutSequences(ctx context.Context, seq *sequences.Collection) (*RootValue, error) {
 	data, err := seq.Serialize(ctx)
@@ -562,6 +633,21 @@ func (root *RootValue) RemoveTables(
 		return nil, err
 	}
 
+	policyCollection, err := newRoot.GetPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	droppedPolicies := false
+	for _, tableName := range tables {
+		droppedPolicies = policyCollection.DropTable(tableName) || droppedPolicies
+	}
+	if droppedPolicies {
+		newRoot, err = newRoot.PutPolicies(ctx, policyCollection)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if skipFKHandling {
 		return newRoot, nil
 	}
@@ -601,6 +687,17 @@ func (root *RootValue) RenameTable(ctx context.Context, oldName, newName doltdb.
 		return nil, err
 	}
 
+	policyCollection, err := newRoot.GetPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if policyCollection.RenameTable(oldName, newName) {
+		newRoot, err = newRoot.PutPolicies(ctx, policyCollection)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return newRoot, nil
 }
 
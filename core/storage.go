@@ -47,7 +47,7 @@ func (r rootStorage) SetSequences(ctx context.Context, h hash.Hash) (rootStorage
 		if err != nil {
 			return rootStorage{}, err
 		}
-		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, h[:])
+		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, h[:], r.srv.TypesBytes(), r.srv.PoliciesBytes())
 		if err != nil {
 			return rootStorage{}, err
 		}
@@ -109,7 +109,7 @@ func (r rootStorage) GetSchemas(ctx context.Context) ([]schema.DatabaseSchema, e
 
 // SetSchemas sets the given schemas and returns a new storage object.
 func (r rootStorage) SetSchemas(ctx context.Context, dbSchemas []schema.DatabaseSchema) (rootStorage, error) {
-	msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes())
+	msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes(), r.srv.TypesBytes(), r.srv.PoliciesBytes())
 	if err != nil {
 		return rootStorage{}, err
 	}
@@ -145,7 +145,35 @@ func (r rootStorage) SetTypes(ctx context.Context, h hash.Hash) (rootStorage, er
 		if err != nil {
 			return rootStorage{}, err
 		}
-		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, h[:])
+		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes(), h[:], r.srv.PoliciesBytes())
+		if err != nil {
+			return rootStorage{}, err
+		}
+		return rootStorage{msg}, nil
+	}
+}
+
+// GetPolicies returns the policies hash.
+func (r rootStorage) GetPolicies() hash.Hash {
+	hashBytes := r.srv.PoliciesBytes()
+	if len(hashBytes) == 0 {
+		return hash.Hash{}
+	}
+	return hash.New(hashBytes)
+}
+
+// SetPolicies sets the policies hash and returns a new storage object.
+func (r rootStorage) SetPolicies(ctx context.Context, h hash.Hash) (rootStorage, error) {
+	if len(r.srv.PoliciesBytes()) > 0 {
+		ret := r.clone()
+		copy(ret.srv.PoliciesBytes(), h[:])
+		return ret, nil
+	} else {
+		dbSchemas, err := r.GetSchemas(ctx)
+		if err != nil {
+			return rootStorage{}, err
+		}
+		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes(), r.srv.TypesBytes(), h[:])
 		if err != nil {
 			return rootStorage{}, err
 		}
@@ -278,7 +306,7 @@ func (r rootStorage) EditTablesMap(ctx context.Context, vrw types.ValueReadWrite
 		return rootStorage{}, err
 	}
 
-	msg, err := r.serializeRootValue(ambytes, dbSchemas, r.srv.SequencesBytes())
+	msg, err := r.serializeRootValue(ambytes, dbSchemas, r.srv.SequencesBytes(), r.srv.TypesBytes(), r.srv.PoliciesBytes())
 	if err != nil {
 		return rootStorage{}, err
 	}
@@ -286,12 +314,20 @@ func (r rootStorage) EditTablesMap(ctx context.Context, vrw types.ValueReadWrite
 }
 
 // serializeRootValue serializes a new serial.RootValue object.
-func (r rootStorage) serializeRootValue(addressMapBytes []byte, dbSchemas []schema.DatabaseSchema, seqHash []byte) (*serial.RootValue, error) {
+func (r rootStorage) serializeRootValue(addressMapBytes []byte, dbSchemas []schema.DatabaseSchema, seqHash []byte, typesHash []byte, policiesHash []byte) (*serial.RootValue, error) {
 	builder := flatbuffers.NewBuilder(80)
 	tablesOffset := builder.CreateByteVector(addressMapBytes)
 	schemasOffset := serializeDatabaseSchemas(builder, dbSchemas)
 	fkOffset := builder.CreateByteVector(r.srv.ForeignKeyAddrBytes())
 	seqOffset := builder.CreateByteVector(seqHash)
+	// Types and policies are only written when present, so that roots without them keep the same hash
+	var typesOffset, policiesOffset flatbuffers.UOffsetT
+	if len(typesHash) > 0 {
+		typesOffset = builder.CreateByteVector(typesHash)
+	}
+	if len(policiesHash) > 0 {
+		policiesOffset = builder.CreateByteVector(policiesHash)
+	}
 
 	serial.RootValueStart(builder)
 	serial.RootValueAddFeatureVersion(builder, r.srv.FeatureVersion())
@@ -302,6 +338,12 @@ func (r rootStorage) serializeRootValue(addressMapBytes []byte, dbSchemas []sche
 	if schemasOffset > 0 {
 		serial.RootValueAddSchemas(builder, schemasOffset)
 	}
+	if typesOffset > 0 {
+		serial.RootValueAddTypes(builder, typesOffset)
+	}
+	if policiesOffset > 0 {
+		serial.RootValueAddPolicies(builder, policiesOffset)
+	}
 
 	bs := doltserial.FinishMessage(builder, serial.RootValueEnd(builder), []byte(doltserial.DoltgresRootValueFileID))
 	msg, err := serial.TryGetRootAsRootValue(bs, doltserial.MessagePrefixSz)
@@ -56,6 +56,12 @@ func Merge(ctx context.Context, ourCollection, theirCollection, ancCollection *T
 				// TODO: check for duplicate check constraints
 				mergedType.Checks = append(mergedType.Checks, theirType.Checks...)
 			}
+		case types.TypeType_Enum:
+			var ancType *types.Type
+			if ancCollection != nil {
+				ancType, _ = ancCollection.GetType(schema, theirType.Name)
+			}
+			return mergeEnumLabels(mergedCollection, mergedType, theirType, ancType)
 		default:
 			// TODO: support merge for other types. (base, range, etc.)
 		}
@@ -66,3 +72,73 @@ func Merge(ctx context.Context, ourCollection, theirCollection, ancCollection *T
 	}
 	return mergedCollection, nil
 }
+
+// mergeEnumLabels merges the labels of their enum type into the merged enum type. Labels are matched using their sort
+// order, so that a label renamed on either side is detected. A label may only be renamed if both sides renamed it
+// identically. The ancestor type is used to report which side renamed a label, and may be nil if the type did not
+// exist in the ancestor.
+func mergeEnumLabels(mergedCollection *TypeCollection, mergedType, theirType, ancType *types.Type) error {
+	for _, theirLabel := range theirType.EnumLabels {
+		mergedIdx := enumLabelIndexBySortOrder(mergedType.EnumLabels, theirLabel.SortOrder)
+		if mergedIdx == -1 {
+			// This is a new label, so we add it as long as it doesn't already exist at another position
+			if enumLabelIndexByLabel(mergedType.EnumLabels, theirLabel.Label) != -1 {
+				return fmt.Errorf(`cannot merge enum type "%s" as label "%s" was added at different positions`,
+					theirType.Name, theirLabel.Label)
+			}
+			newLabel := theirLabel
+			for _, label := range mergedType.EnumLabels {
+				if label.Oid == newLabel.Oid {
+					newLabel.Oid = mergedCollection.NextOid()
+					break
+				}
+			}
+			mergedType.EnumLabels = append(mergedType.EnumLabels, newLabel)
+			continue
+		}
+		ourLabel := mergedType.EnumLabels[mergedIdx].Label
+		if ourLabel == theirLabel.Label {
+			continue
+		}
+		var ancLabel string
+		if ancType != nil {
+			if ancIdx := enumLabelIndexBySortOrder(ancType.EnumLabels, theirLabel.SortOrder); ancIdx != -1 {
+				ancLabel = ancType.EnumLabels[ancIdx].Label
+			}
+		}
+		// Rows on the side that did not rename the label may still hold the old label, and those rows are not rewritten
+		// during a merge, so a rename on only one side cannot be merged.
+		switch ancLabel {
+		case theirLabel.Label:
+			return fmt.Errorf(`cannot merge enum type "%s" as label "%s" was renamed to "%s" on only one branch`,
+				theirType.Name, ancLabel, ourLabel)
+		case ourLabel:
+			return fmt.Errorf(`cannot merge enum type "%s" as label "%s" was renamed to "%s" on only one branch`,
+				theirType.Name, ancLabel, theirLabel.Label)
+		default:
+			return fmt.Errorf(`cannot merge enum type "%s" as labels "%s" and "%s" conflict`,
+				theirType.Name, ourLabel, theirLabel.Label)
+		}
+	}
+	return nil
+}
+
+// enumLabelIndexBySortOrder returns the index of the label with the given sort order. Returns -1 if one is not found.
+func enumLabelIndexBySortOrder(labels []types.EnumLabel, sortOrder float32) int {
+	for i := range labels {
+		if labels[i].SortOrder == sortOrder {
+			return i
+		}
+	}
+	return -1
+}
+
+// enumLabelIndexByLabel returns the index of the given label. Returns -1 if the label is not found.
+func enumLabelIndexByLabel(labels []types.EnumLabel, label string) int {
+	for i := range labels {
+		if labels[i].Label == label {
+			return i
+		}
+	}
+	return -1
+}
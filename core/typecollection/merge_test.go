@@ -0,0 +1,107 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typecollection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/server/types"
+)
+
+// newEnumCollection returns a collection containing a single enum type named "mood" with the given labels and sort
+// orders. Label OIDs are assigned by position, so that separately created collections reuse the same OIDs.
+func newEnumCollection(t *testing.T, labels []string, sortOrders []float32) *TypeCollection {
+	collection, err := Deserialize(context.Background(), nil)
+	require.NoError(t, err)
+	enumLabels := make([]types.EnumLabel, len(labels))
+	for i, label := range labels {
+		enumLabels[i] = types.EnumLabel{
+			Oid:       firstUserOid + 2 + uint32(i),
+			SortOrder: sortOrders[i],
+			Label:     label,
+		}
+	}
+	require.NoError(t, collection.CreateType("public", types.NewEnumType("public", "mood", firstUserOid, firstUserOid+1, enumLabels, "")))
+	return collection
+}
+
+// mergedEnumLabels returns the labels of the "mood" type in declaration order.
+func mergedEnumLabels(t *testing.T, collection *TypeCollection) []string {
+	typ, ok := collection.GetType("public", "mood")
+	require.True(t, ok)
+	return types.EnumTypeFromType(typ).Labels
+}
+
+func TestMergeEnumLabels(t *testing.T) {
+	ctx := context.Background()
+	anc := newEnumCollection(t, []string{"sad", "ok", "happy"}, []float32{1, 2, 3})
+
+	t.Run("labels added on both sides", func(t *testing.T) {
+		ours := newEnumCollection(t, []string{"miserable", "sad", "ok", "happy"}, []float32{0, 1, 2, 3})
+		theirs := newEnumCollection(t, []string{"sad", "ok", "happy", "ecstatic"}, []float32{1, 2, 3, 4})
+		merged, err := Merge(ctx, ours, theirs, anc)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"miserable", "sad", "ok", "happy", "ecstatic"}, mergedEnumLabels(t, merged))
+		// The added labels were created with the same OID on both sides, so one must have been reassigned
+		typ, _ := merged.GetType("public", "mood")
+		oids := make(map[uint32]struct{})
+		for _, label := range typ.EnumLabels {
+			oids[label.Oid] = struct{}{}
+		}
+		assert.Len(t, oids, len(typ.EnumLabels))
+	})
+	t.Run("label renamed on their side", func(t *testing.T) {
+		ours := newEnumCollection(t, []string{"sad", "ok", "happy"}, []float32{1, 2, 3})
+		theirs := newEnumCollection(t, []string{"sad", "fine", "happy"}, []float32{1, 2, 3})
+		_, err := Merge(ctx, ours, theirs, anc)
+		require.ErrorContains(t, err, `label "ok" was renamed to "fine" on only one branch`)
+	})
+	t.Run("label renamed on our side", func(t *testing.T) {
+		ours := newEnumCollection(t, []string{"sad", "fine", "happy"}, []float32{1, 2, 3})
+		theirs := newEnumCollection(t, []string{"sad", "ok", "happy", "ecstatic"}, []float32{1, 2, 3, 4})
+		_, err := Merge(ctx, ours, theirs, anc)
+		require.ErrorContains(t, err, `label "ok" was renamed to "fine" on only one branch`)
+	})
+	t.Run("label renamed on their side and used on ours", func(t *testing.T) {
+		// Our rows may hold "ok" (and the newly added "meh"), which would no longer exist after taking their rename
+		ours := newEnumCollection(t, []string{"sad", "ok", "meh", "happy"}, []float32{1, 2, 2.5, 3})
+		theirs := newEnumCollection(t, []string{"sad", "fine", "happy"}, []float32{1, 2, 3})
+		_, err := Merge(ctx, ours, theirs, anc)
+		require.ErrorContains(t, err, `label "ok" was renamed to "fine" on only one branch`)
+	})
+	t.Run("label renamed identically on both sides", func(t *testing.T) {
+		ours := newEnumCollection(t, []string{"sad", "fine", "happy"}, []float32{1, 2, 3})
+		theirs := newEnumCollection(t, []string{"sad", "fine", "happy", "ecstatic"}, []float32{1, 2, 3, 4})
+		merged, err := Merge(ctx, ours, theirs, anc)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"sad", "fine", "happy", "ecstatic"}, mergedEnumLabels(t, merged))
+	})
+	t.Run("label renamed differently on both sides", func(t *testing.T) {
+		ours := newEnumCollection(t, []string{"sad", "fine", "happy"}, []float32{1, 2, 3})
+		theirs := newEnumCollection(t, []string{"sad", "meh", "happy"}, []float32{1, 2, 3})
+		_, err := Merge(ctx, ours, theirs, anc)
+		require.ErrorContains(t, err, `labels "fine" and "meh" conflict`)
+	})
+	t.Run("same label added at different positions", func(t *testing.T) {
+		ours := newEnumCollection(t, []string{"meh", "sad", "ok", "happy"}, []float32{0, 1, 2, 3})
+		theirs := newEnumCollection(t, []string{"sad", "ok", "happy", "meh"}, []float32{1, 2, 3, 4})
+		_, err := Merge(ctx, ours, theirs, anc)
+		require.Error(t, err)
+	})
+}
@@ -36,7 +36,7 @@ func (pgs *TypeCollection) Serialize(ctx context.Context) ([]byte, error) {
 
 	// Write all the Types to the writer
 	writer := utils.NewWriter(256)
-	writer.VariableUint(0) // Version
+	writer.VariableUint(3) // Version
 	schemaMapKeys := utils.GetMapKeysSorted(pgs.schemaMap)
 	writer.VariableUint(uint64(len(schemaMapKeys)))
 	for _, schemaMapKey := range schemaMapKeys {
@@ -82,6 +82,31 @@ func (pgs *TypeCollection) Serialize(ctx context.Context) ([]byte, error) {
 				writer.String(check.Name)
 				writer.String(check.CheckExpression)
 			}
+			writer.VariableUint(uint64(len(typ.EnumLabels)))
+			for _, label := range typ.EnumLabels {
+				writer.Uint32(label.Oid)
+				writer.Float32(label.SortOrder)
+				writer.String(label.Label)
+			}
+			writer.VariableUint(uint64(len(typ.CompositeAttrs)))
+			for _, attr := range typ.CompositeAttrs {
+				serializedType, err := attr.Type.SerializeType()
+				if err != nil {
+					return nil, err
+				}
+				writer.String(attr.Name)
+				writer.ByteSlice(serializedType)
+			}
+			if typ.RangeSubType != nil {
+				serializedType, err := typ.RangeSubType.SerializeType()
+				if err != nil {
+					return nil, err
+				}
+				writer.Bool(true)
+				writer.ByteSlice(serializedType)
+			} else {
+				writer.Bool(false)
+			}
 		}
 	}
 
@@ -100,7 +125,7 @@ func Deserialize(ctx context.Context, data []byte) (*TypeCollection, error) {
 	schemaMap := make(map[string]map[string]*types.Type)
 	reader := utils.NewReader(data)
 	version := reader.VariableUint()
-	if version != 0 {
+	if version > 3 {
 		return nil, fmt.Errorf("version %d of types is not supported, please upgrade the server", version)
 	}
 
@@ -153,6 +178,40 @@ func Deserialize(ctx context.Context, data []byte) (*TypeCollection, error) {
 					Enforced:        true,
 				})
 			}
+			if version >= 1 {
+				numOfLabels := reader.VariableUint()
+				for k := uint64(0); k < numOfLabels; k++ {
+					labelOid := reader.Uint32()
+					sortOrder := reader.Float32()
+					label := reader.String()
+					typ.EnumLabels = append(typ.EnumLabels, types.EnumLabel{
+						Oid:       labelOid,
+						SortOrder: sortOrder,
+						Label:     label,
+					})
+				}
+			}
+			if version >= 2 {
+				numOfAttrs := reader.VariableUint()
+				for k := uint64(0); k < numOfAttrs; k++ {
+					attrName := reader.String()
+					attrType, err := types.DeserializeType(reader.ByteSlice())
+					if err != nil {
+						return nil, err
+					}
+					typ.CompositeAttrs = append(typ.CompositeAttrs, types.CompositeAttribute{
+						Name: attrName,
+						Type: attrType.(types.DoltgresType),
+					})
+				}
+			}
+			if version >= 3 && reader.Bool() {
+				subType, err := types.DeserializeType(reader.ByteSlice())
+				if err != nil {
+					return nil, err
+				}
+				typ.RangeSubType = subType.(types.DoltgresType)
+			}
 			nameMap[typ.Name] = typ
 		}
 		schemaMap[schemaName] = nameMap
@@ -19,8 +19,12 @@ import (
 	"sync"
 
 	"github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/utils"
 )
 
+// firstUserOid is the first OID that Postgres assigns to user-defined objects.
+const firstUserOid = 16384
+
 // TypeCollection contains a collection of Types.
 type TypeCollection struct {
 	schemaMap map[string]map[string]*types.Type
@@ -41,6 +45,22 @@ func (pgs *TypeCollection) GetType(schName, typName string) (*types.Type, bool)
 	return nil, false
 }
 
+// GetTypeByOid returns the Type with the given OID.
+// Returns nil if the Type cannot be found.
+func (pgs *TypeCollection) GetTypeByOid(oid uint32) (*types.Type, bool) {
+	pgs.mutex.RLock()
+	defer pgs.mutex.RUnlock()
+
+	for _, nameMap := range pgs.schemaMap {
+		for _, typ := range nameMap {
+			if typ.Oid == oid {
+				return typ, true
+			}
+		}
+	}
+	return nil, false
+}
+
 // GetDomainType returns a domain Type with the given schema and name.
 // Returns nil if the Type cannot be found. It checks for type of Type for domain type.
 func (pgs *TypeCollection) GetDomainType(schName, typName string) (*types.Type, bool) {
@@ -111,6 +131,25 @@ func (pgs *TypeCollection) DropType(schName, typName string) error {
 	return types.ErrTypeDoesNotExist.New(typName)
 }
 
+// NextOid returns an OID that is greater than all OIDs used by the Types in the collection (including their array
+// types, relations and enum labels). OIDs are assigned in ascending order, starting from the first OID that Postgres
+// reserves for user-defined objects.
+func (pgs *TypeCollection) NextOid() uint32 {
+	pgs.mutex.RLock()
+	defer pgs.mutex.RUnlock()
+
+	nextOid := uint32(firstUserOid)
+	for _, nameMap := range pgs.schemaMap {
+		for _, typ := range nameMap {
+			nextOid = utils.Max(nextOid, typ.Oid+1, typ.Array+1, typ.RelID+1)
+			for _, label := range typ.EnumLabels {
+				nextOid = utils.Max(nextOid, label.Oid+1)
+			}
+		}
+	}
+	return nextOid
+}
+
 // IterateTypes iterates over all Types in the collection.
 func (pgs *TypeCollection) IterateTypes(f func(schema string, typ *types.Type) error) error {
 	pgs.mutex.Lock()
@@ -142,6 +181,14 @@ func (pgs *TypeCollection) Clone() *TypeCollection {
 		clonedNameMap := make(map[string]*types.Type)
 		for key, typ := range nameMap {
 			newType := *typ
+			if len(typ.EnumLabels) > 0 {
+				newType.EnumLabels = make([]types.EnumLabel, len(typ.EnumLabels))
+				copy(newType.EnumLabels, typ.EnumLabels)
+			}
+			if len(typ.CompositeAttrs) > 0 {
+				newType.CompositeAttrs = make([]types.CompositeAttribute, len(typ.CompositeAttrs))
+				copy(newType.CompositeAttrs, typ.CompositeAttrs)
+			}
 			clonedNameMap[key] = &newType
 		}
 		newCollection.schemaMap[schema] = clonedNameMap
@@ -106,7 +106,7 @@ func helpWith(sqllex sqlLexer, helpText string) int {
 // the current built-in function.
 func helpWithFunction(sqllex sqlLexer, f tree.ResolvableFunctionReference) int {
 	d, err := f.Resolve(sessiondata.SearchPath{})
-	if err != nil {
+	if err != nil || d == nil {
 		return 1
 	}
 
@@ -28,6 +28,7 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/cockroachdb/errors"
 
@@ -244,6 +245,8 @@ func (l *lexer) populateErrorDetails() {
 	// Output a caret indicating where the last token starts.
 	fmt.Fprintf(&buf, "%s^", strings.Repeat(" ", int(lastTok.pos)-j))
 	l.lastError = errors.WithDetail(l.lastError, buf.String())
+	// Postgres reports the position as a 1-based character index, rather than a byte offset
+	l.lastError = pgerror.WithPosition(l.lastError, utf8.RuneCountInString(l.in[:lastTok.pos])+1)
 }
 
 // SetHelp marks the "last error" field in the lexer to become a
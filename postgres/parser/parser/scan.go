@@ -248,6 +248,15 @@ func (s *scanner) scan(lval *sqlSymType) {
 		}
 		return
 
+	case '=':
+		switch s.peek() {
+		case '>': // =>
+			s.pos++
+			lval.id = EQUALS_GREATER
+			return
+		}
+		return
+
 	case '<':
 		switch s.peek() {
 		case '<': // <<
@@ -306,6 +315,10 @@ func (s *scanner) scan(lval *sqlSymType) {
 			s.pos++
 			lval.id = TYPECAST
 			return
+		case '=': // :=
+			s.pos++
+			lval.id = COLON_EQUALS
+			return
 		}
 		return
 
@@ -355,6 +368,11 @@ func (s *scanner) scan(lval *sqlSymType) {
 		case '@': //@@
 			s.pos++
 			lval.id = TEXTSEARCHMATCH
+			return
+		case '?': // @?
+			s.pos++
+			lval.id = JSON_PATH_EXISTS
+			return
 		}
 		return
 
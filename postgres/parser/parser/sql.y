@@ -633,6 +633,9 @@ func (u *sqlSymUnion) triggerEvents() tree.TriggerEvents {
 func (u *sqlSymUnion) triggerTime() tree.TriggerTime {
     return u.val.(tree.TriggerTime)
 }
+func (u *sqlSymUnion) policyCommand() tree.PolicyCommand {
+    return u.val.(tree.PolicyCommand)
+}
 func (u *sqlSymUnion) languageHandler() *tree.LanguageHandler {
     return u.val.(*tree.LanguageHandler)
 }
@@ -685,6 +688,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %token <*tree.NumVal> ICONST FCONST
 %token <*tree.Placeholder> PLACEHOLDER
 %token <str> TYPECAST TYPEANNOTATE DOT_DOT
+%token <str> EQUALS_GREATER COLON_EQUALS
 %token <str> LESS_EQUALS GREATER_EQUALS NOT_EQUALS
 %token <str> NOT_REGMATCH REGIMATCH NOT_REGIMATCH
 %token <str> TEXTSEARCHMATCH
@@ -741,7 +745,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %token <str> INNER INOUT INSERT INSTEAD INT INTEGER INTERNALLENGTH
 %token <str> INTERSECT INTERVAL INTO INTO_DB INVERTED INVOKER IS ISERROR ISNULL ISOLATION IS_TEMPLATE
 
-%token <str> JOB JOBS JOIN JSON JSONB JSON_SOME_EXISTS JSON_ALL_EXISTS
+%token <str> JOB JOBS JOIN JSON JSONB JSON_SOME_EXISTS JSON_ALL_EXISTS JSON_PATH_EXISTS
 
 %token <str> KEY KEYS KMS KV
 
@@ -767,7 +771,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %token <str> POSITION PRECEDING PRECISION PREFERRED PREPARE PRESERVE PRIMARY PRIORITY PRIVILEGES
 %token <str> PROCEDURAL PROCEDURE PROCEDURES PUBLIC PUBLICATION
 
-%token <str> QUERIES QUERY
+%token <str> QUERIES QUERY QUOTE
 
 %token <str> RANGE RANGES READ READ_ONLY READ_WRITE REAL RECEIVE RECURSIVE RECURRING REF REFERENCES REFERENCING REFRESH
 %token <str> REGCLASS REGPROC REGPROCEDURE REGNAMESPACE REGTYPE REINDEX RELEASE REMAINDER
@@ -780,7 +784,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %token <str> SERIALFUNC SERIALIZABLE SERVER SESSION SESSIONS SESSION_USER SET SETOF SETTING SETTINGS SEQUENCE SEQUENCES SFUNC
 %token <str> SHARE SHAREABLE SHOW SIMILAR SIMPLE SKIP SKIP_MISSING_FOREIGN_KEYS
 %token <str> SKIP_MISSING_SEQUENCES SKIP_MISSING_SEQUENCE_OWNERS SKIP_MISSING_VIEWS SMALLINT SMALLSERIAL SNAPSHOT SOME
-%token <str> SORTOP SPLIT SQL SQRT SSPACE STABLE START STATEMENT STATISTICS STATUS STDIN STRATEGY STRICT STRING
+%token <str> SORTOP SPLIT SQL SQRT SSPACE STABLE START STATEMENT STATISTICS STATUS STDIN STDOUT STRATEGY STRICT STRING
 %token <str> STORAGE STORE STORED STYPE SUBSCRIPT SUBSCRIPTION SUBSTRING SUBTYPE SUBTYPE_DIFF SUBTYPE_OPCLASS
 %token <str> SUPERUSER SUPPORT SYMMETRIC SYNTAX SYSID SYSTEM
 
@@ -830,6 +834,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <tree.Statement> alter_ddl_stmt
 %type <tree.Statement> alter_table_stmt
 %type <tree.Statement> alter_trigger_stmt
+%type <tree.Statement> alter_policy_stmt
 %type <tree.Statement> alter_index_stmt
 %type <tree.Statement> alter_materialized_view_stmt
 %type <tree.Statement> alter_function_stmt
@@ -900,6 +905,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <tree.Statement> comment_stmt
 %type <tree.Statement> commit_stmt
 %type <tree.Statement> copy_from_stmt
+%type <tree.Statement> copy_to_stmt
 
 %type <tree.Statement> create_stmt
 %type <tree.Statement> create_changefeed_stmt
@@ -920,6 +926,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <tree.Statement> create_materialized_view_stmt
 %type <tree.Statement> create_sequence_stmt
 %type <tree.Statement> create_trigger_stmt
+%type <tree.Statement> create_policy_stmt
 %type <tree.Statement> create_domain_stmt
 %type <tree.Statement> create_aggregate_stmt
 
@@ -944,6 +951,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <tree.Statement> drop_schema_stmt
 %type <tree.Statement> drop_table_stmt
 %type <tree.Statement> drop_trigger_stmt
+%type <tree.Statement> drop_policy_stmt
 %type <tree.Statement> drop_type_stmt
 %type <tree.Statement> drop_view_stmt
 %type <tree.Statement> drop_domain_stmt
@@ -1156,11 +1164,15 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <tree.TriggerEvent> trigger_event
 %type <tree.TriggerEvents> trigger_events
 %type <tree.TriggerTime> trigger_time
+%type <tree.PolicyCommand> opt_policy_command
+%type <bool> opt_policy_restrictive
+%type <[]string> opt_policy_roles
+%type <tree.Expr> opt_policy_using opt_policy_with_check
 
 %type <*tree.TableIndexName> table_index_name
 %type <tree.TableIndexNames> table_index_name_list
 
-%type <tree.Operator> math_op operator
+%type <tree.Operator> math_op operator qual_op
 
 %type <tree.IsolationLevel> iso_level
 %type <tree.UserPriority> user_priority
@@ -1186,7 +1198,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <tree.TableExprs> from_list rowsfrom_list opt_from_list
 %type <tree.TablePatterns> table_pattern_list single_table_pattern_list
 %type <tree.TableNames> table_name_list opt_locked_rels opt_inherits
-%type <tree.Exprs> expr_list opt_expr_list tuple1_ambiguous_values tuple1_unambiguous_values
+%type <tree.Exprs> expr_list func_arg_list opt_expr_list tuple1_ambiguous_values tuple1_unambiguous_values
 %type <*tree.Tuple> expr_tuple1_ambiguous expr_tuple_unambiguous
 %type <tree.SelectExprs> target_list
 %type <tree.UpdateExprs> set_clause_list
@@ -1248,7 +1260,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <*tree.IndexFlags> opt_index_flags
 %type <*tree.IndexFlags> index_flags_param
 %type <*tree.IndexFlags> index_flags_param_list
-%type <tree.Expr> a_expr b_expr c_expr d_expr typed_literal
+%type <tree.Expr> a_expr b_expr c_expr d_expr typed_literal func_arg_expr
 %type <tree.Expr> substr_from substr_for
 %type <tree.Expr> in_expr partition_bound_expr
 %type <tree.Expr> having_clause
@@ -1379,7 +1391,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %nonassoc  '<' '>' '=' LESS_EQUALS GREATER_EQUALS NOT_EQUALS
 %nonassoc  '~' BETWEEN DEFERRABLE IN LIKE ILIKE SIMILAR NOT_REGMATCH REGIMATCH NOT_REGIMATCH NOT_LA TEXTSEARCHMATCH
 %nonassoc  ESCAPE              // ESCAPE must be just above LIKE/ILIKE/SIMILAR
-%nonassoc  CONTAINS CONTAINED_BY '?' JSON_SOME_EXISTS JSON_ALL_EXISTS
+%nonassoc  CONTAINS CONTAINED_BY '?' JSON_SOME_EXISTS JSON_ALL_EXISTS JSON_PATH_EXISTS
 %nonassoc  OVERLAPS
 %left      POSTFIXOP           // dummy for postfix OP rules
 // To support target_elem without AS, we must give IDENT an explicit priority
@@ -1407,7 +1419,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 // funny behavior of UNBOUNDED on the SQL standard, though.
 %nonassoc  UNBOUNDED         // ideally should have same precedence as IDENT
 %nonassoc  IDENT NULL PARTITION RANGE ROWS GROUPS PRECEDING FOLLOWING CUBE ROLLUP
-%left      CONCAT FETCHVAL FETCHTEXT FETCHVAL_PATH FETCHTEXT_PATH REMOVE_PATH  // multi-character ops
+%left      CONCAT FETCHVAL FETCHTEXT FETCHVAL_PATH FETCHTEXT_PATH REMOVE_PATH OPERATOR  // multi-character ops
 %left      '|'
 %left      '#'
 %left      '&'
@@ -1455,6 +1467,7 @@ non_transaction_stmt:
 | analyze_stmt      // EXTEND WITH HELP: ANALYZE
 | call_stmt
 | copy_from_stmt
+| copy_to_stmt
 | comment_stmt
 | execute_stmt      // EXTEND WITH HELP: EXECUTE
 | deallocate_stmt   // EXTEND WITH HELP: DEALLOCATE
@@ -1504,6 +1517,7 @@ alter_ddl_stmt:
 | alter_schema_stmt             // EXTEND WITH HELP: ALTER SCHEMA
 | alter_type_stmt               // EXTEND WITH HELP: ALTER TYPE
 | alter_trigger_stmt            // EXTEND WITH HELP: ALTER TRIGGER
+| alter_policy_stmt             // EXTEND WITH HELP: ALTER POLICY
 | alter_language_stmt           // EXTEND WITH HELP: ALTER LANGUAGE
 | alter_domain_stmt             // EXTEND WITH HELP: ALTER DOMAIN
 
@@ -2314,15 +2328,15 @@ row_level_security:
   {
     $$.val = &tree.AlterTableRowLevelSecurity{Type: tree.RowLevelSecurityDisable}
   }
-| ENABLE
+| ENABLE ROW LEVEL SECURITY
   {
     $$.val = &tree.AlterTableRowLevelSecurity{Type: tree.RowLevelSecurityEnable}
   }
-| FORCE
+| FORCE ROW LEVEL SECURITY
   {
     $$.val = &tree.AlterTableRowLevelSecurity{Type: tree.RowLevelSecurityForce}
   }
-| NO FORCE
+| NO FORCE ROW LEVEL SECURITY
   {
     $$.val = &tree.AlterTableRowLevelSecurity{Type: tree.RowLevelSecurityNoForce}
   }
@@ -2791,6 +2805,22 @@ alter_trigger_stmt:
     $$.val = &tree.AlterTrigger{Name: tree.Name($3), OnTable: $5.unresolvedObjectName().ToTableName(), No: $6.bool(), Extension: $10}
   }
 
+alter_policy_stmt:
+  ALTER POLICY name ON table_name RENAME TO name
+  {
+    $$.val = &tree.AlterPolicy{Name: tree.Name($3), Table: $5.unresolvedObjectName().ToTableName(), NewName: tree.Name($8)}
+  }
+| ALTER POLICY name ON table_name opt_policy_roles opt_policy_using opt_policy_with_check
+  {
+    $$.val = &tree.AlterPolicy{
+      Name: tree.Name($3),
+      Table: $5.unresolvedObjectName().ToTableName(),
+      Roles: $6.strs(),
+      Using: $7.expr(),
+      WithCheck: $8.expr(),
+    }
+  }
+
 alter_aggregate_stmt:
   ALTER AGGREGATE aggregate_name '(' aggregate_signature ')' RENAME TO unrestricted_name
   {
@@ -3559,7 +3589,7 @@ call_stmt:
 // We currently support only the #2 format.
 // See the comment for CopyStmt in https://github.com/postgres/postgres/blob/master/src/backend/parser/gram.y.
 copy_from_stmt:
- COPY table_name opt_column_list FROM SCONST opt_with '(' copy_options_list ')'
+ COPY table_name opt_column_list FROM SCONST opt_with '(' copy_options_list ')' opt_where_clause
   {
     name := $2.unresolvedObjectName().ToTableName()
     $$.val = &tree.CopyFrom{
@@ -3568,9 +3598,10 @@ copy_from_stmt:
        Columns: $3.nameList(),
        Stdin: false,
        Options: *$8.copyOptions(),
+       Where: tree.NewWhere(tree.AstWhere, $10.expr()),
     }
   }
-| COPY table_name opt_column_list FROM SCONST opt_legacy_copy_options
+| COPY table_name opt_column_list FROM SCONST opt_legacy_copy_options opt_where_clause
   {
     name := $2.unresolvedObjectName().ToTableName()
     $$.val = &tree.CopyFrom{
@@ -3579,9 +3610,10 @@ copy_from_stmt:
        Columns: $3.nameList(),
        Stdin: false,
        Options: *$6.copyOptions(),
+       Where: tree.NewWhere(tree.AstWhere, $7.expr()),
     }
   }
-| COPY table_name opt_column_list FROM STDIN opt_with '(' copy_options_list ')'
+| COPY table_name opt_column_list FROM STDIN opt_with '(' copy_options_list ')' opt_where_clause
   {
     name := $2.unresolvedObjectName().ToTableName()
     $$.val = &tree.CopyFrom{
@@ -3589,9 +3621,10 @@ copy_from_stmt:
        Columns: $3.nameList(),
        Stdin: true,
        Options: *$8.copyOptions(),
+       Where: tree.NewWhere(tree.AstWhere, $10.expr()),
     }
   }
-| COPY table_name opt_column_list FROM STDIN opt_legacy_copy_options
+| COPY table_name opt_column_list FROM STDIN opt_legacy_copy_options opt_where_clause
   {
     name := $2.unresolvedObjectName().ToTableName()
     $$.val = &tree.CopyFrom{
@@ -3599,12 +3632,86 @@ copy_from_stmt:
        Columns: $3.nameList(),
        Stdin: true,
        Options: *$6.copyOptions(),
+       Where: tree.NewWhere(tree.AstWhere, $7.expr()),
     }
   }
 
+copy_to_stmt:
+  COPY table_name opt_column_list TO SCONST opt_with '(' copy_options_list ')'
+  {
+    name := $2.unresolvedObjectName().ToTableName()
+    $$.val = &tree.CopyTo{
+       Table: name,
+       Columns: $3.nameList(),
+       File: $5,
+       Options: *$8.copyOptions(),
+    }
+  }
+| COPY table_name opt_column_list TO SCONST opt_legacy_copy_options
+  {
+    name := $2.unresolvedObjectName().ToTableName()
+    $$.val = &tree.CopyTo{
+       Table: name,
+       Columns: $3.nameList(),
+       File: $5,
+       Options: *$6.copyOptions(),
+    }
+  }
+| COPY table_name opt_column_list TO STDOUT opt_with '(' copy_options_list ')'
+  {
+    name := $2.unresolvedObjectName().ToTableName()
+    $$.val = &tree.CopyTo{
+       Table: name,
+       Columns: $3.nameList(),
+       Stdout: true,
+       Options: *$8.copyOptions(),
+    }
+  }
+| COPY table_name opt_column_list TO STDOUT opt_legacy_copy_options
+  {
+    name := $2.unresolvedObjectName().ToTableName()
+    $$.val = &tree.CopyTo{
+       Table: name,
+       Columns: $3.nameList(),
+       Stdout: true,
+       Options: *$6.copyOptions(),
+    }
+  }
+| COPY select_with_parens TO SCONST opt_with '(' copy_options_list ')'
+  {
+    $$.val = &tree.CopyTo{
+       Statement: $2.selectStmt().(*tree.ParenSelect).Select,
+       File: $4,
+       Options: *$7.copyOptions(),
+    }
+  }
+| COPY select_with_parens TO SCONST opt_legacy_copy_options
+  {
+    $$.val = &tree.CopyTo{
+       Statement: $2.selectStmt().(*tree.ParenSelect).Select,
+       File: $4,
+       Options: *$5.copyOptions(),
+    }
+  }
+| COPY select_with_parens TO STDOUT opt_with '(' copy_options_list ')'
+  {
+    $$.val = &tree.CopyTo{
+       Statement: $2.selectStmt().(*tree.ParenSelect).Select,
+       Stdout: true,
+       Options: *$7.copyOptions(),
+    }
+  }
+| COPY select_with_parens TO STDOUT opt_legacy_copy_options
+  {
+    $$.val = &tree.CopyTo{
+       Statement: $2.selectStmt().(*tree.ParenSelect).Select,
+       Stdout: true,
+       Options: *$5.copyOptions(),
+    }
+  }
 
 // legacy_copy_options represent the previous format that PostgreSQL supported for
-// specifying COPY FROM options. They do not use the WITH keyword and do not use parens.
+// specifying COPY options. They do not use the WITH keyword and do not use parens.
 opt_legacy_copy_options:
   legacy_copy_options_list
   {
@@ -3630,7 +3737,7 @@ legacy_copy_options_list:
 legacy_copy_options:
  BINARY
   {
-    $$.val = &tree.CopyOptions{CopyFormat: tree.CopyFormatBinary}
+    $$.val = &tree.CopyOptions{CopyFormat: tree.CopyFormatBinary, HasFormat: true}
   }
 | DELIMITER SCONST
   {
@@ -3638,11 +3745,43 @@ legacy_copy_options:
   }
 | CSV
   {
-    $$.val = &tree.CopyOptions{CopyFormat: tree.CopyFormatCsv}
+    $$.val = &tree.CopyOptions{CopyFormat: tree.CopyFormatCsv, HasFormat: true}
   }
 | HEADER
   {
-    $$.val = &tree.CopyOptions{Header: true}
+    $$.val = &tree.CopyOptions{Header: true, HasHeader: true}
+  }
+| NULL opt_as SCONST
+  {
+    null := $3
+    $$.val = &tree.CopyOptions{Null: &null}
+  }
+| QUOTE opt_as SCONST
+  {
+    quote := $3
+    $$.val = &tree.CopyOptions{Quote: &quote}
+  }
+| ESCAPE opt_as SCONST
+  {
+    escape := $3
+    $$.val = &tree.CopyOptions{Escape: &escape}
+  }
+| FORCE QUOTE name
+  {
+    // The legacy options are separated by commas, so only a single column may be given here
+    $$.val = &tree.CopyOptions{ForceQuote: tree.NameList{tree.Name($3)}}
+  }
+| FORCE QUOTE '*'
+  {
+    $$.val = &tree.CopyOptions{ForceQuoteAll: true}
+  }
+| FORCE NOT NULL name
+  {
+    $$.val = &tree.CopyOptions{ForceNotNull: tree.NameList{tree.Name($4)}}
+  }
+| FORCE NULL name
+  {
+    $$.val = &tree.CopyOptions{ForceNull: tree.NameList{tree.Name($3)}}
   }
 
 copy_options_list:
@@ -3660,28 +3799,76 @@ copy_options_list:
 copy_options:
  FORMAT CSV
   {
-    $$.val = &tree.CopyOptions{CopyFormat: tree.CopyFormatCsv}
+    $$.val = &tree.CopyOptions{CopyFormat: tree.CopyFormatCsv, HasFormat: true}
   }
 | FORMAT TEXT
   {
-    $$.val = &tree.CopyOptions{CopyFormat: tree.CopyFormatText}
+    $$.val = &tree.CopyOptions{CopyFormat: tree.CopyFormatText, HasFormat: true}
   }
 | FORMAT BINARY
   {
-    $$.val = &tree.CopyOptions{CopyFormat: tree.CopyFormatBinary}
+    $$.val = &tree.CopyOptions{CopyFormat: tree.CopyFormatBinary, HasFormat: true}
   }
 | HEADER
   {
-    $$.val = &tree.CopyOptions{Header: true}
+    $$.val = &tree.CopyOptions{Header: true, HasHeader: true}
   }
 | HEADER boolean_value
   {
-    $$.val = &tree.CopyOptions{Header: $2.val.(bool)}
+    $$.val = &tree.CopyOptions{Header: $2.val.(bool), HasHeader: true}
   }
 | DELIMITER SCONST
   {
     $$.val = &tree.CopyOptions{Delimiter: $2}
   }
+| NULL SCONST
+  {
+    null := $2
+    $$.val = &tree.CopyOptions{Null: &null}
+  }
+| QUOTE SCONST
+  {
+    quote := $2
+    $$.val = &tree.CopyOptions{Quote: &quote}
+  }
+| ESCAPE SCONST
+  {
+    escape := $2
+    $$.val = &tree.CopyOptions{Escape: &escape}
+  }
+| ENCODING SCONST
+  {
+    encoding := $2
+    $$.val = &tree.CopyOptions{Encoding: &encoding}
+  }
+| IDENT '(' name_list ')'
+  {
+    switch $1 {
+    case "force_quote":
+      $$.val = &tree.CopyOptions{ForceQuote: $3.nameList()}
+    case "force_not_null":
+      $$.val = &tree.CopyOptions{ForceNotNull: $3.nameList()}
+    case "force_null":
+      $$.val = &tree.CopyOptions{ForceNull: $3.nameList()}
+    default:
+      sqllex.Error(fmt.Sprintf("option %q not recognized", $1))
+      return 1
+    }
+  }
+| IDENT '*'
+  {
+    switch $1 {
+    case "force_quote":
+      $$.val = &tree.CopyOptions{ForceQuoteAll: true}
+    case "force_not_null":
+      $$.val = &tree.CopyOptions{ForceNotNullAll: true}
+    case "force_null":
+      $$.val = &tree.CopyOptions{ForceNullAll: true}
+    default:
+      sqllex.Error(fmt.Sprintf("option %q not recognized", $1))
+      return 1
+    }
+  }
 
 // %Help: CANCEL
 // %Category: Group
@@ -4752,6 +4939,7 @@ create_ddl_stmt_schema_element:
 | create_materialized_view_stmt // EXTEND WITH HELP: CREATE MATERIALIZED VIEW
 | create_sequence_stmt // EXTEND WITH HELP: CREATE SEQUENCE
 | create_trigger_stmt  // EXTEND WITH HELP: CREATE TRIGGER
+| create_policy_stmt   // EXTEND WITH HELP: CREATE POLICY
 
 // %Help: CREATE STATISTICS - create a new table statistic
 // %Category: Misc
@@ -4960,6 +5148,7 @@ drop_ddl_stmt:
 | drop_index_stmt    // EXTEND WITH HELP: DROP INDEX
 | drop_table_stmt    // EXTEND WITH HELP: DROP TABLE
 | drop_trigger_stmt  // EXTEND WITH HELP: DROP TRIGGER
+| drop_policy_stmt   // EXTEND WITH HELP: DROP POLICY
 | drop_view_stmt     // EXTEND WITH HELP: DROP VIEW
 | drop_sequence_stmt // EXTEND WITH HELP: DROP SEQUENCE
 | drop_schema_stmt   // EXTEND WITH HELP: DROP SCHEMA
@@ -5038,6 +5227,16 @@ drop_trigger_stmt:
     $$.val = &tree.DropTrigger{Name: tree.Name($5), OnTable: $7.unresolvedObjectName().ToTableName(), DropBehavior: $8.dropBehavior()}
   }
 
+drop_policy_stmt:
+  DROP POLICY name ON table_name opt_drop_behavior
+  {
+    $$.val = &tree.DropPolicy{Name: tree.Name($3), Table: $5.unresolvedObjectName().ToTableName(), DropBehavior: $6.dropBehavior()}
+  }
+| DROP POLICY IF EXISTS name ON table_name opt_drop_behavior
+  {
+    $$.val = &tree.DropPolicy{Name: tree.Name($5), IfExists: true, Table: $7.unresolvedObjectName().ToTableName(), DropBehavior: $8.dropBehavior()}
+  }
+
 // %Help: DROP INDEX - remove an index
 // %Category: DDL
 // %Text: DROP INDEX [CONCURRENTLY] [IF EXISTS] <idxname> [, ...] [CASCADE | RESTRICT]
@@ -8450,6 +8649,94 @@ function_or_procedure:
   FUNCTION
 | PROCEDURE
 
+create_policy_stmt:
+  CREATE POLICY name ON table_name opt_policy_restrictive opt_policy_command opt_policy_roles opt_policy_using opt_policy_with_check
+  {
+    $$.val = &tree.CreatePolicy{
+      Name: tree.Name($3),
+      Table: $5.unresolvedObjectName().ToTableName(),
+      Restrictive: $6.bool(),
+      Command: $7.policyCommand(),
+      Roles: $8.strs(),
+      Using: $9.expr(),
+      WithCheck: $10.expr(),
+    }
+  }
+
+opt_policy_restrictive:
+  /* EMPTY */
+  {
+    $$.val = false
+  }
+| AS IDENT
+  {
+    switch $2 {
+    case "permissive":
+      $$.val = false
+    case "restrictive":
+      $$.val = true
+    default:
+      sqllex.Error(fmt.Sprintf("unrecognized row security option %q", $2))
+      return 1
+    }
+  }
+
+opt_policy_command:
+  /* EMPTY */
+  {
+    $$.val = tree.PolicyCommandAll
+  }
+| FOR ALL
+  {
+    $$.val = tree.PolicyCommandAll
+  }
+| FOR SELECT
+  {
+    $$.val = tree.PolicyCommandSelect
+  }
+| FOR INSERT
+  {
+    $$.val = tree.PolicyCommandInsert
+  }
+| FOR UPDATE
+  {
+    $$.val = tree.PolicyCommandUpdate
+  }
+| FOR DELETE
+  {
+    $$.val = tree.PolicyCommandDelete
+  }
+
+opt_policy_roles:
+  /* EMPTY */
+  {
+    $$.val = []string(nil)
+  }
+| TO role_spec_list
+  {
+    $$.val = $2.strs()
+  }
+
+opt_policy_using:
+  /* EMPTY */
+  {
+    $$.val = nil
+  }
+| USING '(' a_expr ')'
+  {
+    $$.val = $3.expr()
+  }
+
+opt_policy_with_check:
+  /* EMPTY */
+  {
+    $$.val = nil
+  }
+| WITH CHECK '(' a_expr ')'
+  {
+    $$.val = $4.expr()
+  }
+
 opt_when:
   /* EMPTY */
   {
@@ -8875,7 +9162,7 @@ opt_role_options:
 valid_until_clause:
   VALID UNTIL non_reserved_word_or_sconst
   {
-    $$.val = tree.KVOption{Key: tree.Name(fmt.Sprintf("%s_%s", $1, $2)), Value: tree.NewDString($2)}
+    $$.val = tree.KVOption{Key: tree.Name(fmt.Sprintf("%s_%s", $1, $2)), Value: tree.NewDString($3)}
   }
 | VALID UNTIL NULL
   {
@@ -12029,6 +12316,10 @@ a_expr:
   {
     $$.val = &tree.CollateExpr{Expr: $1.expr(), Locale: $3}
   }
+| a_expr COLLATE name '.' collation_name
+  {
+    $$.val = &tree.CollateExpr{Expr: $1.expr(), Locale: $5}
+  }
 | a_expr AT TIME ZONE a_expr %prec AT
   {
     $$.val = &tree.FuncExpr{Func: tree.WrapFunction("timezone"), Exprs: tree.Exprs{$5.expr(), $1.expr()}}
@@ -12125,6 +12416,10 @@ a_expr:
   {
     $$.val = &tree.ComparisonExpr{Operator: tree.JSONAllExists, Left: $1.expr(), Right: $3.expr()}
   }
+| a_expr JSON_PATH_EXISTS a_expr
+  {
+    $$.val = &tree.ComparisonExpr{Operator: tree.JSONPathExists, Left: $1.expr(), Right: $3.expr()}
+  }
 | a_expr CONTAINS a_expr
   {
     $$.val = &tree.ComparisonExpr{Operator: tree.Contains, Left: $1.expr(), Right: $3.expr()}
@@ -12167,7 +12462,7 @@ a_expr:
   }
 | a_expr REMOVE_PATH a_expr
   {
-    $$.val = &tree.FuncExpr{Func: tree.WrapFunction("json_remove_path"), Exprs: tree.Exprs{$1.expr(), $3.expr()}}
+    $$.val = &tree.FuncExpr{Func: tree.WrapFunction("jsonb_delete_path"), Exprs: tree.Exprs{$1.expr(), $3.expr()}}
   }
 | a_expr INET_CONTAINED_BY_OR_EQUALS a_expr
   {
@@ -12277,6 +12572,18 @@ a_expr:
   {
     $$.val = &tree.ComparisonExpr{Operator: tree.TextSearchMatch, Left: $1.expr(), Right: $3.expr()}
   }
+| a_expr qual_op a_expr %prec CONCAT
+  {
+    switch op := $2.op().(type) {
+    case tree.ComparisonOperator:
+      $$.val = &tree.ComparisonExpr{Operator: op, Left: $1.expr(), Right: $3.expr()}
+    case tree.BinaryOperator:
+      $$.val = &tree.BinaryExpr{Operator: op, Left: $1.expr(), Right: $3.expr()}
+    default:
+      sqllex.Error(fmt.Sprintf("operator %s is not a binary operator", op))
+      return 1
+    }
+  }
 | a_expr IS NAN %prec IS
   {
     $$.val = &tree.ComparisonExpr{Operator: tree.EQ, Left: $1.expr(), Right: tree.NewStrVal("NaN")}
@@ -12301,7 +12608,19 @@ a_expr:
   {
     $$.val = &tree.IsNotNullExpr{Expr: $1.expr()}
   }
-| row OVERLAPS row { return unimplemented(sqllex, "overlaps") }
+| row OVERLAPS row
+  {
+    left, right := $1.tuple(), $3.tuple()
+    if len(left.Exprs) != 2 {
+      sqllex.Error("wrong number of parameters on left side of OVERLAPS expression")
+      return 1
+    }
+    if len(right.Exprs) != 2 {
+      sqllex.Error("wrong number of parameters on right side of OVERLAPS expression")
+      return 1
+    }
+    $$.val = &tree.FuncExpr{Func: tree.WrapFunction("overlaps"), Exprs: tree.Exprs{left.Exprs[0], left.Exprs[1], right.Exprs[0], right.Exprs[1]}}
+  }
 | a_expr IS TRUE %prec IS
   {
     $$.val = &tree.ComparisonExpr{Operator: tree.IsNotDistinctFrom, Left: $1.expr(), Right: tree.MakeDBool(true)}
@@ -12588,7 +12907,7 @@ d_expr:
     if err != nil { return setErr(sqllex, err) }
     $$.val = d
   }
-| func_name '(' expr_list opt_sort_clause ')' SCONST { return unimplemented(sqllex, $1.unresolvedName().String() + "(...) SCONST") }
+| func_name '(' func_arg_list opt_sort_clause ')' SCONST { return unimplemented(sqllex, $1.unresolvedName().String() + "(...) SCONST") }
 | typed_literal
   {
     $$.val = $1.expr()
@@ -12666,12 +12985,12 @@ func_application:
   {
     $$.val = &tree.FuncExpr{Func: $1.resolvableFuncRefFromName()}
   }
-| func_name '(' expr_list opt_sort_clause ')'
+| func_name '(' func_arg_list opt_sort_clause ')'
   {
     $$.val = &tree.FuncExpr{Func: $1.resolvableFuncRefFromName(), Exprs: $3.exprs(), OrderBy: $4.orderBy(), AggType: tree.GeneralAgg}
   }
 | func_name '(' VARIADIC a_expr opt_sort_clause ')' { return unimplemented(sqllex, "variadic") }
-| func_name '(' expr_list ',' VARIADIC a_expr opt_sort_clause ')' { return unimplemented(sqllex, "variadic") }
+| func_name '(' func_arg_list ',' VARIADIC a_expr opt_sort_clause ')' { return unimplemented(sqllex, "variadic") }
 | func_name '(' ALL expr_list opt_sort_clause ')'
   {
     $$.val = &tree.FuncExpr{Func: $1.resolvableFuncRefFromName(), Type: tree.AllFuncType, Exprs: $4.exprs(), OrderBy: $5.orderBy(), AggType: tree.GeneralAgg}
@@ -13262,6 +13581,7 @@ operator:
 | '?' { $$.val = tree.JSONExists }
 | JSON_SOME_EXISTS { $$.val = tree.JSONSomeExists }
 | JSON_ALL_EXISTS { $$.val = tree.JSONAllExists }
+| JSON_PATH_EXISTS { $$.val = tree.JSONPathExists }
 | CONTAINS { $$.val = tree.Contains }
 | CONTAINED_BY { $$.val = tree.ContainedBy }
 | CONCAT { $$.val = tree.Concat }
@@ -13274,6 +13594,18 @@ operator:
 | AND_AND { $$.val = tree.Overlaps }
 | TEXTSEARCHMATCH { $$.val = tree.TextSearchMatch }
 
+// OPERATOR(schema.op) is how PostgreSQL qualifies an operator with its schema. There are no user-defined operators, so
+// the schema name is accepted but otherwise ignored.
+qual_op:
+  OPERATOR '(' operator ')'
+  {
+    $$.val = $3.op()
+  }
+| OPERATOR '(' name '.' operator ')'
+  {
+    $$.val = $5.op()
+  }
+
 math_op:
   '+' { $$.val = tree.Plus  }
 | '-' { $$.val = tree.Minus }
@@ -13385,6 +13717,29 @@ expr_list:
     $$.val = append($1.exprs(), $3.expr())
   }
 
+// Function arguments may be given in positional notation, or in named notation
+// using either "name => value" or the older "name := value" syntax.
+func_arg_list:
+  func_arg_expr
+  {
+    $$.val = tree.Exprs{$1.expr()}
+  }
+| func_arg_list ',' func_arg_expr
+  {
+    $$.val = append($1.exprs(), $3.expr())
+  }
+
+func_arg_expr:
+  a_expr
+| type_function_name EQUALS_GREATER a_expr
+  {
+    $$.val = &tree.NamedArgExpr{Name: tree.Name($1), Expr: $3.expr()}
+  }
+| type_function_name COLON_EQUALS a_expr
+  {
+    $$.val = &tree.NamedArgExpr{Name: tree.Name($1), Expr: $3.expr()}
+  }
+
 type_list:
   typename
   {
@@ -13480,6 +13835,7 @@ position_list:
 //   - substring(text for int) get first "int" characters of string
 //   - substring(text from pattern) get entire string matching pattern
 //   - substring(text from pattern for escape) same with specified escape char
+//   - substring(text similar pattern escape escape) same as above, using the SQL standard syntax
 // We also want to support generic substring functions which accept
 // the usual generic list of arguments. So we will accept both styles
 // here, and convert the SQL9x style to the generic list for further
@@ -13499,7 +13855,11 @@ substr_list:
   }
 | a_expr substr_for
   {
-    $$.val = tree.Exprs{$1.expr(), tree.NewDInt(1), $2.expr()}
+    $$.val = tree.Exprs{$1.expr(), tree.NewNumVal(constant.MakeInt64(1), "1", false /* negative */), $2.expr()}
+  }
+| a_expr SIMILAR a_expr ESCAPE a_expr
+  {
+    $$.val = tree.Exprs{$1.expr(), $3.expr(), $5.expr()}
   }
 | opt_expr_list
   {
@@ -14485,6 +14845,7 @@ unreserved_keyword:
 | PUBLICATION
 | QUERIES
 | QUERY
+| QUOTE
 | RANGE
 | RANGES
 | READ
@@ -14570,6 +14931,7 @@ unreserved_keyword:
 | STATISTICS
 | STATUS
 | STDIN
+| STDOUT
 | STORAGE
 | STORE
 | STORED
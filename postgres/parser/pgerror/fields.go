@@ -0,0 +1,168 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgerror
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gogo/protobuf/proto"
+)
+
+// Field identifies one of the optional object-name fields of a Postgres error. The values match the field identifiers
+// used by the ErrorResponse message of the wire protocol.
+// https://www.postgresql.org/docs/current/protocol-error-fields.html
+type Field byte
+
+const (
+	Field_SchemaName     Field = 's'
+	Field_TableName      Field = 't'
+	Field_ColumnName     Field = 'c'
+	Field_DataTypeName   Field = 'd'
+	Field_ConstraintName Field = 'n'
+)
+
+// WithField decorates the error with the given field. An empty value leaves the error unchanged.
+func WithField(err error, field Field, value string) error {
+	if err == nil || len(value) == 0 {
+		return err
+	}
+	return &withField{cause: err, field: field, value: value}
+}
+
+// WithSchemaName decorates the error with the name of the schema that the error is associated with.
+func WithSchemaName(err error, schemaName string) error {
+	return WithField(err, Field_SchemaName, schemaName)
+}
+
+// WithTableName decorates the error with the name of the table that the error is associated with.
+func WithTableName(err error, tableName string) error {
+	return WithField(err, Field_TableName, tableName)
+}
+
+// WithColumnName decorates the error with the name of the column that the error is associated with.
+func WithColumnName(err error, columnName string) error {
+	return WithField(err, Field_ColumnName, columnName)
+}
+
+// WithConstraintName decorates the error with the name of the constraint that the error is associated with.
+func WithConstraintName(err error, constraintName string) error {
+	return WithField(err, Field_ConstraintName, constraintName)
+}
+
+// GetField returns the outermost value for the given field, or an empty string if the field was never set.
+func GetField(err error, field Field) string {
+	for ; err != nil; err = errors.UnwrapOnce(err) {
+		if w, ok := err.(*withField); ok && w.field == field {
+			return w.value
+		}
+	}
+	return ""
+}
+
+// WithPosition decorates the error with a cursor position, which is a 1-based character index into the original
+// query string.
+func WithPosition(err error, position int) error {
+	if err == nil || position <= 0 {
+		return err
+	}
+	return &withPosition{cause: err, position: int32(position)}
+}
+
+// GetPosition returns the cursor position of the error, or zero if no position was set.
+func GetPosition(err error) int32 {
+	if w := (*withPosition)(nil); errors.As(err, &w) {
+		return w.position
+	}
+	return 0
+}
+
+// withField decorates an error with an object-name field.
+type withField struct {
+	cause error
+	field Field
+	value string
+}
+
+var _ error = (*withField)(nil)
+var _ errors.SafeDetailer = (*withField)(nil)
+var _ fmt.Formatter = (*withField)(nil)
+var _ errors.Formatter = (*withField)(nil)
+
+func (w *withField) Error() string         { return w.cause.Error() }
+func (w *withField) Cause() error          { return w.cause }
+func (w *withField) Unwrap() error         { return w.cause }
+func (w *withField) SafeDetails() []string { return []string{string(w.field), w.value} }
+
+func (w *withField) Format(s fmt.State, verb rune) { errors.FormatError(w, s, verb) }
+
+func (w *withField) FormatError(p errors.Printer) (next error) {
+	if p.Detail() {
+		p.Printf("field %c: %s", w.field, w.value)
+	}
+	return w.cause
+}
+
+// decodeWithField is a custom decoder that will be used when decoding withField error objects.
+func decodeWithField(_ context.Context, cause error, _ string, details []string, _ proto.Message) error {
+	if len(details) < 2 || len(details[0]) != 1 {
+		return cause
+	}
+	return &withField{cause: cause, field: Field(details[0][0]), value: details[1]}
+}
+
+// withPosition decorates an error with a cursor position.
+type withPosition struct {
+	cause    error
+	position int32
+}
+
+var _ error = (*withPosition)(nil)
+var _ errors.SafeDetailer = (*withPosition)(nil)
+var _ fmt.Formatter = (*withPosition)(nil)
+var _ errors.Formatter = (*withPosition)(nil)
+
+func (w *withPosition) Error() string         { return w.cause.Error() }
+func (w *withPosition) Cause() error          { return w.cause }
+func (w *withPosition) Unwrap() error         { return w.cause }
+func (w *withPosition) SafeDetails() []string { return []string{strconv.Itoa(int(w.position))} }
+
+func (w *withPosition) Format(s fmt.State, verb rune) { errors.FormatError(w, s, verb) }
+
+func (w *withPosition) FormatError(p errors.Printer) (next error) {
+	if p.Detail() {
+		p.Printf("position: %d", w.position)
+	}
+	return w.cause
+}
+
+// decodeWithPosition is a custom decoder that will be used when decoding withPosition error objects.
+func decodeWithPosition(_ context.Context, cause error, _ string, details []string, _ proto.Message) error {
+	if len(details) == 0 {
+		return cause
+	}
+	position, err := strconv.Atoi(details[0])
+	if err != nil {
+		return cause
+	}
+	return &withPosition{cause: cause, position: int32(position)}
+}
+
+func init() {
+	errors.RegisterWrapperDecoder(errors.GetTypeKey((*withField)(nil)), decodeWithField)
+	errors.RegisterWrapperDecoder(errors.GetTypeKey((*withPosition)(nil)), decodeWithPosition)
+}
@@ -25,7 +25,7 @@
 package tree
 
 import (
-	"github.com/cockroachdb/errors"
+	"github.com/dolthub/doltgresql/postgres/parser/lex"
 )
 
 // CopyFrom represents a COPY FROM statement.
@@ -35,13 +35,38 @@ type CopyFrom struct {
 	Columns NameList
 	Stdin   bool
 	Options CopyOptions
+	Where   *Where
+}
+
+// CopyTo represents a COPY TO statement. Either the Table or the Statement is set, depending on whether a table or
+// the results of a query are copied.
+type CopyTo struct {
+	Table     TableName
+	Columns   NameList
+	Statement *Select
+	File      string
+	Stdout    bool
+	Options   CopyOptions
 }
 
 // CopyOptions describes options for COPY execution.
 type CopyOptions struct {
-	CopyFormat CopyFormat
-	Header     bool
-	Delimiter  string
+	CopyFormat      CopyFormat
+	HasFormat       bool // HasFormat is set when the format was given, since the TEXT format is also the default
+	Header          bool
+	HasHeader       bool // HasHeader is set when the header option was given, since it may be given as false
+	Delimiter       string
+	Null            *string
+	Quote           *string
+	Escape          *string
+	Encoding        *string
+	ForceQuote      NameList
+	ForceQuoteAll   bool
+	ForceNotNull    NameList
+	ForceNotNullAll bool
+	ForceNull       NameList
+	ForceNullAll    bool
+	Redundant       bool // Redundant is set when an option was given more than once, which is an error for Postgres
 }
 
 var _ NodeFormatter = &CopyOptions{}
@@ -66,6 +91,38 @@ func (node *CopyFrom) Format(ctx *FmtCtx) {
 	if node.Options.Delimiter != "" {
 		ctx.WriteString(" DELIMITER '" + node.Options.Delimiter + "'")
 	}
+	if node.Where != nil {
+		ctx.WriteByte(' ')
+		ctx.FormatNode(node.Where)
+	}
+}
+
+// Format implements the NodeFormatter interface.
+func (node *CopyTo) Format(ctx *FmtCtx) {
+	ctx.WriteString("COPY ")
+	if node.Statement != nil {
+		ctx.WriteString("(")
+		ctx.FormatNode(node.Statement)
+		ctx.WriteString(")")
+	} else {
+		ctx.FormatNode(&node.Table)
+		if len(node.Columns) > 0 {
+			ctx.WriteString(" (")
+			ctx.FormatNode(&node.Columns)
+			ctx.WriteString(")")
+		}
+	}
+	ctx.WriteString(" TO ")
+	if node.Stdout {
+		ctx.WriteString("STDOUT")
+	} else {
+		lex.EncodeSQLString(&ctx.Buffer, node.File)
+	}
+	if !node.Options.IsDefault() {
+		ctx.WriteString(" WITH (")
+		ctx.FormatNode(&node.Options)
+		ctx.WriteString(")")
+	}
 }
 
 // Format implements the NodeFormatter interface
@@ -90,37 +147,131 @@ func (o *CopyOptions) Format(ctx *FmtCtx) {
 		maybeAddSep()
 		ctx.WriteString("HEADER")
 	}
+	if o.Null != nil {
+		maybeAddSep()
+		ctx.WriteString("NULL ")
+		lex.EncodeSQLString(&ctx.Buffer, *o.Null)
+	}
+	if o.Quote != nil {
+		maybeAddSep()
+		ctx.WriteString("QUOTE ")
+		lex.EncodeSQLString(&ctx.Buffer, *o.Quote)
+	}
+	if o.Escape != nil {
+		maybeAddSep()
+		ctx.WriteString("ESCAPE ")
+		lex.EncodeSQLString(&ctx.Buffer, *o.Escape)
+	}
+	if o.Encoding != nil {
+		maybeAddSep()
+		ctx.WriteString("ENCODING ")
+		lex.EncodeSQLString(&ctx.Buffer, *o.Encoding)
+	}
+	formatColumns := func(option string, columns NameList, all bool) {
+		if all {
+			maybeAddSep()
+			ctx.WriteString(option)
+			ctx.WriteString(" *")
+		} else if len(columns) > 0 {
+			maybeAddSep()
+			ctx.WriteString(option)
+			ctx.WriteString(" (")
+			ctx.FormatNode(&columns)
+			ctx.WriteString(")")
+		}
+	}
+	formatColumns("FORCE_QUOTE", o.ForceQuote, o.ForceQuoteAll)
+	formatColumns("FORCE_NOT_NULL", o.ForceNotNull, o.ForceNotNullAll)
+	formatColumns("FORCE_NULL", o.ForceNull, o.ForceNullAll)
 }
 
 // IsDefault returns true if this struct has default value.
 func (o CopyOptions) IsDefault() bool {
-	return o == CopyOptions{}
+	return o.CopyFormat == CopyFormatText && !o.Header && o.Delimiter == "" && o.Null == nil && o.Quote == nil &&
+		o.Escape == nil && o.Encoding == nil && len(o.ForceQuote) == 0 && !o.ForceQuoteAll && len(o.ForceNotNull) == 0 &&
+		!o.ForceNotNullAll && len(o.ForceNull) == 0 && !o.ForceNullAll
 }
 
-// CombineWith merges other options into this struct. An error is returned if
-// the same option merged multiple times.
+// CombineWith merges other options into this struct. If the same option is
+// merged multiple times, then the options are marked as redundant, which is
+// reported once the statement is validated.
 func (o *CopyOptions) CombineWith(other *CopyOptions) error {
-	if other.CopyFormat != CopyFormatText {
-		if o.CopyFormat != CopyFormatText {
-			return errors.New("format option specified multiple times")
+	o.Redundant = o.Redundant || other.Redundant
+	if other.HasFormat {
+		if o.HasFormat {
+			o.Redundant = true
 		}
 		o.CopyFormat = other.CopyFormat
+		o.HasFormat = true
 	}
 
-	if other.Header {
-		if o.Header {
-			return errors.New("header option specified multiple times")
+	if other.HasHeader {
+		if o.HasHeader {
+			o.Redundant = true
 		}
 		o.Header = other.Header
+		o.HasHeader = true
 	}
 
 	if other.Delimiter != "" {
 		if o.Delimiter != "" {
-			return errors.New("delimiter option specified multiple times")
+			o.Redundant = true
 		}
 		o.Delimiter = other.Delimiter
 	}
 
+	if other.Null != nil {
+		if o.Null != nil {
+			o.Redundant = true
+		}
+		o.Null = other.Null
+	}
+
+	if other.Quote != nil {
+		if o.Quote != nil {
+			o.Redundant = true
+		}
+		o.Quote = other.Quote
+	}
+
+	if other.Escape != nil {
+		if o.Escape != nil {
+			o.Redundant = true
+		}
+		o.Escape = other.Escape
+	}
+
+	if len(other.ForceQuote) > 0 || other.ForceQuoteAll {
+		if len(o.ForceQuote) > 0 || o.ForceQuoteAll {
+			o.Redundant = true
+		}
+		o.ForceQuote = other.ForceQuote
+		o.ForceQuoteAll = other.ForceQuoteAll
+	}
+
+	if other.Encoding != nil {
+		if o.Encoding != nil {
+			o.Redundant = true
+		}
+		o.Encoding = other.Encoding
+	}
+
+	if len(other.ForceNotNull) > 0 || other.ForceNotNullAll {
+		if len(o.ForceNotNull) > 0 || o.ForceNotNullAll {
+			o.Redundant = true
+		}
+		o.ForceNotNull = other.ForceNotNull
+		o.ForceNotNullAll = other.ForceNotNullAll
+	}
+
+	if len(other.ForceNull) > 0 || other.ForceNullAll {
+		if len(o.ForceNull) > 0 || o.ForceNullAll {
+			o.Redundant = true
+		}
+		o.ForceNull = other.ForceNull
+		o.ForceNullAll = other.ForceNullAll
+	}
+
 	return nil
 }
 
@@ -0,0 +1,84 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strings"
+
+var _ Statement = &CreatePolicy{}
+
+// CreatePolicy represents a CREATE POLICY statement.
+type CreatePolicy struct {
+	Name        Name
+	Table       TableName
+	Restrictive bool
+	Command     PolicyCommand
+	Roles       []string
+	Using       Expr
+	WithCheck   Expr
+}
+
+// Format implements the NodeFormatter interface.
+func (node *CreatePolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE POLICY ")
+	ctx.FormatNode(&node.Name)
+	ctx.WriteString(" ON ")
+	ctx.FormatNode(&node.Table)
+	if node.Restrictive {
+		ctx.WriteString(" AS RESTRICTIVE")
+	}
+	ctx.WriteString(" FOR ")
+	ctx.WriteString(node.Command.String())
+	if len(node.Roles) > 0 {
+		ctx.WriteString(" TO ")
+		ctx.WriteString(strings.Join(node.Roles, ", "))
+	}
+	if node.Using != nil {
+		ctx.WriteString(" USING (")
+		ctx.FormatNode(node.Using)
+		ctx.WriteByte(')')
+	}
+	if node.WithCheck != nil {
+		ctx.WriteString(" WITH CHECK (")
+		ctx.FormatNode(node.WithCheck)
+		ctx.WriteByte(')')
+	}
+}
+
+// PolicyCommand is the command that a policy applies to.
+type PolicyCommand int
+
+const (
+	PolicyCommandAll PolicyCommand = iota
+	PolicyCommandSelect
+	PolicyCommandInsert
+	PolicyCommandUpdate
+	PolicyCommandDelete
+)
+
+// String returns the command as it would appear within a statement.
+func (c PolicyCommand) String() string {
+	switch c {
+	case PolicyCommandSelect:
+		return "SELECT"
+	case PolicyCommandInsert:
+		return "INSERT"
+	case PolicyCommandUpdate:
+		return "UPDATE"
+	case PolicyCommandDelete:
+		return "DELETE"
+	default:
+		return "ALL"
+	}
+}
@@ -334,6 +334,33 @@ func (node *DropTrigger) Format(ctx *FmtCtx) {
 	}
 }
 
+var _ Statement = &DropPolicy{}
+
+// DropPolicy represents a DROP POLICY statement.
+type DropPolicy struct {
+	Name         Name
+	IfExists     bool
+	Table        TableName
+	DropBehavior DropBehavior
+}
+
+// Format implements the NodeFormatter interface.
+func (node *DropPolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP POLICY ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	ctx.FormatNode(&node.Name)
+	ctx.WriteString(" ON ")
+	ctx.FormatNode(&node.Table)
+	switch node.DropBehavior {
+	case DropDefault:
+	default:
+		ctx.WriteByte(' ')
+		ctx.WriteString(dropBehaviorName[node.DropBehavior])
+	}
+}
+
 var _ Statement = &DropView{}
 
 // DropView represents a DROP VIEW statement.
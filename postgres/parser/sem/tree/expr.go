@@ -343,6 +343,20 @@ func (node *ParenExpr) Format(ctx *FmtCtx) {
 	ctx.WriteByte(')')
 }
 
+// NamedArgExpr represents a function argument given in named notation, such as
+// "days => 10".
+type NamedArgExpr struct {
+	Name Name
+	Expr Expr
+}
+
+// Format implements the NodeFormatter interface.
+func (node *NamedArgExpr) Format(ctx *FmtCtx) {
+	ctx.FormatNode(&node.Name)
+	ctx.WriteString(" => ")
+	ctx.FormatNode(node.Expr)
+}
+
 // TypedInnerExpr returns the ParenExpr's inner expression as a TypedExpr.
 func (node *ParenExpr) TypedInnerExpr() TypedExpr {
 	return node.Expr.(TypedExpr)
@@ -395,6 +409,7 @@ const (
 	JSONExists
 	JSONSomeExists
 	JSONAllExists
+	JSONPathExists
 	Overlaps
 
 	// The following operators will always be used with an associated SubOperator.
@@ -447,6 +462,7 @@ var comparisonOpName = [...]string{
 	JSONExists:        "?",
 	JSONSomeExists:    "?|",
 	JSONAllExists:     "?&",
+	JSONPathExists:    "@?",
 	Overlaps:          "&&",
 	Any:               "ANY",
 	Some:              "SOME",
@@ -1395,6 +1411,20 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 	ctx.WithFlags(ctx.flags&^FmtAnonymize, func() {
 		ctx.FormatNode(&node.Func)
 	})
+	if ctx.HasFlags(FmtFunctionArguments) {
+		var typ string
+		if node.Type != 0 {
+			typ = funcTypeName[node.Type] + " "
+		}
+		ctx.WriteByte('(')
+		ctx.WriteString(typ)
+		ctx.FormatNode(&node.Exprs)
+		if node.AggType == GeneralAgg && len(node.OrderBy) > 0 {
+			ctx.WriteByte(' ')
+			ctx.FormatNode(&node.OrderBy)
+		}
+		ctx.WriteByte(')')
+	}
 
 	if ctx.HasFlags(FmtParsable) && node.typ != nil {
 		if node.fnProps.AmbiguousReturnType {
@@ -1738,6 +1768,7 @@ func (node *NotExpr) String() string          { return AsString(node) }
 func (node *IsNullExpr) String() string       { return AsString(node) }
 func (node *IsNotNullExpr) String() string    { return AsString(node) }
 func (node *NullIfExpr) String() string       { return AsString(node) }
+func (node *NamedArgExpr) String() string     { return AsString(node) }
 func (node *NumVal) String() string           { return AsString(node) }
 func (node *OrExpr) String() string           { return AsString(node) }
 func (node *ParenExpr) String() string        { return AsString(node) }
@@ -153,6 +153,11 @@ const (
 	// rather than string literals. For example, the bytes \x40 will be formatted
 	// as b'\x40' rather than '\x40'.
 	fmtFormatByteLiterals
+
+	// FmtFunctionArguments instructs the pretty-printer to include the
+	// arguments of function calls. By default, only the function name is
+	// written, as that is what is used for generated column names.
+	FmtFunctionArguments
 )
 
 // Composite/derived flag definitions follow.
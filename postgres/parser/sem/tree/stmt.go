@@ -69,6 +69,8 @@ const (
 	Rows
 	// CopyIn indicates a COPY FROM statement.
 	CopyIn
+	// CopyOut indicates a COPY TO statement.
+	CopyOut
 	// Unknown indicates that the statement does not have a known
 	// return style at the time of parsing. This is not first in the
 	// enumeration because it is more convenient to have Ack as a zero
@@ -312,6 +314,12 @@ func (*AlterTablePartition) StatementTag() string { return "ALTER TABLE ... PART
 
 func (*AlterTablePartition) hiddenFromShowQueries() {}
 
+// StatementType implements the Statement interface.
+func (*AlterPolicy) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*AlterPolicy) StatementTag() string { return "ALTER POLICY" }
+
 // StatementType implements the Statement interface.
 func (*AlterTrigger) StatementType() StatementType { return DDL }
 
@@ -458,6 +466,12 @@ func (*CopyFrom) StatementType() StatementType { return CopyIn }
 // StatementTag returns a short string identifying the type of statement.
 func (*CopyFrom) StatementTag() string { return "COPY" }
 
+// StatementType implements the Statement interface.
+func (*CopyTo) StatementType() StatementType { return CopyOut }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*CopyTo) StatementTag() string { return "COPY" }
+
 // StatementType implements the Statement interface.
 func (*CreateAggregate) StatementType() StatementType { return DDL }
 
@@ -550,6 +564,14 @@ func (n *CreateTable) StatementTag() string {
 // modifiesSchema implements the canModifySchema interface.
 func (*CreateTable) modifiesSchema() bool { return true }
 
+// StatementType implements the Statement interface.
+func (*CreatePolicy) StatementType() StatementType { return DDL }
+
+// StatementTag implements the Statement interface.
+func (*CreatePolicy) StatementTag() string { return "CREATE POLICY" }
+
+func (*CreatePolicy) modifiesSchema() bool { return true }
+
 // StatementType implements the Statement interface.
 func (*CreateTrigger) StatementType() StatementType { return DDL }
 
@@ -670,6 +692,12 @@ func (*DropTable) StatementType() StatementType { return DDL }
 // StatementTag returns a short string identifying the type of statement.
 func (*DropTable) StatementTag() string { return "DROP TABLE" }
 
+// StatementType implements the Statement interface.
+func (*DropPolicy) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*DropPolicy) StatementTag() string { return "DROP POLICY" }
+
 // StatementType implements the Statement interface.
 func (*DropTrigger) StatementType() StatementType { return DDL }
 
@@ -1198,6 +1226,7 @@ func (n *AlterTable) String() string                { return AsString(n) }
 func (n *AlterTableAllInTablespace) String() string { return AsString(n) }
 func (n *AlterTableSetSchema) String() string       { return AsString(n) }
 func (n *AlterTablePartition) String() string       { return AsString(n) }
+func (n *AlterPolicy) String() string               { return AsString(n) }
 func (n *AlterTrigger) String() string              { return AsString(n) }
 func (n *AlterType) String() string                 { return AsString(n) }
 func (n *AlterView) String() string                 { return AsString(n) }
@@ -1216,6 +1245,7 @@ func (n *CannedOptPlan) String() string             { return AsString(n) }
 func (n *Comment) String() string                   { return AsString(n) }
 func (n *CommitTransaction) String() string         { return AsString(n) }
 func (n *CopyFrom) String() string                  { return AsString(n) }
+func (n *CopyTo) String() string                    { return AsString(n) }
 func (n *CreateAggregate) String() string           { return AsString(n) }
 func (n *CreateChangefeed) String() string          { return AsString(n) }
 func (n *CreateDatabase) String() string            { return AsString(n) }
@@ -1228,6 +1258,7 @@ func (n *CreateMaterializedView) String() string    { return AsString(n) }
 func (n *CreateProcedure) String() string           { return AsString(n) }
 func (n *CreateRole) String() string                { return AsString(n) }
 func (n *CreateTable) String() string               { return AsString(n) }
+func (n *CreatePolicy) String() string              { return AsString(n) }
 func (n *CreateTrigger) String() string             { return AsString(n) }
 func (n *CreateType) String() string                { return AsString(n) }
 func (n *CreateSchema) String() string              { return AsString(n) }
@@ -1246,6 +1277,7 @@ func (n *DropLanguage) String() string              { return AsString(n) }
 func (n *DropProcedure) String() string             { return AsString(n) }
 func (n *DropSchema) String() string                { return AsString(n) }
 func (n *DropTable) String() string                 { return AsString(n) }
+func (n *DropPolicy) String() string                { return AsString(n) }
 func (n *DropTrigger) String() string               { return AsString(n) }
 func (n *DropType) String() string                  { return AsString(n) }
 func (n *DropView) String() string                  { return AsString(n) }
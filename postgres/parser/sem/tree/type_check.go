@@ -1200,6 +1200,13 @@ func (expr *OrExpr) TypeCheck(
 	return expr, nil
 }
 
+// TypeCheck implements the Expr interface.
+func (expr *NamedArgExpr) TypeCheck(
+	ctx context.Context, semaCtx *SemaContext, desired *types.T,
+) (TypedExpr, error) {
+	return nil, pgerror.Newf(pgcode.FeatureNotSupported, "named argument %s is not supported here", expr.Name)
+}
+
 // TypeCheck implements the Expr interface.
 func (expr *ParenExpr) TypeCheck(
 	ctx context.Context, semaCtx *SemaContext, desired *types.T,
@@ -2586,12 +2586,9 @@ var unreservedTypeTokens = map[string]*T{
 // PostgreSQL types that are already implemented in CockroachDB.
 var postgresPredefinedTypeIssues = map[string]int{
 	"box":           21286,
-	"cidr":          18846,
 	"circle":        21286,
 	"line":          21286,
 	"lseg":          21286,
-	"macaddr":       -1,
-	"macaddr8":      -1,
 	"money":         -1,
 	"path":          21286,
 	"pg_lsn":        -1,
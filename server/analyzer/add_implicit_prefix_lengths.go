@@ -72,7 +72,7 @@ func AddImplicitPrefixLengths(_ *sql.Context, _ *analyzer.Analyzer, node sql.Nod
 					if !ok {
 						return nil, false, fmt.Errorf("indexed column %s not found in schema", index.Columns[i].Name)
 					}
-					if _, ok := col.Type.(pgtypes.TextType); ok && index.Columns[i].Length == 0 {
+					if requiresImplicitPrefixLength(col.Type) && index.Columns[i].Length == 0 {
 						index.Columns[i].Length = defaultIndexPrefixLength
 						indexModified = true
 					}
@@ -97,7 +97,7 @@ func AddImplicitPrefixLengths(_ *sql.Context, _ *analyzer.Analyzer, node sql.Nod
 					if !ok {
 						return nil, false, fmt.Errorf("indexed column %s not found in schema", newColumns[i].Name)
 					}
-					if _, ok := col.Type.(pgtypes.TextType); ok && newColumns[i].Length == 0 {
+					if requiresImplicitPrefixLength(col.Type) && newColumns[i].Length == 0 {
 						newColumns[i].Length = defaultIndexPrefixLength
 						indexModified = true
 					}
@@ -112,6 +112,16 @@ func AddImplicitPrefixLengths(_ *sql.Context, _ *analyzer.Analyzer, node sql.Nod
 	})
 }
 
+// requiresImplicitPrefixLength returns whether the given type requires a prefix length when used in an index.
+func requiresImplicitPrefixLength(typ sql.Type) bool {
+	switch typ.(type) {
+	case pgtypes.TextType:
+		return true
+	default:
+		return false
+	}
+}
+
 func schToColMap(sch sql.Schema) map[string]*sql.Column {
 	colMap := make(map[string]*sql.Column, len(sch))
 	for _, col := range sch {
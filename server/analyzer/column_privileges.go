@@ -0,0 +1,225 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+)
+
+// columnReference is a column that is referenced by a query, along with the privilege that the reference requires.
+type columnReference struct {
+	table     string
+	column    string
+	privilege auth.Privilege
+}
+
+// columnPrivilegeCollector gathers all of the column references within a node tree, along with the tables that the
+// references point to.
+type columnPrivilegeCollector struct {
+	tables     map[string]doltdb.TableName
+	references []columnReference
+}
+
+// CheckColumnPrivileges verifies that the user has the appropriate privileges on every column that a query references.
+// Table-level authorization allows a query through when a privilege was granted on any of the table's columns, so this
+// verifies the specific columns once they've been resolved (including those from an expanded `*`).
+func CheckColumnPrivileges(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	role, isInternal, err := auth.GetSessionRole(ctx)
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	if isInternal {
+		return node, transform.SameTree, nil
+	}
+	var public auth.Role
+	var skip bool
+	auth.LockRead(func() {
+		public = auth.GetRole("public")
+		skip = !auth.ColumnPrivilegesExist() || auth.IsSuperUser(role.ID())
+	})
+	if skip {
+		return node, transform.SameTree, nil
+	}
+	collector := &columnPrivilegeCollector{tables: make(map[string]doltdb.TableName)}
+	if err = collector.collectNode(ctx, node); err != nil {
+		return nil, transform.NewTree, err
+	}
+	auth.LockRead(func() {
+		err = collector.verify(role, public)
+	})
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	return node, transform.SameTree, nil
+}
+
+// collectNode gathers the tables and column references from the given node and all of its children.
+func (c *columnPrivilegeCollector) collectNode(ctx *sql.Context, node sql.Node) (err error) {
+	transform.Inspect(node, func(n sql.Node) bool {
+		if err != nil {
+			return false
+		}
+		switch n := n.(type) {
+		case *plan.ResolvedTable:
+			err = c.addTable(ctx, n.Name(), n)
+			return false
+		case *plan.TableAlias:
+			if rt, ok := n.Child.(*plan.ResolvedTable); ok {
+				err = c.addTable(ctx, n.Name(), rt)
+				return false
+			}
+		case *plan.InsertInto:
+			destination := n.Destination
+			if insertDestination, ok := destination.(*plan.InsertDestination); ok {
+				destination = insertDestination.Child
+			}
+			if rt, ok := destination.(*plan.ResolvedTable); ok {
+				if err = c.addTable(ctx, rt.Name(), rt); err != nil {
+					return false
+				}
+				for _, column := range n.ColumnNames {
+					c.references = append(c.references, columnReference{
+						table:     strings.ToLower(rt.Name()),
+						column:    column,
+						privilege: auth.Privilege_INSERT,
+					})
+				}
+			}
+			for _, expr := range n.OnDupExprs {
+				if err = c.collectExpression(ctx, expr); err != nil {
+					return false
+				}
+			}
+			// The source is not a child of the insert, so we have to handle it separately
+			if n.Source != nil {
+				err = c.collectNode(ctx, n.Source)
+			}
+			return false
+		case *plan.Update, *plan.DeleteFrom:
+			// The expressions on these nodes are check constraints, which do not require any privileges
+			return true
+		}
+		if expressioner, ok := n.(sql.Expressioner); ok {
+			for _, expr := range expressioner.Expressions() {
+				if err = c.collectExpression(ctx, expr); err != nil {
+					return false
+				}
+			}
+		}
+		return true
+	})
+	return err
+}
+
+// collectExpression gathers the column references from the given expression. Subqueries are also traversed.
+func (c *columnPrivilegeCollector) collectExpression(ctx *sql.Context, expr sql.Expression) (err error) {
+	sql.Inspect(expr, func(expr sql.Expression) bool {
+		if err != nil {
+			return false
+		}
+		switch expr := expr.(type) {
+		case *expression.SetField:
+			// The left side is the column being updated, while the right side is read
+			if gf, ok := expr.LeftChild.(*expression.GetField); ok {
+				c.references = append(c.references, columnReference{
+					table:     strings.ToLower(gf.Table()),
+					column:    gf.Name(),
+					privilege: auth.Privilege_UPDATE,
+				})
+			}
+			err = c.collectExpression(ctx, expr.RightChild)
+			return false
+		case *expression.GetField:
+			c.references = append(c.references, columnReference{
+				table:     strings.ToLower(expr.Table()),
+				column:    expr.Name(),
+				privilege: auth.Privilege_SELECT,
+			})
+		case *plan.Subquery:
+			err = c.collectNode(ctx, expr.Query)
+			return false
+		case *pgexprs.RowSecurityPolicy:
+			// Policies are applied on behalf of the table, so their column references do not require any privileges
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// addTable adds the given table under the given name, which is the name that column references will use.
+func (c *columnPrivilegeCollector) addTable(ctx *sql.Context, name string, rt *plan.ResolvedTable) error {
+	schemaName, err := core.GetSchemaName(ctx, rt.SqlDatabase, "")
+	if err != nil {
+		return err
+	}
+	c.tables[strings.ToLower(name)] = doltdb.TableName{Name: rt.Name(), Schema: schemaName}
+	return nil
+}
+
+// verify checks that the role (or the public role) has the required privilege on each referenced column. Reads are
+// only checked for tables where the role relies on column privileges, as tables may also be read through other
+// table-level privileges (such as UPDATE or DELETE).
+func (c *columnPrivilegeCollector) verify(role auth.Role, public auth.Role) error {
+	for _, reference := range c.references {
+		table, ok := c.tables[reference.table]
+		if !ok {
+			continue
+		}
+		roleTableKey := auth.TablePrivilegeKey{Role: role.ID(), Table: table}
+		publicTableKey := auth.TablePrivilegeKey{Role: public.ID(), Table: table}
+		if auth.HasTablePrivilege(roleTableKey, reference.privilege) || auth.HasTablePrivilege(publicTableKey, reference.privilege) {
+			continue
+		}
+		if reference.privilege == auth.Privilege_SELECT && !hasAnyColumnPrivileges(roleTableKey, publicTableKey) {
+			continue
+		}
+		if auth.HasColumnPrivilege(auth.ColumnPrivilegeKey{
+			Role:   role.ID(),
+			Table:  table,
+			Column: reference.column,
+		}, reference.privilege) || auth.HasColumnPrivilege(auth.ColumnPrivilegeKey{
+			Role:   public.ID(),
+			Table:  table,
+			Column: reference.column,
+		}, reference.privilege) {
+			continue
+		}
+		return fmt.Errorf("permission denied for table %s", table.Name)
+	}
+	return nil
+}
+
+// hasAnyColumnPrivileges returns whether either key has been granted any privilege on at least one column of the table.
+func hasAnyColumnPrivileges(roleTableKey auth.TablePrivilegeKey, publicTableKey auth.TablePrivilegeKey) bool {
+	for _, privilege := range auth.PrivilegeObject_TABLE_COLUMN.AllPrivileges() {
+		if auth.HasAnyColumnPrivilege(roleTableKey, privilege) || auth.HasAnyColumnPrivilege(publicTableKey, privilege) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// ExpandFieldStars expands each (E).* in a select list into a column for every attribute of the composite value.
+func ExpandFieldStars(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		project, ok := node.(*plan.Project)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		var newProjections []sql.Expression
+		same := transform.SameTree
+		for _, projection := range project.Projections {
+			fieldStar, ok := projection.(*pgexprs.FieldStar)
+			if !ok {
+				if alias, ok := projection.(*expression.Alias); ok {
+					fieldStar, ok = alias.Child.(*pgexprs.FieldStar)
+				}
+			}
+			if fieldStar == nil {
+				newProjections = append(newProjections, projection)
+				continue
+			}
+			compositeType, ok := fieldStar.Child().Type().(pgtypes.CompositeType)
+			if !ok {
+				return nil, transform.SameTree, fmt.Errorf("type %s is not composite", fieldStar.Child().Type().String())
+			}
+			for _, attr := range compositeType.Attributes {
+				fieldAccess, err := pgexprs.NewFieldAccess(fieldStar.Child(), attr.Name)
+				if err != nil {
+					return nil, transform.SameTree, err
+				}
+				newProjections = append(newProjections, expression.NewAlias(attr.Name, fieldAccess))
+			}
+			same = transform.NewTree
+		}
+		if same {
+			return node, transform.SameTree, nil
+		}
+		return plan.NewProject(newProjections, project.Child), transform.NewTree, nil
+	})
+}
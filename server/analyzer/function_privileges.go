@@ -0,0 +1,98 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// CheckFunctionPrivileges verifies that the user has the EXECUTE privilege on every function that a query calls. All
+// roles may execute functions by default, so this only applies once the default has been revoked from some function.
+func CheckFunctionPrivileges(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	role, isInternal, err := auth.GetSessionRole(ctx)
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	if isInternal {
+		return node, transform.SameTree, nil
+	}
+	var public auth.Role
+	var skip bool
+	auth.LockRead(func() {
+		public = auth.GetRole("public")
+		skip = !auth.FunctionDefaultsRevoked() || auth.IsSuperUser(role.ID())
+	})
+	if skip {
+		return node, transform.SameTree, nil
+	}
+	functions := make(map[auth.FunctionPrivilegeKey]struct{})
+	collectFunctions(node, functions)
+	sortedFunctions := utils.GetMapKeys(functions)
+	sort.Slice(sortedFunctions, func(i, j int) bool {
+		if sortedFunctions[i].Name != sortedFunctions[j].Name {
+			return sortedFunctions[i].Name < sortedFunctions[j].Name
+		}
+		return sortedFunctions[i].Arguments < sortedFunctions[j].Arguments
+	})
+	var deniedFunction string
+	auth.LockRead(func() {
+		for _, function := range sortedFunctions {
+			roleKey, publicKey := function, function
+			roleKey.Role = role.ID()
+			publicKey.Role = public.ID()
+			if !auth.HasFunctionPrivilege(roleKey, auth.Privilege_EXECUTE) && !auth.HasFunctionPrivilege(publicKey, auth.Privilege_EXECUTE) {
+				deniedFunction = function.Name
+				return
+			}
+		}
+	})
+	if len(deniedFunction) > 0 {
+		return nil, transform.NewTree, pgerror.Newf(pgcode.InsufficientPrivilege, "permission denied for function %s", deniedFunction)
+	}
+	return node, transform.SameTree, nil
+}
+
+// collectFunctions gathers the overloads of all functions that are called within the node tree, including those within
+// subqueries. The role of each key is left unset. Operators are implemented using functions, but they do not require
+// any privileges.
+func collectFunctions(node sql.Node, functions map[auth.FunctionPrivilegeKey]struct{}) {
+	transform.InspectExpressions(node, func(expr sql.Expression) bool {
+		switch expr := expr.(type) {
+		case *framework.CompiledFunction:
+			if !expr.IsOperator {
+				functions[auth.FunctionPrivilegeKey{
+					Schema:    expr.Schema(),
+					Name:      strings.ToLower(expr.Name),
+					Arguments: expr.Signature(),
+				}] = struct{}{}
+			}
+		case *plan.Subquery:
+			collectFunctions(expr.Query, functions)
+		}
+		return true
+	})
+}
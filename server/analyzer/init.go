@@ -32,26 +32,43 @@ const (
 	ruleId_AddImplicitPrefixLengths
 	ruleId_InsertContextRootFinalizer
 	ruleId_ResolveType
+	ruleId_CheckColumnPrivileges
+	ruleId_ReplaceInformationSchemaTables
+	ruleId_ApplyRowLevelSecurity
+	ruleId_CheckFunctionPrivileges
+	ruleId_ExpandFieldStars
+	ruleId_InsertProjectSet
 )
 
 // Init adds additional rules to the analyzer to handle Doltgres-specific functionality.
 func Init() {
 	analyzer.AlwaysBeforeDefault = append(analyzer.AlwaysBeforeDefault,
-		analyzer.Rule{Id: ruleId_ResolveType, Apply: ResolveType},
 		analyzer.Rule{Id: ruleId_TypeSanitizer, Apply: TypeSanitizer},
 		analyzer.Rule{Id: ruleId_AddDomainConstraints, Apply: AddDomainConstraints},
 		getAnalyzerRule(analyzer.OnceBeforeDefault, analyzer.ValidateColumnDefaultsId),
 		analyzer.Rule{Id: ruleId_AssignInsertCasts, Apply: AssignInsertCasts},
 		analyzer.Rule{Id: ruleId_AssignUpdateCasts, Apply: AssignUpdateCasts},
 		analyzer.Rule{Id: ruleId_ReplaceIndexedTables, Apply: ReplaceIndexedTables},
+		analyzer.Rule{Id: ruleId_ReplaceInformationSchemaTables, Apply: ReplaceInformationSchemaTables},
+		analyzer.Rule{Id: ruleId_CheckColumnPrivileges, Apply: CheckColumnPrivileges},
+		analyzer.Rule{Id: ruleId_CheckFunctionPrivileges, Apply: CheckFunctionPrivileges},
+		analyzer.Rule{Id: ruleId_ApplyRowLevelSecurity, Apply: ApplyRowLevelSecurity},
 	)
 
 	// Column default validation was moved to occur after type sanitization, so we'll remove it from its original place
 	analyzer.OnceBeforeDefault = removeAnalyzerRules(analyzer.OnceBeforeDefault, analyzer.ValidateColumnDefaultsId)
 
-	// PostgreSQL doesn't have the concept of prefix lengths, so we add a rule to implicitly add them
-	analyzer.OnceBeforeDefault = append([]analyzer.Rule{{Id: ruleId_AddImplicitPrefixLengths, Apply: AddImplicitPrefixLengths}},
-		analyzer.OnceBeforeDefault...)
+	// PostgreSQL doesn't have the concept of prefix lengths, so we add a rule to implicitly add them. User-defined types
+	// must be resolved before anything else, as the other rules (including those that evaluate constant expressions)
+	// need to know the actual types. Row-level security must be applied before any rule that reads a table's contents
+	// without reading its rows (such as replacing COUNT(*) with the table's row count). It is also applied with the
+	// rules that always run, as simple inserts and updates skip this batch, and applying it again has no effect.
+	analyzer.OnceBeforeDefault = append([]analyzer.Rule{
+		{Id: ruleId_ResolveType, Apply: ResolveType},
+		{Id: ruleId_ExpandFieldStars, Apply: ExpandFieldStars},
+		{Id: ruleId_AddImplicitPrefixLengths, Apply: AddImplicitPrefixLengths},
+		{Id: ruleId_ApplyRowLevelSecurity, Apply: ApplyRowLevelSecurity},
+	}, analyzer.OnceBeforeDefault...)
 
 	// Remove all other validation rules that do not apply to Postgres
 	analyzer.DefaultValidationRules = removeAnalyzerRules(analyzer.DefaultValidationRules, analyzer.ValidateOperandsId)
@@ -61,6 +78,10 @@ func Init() {
 		analyzer.Rule{Id: ruleId_ReplaceDropTable, Apply: ReplaceDropTable},
 	)
 
+	// Set-returning functions are moved into their own node once the projections reference their children by index
+	analyzer.OnceAfterAll = insertAnalyzerRules(analyzer.OnceAfterAll, analyzer.BacktickDefaulColumnValueNamesId, true,
+		analyzer.Rule{Id: ruleId_InsertProjectSet, Apply: InsertProjectSet})
+
 	// The auto-commit rule writes the contents of the context, so we need to insert our finalizer before that
 	analyzer.OnceAfterAll = insertAnalyzerRules(analyzer.OnceAfterAll, analyzer.BacktickDefaulColumnValueNamesId, false,
 		analyzer.Rule{Id: ruleId_InsertContextRootFinalizer, Apply: InsertContextRootFinalizer})
@@ -0,0 +1,167 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// InsertProjectSet moves the set-returning functions of each projection into a ProjectSet node that is inserted
+// beneath the projection. The projection then references the values produced by the ProjectSet, so that a row is
+// returned for each value of the sets. Identical function calls are only evaluated once, which allows expressions such
+// as (jsonb_each(j)).key and (jsonb_each(j)).value to refer to the same row of the set.
+func InsertProjectSet(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	// Projections only reference the expressions of their children by index once execution indexes have been assigned,
+	// which only happens for the root node, so subqueries are handled when the rule is applied to the root node.
+	if scope.RecursionDepth() > 0 {
+		return node, transform.SameTree, nil
+	}
+	return insertProjectSet(node)
+}
+
+// insertProjectSet handles the transformation for InsertProjectSet, which includes the nodes within subqueries.
+func insertProjectSet(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+	return transform.NodeWithOpaque(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		node, sameExprs, err := transform.OneNodeExpressions(node, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			subquery, ok := expr.(*plan.Subquery)
+			if !ok {
+				return expr, transform.SameTree, nil
+			}
+			newQuery, same, err := insertProjectSet(subquery.Query)
+			if err != nil || same {
+				return expr, transform.SameTree, err
+			}
+			return subquery.WithQuery(newQuery), transform.NewTree, nil
+		})
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		// Nodes above a projection may repeat its expressions rather than referencing its output, which would evaluate
+		// the set-returning functions a second time, so we replace them with references to the projection's output.
+		if children := node.Children(); len(children) == 1 {
+			if outputs, count := setReturningOutputs(children[0]); len(outputs) > 0 {
+				var sameOutputs transform.TreeIdentity
+				node, sameOutputs, err = transform.OneNodeExpressions(node, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+					if idx, ok := outputs[expr.String()]; ok {
+						return pgexprs.NewSetReturningValue(expr, idx, count), transform.NewTree, nil
+					}
+					return expr, transform.SameTree, nil
+				})
+				if err != nil {
+					return nil, transform.NewTree, err
+				}
+				sameExprs = sameExprs && sameOutputs
+			}
+		}
+		project, ok := node.(*plan.Project)
+		if !ok {
+			return node, sameExprs, nil
+		}
+		var functions []sql.Expression
+		functionIndexes := make(map[string]int)
+		for _, projection := range project.Projections {
+			transform.InspectExpr(projection, func(expr sql.Expression) bool {
+				if !isSetReturningExpression(expr) {
+					return false
+				}
+				for _, child := range expr.Children() {
+					if transform.InspectExpr(child, isSetReturningExpression) {
+						err = fmt.Errorf("nested set-returning functions are not yet supported")
+						return true
+					}
+				}
+				if _, ok := functionIndexes[expr.String()]; !ok {
+					functionIndexes[expr.String()] = len(functions)
+					functions = append(functions, expr)
+				}
+				return false
+			})
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+		}
+		if len(functions) == 0 {
+			return node, sameExprs, nil
+		}
+		newProjections := make([]sql.Expression, len(project.Projections))
+		for i, projection := range project.Projections {
+			newProjections[i], _, err = transform.Expr(projection, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+				if !isSetReturningExpression(expr) {
+					return expr, transform.SameTree, nil
+				}
+				return pgexprs.NewSetReturningValue(expr, functionIndexes[expr.String()], len(functions)), transform.NewTree, nil
+			})
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+		}
+		newProject, err := project.WithChildren(pgnodes.NewProjectSet(functions, project.Child))
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		newProject, err = newProject.(*plan.Project).WithExpressions(newProjections...)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		return newProject, transform.NewTree, nil
+	})
+}
+
+// setReturningOutputs returns the output columns of the given node that contain the values of set-returning functions,
+// along with the total number of output columns. Nodes that pass the rows of their child through unchanged return the
+// outputs of their child.
+func setReturningOutputs(node sql.Node) (map[string]int, int) {
+	switch node := node.(type) {
+	case *plan.Project:
+		if _, ok := node.Child.(*pgnodes.ProjectSet); !ok {
+			return nil, 0
+		}
+		outputs := make(map[string]int)
+		for i, projection := range node.Projections {
+			if transform.InspectExpr(projection, func(expr sql.Expression) bool {
+				_, ok := expr.(*pgexprs.SetReturningValue)
+				return ok
+			}) {
+				outputs[projection.String()] = i
+			}
+		}
+		return outputs, len(node.Projections)
+	case *plan.Sort, *plan.TopN, *plan.Filter, *plan.Having, *plan.Distinct, *plan.OrderedDistinct, *plan.Limit, *plan.Offset:
+		return setReturningOutputs(node.Children()[0])
+	default:
+		return nil, 0
+	}
+}
+
+// isSetReturningExpression returns whether the expression must be evaluated by a ProjectSet.
+func isSetReturningExpression(expr sql.Expression) bool {
+	switch expr := expr.(type) {
+	case *framework.CompiledFunction:
+		return expr.IsSRF()
+	case *pgexprs.Ordinality:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/information_schema"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	pginfoschema "github.com/dolthub/doltgresql/server/tables/information_schema"
+)
+
+// ReplaceInformationSchemaTables replaces information_schema tables that cannot be injected into GMS with tables that
+// return Doltgres-specific information.
+func ReplaceInformationSchemaTables(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		rt, ok := n.(*plan.ResolvedTable)
+		if !ok {
+			return n, transform.SameTree, nil
+		}
+		table, ok := rt.UnderlyingTable().(*information_schema.InformationSchemaTable)
+		if !ok || table.TableName != information_schema.ColumnPrivilegesTableName {
+			return n, transform.SameTree, nil
+		}
+		newRt, err := rt.WithTable(pginfoschema.WithColumnPrivilegesRows(table))
+		return newRt, transform.NewTree, err
+	})
+}
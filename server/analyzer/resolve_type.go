@@ -24,12 +24,14 @@ import (
 
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 	"github.com/dolthub/doltgresql/server/types"
 )
 
 // ResolveType replaces types.ResolvableType to appropriate types.DoltgresType.
 func ResolveType(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
-	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+	node, same, err := transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
 		switch n := node.(type) {
 		case sql.SchemaTarget:
 			switch n.(type) {
@@ -53,43 +55,146 @@ func ResolveType(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *p
 				}
 			}
 			return node, same, nil
+		case *pgnodes.CreateType:
+			// Attributes of composite types may use other user-defined types
+			var same = transform.SameTree
+			for i, attr := range n.CompositeAttrs {
+				if rt, ok := attr.Type.(types.ResolvableType); ok {
+					dt, err := resolveResolvableType(ctx, rt.Typ)
+					if err != nil {
+						return nil, transform.SameTree, err
+					}
+					same = transform.NewTree
+					n.CompositeAttrs[i].Type = dt
+				}
+			}
+			// The subtype of a range type may also be a user-defined type
+			if rt, ok := n.RangeSubType.(types.ResolvableType); ok {
+				dt, err := resolveResolvableType(ctx, rt.Typ)
+				if err != nil {
+					return nil, transform.SameTree, err
+				}
+				same = transform.NewTree
+				n.RangeSubType = dt
+			}
+			return node, same, nil
+		case *pgnodes.CreateDomain:
+			// The base type of a domain may be a user-defined type
+			rt, ok := n.AsType.(types.ResolvableType)
+			if !ok {
+				return node, transform.SameTree, nil
+			}
+			dt, err := resolveResolvableType(ctx, rt.Typ)
+			if err != nil {
+				return nil, transform.SameTree, err
+			}
+			if _, ok = dt.(types.DomainType); ok {
+				return nil, transform.SameTree, fmt.Errorf(`domains over domains are not yet supported`)
+			}
+			n.AsType = dt
+			return node, transform.NewTree, nil
 		default:
 			return node, transform.SameTree, nil
 		}
 	})
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	node, sameExprs, err := transform.NodeExprsWithOpaque(node, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		// Casts to user-defined types (such as 'value'::my_enum) must also be resolved
+		cast, ok := expr.(*pgexprs.ExplicitCast)
+		if !ok {
+			return expr, transform.SameTree, nil
+		}
+		rt, ok := cast.CastToType().(types.ResolvableType)
+		if !ok {
+			return expr, transform.SameTree, nil
+		}
+		dt, err := resolveResolvableType(ctx, rt.Typ)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		return pgexprs.NewExplicitCast(cast.Child(), dt), transform.NewTree, nil
+	})
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return node, same && sameExprs, nil
 }
 
 // resolveResolvableType resolves any type that is unresolved yet.
 func resolveResolvableType(ctx *sql.Context, typ tree.ResolvableTypeReference) (types.DoltgresType, error) {
 	switch t := typ.(type) {
 	case *tree.UnresolvedObjectName:
-		domain := t.ToTableName()
-		return resolveDomainType(ctx, string(domain.SchemaName), string(domain.ObjectName))
+		name := t.ToTableName()
+		// The built-in range and network address types are not known to the parser, so they're resolved here
+		if schemaName := string(name.SchemaName); schemaName == "" || schemaName == "pg_catalog" {
+			if rangeType, ok := types.GetBuiltInRangeType(string(name.ObjectName)); ok {
+				return rangeType, nil
+			}
+			switch string(name.ObjectName) {
+			case "cidr":
+				return types.Cidr, nil
+			case "macaddr":
+				return types.MacAddr, nil
+			case "macaddr8":
+				return types.MacAddr8, nil
+			}
+		}
+		return resolveUserDefinedType(ctx, string(name.SchemaName), string(name.ObjectName))
 	default:
 		// TODO: add other types that need resolution at analyzer stage.
 		return nil, fmt.Errorf("the given type %T is not yet supported", typ)
 	}
 }
 
-// resolveDomainType resolves DomainType from given schema and domain name.
-func resolveDomainType(ctx *sql.Context, schema, domainName string) (types.DoltgresType, error) {
+// resolveUserDefinedType resolves the user-defined type from the given schema and type name.
+func resolveUserDefinedType(ctx *sql.Context, schema, typeName string) (types.DoltgresType, error) {
 	schema, err := core.GetSchemaName(ctx, nil, schema)
 	if err != nil {
 		return nil, err
 	}
-	domains, err := core.GetTypesCollectionFromContext(ctx)
+	collection, err := core.GetTypesCollectionFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	domain, exists := domains.GetDomainType(schema, domainName)
+	typ, exists := collection.GetType(schema, typeName)
 	if !exists {
-		return nil, types.ErrTypeDoesNotExist.New(domainName)
+		return nil, types.ErrTypeDoesNotExist.New(typeName)
+	}
+	switch typ.TypType {
+	case types.TypeType_Domain:
+		return resolveDomainType(ctx, schema, typ)
+	case types.TypeType_Enum:
+		return types.EnumTypeFromType(typ), nil
+	case types.TypeType_Composite:
+		return types.CompositeTypeFromType(typ), nil
+	case types.TypeType_Range:
+		return types.RangeTypeFromType(typ), nil
+	default:
+		return nil, fmt.Errorf(`type "%s" is not yet supported`, typeName)
 	}
+}
+
+// resolveDomainType resolves DomainType from given schema and domain.
+func resolveDomainType(ctx *sql.Context, schema string, domain *types.Type) (types.DoltgresType, error) {
+	domainName := domain.Name
 
-	// TODO: need to resolve OID for non build-in type
 	asType, ok := types.OidToBuildInDoltgresType[domain.BaseTypeOID]
 	if !ok {
-		return nil, fmt.Errorf(`cannot resolve base type for "%s" domain type`, domainName)
+		// The base type may be a user-defined type, which is resolved from the collection so that it's always current
+		collection, err := core.GetTypesCollectionFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		baseType, exists := collection.GetTypeByOid(domain.BaseTypeOID)
+		if !exists || baseType.TypType == types.TypeType_Domain {
+			return nil, fmt.Errorf(`cannot resolve base type for "%s" domain type`, domainName)
+		}
+		asType, err = resolveUserDefinedType(ctx, baseType.Schema, baseType.Name)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return types.DomainType{
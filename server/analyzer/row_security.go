@@ -0,0 +1,410 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/policies"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+)
+
+// createViewContextKey is the key of the context value that marks queries that create a view.
+type createViewContextKey struct{}
+
+// rowSecurity applies the row-level security policies of a single query.
+type rowSecurity struct {
+	catalog     sql.Catalog
+	role        auth.Role
+	collections map[string]*policies.Collection
+}
+
+// ApplyRowLevelSecurity adds the row-level security policies of every referenced table to the query. Rows that are
+// read are filtered using the USING expressions, while rows that are written by INSERT and UPDATE are verified using
+// the WITH CHECK expressions.
+func ApplyRowLevelSecurity(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector, qFlags *sql.QueryFlags) (sql.Node, transform.TreeIdentity, error) {
+	role, isInternal, err := auth.GetSessionRole(ctx)
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	// Superusers and roles with BYPASSRLS are never subject to row-level security
+	if isInternal || role.IsSuperUser || role.CanBypassRowLevelSecurity || ctx.Value(createViewContextKey{}) != nil {
+		return node, transform.SameTree, nil
+	}
+	rls := &rowSecurity{
+		catalog:     a.Catalog,
+		role:        role,
+		collections: make(map[string]*policies.Collection),
+	}
+	switch n := node.(type) {
+	case *plan.InsertInto:
+		newSource, sameSource, err := rls.applyFilters(ctx, n.Source, policies.Command_Select, "")
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		checks, sameChecks, err := rls.addCheck(ctx, n, n.Destination, policies.Command_Insert)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		if sameSource && sameChecks {
+			return n, transform.SameTree, nil
+		}
+		return n.WithSource(newSource).WithChecks(checks), transform.NewTree, nil
+	case *plan.Update:
+		var target string
+		if updatable, err := plan.GetUpdatable(n.Child); err == nil {
+			target = updatable.Name()
+		}
+		newChild, sameChild, err := rls.applyFilters(ctx, n.Child, policies.Command_Update, target)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		checks, sameChecks, err := rls.addCheck(ctx, n, n.Child, policies.Command_Update)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		if sameChild && sameChecks {
+			return n, transform.SameTree, nil
+		}
+		newNode, err := n.WithChildren(newChild)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		return newNode.(*plan.Update).WithChecks(checks), transform.NewTree, nil
+	case *plan.CreateView:
+		// Policies are applied whenever the view is read, so the definition must not contain them. The definition is
+		// analyzed separately from this node, so we mark the query's context to skip it.
+		ctx.Context = context.WithValue(ctx.Context, createViewContextKey{}, true)
+		return n, transform.SameTree, nil
+	case *plan.DeleteFrom:
+		var target string
+		if deletable, err := plan.GetDeletable(n.Child); err == nil {
+			target = deletable.Name()
+		}
+		newChild, sameChild, err := rls.applyFilters(ctx, n.Child, policies.Command_Delete, target)
+		if err != nil || sameChild {
+			return n, sameChild, err
+		}
+		newNode, err := n.WithChildren(newChild)
+		return newNode, transform.NewTree, err
+	default:
+		return rls.applyFilters(ctx, node, policies.Command_Select, "")
+	}
+}
+
+// applyFilters wraps every table that has row-level security enabled with a filter containing its policies. Tables
+// that are named |target| use the policies for the given command, while all other tables use the SELECT policies.
+func (rls *rowSecurity) applyFilters(ctx *sql.Context, node sql.Node, command policies.Command, target string) (sql.Node, transform.TreeIdentity, error) {
+	return transform.NodeWithCtx(node, nil, func(c transform.Context) (sql.Node, transform.TreeIdentity, error) {
+		switch n := c.Node.(type) {
+		case *plan.ResolvedTable, *plan.TableAlias:
+			if _, ok := c.Parent.(*plan.TableAlias); ok {
+				// The alias will handle this table
+				return n, transform.SameTree, nil
+			}
+			if _, ok := c.Parent.(*plan.Filter); ok {
+				// The parent filter will handle this table, as filter pushdown does not handle nested filters
+				return n, transform.SameTree, nil
+			}
+			expr, err := rls.tableExpression(ctx, n, command, target)
+			if err != nil || expr == nil {
+				return n, transform.SameTree, err
+			}
+			return plan.NewFilter(expr, n), transform.NewTree, nil
+		case *plan.Filter:
+			newNode, same, err := rls.applySubqueryFilters(ctx, n)
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+			filter := newNode.(*plan.Filter)
+			// The policies have already been applied if the filter contains them
+			for _, filterExpr := range expression.SplitConjunction(filter.Expression) {
+				if _, ok := filterExpr.(*pgexprs.RowSecurityPolicy); ok {
+					return filter, same, nil
+				}
+			}
+			expr, err := rls.tableExpression(ctx, filter.Child, command, target)
+			if err != nil || expr == nil {
+				return filter, same, err
+			}
+			return plan.NewFilter(expression.JoinAnd(expr, filter.Expression), filter.Child), transform.NewTree, nil
+		case *plan.SubqueryAlias:
+			// Subquery aliases are opaque, so we have to handle their children ourselves
+			newChild, same, err := rls.applyFilters(ctx, n.Child, policies.Command_Select, "")
+			if err != nil || same {
+				return n, transform.SameTree, err
+			}
+			newNode, err := n.WithChildren(newChild)
+			return newNode, transform.NewTree, err
+		default:
+			return rls.applySubqueryFilters(ctx, n)
+		}
+	})
+}
+
+// tableExpression returns the filter expression for the given node if it is a table (or an aliased table) that has
+// row-level security enabled. Returns a nil expression for all other nodes.
+func (rls *rowSecurity) tableExpression(ctx *sql.Context, node sql.Node, command policies.Command, target string) (sql.Expression, error) {
+	var rt *plan.ResolvedTable
+	var tableIdNode plan.TableIdNode
+	switch n := node.(type) {
+	case *plan.ResolvedTable:
+		rt = n
+		tableIdNode = n
+	case *plan.TableAlias:
+		var ok bool
+		if rt, ok = n.Child.(*plan.ResolvedTable); !ok {
+			return nil, nil
+		}
+		tableIdNode = n
+	default:
+		return nil, nil
+	}
+	tableCommand := policies.Command_Select
+	if len(target) > 0 && strings.EqualFold(rt.Name(), target) {
+		tableCommand = command
+	}
+	expr, err := rls.buildExpression(ctx, rt, tableIdNode, tableCommand, false)
+	if err != nil || expr == nil {
+		return nil, err
+	}
+	return pgexprs.NewRowSecurityFilter(expr, rt.Name()), nil
+}
+
+// applySubqueryFilters applies the policies to all subqueries that are found within the node's expressions.
+func (rls *rowSecurity) applySubqueryFilters(ctx *sql.Context, node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+	return transform.OneNodeExpressions(node, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		subquery, ok := expr.(*plan.Subquery)
+		if !ok {
+			return expr, transform.SameTree, nil
+		}
+		newQuery, same, err := rls.applyFilters(ctx, subquery.Query, policies.Command_Select, "")
+		if err != nil || same {
+			return expr, transform.SameTree, err
+		}
+		return subquery.WithQuery(newQuery), transform.NewTree, nil
+	})
+}
+
+// addCheck adds a check containing the policies of the table found within the given node. Returns the node's checks
+// if the table does not have row-level security enabled.
+func (rls *rowSecurity) addCheck(ctx *sql.Context, checkNode sql.CheckConstraintNode, node sql.Node, command policies.Command) (sql.CheckConstraints, transform.TreeIdentity, error) {
+	checks := checkNode.Checks()
+	for _, check := range checks {
+		if policy, ok := check.Expr.(*pgexprs.RowSecurityPolicy); ok && policy.IsCheck() {
+			return checks, transform.SameTree, nil
+		}
+	}
+	var rt *plan.ResolvedTable
+	transform.Inspect(node, func(n sql.Node) bool {
+		if resolvedTable, ok := n.(*plan.ResolvedTable); ok && rt == nil {
+			rt = resolvedTable
+		}
+		return rt == nil
+	})
+	if rt == nil {
+		return checks, transform.SameTree, nil
+	}
+	expr, err := rls.buildExpression(ctx, rt, rt, command, true)
+	if err != nil || expr == nil {
+		return checks, transform.SameTree, err
+	}
+	newChecks := make(sql.CheckConstraints, len(checks), len(checks)+1)
+	copy(newChecks, checks)
+	newChecks = append(newChecks, &sql.CheckConstraint{
+		Name:     "row_level_security",
+		Expr:     pgexprs.NewRowSecurityCheck(expr, rt.Name()),
+		Enforced: true,
+	})
+	return newChecks, transform.NewTree, nil
+}
+
+// buildExpression returns the combination of all policies that apply to the table for the given command. Returns a nil
+// expression if row-level security does not apply to the table. |tableIdNode| determines the name and column IDs that
+// are used by the returned expression.
+func (rls *rowSecurity) buildExpression(ctx *sql.Context, rt *plan.ResolvedTable, tableIdNode plan.TableIdNode, command policies.Command, isCheck bool) (sql.Expression, error) {
+	table, tableName, err := rls.getTable(ctx, rt)
+	if err != nil || table == nil || !table.RowLevelSecurity {
+		return nil, err
+	}
+	var applicable []*policies.Policy
+	var hasOwnerAccess bool
+	auth.LockRead(func() {
+		hasOwnerAccess = auth.HasOwnerAccess(auth.OwnershipKey{
+			PrivilegeObject: auth.PrivilegeObject_TABLE,
+			Schema:          tableName.Schema,
+			Name:            tableName.Name,
+		}, rls.role.ID()).IsValid()
+		for _, policy := range table.SortedPolicies() {
+			if policy.AppliesTo(command) && rls.appliesToRole(policy) {
+				applicable = append(applicable, policy)
+			}
+		}
+	})
+	// Table owners are not subject to row-level security unless it is forced
+	if hasOwnerAccess && !table.ForceRowLevelSecurity {
+		return nil, nil
+	}
+
+	var permissive []sql.Expression
+	var restrictive []sql.Expression
+	for _, policy := range applicable {
+		policyExpr := policy.Using
+		if isCheck && len(policy.WithCheck) > 0 {
+			policyExpr = policy.WithCheck
+		}
+		var expr sql.Expression = pgexprs.NewRawLiteralBool(true)
+		if len(policyExpr) > 0 {
+			expr, err = rls.buildPolicyExpression(ctx, rt, tableIdNode, policyExpr)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if policy.Restrictive {
+			restrictive = append(restrictive, expr)
+		} else {
+			permissive = append(permissive, expr)
+		}
+	}
+	// When no permissive policies apply, then all rows are denied
+	if len(permissive) == 0 {
+		return pgexprs.NewRawLiteralBool(false), nil
+	}
+	return expression.JoinAnd(append([]sql.Expression{expression.JoinOr(permissive...)}, restrictive...)...), nil
+}
+
+// buildPolicyExpression builds the given policy expression, assigning the column IDs and indexes from the table.
+func (rls *rowSecurity) buildPolicyExpression(ctx *sql.Context, rt *plan.ResolvedTable, tableIdNode plan.TableIdNode, policyExpr string) (_ sql.Expression, err error) {
+	// The builder panics on errors, so we convert them back into errors
+	defer func() {
+		if r := recover(); r != nil {
+			if recoveredErr, ok := r.(error); ok {
+				err = recoveredErr
+			} else {
+				err = fmt.Errorf("%v", r)
+			}
+		}
+	}()
+
+	parsed, err := sql.GlobalParser.ParseSimple(fmt.Sprintf("SELECT %s", policyExpr))
+	if err != nil {
+		return nil, err
+	}
+	selectStmt, ok := parsed.(*vitess.Select)
+	if !ok || len(selectStmt.SelectExprs) != 1 {
+		return nil, fmt.Errorf("invalid policy expression: %s", policyExpr)
+	}
+	aliasedExpr, ok := selectStmt.SelectExprs[0].(*vitess.AliasedExpr)
+	if !ok {
+		return nil, fmt.Errorf("invalid policy expression: %s", policyExpr)
+	}
+	schemaName, err := core.GetSchemaName(ctx, rt.SqlDatabase, "")
+	if err != nil {
+		return nil, err
+	}
+	tableExpr := &vitess.AliasedTableExpr{
+		Expr: vitess.TableName{
+			Name:            vitess.NewTableIdent(rt.Name()),
+			SchemaQualifier: vitess.NewTableIdent(schemaName),
+			DbQualifier:     vitess.NewTableIdent(rt.SqlDatabase.Name()),
+		},
+	}
+	builder := planbuilder.New(ctx, rls.catalog, nil, sql.GlobalParser)
+	expr := builder.BuildScalarWithTable(aliasedExpr.Expr, tableExpr)
+
+	// The builder assigns IDs that are only valid for its own scope, so we replace them with the IDs from the query
+	sch := rt.Schema()
+	var columnIds []sql.ColumnId
+	cols := tableIdNode.Columns()
+	for id, ok := cols.Next(1); ok; id, ok = cols.Next(id + 1) {
+		columnIds = append(columnIds, id)
+	}
+	expr, _, err = transform.Expr(expr, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		switch expr := expr.(type) {
+		case *expression.GetField:
+			idx := sch.IndexOfColName(expr.Name())
+			if idx < 0 {
+				return nil, transform.NewTree, fmt.Errorf(`column "%s" does not exist`, expr.Name())
+			}
+			id := sql.ColumnId(idx + 1)
+			if idx < len(columnIds) {
+				id = columnIds[idx]
+			}
+			return expression.NewGetFieldWithTable(idx, int(tableIdNode.Id()), expr.Type(), rt.SqlDatabase.Name(),
+				tableIdNode.Name(), expr.Name(), expr.IsNullable()).WithId(id).(*expression.GetField), transform.NewTree, nil
+		case *plan.Subquery:
+			return nil, transform.NewTree, fmt.Errorf("subqueries are not yet supported in policy expressions")
+		default:
+			return expr, transform.SameTree, nil
+		}
+	})
+	return expr, err
+}
+
+// getTable returns the row-level security settings for the given table, along with the table's full name. Returns a
+// nil table if the table does not have any settings.
+func (rls *rowSecurity) getTable(ctx *sql.Context, rt *plan.ResolvedTable) (*policies.Table, doltdb.TableName, error) {
+	if _, ok := rt.SqlDatabase.(dsess.SqlDatabase); !ok {
+		return nil, doltdb.TableName{}, nil
+	}
+	database := rt.SqlDatabase.Name()
+	collection, ok := rls.collections[database]
+	if !ok {
+		var err error
+		collection, err = core.GetPoliciesFromDatabase(ctx, database)
+		if err != nil {
+			return nil, doltdb.TableName{}, err
+		}
+		rls.collections[database] = collection
+	}
+	schemaName, err := core.GetSchemaName(ctx, rt.SqlDatabase, "")
+	if err != nil {
+		return nil, doltdb.TableName{}, err
+	}
+	tableName := doltdb.TableName{Name: rt.Name(), Schema: schemaName}
+	return collection.GetTable(tableName), tableName, nil
+}
+
+// appliesToRole returns whether the policy applies to the role, either directly or through role membership. This
+// should be called while holding a read lock.
+func (rls *rowSecurity) appliesToRole(policy *policies.Policy) bool {
+	for _, roleName := range policy.Roles {
+		if roleName == "public" {
+			return true
+		}
+		policyRole := auth.GetRole(roleName)
+		if !policyRole.IsValid() {
+			continue
+		}
+		if groupID, _, _ := auth.IsRoleAMember(rls.role.ID(), policyRole.ID()); groupID.IsValid() {
+			return true
+		}
+	}
+	return false
+}
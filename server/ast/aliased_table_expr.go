@@ -21,18 +21,20 @@ import (
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 	"github.com/dolthub/doltgresql/server/auth"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
 // nodeAliasedTableExpr handles *tree.AliasedTableExpr nodes.
 func nodeAliasedTableExpr(ctx *Context, node *tree.AliasedTableExpr) (*vitess.AliasedTableExpr, error) {
-	if node.Ordinality {
-		return nil, fmt.Errorf("ordinality is not yet supported")
-	}
 	if node.IndexFlags != nil {
 		return nil, fmt.Errorf("index flags are not yet supported")
 	}
 	var aliasExpr vitess.SimpleTableExpr
 	var authInfo vitess.AuthInformation
+	alias := string(node.As.Alias)
+	lateral := node.Lateral
 
 	switch expr := node.Expr.(type) {
 	case *tree.TableName:
@@ -101,13 +103,30 @@ func nodeAliasedTableExpr(ctx *Context, node *tree.AliasedTableExpr) (*vitess.Al
 		}
 		aliasExpr = subquery
 	case *tree.RowsFromExpr:
+		// Functions from our catalog are evaluated within a projection, which handles set-returning functions
+		subquery, functionName, err := nodeRowsFromFunctions(ctx, node, expr)
+		if err != nil {
+			return nil, err
+		}
+		if subquery != nil {
+			// Functions in the FROM clause may always reference the columns of the preceding FROM items
+			aliasExpr = subquery
+			lateral = true
+			if len(alias) == 0 {
+				alias = functionName
+			}
+			break
+		}
+		if node.Ordinality {
+			return nil, fmt.Errorf("WITH ORDINALITY is not yet supported for this function")
+		}
 		tableExpr, err := nodeTableExpr(ctx, expr)
 		if err != nil {
 			return nil, err
 		}
 
 		// TODO: this should be represented as a table function more directly
-		subquery := &vitess.Subquery{
+		subquery = &vitess.Subquery{
 			Select: &vitess.Select{
 				From: vitess.TableExprs{tableExpr},
 			},
@@ -124,7 +143,6 @@ func nodeAliasedTableExpr(ctx *Context, node *tree.AliasedTableExpr) (*vitess.Al
 	default:
 		return nil, fmt.Errorf("unhandled table expression: `%T`", expr)
 	}
-	alias := string(node.As.Alias)
 
 	var asOf *vitess.AsOf
 	if node.AsOf != nil {
@@ -142,7 +160,102 @@ func nodeAliasedTableExpr(ctx *Context, node *tree.AliasedTableExpr) (*vitess.Al
 		Expr:    aliasExpr,
 		As:      vitess.NewTableIdent(alias),
 		AsOf:    asOf,
-		Lateral: node.Lateral,
+		Lateral: lateral,
 		Auth:    authInfo,
 	}, nil
 }
+
+// nodeRowsFromFunctions returns a subquery that projects the results of the functions within the given
+// *tree.RowsFromExpr, along with the name of the first function. Multiple functions are evaluated in lockstep, with
+// functions that return a composite type being expanded into a column for each attribute. A nil subquery is returned
+// when any item is not a function from our catalog, such as the table functions that are provided by Dolt.
+func nodeRowsFromFunctions(ctx *Context, node *tree.AliasedTableExpr, rowsFrom *tree.RowsFromExpr) (*vitess.Subquery, string, error) {
+	var funcExprs []*vitess.FuncExpr
+	for _, item := range rowsFrom.Items {
+		treeFuncExpr, ok := item.(*tree.FuncExpr)
+		if !ok {
+			return nil, "", nil
+		}
+		funcExpr, err := nodeFuncExpr(ctx, treeFuncExpr)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, ok = framework.Catalog[funcExpr.Name.Lowered()]; !ok {
+			return nil, "", nil
+		}
+		// A multi-argument unnest is equivalent to calling unnest on each argument
+		if funcExpr.Name.Lowered() == "unnest" && len(funcExpr.Exprs) > 1 {
+			for _, arg := range funcExpr.Exprs {
+				funcExprs = append(funcExprs, &vitess.FuncExpr{
+					Qualifier: funcExpr.Qualifier,
+					Name:      funcExpr.Name,
+					Exprs:     vitess.SelectExprs{arg},
+				})
+			}
+		} else {
+			funcExprs = append(funcExprs, funcExpr)
+		}
+	}
+	if len(funcExprs) == 0 {
+		return nil, "", nil
+	}
+	var selectExprs vitess.SelectExprs
+	for _, funcExpr := range funcExprs {
+		if attributes := compositeReturnAttributes(funcExpr.Name.Lowered()); len(attributes) > 0 {
+			for _, attribute := range attributes {
+				selectExprs = append(selectExprs, &vitess.AliasedExpr{
+					Expr: vitess.InjectedExpr{
+						Expression: pgexprs.NewFieldAccessInjectable(attribute),
+						Children:   vitess.Exprs{funcExpr},
+					},
+					As: vitess.NewColIdent(attribute),
+				})
+			}
+			continue
+		}
+		// A single function that returns a scalar is named after the table alias when one is given
+		columnName := funcExpr.Name.String()
+		if len(funcExprs) == 1 && len(node.As.Alias) > 0 {
+			columnName = string(node.As.Alias)
+		}
+		selectExprs = append(selectExprs, &vitess.AliasedExpr{
+			Expr: funcExpr,
+			As:   vitess.NewColIdent(columnName),
+		})
+	}
+	if node.Ordinality {
+		selectExprs = append(selectExprs, &vitess.AliasedExpr{
+			Expr: vitess.InjectedExpr{Expression: pgexprs.NewOrdinality()},
+			As:   vitess.NewColIdent("ordinality"),
+		})
+	}
+	if len(node.As.Cols) > len(selectExprs) {
+		return nil, "", fmt.Errorf(`table "%s" has %d columns available but %d columns specified`,
+			string(node.As.Alias), len(selectExprs), len(node.As.Cols))
+	}
+	for i, col := range node.As.Cols {
+		selectExprs[i].(*vitess.AliasedExpr).As = vitess.NewColIdent(string(col))
+	}
+	return &vitess.Subquery{
+		Select: &vitess.Select{
+			SelectExprs: selectExprs,
+		},
+	}, funcExprs[0].Name.String(), nil
+}
+
+// compositeReturnAttributes returns the attribute names of the composite type that is returned by the function with
+// the given name. Returns nil if the function does not return a composite type.
+func compositeReturnAttributes(functionName string) []string {
+	for _, function := range framework.Catalog[functionName] {
+		compositeType, ok := function.GetReturn().(pgtypes.CompositeType)
+		if !ok || len(compositeType.Attributes) == 0 {
+			continue
+		}
+		attributes := make([]string, len(compositeType.Attributes))
+		for i, attribute := range compositeType.Attributes {
+			attributes[i] = attribute.Name
+		}
+		return attributes
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeAlterDatabase handles *tree.AlterDatabase nodes.
@@ -27,5 +28,32 @@ func nodeAlterDatabase(ctx *Context, node *tree.AlterDatabase) (vitess.Statement
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("ALTER DATABASE is not yet supported")
+	if len(node.Options) == 0 {
+		return nil, fmt.Errorf("ALTER DATABASE is not yet supported")
+	}
+	alterDatabase := &pgnodes.AlterDatabase{
+		Name: node.Name.String(),
+	}
+	for _, option := range node.Options {
+		switch option.Opt {
+		case tree.OptAllowConnections:
+			allowConnections, err := databaseAllowConnections(option.Val)
+			if err != nil {
+				return nil, err
+			}
+			alterDatabase.AllowConnections = &allowConnections
+		case tree.OptConnectionLimit:
+			connectionLimit, err := databaseConnectionLimit(option.Val)
+			if err != nil {
+				return nil, err
+			}
+			alterDatabase.ConnectionLimit = &connectionLimit
+		default:
+			return nil, fmt.Errorf("%s is not yet supported", option.Opt)
+		}
+	}
+	return vitess.InjectedStatement{
+		Statement: alterDatabase,
+		Children:  nil,
+	}, nil
 }
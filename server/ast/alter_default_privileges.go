@@ -0,0 +1,77 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeAlterDefaultPrivileges handles *tree.AlterDefaultPrivileges nodes.
+func nodeAlterDefaultPrivileges(ctx *Context, node *tree.AlterDefaultPrivileges) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	var object auth.PrivilegeObject
+	switch node.Target.TargetType {
+	case privilege.Table:
+		object = auth.PrivilegeObject_TABLE
+	case privilege.Sequence:
+		object = auth.PrivilegeObject_SEQUENCE
+	case privilege.Function, privilege.Routine:
+		object = auth.PrivilegeObject_FUNCTION
+	case privilege.Type:
+		object = auth.PrivilegeObject_TYPE
+	case privilege.Schema:
+		if len(node.Target.InSchema) > 0 {
+			return nil, fmt.Errorf("cannot use IN SCHEMA clause when using GRANT/REVOKE ON SCHEMAS")
+		}
+		return nil, fmt.Errorf("ALTER DEFAULT PRIVILEGES ON SCHEMAS is not yet supported")
+	default:
+		return nil, fmt.Errorf("ALTER DEFAULT PRIVILEGES ON %sS is not yet supported", strings.ToUpper(string(node.Target.TargetType)))
+	}
+	privileges, err := convertPrivilegeKinds(object, node.Privileges)
+	if err != nil {
+		return nil, err
+	}
+	grantees := make([]string, len(node.Grantees))
+	for i, grantee := range node.Grantees {
+		// The GROUP keyword is noise, so we remove it from the role name
+		if strings.HasPrefix(strings.ToLower(grantee), "group ") {
+			grantee = grantee[len("group "):]
+		}
+		grantees[i] = grantee
+	}
+	return vitess.InjectedStatement{
+		Statement: &pgnodes.AlterDefaultPrivileges{
+			TargetRoles:     node.TargetRoles,
+			Schemas:         node.Target.InSchema,
+			PrivilegeObject: object,
+			Privileges:      privileges,
+			Grantees:        grantees,
+			Grant:           node.Grant,
+			GrantOption:     node.GrantOption,
+			Cascade:         node.DropBehavior == tree.DropCascade,
+		},
+		Children: nil,
+	}, nil
+}
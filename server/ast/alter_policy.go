@@ -0,0 +1,55 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeAlterPolicy handles *tree.AlterPolicy nodes.
+func nodeAlterPolicy(ctx *Context, node *tree.AlterPolicy) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	tableName, err := nodeTableName(ctx, &node.Table)
+	if err != nil {
+		return nil, err
+	}
+	using, usingColumns, err := nodePolicyExpression(node.Using)
+	if err != nil {
+		return nil, err
+	}
+	withCheck, withCheckColumns, err := nodePolicyExpression(node.WithCheck)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: &pgnodes.AlterPolicy{
+			Database:   tableName.DbQualifier.String(),
+			SchemaName: tableName.SchemaQualifier.String(),
+			Table:      tableName.Name.String(),
+			Name:       string(node.Name),
+			NewName:    string(node.NewName),
+			Roles:      node.Roles,
+			Using:      using,
+			WithCheck:  withCheck,
+			Columns:    append(usingColumns, withCheckColumns...),
+		},
+		Children: nil,
+	}, nil
+}
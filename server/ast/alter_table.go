@@ -20,10 +20,11 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeAlterTable handles *tree.AlterTable nodes.
-func nodeAlterTable(ctx *Context, node *tree.AlterTable) (*vitess.AlterTable, error) {
+func nodeAlterTable(ctx *Context, node *tree.AlterTable) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
@@ -34,6 +35,11 @@ func nodeAlterTable(ctx *Context, node *tree.AlterTable) (*vitess.AlterTable, er
 		return nil, err
 	}
 
+	// Row-level security settings are not handled by GMS, so we use our own node when they're present
+	if rowLevelSecurity, ok, err := nodeAlterTableRowLevelSecurity(ctx, node.Cmds, tableName, node.IfExists); ok || err != nil {
+		return rowLevelSecurity, err
+	}
+
 	statements, err := nodeAlterTableCmds(ctx, node.Cmds, tableName, node.IfExists)
 	if err != nil {
 		return nil, err
@@ -45,6 +51,48 @@ func nodeAlterTable(ctx *Context, node *tree.AlterTable) (*vitess.AlterTable, er
 	}, nil
 }
 
+// nodeAlterTableRowLevelSecurity handles ALTER TABLE statements that only contain tree.AlterTableRowLevelSecurity
+// commands. Returns false if none of the commands modify row-level security.
+func nodeAlterTableRowLevelSecurity(
+	ctx *Context,
+	node tree.AlterTableCmds,
+	tableName vitess.TableName,
+	ifExists bool) (vitess.Statement, bool, error) {
+
+	alterRowLevelSecurity := &pgnodes.AlterTableRowLevelSecurity{
+		Database:   tableName.DbQualifier.String(),
+		SchemaName: tableName.SchemaQualifier.String(),
+		Table:      tableName.Name.String(),
+		IfExists:   ifExists,
+	}
+	hasRowLevelSecurity := false
+	for _, cmd := range node {
+		if _, ok := cmd.(*tree.AlterTableRowLevelSecurity); ok {
+			hasRowLevelSecurity = true
+		}
+	}
+	if !hasRowLevelSecurity {
+		return nil, false, nil
+	}
+	for _, cmd := range node {
+		rowLevelSecurity, ok := cmd.(*tree.AlterTableRowLevelSecurity)
+		if !ok {
+			return nil, true, fmt.Errorf("ROW LEVEL SECURITY cannot yet be combined with other ALTER TABLE commands")
+		}
+		enabled := rowLevelSecurity.Type == tree.RowLevelSecurityEnable || rowLevelSecurity.Type == tree.RowLevelSecurityForce
+		switch rowLevelSecurity.Type {
+		case tree.RowLevelSecurityEnable, tree.RowLevelSecurityDisable:
+			alterRowLevelSecurity.Enable = &enabled
+		case tree.RowLevelSecurityForce, tree.RowLevelSecurityNoForce:
+			alterRowLevelSecurity.Force = &enabled
+		}
+	}
+	return vitess.InjectedStatement{
+		Statement: alterRowLevelSecurity,
+		Children:  nil,
+	}, true, nil
+}
+
 // nodeAlterTableSetSchema handles *tree.AlterTableSetSchema nodes.
 func nodeAlterTableSetSchema(ctx *Context, node *tree.AlterTableSetSchema) (vitess.Statement, error) {
 	if node == nil {
@@ -20,6 +20,7 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeAlterType handles *tree.AlterType nodes.
@@ -27,5 +28,38 @@ func nodeAlterType(ctx *Context, node *tree.AlterType) (vitess.Statement, error)
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("ALTER TYPE is not yet supported")
+	name, err := nodeUnresolvedObjectName(ctx, node.Type)
+	if err != nil {
+		return nil, err
+	}
+	switch cmd := node.Cmd.(type) {
+	case *tree.AlterTypeAddValue:
+		addValue := &pgnodes.AlterTypeAddValue{
+			SchemaName:  name.SchemaQualifier.String(),
+			Name:        name.Name.String(),
+			NewLabel:    cmd.NewVal,
+			IfNotExists: cmd.IfNotExists,
+		}
+		if cmd.Placement != nil {
+			addValue.HasPlacement = true
+			addValue.Before = cmd.Placement.Before
+			addValue.ExistingLabel = cmd.Placement.ExistingVal
+		}
+		return vitess.InjectedStatement{
+			Statement: addValue,
+			Children:  nil,
+		}, nil
+	case *tree.AlterTypeRenameValue:
+		return vitess.InjectedStatement{
+			Statement: &pgnodes.AlterTypeRenameValue{
+				SchemaName: name.SchemaQualifier.String(),
+				Name:       name.Name.String(),
+				OldLabel:   cmd.OldVal,
+				NewLabel:   cmd.NewVal,
+			},
+			Children: nil,
+		}, nil
+	default:
+		return nil, fmt.Errorf("ALTER TYPE is not yet supported")
+	}
 }
@@ -31,10 +31,14 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeAlterAggregate(ctx, stmt)
 	case *tree.AlterDatabase:
 		return nodeAlterDatabase(ctx, stmt)
+	case *tree.AlterDefaultPrivileges:
+		return nodeAlterDefaultPrivileges(ctx, stmt)
 	case *tree.AlterFunction:
 		return nodeAlterFunction(ctx, stmt)
 	case *tree.AlterIndex:
 		return nodeAlterIndex(ctx, stmt)
+	case *tree.AlterPolicy:
+		return nodeAlterPolicy(ctx, stmt)
 	case *tree.AlterProcedure:
 		return nodeAlterProcedure(ctx, stmt)
 	case *tree.AlterRole:
@@ -77,6 +81,8 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeControlSchedules(ctx, stmt)
 	case *tree.CopyFrom:
 		return nodeCopyFrom(ctx, stmt)
+	case *tree.CopyTo:
+		return nodeCopyTo(ctx, stmt)
 	case *tree.CreateAggregate:
 		return nodeCreateAggregate(ctx, stmt)
 	case *tree.CreateChangefeed:
@@ -89,6 +95,8 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeCreateFunction(ctx, stmt)
 	case *tree.CreateIndex:
 		return nodeCreateIndex(ctx, stmt)
+	case *tree.CreatePolicy:
+		return nodeCreatePolicy(ctx, stmt)
 	case *tree.CreateProcedure:
 		return nodeCreateProcedure(ctx, stmt)
 	case *tree.CreateRole:
@@ -121,6 +129,8 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeDropDomain(ctx, stmt)
 	case *tree.DropIndex:
 		return nodeDropIndex(ctx, stmt)
+	case *tree.DropPolicy:
+		return nodeDropPolicy(ctx, stmt)
 	case *tree.DropRole:
 		return nodeDropRole(ctx, stmt)
 	case *tree.DropSchema:
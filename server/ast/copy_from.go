@@ -15,11 +15,11 @@
 package ast
 
 import (
-	"fmt"
-
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
@@ -29,14 +29,25 @@ func nodeCopyFrom(ctx *Context, node *tree.CopyFrom) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	if node.Options.CopyFormat == tree.CopyFormatBinary {
-		return nil, fmt.Errorf("COPY FROM does not support format BINARY")
+	if len(node.Options.ForceQuote) > 0 || node.Options.ForceQuoteAll {
+		return nil, pgerror.New(pgcode.FeatureNotSupported, "COPY FORCE_QUOTE cannot be used with COPY FROM")
+	}
+	if err := validateCopyOptions(node.Options); err != nil {
+		return nil, err
+	}
+	var where vitess.Expr
+	if node.Where != nil {
+		var err error
+		where, err = nodeExpr(ctx, node.Where.Expr)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return vitess.InjectedStatement{
 		Statement: pgnodes.NewCopyFrom(node.Table.Catalog(), doltdb.TableName{
 			Name:   node.Table.Object(),
 			Schema: node.Table.Schema(),
-		}, node.Options, node.File, node.Stdin, node.Columns),
+		}, node.Options, node.File, node.Stdin, node.Columns, where),
 		Children: nil,
 	}, nil
 }
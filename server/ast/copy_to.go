@@ -0,0 +1,148 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core/dataloader"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeCopyTo handles *tree.CopyTo nodes.
+func nodeCopyTo(ctx *Context, node *tree.CopyTo) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	if node.Options.CopyFormat == tree.CopyFormatBinary {
+		return nil, fmt.Errorf("COPY TO does not support format BINARY")
+	}
+	if len(node.Options.Delimiter) > 1 {
+		return nil, pgerror.New(pgcode.FeatureNotSupported, "COPY delimiter must be a single one-byte character")
+	}
+	if len(node.Options.ForceNotNull) > 0 || node.Options.ForceNotNullAll {
+		return nil, pgerror.New(pgcode.FeatureNotSupported, "COPY FORCE_NOT_NULL cannot be used with COPY TO")
+	}
+	if len(node.Options.ForceNull) > 0 || node.Options.ForceNullAll {
+		return nil, pgerror.New(pgcode.FeatureNotSupported, "COPY FORCE_NULL cannot be used with COPY TO")
+	}
+	if err := validateCopyOptions(node.Options); err != nil {
+		return nil, err
+	}
+
+	// Copying a table is the same as copying the results of selecting its columns
+	query := node.Statement
+	if query == nil {
+		selectExprs := tree.SelectExprs{tree.StarSelectExpr()}
+		if len(node.Columns) > 0 {
+			selectExprs = make(tree.SelectExprs, len(node.Columns))
+			for i, column := range node.Columns {
+				selectExprs[i] = tree.SelectExpr{Expr: tree.NewUnresolvedName(string(column))}
+			}
+		}
+		table := node.Table
+		query = &tree.Select{
+			Select: &tree.SelectClause{
+				Exprs: selectExprs,
+				From:  tree.From{Tables: tree.TableExprs{&tree.AliasedTableExpr{Expr: &table}}},
+			},
+		}
+	}
+	selectStmt, err := nodeSelect(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewCopyTo(selectStmt, query.String(), node.Options, node.File, node.Stdout),
+		Children:  nil,
+	}, nil
+}
+
+// validateCopyOptions returns an error if the given options are invalid, using the same rules as Postgres.
+func validateCopyOptions(options tree.CopyOptions) error {
+	if options.Redundant {
+		return pgerror.New(pgcode.Syntax, "conflicting or redundant options")
+	}
+	isCsv := options.CopyFormat == tree.CopyFormatCsv
+	if options.CopyFormat == tree.CopyFormatBinary {
+		if len(options.Delimiter) > 0 {
+			return pgerror.New(pgcode.Syntax, "cannot specify DELIMITER in BINARY mode")
+		}
+		if options.Null != nil {
+			return pgerror.New(pgcode.Syntax, "cannot specify NULL in BINARY mode")
+		}
+		if options.Header {
+			return pgerror.New(pgcode.Syntax, "cannot specify HEADER in BINARY mode")
+		}
+	}
+	if options.Delimiter == "\n" || options.Delimiter == "\r" {
+		return pgerror.New(pgcode.InvalidParameterValue, "COPY delimiter cannot be newline or carriage return")
+	}
+	delimiter := options.Delimiter
+	if len(delimiter) == 0 {
+		delimiter = "\t"
+		if isCsv {
+			delimiter = ","
+		}
+	}
+	if options.Null != nil {
+		if strings.ContainsAny(*options.Null, "\r\n") {
+			return pgerror.New(pgcode.InvalidParameterValue, "COPY null representation cannot use newline or carriage return")
+		}
+		if strings.Contains(*options.Null, delimiter) {
+			return pgerror.New(pgcode.InvalidParameterValue, "COPY delimiter character must not appear in the NULL specification")
+		}
+	}
+	if options.Quote != nil {
+		if !isCsv {
+			return pgerror.New(pgcode.FeatureNotSupported, "COPY QUOTE requires CSV mode")
+		}
+		if len(*options.Quote) != 1 {
+			return pgerror.New(pgcode.FeatureNotSupported, "COPY quote must be a single one-byte character")
+		}
+		if *options.Quote == delimiter {
+			return pgerror.New(pgcode.InvalidParameterValue, "COPY delimiter and quote must be different")
+		}
+	}
+	if options.Escape != nil {
+		if !isCsv {
+			return pgerror.New(pgcode.FeatureNotSupported, "COPY ESCAPE requires CSV mode")
+		}
+		if len(*options.Escape) != 1 {
+			return pgerror.New(pgcode.FeatureNotSupported, "COPY escape must be a single one-byte character")
+		}
+	}
+	if (len(options.ForceQuote) > 0 || options.ForceQuoteAll) && !isCsv {
+		return pgerror.New(pgcode.FeatureNotSupported, "COPY FORCE_QUOTE requires CSV mode")
+	}
+	if (len(options.ForceNotNull) > 0 || options.ForceNotNullAll) && !isCsv {
+		return pgerror.New(pgcode.FeatureNotSupported, "COPY FORCE_NOT_NULL requires CSV mode")
+	}
+	if (len(options.ForceNull) > 0 || options.ForceNullAll) && !isCsv {
+		return pgerror.New(pgcode.FeatureNotSupported, "COPY FORCE_NULL requires CSV mode")
+	}
+	if options.Encoding != nil {
+		if _, err := dataloader.LookupEncoding(*options.Encoding); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -16,14 +16,19 @@ package ast
 
 import (
 	"fmt"
+	"strings"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeCreateDatabase handles *tree.CreateDatabase nodes.
-func nodeCreateDatabase(ctx *Context, node *tree.CreateDatabase) (*vitess.DBDDL, error) {
+func nodeCreateDatabase(ctx *Context, node *tree.CreateDatabase) (vitess.Statement, error) {
 	if len(node.Owner) > 0 {
 		return nil, fmt.Errorf("OWNER clause is not yet supported")
 	}
@@ -63,14 +68,20 @@ func nodeCreateDatabase(ctx *Context, node *tree.CreateDatabase) (*vitess.DBDDL,
 	if len(node.Tablespace) > 0 {
 		return nil, fmt.Errorf("TABLESPACE clause is not yet supported")
 	}
-	// TODO: some clauses have default values in case of not being defined.
-	// ALLOW_CONNECTIONS defaults to TRUE
+	setting := auth.DefaultDatabaseSetting()
 	if node.AllowConnections != nil {
-		return nil, fmt.Errorf("ALLOW_CONNECTIONS clause is not yet supported")
+		allowConnections, err := databaseAllowConnections(node.AllowConnections)
+		if err != nil {
+			return nil, err
+		}
+		setting.AllowConnections = allowConnections
 	}
-	// CONNECTION LIMIT defaults to -1
 	if node.ConnectionLimit != nil {
-		return nil, fmt.Errorf("CONNECTION LIMIT clause is not yet supported")
+		connectionLimit, err := databaseConnectionLimit(node.ConnectionLimit)
+		if err != nil {
+			return nil, err
+		}
+		setting.ConnectionLimit = connectionLimit
 	}
 	// IS_TEMPLATE defaults to FALSE
 	if node.IsTemplate != nil {
@@ -80,10 +91,48 @@ func nodeCreateDatabase(ctx *Context, node *tree.CreateDatabase) (*vitess.DBDDL,
 		return nil, fmt.Errorf("OID clause is not yet supported")
 	}
 
-	return &vitess.DBDDL{
-		Action:           vitess.CreateStr,
-		SchemaOrDatabase: "database",
-		DBName:           node.Name.String(),
-		IfNotExists:      node.IfNotExists,
+	return vitess.InjectedStatement{
+		Statement: &pgnodes.CreateDatabase{
+			Name:        node.Name.String(),
+			IfNotExists: node.IfNotExists,
+			Setting:     setting,
+		},
+		Children: nil,
 	}, nil
 }
+
+// databaseAllowConnections returns the value of the ALLOW_CONNECTIONS option of CREATE DATABASE and ALTER DATABASE.
+func databaseAllowConnections(expr tree.Expr) (bool, error) {
+	var value string
+	switch expr := expr.(type) {
+	case *tree.DBool:
+		return bool(*expr), nil
+	case *tree.NumVal:
+		value = expr.String()
+	case *tree.StrVal:
+		value = expr.RawString()
+	default:
+		value = expr.String()
+	}
+	switch strings.ToLower(value) {
+	case "true", "on", "yes", "1":
+		return true, nil
+	case "false", "off", "no", "0":
+		return false, nil
+	default:
+		return false, pgerror.Newf(pgcode.InvalidParameterValue, `invalid value for parameter "allow_connections": %s`, value)
+	}
+}
+
+// databaseConnectionLimit returns the value of the CONNECTION LIMIT option of CREATE DATABASE and ALTER DATABASE.
+func databaseConnectionLimit(expr tree.Expr) (int32, error) {
+	numVal, ok := expr.(*tree.NumVal)
+	if !ok {
+		return 0, pgerror.Newf(pgcode.Syntax, `invalid connection limit: %s`, expr.String())
+	}
+	connectionLimit, err := numVal.AsInt32()
+	if err != nil || connectionLimit < -1 {
+		return 0, pgerror.Newf(pgcode.InvalidParameterValue, `invalid connection limit: %s`, numVal.String())
+	}
+	return connectionLimit, nil
+}
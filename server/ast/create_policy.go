@@ -0,0 +1,102 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core/policies"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeCreatePolicy handles *tree.CreatePolicy nodes.
+func nodeCreatePolicy(ctx *Context, node *tree.CreatePolicy) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	tableName, err := nodeTableName(ctx, &node.Table)
+	if err != nil {
+		return nil, err
+	}
+	var command policies.Command
+	switch node.Command {
+	case tree.PolicyCommandAll:
+		command = policies.Command_All
+	case tree.PolicyCommandSelect:
+		command = policies.Command_Select
+	case tree.PolicyCommandInsert:
+		command = policies.Command_Insert
+	case tree.PolicyCommandUpdate:
+		command = policies.Command_Update
+	case tree.PolicyCommandDelete:
+		command = policies.Command_Delete
+	default:
+		return nil, fmt.Errorf("unknown policy command: %s", node.Command.String())
+	}
+	using, usingColumns, err := nodePolicyExpression(node.Using)
+	if err != nil {
+		return nil, err
+	}
+	withCheck, withCheckColumns, err := nodePolicyExpression(node.WithCheck)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: &pgnodes.CreatePolicy{
+			Database:    tableName.DbQualifier.String(),
+			SchemaName:  tableName.SchemaQualifier.String(),
+			Table:       tableName.Name.String(),
+			Name:        string(node.Name),
+			Command:     command,
+			Restrictive: node.Restrictive,
+			Roles:       node.Roles,
+			Using:       using,
+			WithCheck:   withCheck,
+			Columns:     append(usingColumns, withCheckColumns...),
+		},
+		Children: nil,
+	}, nil
+}
+
+// nodePolicyExpression returns the string form of a policy's expression, along with the names of all columns that the
+// expression references. Returns an empty string if the expression is nil.
+func nodePolicyExpression(expr tree.Expr) (string, []string, error) {
+	if expr == nil {
+		return "", nil, nil
+	}
+	var columns []string
+	_, err := tree.SimpleVisit(expr, func(visitingExpr tree.Expr) (recurse bool, newExpr tree.Expr, err error) {
+		switch visitingExpr := visitingExpr.(type) {
+		case *tree.UnresolvedName:
+			if !visitingExpr.Star {
+				columns = append(columns, visitingExpr.Parts[0])
+			}
+			return false, visitingExpr, nil
+		case *tree.ColumnItem:
+			columns = append(columns, string(visitingExpr.ColumnName))
+			return false, visitingExpr, nil
+		case *tree.Subquery:
+			return false, nil, fmt.Errorf("subqueries are not yet supported in policy expressions")
+		}
+		return true, visitingExpr, nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return tree.AsStringWithFlags(expr, tree.FmtFunctionArguments), columns, nil
+}
@@ -20,6 +20,8 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
 // nodeCreateType handles *tree.CreateType nodes.
@@ -27,5 +29,50 @@ func nodeCreateType(ctx *Context, node *tree.CreateType) (vitess.Statement, erro
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("CREATE TYPE is not yet supported")
+	name, err := nodeUnresolvedObjectName(ctx, node.TypeName)
+	if err != nil {
+		return nil, err
+	}
+	switch node.Variety {
+	case tree.Composite:
+		attrs := make([]pgtypes.CompositeAttribute, len(node.Composite.Types))
+		for i, elem := range node.Composite.Types {
+			if len(elem.Collate) > 0 {
+				return nil, fmt.Errorf("collations are not yet supported")
+			}
+			_, attrType, err := nodeResolvableTypeReference(ctx, elem.Type)
+			if err != nil {
+				return nil, err
+			}
+			attrs[i] = pgtypes.CompositeAttribute{
+				Name: elem.AttrName,
+				Type: attrType,
+			}
+		}
+		return vitess.InjectedStatement{
+			Statement: pgnodes.NewCreateCompositeType(name.SchemaQualifier.String(), name.Name.String(), attrs),
+			Children:  nil,
+		}, nil
+	case tree.Enum:
+		return vitess.InjectedStatement{
+			Statement: pgnodes.NewCreateEnumType(name.SchemaQualifier.String(), name.Name.String(), node.Enum.Labels),
+			Children:  nil,
+		}, nil
+	case tree.Range:
+		for _, opt := range node.Range.Options {
+			if opt.Option == tree.RangeTypeCanonical {
+				return nil, fmt.Errorf("canonical functions for range types are not yet supported")
+			}
+		}
+		_, subType, err := nodeResolvableTypeReference(ctx, node.Range.Subtype)
+		if err != nil {
+			return nil, err
+		}
+		return vitess.InjectedStatement{
+			Statement: pgnodes.NewCreateRangeType(name.SchemaQualifier.String(), name.Name.String(), subType),
+			Children:  nil,
+		}, nil
+	default:
+		return nil, fmt.Errorf("CREATE TYPE is not yet supported for this kind of type")
+	}
 }
@@ -222,6 +222,13 @@ func nodeExpr(ctx *Context, node tree.Expr) (vitess.Expr, error) {
 		if err != nil {
 			return nil, err
 		}
+		// A parenthesized list that is being cast is a row constructor, such as (1, 'a')::my_type
+		if valTuple, ok := expr.(vitess.ValTuple); ok {
+			expr = vitess.InjectedExpr{
+				Expression: pgexprs.NewRecord(),
+				Children:   vitess.Exprs(valTuple),
+			}
+		}
 
 		switch node.SyntaxMode {
 		case tree.CastExplicit, tree.CastShort:
@@ -282,9 +289,23 @@ func nodeExpr(ctx *Context, node tree.Expr) (vitess.Expr, error) {
 			Exprs: exprs,
 		}, nil
 	case *tree.CollateExpr:
+		// The default collation is what we already use, so it may be ignored. This is used by tools such as psql.
+		if node.Locale == "default" {
+			return nodeExpr(ctx, node.Expr)
+		}
 		return nil, fmt.Errorf("collations are not yet supported")
 	case *tree.ColumnAccessExpr:
-		return nil, fmt.Errorf("(E).x is not yet supported")
+		if node.ByIndex {
+			return nil, fmt.Errorf("(E).@N is not yet supported")
+		}
+		expr, err := nodeExpr(ctx, node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return vitess.InjectedExpr{
+			Expression: pgexprs.NewFieldAccessInjectable(node.ColName),
+			Children:   vitess.Exprs{expr},
+		}, nil
 	case *tree.ColumnItem:
 		var tableName vitess.TableName
 		if node.TableName != nil {
@@ -369,23 +390,50 @@ func nodeExpr(ctx *Context, node tree.Expr) (vitess.Expr, error) {
 		case tree.NotLike:
 			operator = vitess.NotLikeStr
 		case tree.ILike:
-			return nil, fmt.Errorf("ILIKE is not yet supported")
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryILike),
+				Children:   vitess.Exprs{left, right},
+			}, nil
 		case tree.NotILike:
-			return nil, fmt.Errorf("ILIKE is not yet supported")
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryNotILike),
+				Children:   vitess.Exprs{left, right},
+			}, nil
 		case tree.SimilarTo:
-			return nil, fmt.Errorf("similar to is not yet supported")
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryRegexMatch),
+				Children:   vitess.Exprs{left, patternEscapeFuncExpr("similar_to_escape", right)},
+			}, nil
 		case tree.NotSimilarTo:
-			return nil, fmt.Errorf("similar to is not yet supported")
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryNotRegexMatch),
+				Children:   vitess.Exprs{left, patternEscapeFuncExpr("similar_to_escape", right)},
+			}, nil
 		case tree.RegMatch:
-			operator = vitess.RegexpStr
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryRegexMatch),
+				Children:   vitess.Exprs{left, right},
+			}, nil
 		case tree.NotRegMatch:
-			operator = vitess.NotRegexpStr
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryNotRegexMatch),
+				Children:   vitess.Exprs{left, right},
+			}, nil
 		case tree.RegIMatch:
-			return nil, fmt.Errorf("~* is not yet supported")
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryRegexIMatch),
+				Children:   vitess.Exprs{left, right},
+			}, nil
 		case tree.NotRegIMatch:
-			return nil, fmt.Errorf("~* is not yet supported")
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryNotRegexIMatch),
+				Children:   vitess.Exprs{left, right},
+			}, nil
 		case tree.TextSearchMatch:
-			return nil, fmt.Errorf("@@ is not yet supported")
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryJSONPathMatch),
+				Children:   vitess.Exprs{left, right},
+			}, nil
 		case tree.IsDistinctFrom:
 			return nil, fmt.Errorf("IS DISTINCT FROM is not yet supported")
 		case tree.IsNotDistinctFrom:
@@ -415,8 +463,16 @@ func nodeExpr(ctx *Context, node tree.Expr) (vitess.Expr, error) {
 				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryJSONTopLevelAll),
 				Children:   vitess.Exprs{left, right},
 			}, nil
+		case tree.JSONPathExists:
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryJSONPathExists),
+				Children:   vitess.Exprs{left, right},
+			}, nil
 		case tree.Overlaps:
-			return nil, fmt.Errorf("&& is not yet supported")
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryOverlaps),
+				Children:   vitess.Exprs{left, right},
+			}, nil
 		case tree.Any:
 			return vitess.InjectedExpr{
 				Expression: pgexprs.NewAnyExpr(node.SubOperator.String()),
@@ -546,6 +602,12 @@ func nodeExpr(ctx *Context, node tree.Expr) (vitess.Expr, error) {
 			Expression: &pgnodes.DomainColumn{Typ: dataType},
 		}, nil
 	case *tree.FuncExpr:
+		if patternExpr, ok, err := nodePatternEscapeFuncExpr(ctx, node); ok || err != nil {
+			return patternExpr, err
+		}
+		if jsonExpr, ok, err := nodeJsonFuncExpr(ctx, node); ok || err != nil {
+			return jsonExpr, err
+		}
 		return nodeFuncExpr(ctx, node)
 	case *tree.IfErrExpr:
 		return nil, fmt.Errorf("IFERROR is not yet supported")
@@ -582,7 +644,37 @@ func nodeExpr(ctx *Context, node tree.Expr) (vitess.Expr, error) {
 		// TODO: figure out if I can delete this
 		return nil, fmt.Errorf("this should probably be deleted (internal error, IndexedVar)")
 	case *tree.IndirectionExpr:
-		return nil, fmt.Errorf("subscripts are not yet supported")
+		expr, err := nodeExpr(ctx, node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		children := vitess.Exprs{expr}
+		subscripts := make([]pgexprs.SubscriptBounds, len(node.Indirection))
+		for i, subscript := range node.Indirection {
+			subscripts[i] = pgexprs.SubscriptBounds{
+				Slice:    subscript.Slice,
+				HasLower: subscript.Begin != nil,
+				HasUpper: subscript.Slice && subscript.End != nil,
+			}
+			if subscript.Begin != nil {
+				begin, err := nodeExpr(ctx, subscript.Begin)
+				if err != nil {
+					return nil, err
+				}
+				children = append(children, begin)
+			}
+			if subscripts[i].HasUpper {
+				end, err := nodeExpr(ctx, subscript.End)
+				if err != nil {
+					return nil, err
+				}
+				children = append(children, end)
+			}
+		}
+		return vitess.InjectedExpr{
+			Expression: pgexprs.NewSubscriptInjectable(subscripts),
+			Children:   children,
+		}, nil
 	case *tree.IsNotNullExpr:
 		expr, err := nodeExpr(ctx, node.Expr)
 		if err != nil {
@@ -611,6 +703,8 @@ func nodeExpr(ctx *Context, node tree.Expr) (vitess.Expr, error) {
 		return &vitess.NotExpr{
 			Expr: expr,
 		}, nil
+	case *tree.NamedArgExpr:
+		return nil, fmt.Errorf("named argument %s is only allowed in a function call", node.Name)
 	case *tree.NullIfExpr:
 		expr1, err := nodeExprToSelectExpr(ctx, node.Expr1)
 		if err != nil {
@@ -732,17 +826,27 @@ func nodeExpr(ctx *Context, node tree.Expr) (vitess.Expr, error) {
 		if len(node.Labels) > 0 {
 			return nil, fmt.Errorf("tuple labels are not yet supported")
 		}
-		if node.Row {
-			return nil, fmt.Errorf("ROW keyword for tuples not yet supported")
-		}
 
 		valTuple, err := nodeExprs(ctx, node.Exprs)
 		if err != nil {
 			return nil, err
 		}
+		if node.Row {
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewRecord(),
+				Children:   valTuple,
+			}, nil
+		}
 		return vitess.ValTuple(valTuple), nil
 	case *tree.TupleStar:
-		return nil, fmt.Errorf("(E).* is not yet supported")
+		expr, err := nodeExpr(ctx, node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return vitess.InjectedExpr{
+			Expression: pgexprs.NewFieldStarInjectable(),
+			Children:   vitess.Exprs{expr},
+		}, nil
 	case *tree.UnaryExpr:
 		expr, err := nodeExpr(ctx, node.Expr)
 		if err != nil {
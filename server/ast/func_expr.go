@@ -16,10 +16,14 @@ package ast
 
 import (
 	"fmt"
+	"go/constant"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/postgres/parser/types"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
 )
 
 // nodeFuncExpr handles *tree.FuncExpr nodes.
@@ -65,7 +69,11 @@ func nodeFuncExpr(ctx *Context, node *tree.FuncExpr) (*vitess.FuncExpr, error) {
 	if err != nil {
 		return nil, err
 	}
-	exprs, err := nodeExprsToSelectExprs(ctx, node.Exprs)
+	args, err := positionalFuncArgs(name.Lowered(), node.Exprs)
+	if err != nil {
+		return nil, err
+	}
+	exprs, err := nodeExprsToSelectExprs(ctx, args)
 	if err != nil {
 		return nil, err
 	}
@@ -77,3 +85,266 @@ func nodeFuncExpr(ctx *Context, node *tree.FuncExpr) (*vitess.FuncExpr, error) {
 		Over:      (*vitess.Over)(windowDef),
 	}, nil
 }
+
+// namedParameter is a parameter of a function that may be called using named notation.
+type namedParameter struct {
+	name string
+	// defaultValue is the value used when the argument is omitted, or nil if the argument is required.
+	defaultValue tree.Expr
+}
+
+// namedParameters contains the parameters, in order, of the built-in functions that may be called using named notation.
+var namedParameters = map[string][]namedParameter{
+	"make_date": {{name: "year"}, {name: "month"}, {name: "day"}},
+	"make_interval": {
+		{name: "years", defaultValue: zeroArg},
+		{name: "months", defaultValue: zeroArg},
+		{name: "weeks", defaultValue: zeroArg},
+		{name: "days", defaultValue: zeroArg},
+		{name: "hours", defaultValue: zeroArg},
+		{name: "mins", defaultValue: zeroArg},
+		{name: "secs", defaultValue: zeroArg},
+	},
+	"make_time":        {{name: "hour"}, {name: "min"}, {name: "sec"}},
+	"make_timestamp":   {{name: "year"}, {name: "month"}, {name: "mday"}, {name: "hour"}, {name: "min"}, {name: "sec"}},
+	"make_timestamptz": {{name: "year"}, {name: "month"}, {name: "mday"}, {name: "hour"}, {name: "min"}, {name: "sec"}, {name: "timezone"}},
+}
+
+// zeroArg is the default value for the numeric parameters of functions in namedParameters.
+var zeroArg = tree.NewNumVal(constant.MakeInt64(0), "0", false)
+
+// positionalFuncArgs returns the given function arguments in positional notation. Arguments given in named notation are
+// moved to the position of their parameter, and any omitted arguments before the last given argument are filled in
+// using their default values.
+func positionalFuncArgs(funcName string, exprs tree.Exprs) (tree.Exprs, error) {
+	firstNamed := -1
+	for i, expr := range exprs {
+		if _, ok := expr.(*tree.NamedArgExpr); ok {
+			firstNamed = i
+			break
+		}
+	}
+	if firstNamed == -1 {
+		return exprs, nil
+	}
+	params, ok := namedParameters[funcName]
+	if !ok {
+		return nil, fmt.Errorf("named arguments are not yet supported for function %s", funcName)
+	}
+	if len(exprs) > len(params) {
+		return nil, errNoSuchFunction(funcName, exprs)
+	}
+	args := make(tree.Exprs, len(params))
+	copy(args, exprs[:firstNamed])
+	lastArg := firstNamed - 1
+	for _, expr := range exprs[firstNamed:] {
+		namedArg, ok := expr.(*tree.NamedArgExpr)
+		if !ok {
+			return nil, fmt.Errorf("positional argument cannot follow named argument")
+		}
+		paramIdx := -1
+		for i, param := range params {
+			if param.name == string(namedArg.Name) {
+				paramIdx = i
+				break
+			}
+		}
+		if paramIdx == -1 {
+			return nil, errNoSuchFunction(funcName, exprs)
+		}
+		if args[paramIdx] != nil {
+			return nil, fmt.Errorf(`argument name "%s" used more than once`, namedArg.Name)
+		}
+		args[paramIdx] = namedArg.Expr
+		lastArg = max(lastArg, paramIdx)
+	}
+	args = args[:lastArg+1]
+	for i := range args {
+		if args[i] == nil {
+			if params[i].defaultValue == nil {
+				return nil, errNoSuchFunction(funcName, exprs)
+			}
+			args[i] = params[i].defaultValue
+		}
+	}
+	return args, nil
+}
+
+// errNoSuchFunction returns the error for a call whose named arguments do not match the function's parameters.
+func errNoSuchFunction(funcName string, exprs tree.Exprs) error {
+	return fmt.Errorf("function %s(%s) does not exist", funcName, tree.AsString(&exprs))
+}
+
+// nodeJsonFuncExpr handles the JSON functions that cannot be represented as ordinary functions. The build functions
+// accept any number of arguments of any type, while the aggregate functions (including array_agg, which shares the same
+// implementation) are rewritten in terms of GMS's JSON aggregates. Returns false if the function is not one of these
+// functions.
+func nodeJsonFuncExpr(ctx *Context, node *tree.FuncExpr) (vitess.Expr, bool, error) {
+	funcRef, ok := node.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok || funcRef.NumParts != 1 {
+		return nil, false, nil
+	}
+	switch funcRef.Parts[0] {
+	case "json_build_array", "jsonb_build_array", "json_build_object", "jsonb_build_object":
+		exprs, err := nodeExprs(ctx, node.Exprs)
+		if err != nil {
+			return nil, true, err
+		}
+		var expression vitess.Injectable
+		switch funcRef.Parts[0] {
+		case "json_build_array":
+			expression = pgexprs.NewJsonBuildArray(false)
+		case "jsonb_build_array":
+			expression = pgexprs.NewJsonBuildArray(true)
+		case "json_build_object":
+			expression = pgexprs.NewJsonBuildObject(false)
+		case "jsonb_build_object":
+			expression = pgexprs.NewJsonBuildObject(true)
+		}
+		return vitess.InjectedExpr{
+			Expression: expression,
+			Children:   exprs,
+		}, true, nil
+	case "array_agg":
+		if len(node.Exprs) != 1 {
+			return nil, false, nil
+		}
+		aggExpr, err := nodeFuncExpr(ctx, &tree.FuncExpr{
+			Func:      tree.WrapFunction("json_arrayagg"),
+			Type:      node.Type,
+			Exprs:     node.Exprs,
+			Filter:    node.Filter,
+			WindowDef: node.WindowDef,
+			AggType:   node.AggType,
+			OrderBy:   node.OrderBy,
+		})
+		if err != nil {
+			return nil, true, err
+		}
+		// The result of json_arrayagg is json, so this is only used to determine the type of the elements
+		typeExpr, err := nodeFuncExpr(ctx, &tree.FuncExpr{
+			Func:      tree.WrapFunction("any_value"),
+			Exprs:     node.Exprs,
+			WindowDef: node.WindowDef,
+		})
+		if err != nil {
+			return nil, true, err
+		}
+		return vitess.InjectedExpr{
+			Expression: pgexprs.NewArrayAggregate(),
+			Children:   vitess.Exprs{aggExpr, typeExpr},
+		}, true, nil
+	case "json_agg", "jsonb_agg":
+		if len(node.Exprs) != 1 {
+			return nil, false, nil
+		}
+		jsonb := funcRef.Parts[0] == "jsonb_agg"
+		conversionFunc := "to_json"
+		if jsonb {
+			conversionFunc = "to_jsonb"
+		}
+		aggExpr, err := nodeFuncExpr(ctx, &tree.FuncExpr{
+			Func:      tree.WrapFunction("json_arrayagg"),
+			Type:      node.Type,
+			Exprs:     tree.Exprs{&tree.FuncExpr{Func: tree.WrapFunction(conversionFunc), Exprs: node.Exprs}},
+			Filter:    node.Filter,
+			WindowDef: node.WindowDef,
+			AggType:   node.AggType,
+			OrderBy:   node.OrderBy,
+		})
+		if err != nil {
+			return nil, true, err
+		}
+		return vitess.InjectedExpr{
+			Expression: pgexprs.NewJsonAggregate(jsonb),
+			Children:   vitess.Exprs{aggExpr},
+		}, true, nil
+	case "jsonb_object_agg":
+		if len(node.Exprs) != 2 {
+			return nil, false, nil
+		}
+		aggExpr, err := nodeFuncExpr(ctx, &tree.FuncExpr{
+			Func: tree.WrapFunction("json_objectagg"),
+			Type: node.Type,
+			Exprs: tree.Exprs{
+				&tree.CastExpr{Expr: node.Exprs[0], Type: types.String, SyntaxMode: tree.CastShort},
+				&tree.FuncExpr{Func: tree.WrapFunction("to_jsonb"), Exprs: tree.Exprs{node.Exprs[1]}},
+			},
+			Filter:    node.Filter,
+			WindowDef: node.WindowDef,
+			AggType:   node.AggType,
+			OrderBy:   node.OrderBy,
+		})
+		if err != nil {
+			return nil, true, err
+		}
+		return vitess.InjectedExpr{
+			Expression: pgexprs.NewJsonAggregate(true),
+			Children:   vitess.Exprs{aggExpr},
+		}, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// nodePatternEscapeFuncExpr handles the functions that the parser creates for pattern matching expressions that have an
+// ESCAPE clause, such as "a LIKE b ESCAPE c". These are converted into their respective operators, returning false if
+// the function is not one of these functions.
+func nodePatternEscapeFuncExpr(ctx *Context, node *tree.FuncExpr) (vitess.Expr, bool, error) {
+	// The parser creates these with three arguments, which does not conflict with any function that PostgreSQL defines
+	funcRef, ok := node.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok || funcRef.NumParts != 1 || len(node.Exprs) != 3 {
+		return nil, false, nil
+	}
+	var operator framework.Operator
+	var escapeFunction string
+	switch funcRef.Parts[0] {
+	case "like_escape", "not_like_escape":
+		// LIKE is handled by GMS, which supports an escape character directly
+		exprs, err := nodeExprs(ctx, node.Exprs)
+		if err != nil {
+			return nil, true, err
+		}
+		likeOperator := vitess.LikeStr
+		if funcRef.Parts[0] == "not_like_escape" {
+			likeOperator = vitess.NotLikeStr
+		}
+		return &vitess.ComparisonExpr{
+			Operator: likeOperator,
+			Left:     exprs[0],
+			Right:    exprs[1],
+			Escape:   exprs[2],
+		}, true, nil
+	case "ilike_escape":
+		operator, escapeFunction = framework.Operator_BinaryILike, "like_escape"
+	case "not_ilike_escape":
+		operator, escapeFunction = framework.Operator_BinaryNotILike, "like_escape"
+	case "similar_to_escape":
+		operator, escapeFunction = framework.Operator_BinaryRegexMatch, "similar_to_escape"
+	case "not_similar_to_escape":
+		operator, escapeFunction = framework.Operator_BinaryNotRegexMatch, "similar_to_escape"
+	default:
+		return nil, false, nil
+	}
+	exprs, err := nodeExprs(ctx, node.Exprs)
+	if err != nil {
+		return nil, true, err
+	}
+	return vitess.InjectedExpr{
+		Expression: pgexprs.NewBinaryOperator(operator),
+		Children:   vitess.Exprs{exprs[0], patternEscapeFuncExpr(escapeFunction, exprs[1], exprs[2])},
+	}, true, nil
+}
+
+// patternEscapeFuncExpr returns a call to the given function, which converts a pattern (along with an optional escape
+// character) into a form that the pattern matching operators accept.
+func patternEscapeFuncExpr(name string, args ...vitess.Expr) *vitess.FuncExpr {
+	exprs := make(vitess.SelectExprs, len(args))
+	for i, arg := range args {
+		exprs[i] = &vitess.AliasedExpr{Expr: arg}
+	}
+	return &vitess.FuncExpr{
+		Name:  vitess.NewColIdent(name),
+		Exprs: exprs,
+	}
+}
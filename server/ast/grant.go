@@ -16,6 +16,7 @@ package ast
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
@@ -24,6 +25,7 @@ import (
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 	"github.com/dolthub/doltgresql/server/auth"
 	pgnodes "github.com/dolthub/doltgresql/server/node"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
 // nodeGrant handles *tree.Grant nodes.
@@ -32,8 +34,12 @@ func nodeGrant(ctx *Context, node *tree.Grant) (vitess.Statement, error) {
 		return nil, nil
 	}
 	var grantTable *pgnodes.GrantTable
+	var grantColumn *pgnodes.GrantColumn
 	var grantSchema *pgnodes.GrantSchema
 	var grantDatabase *pgnodes.GrantDatabase
+	var grantSequence *pgnodes.GrantSequence
+	var grantFunction *pgnodes.GrantFunction
+	var grantType *pgnodes.GrantType
 	switch node.Targets.TargetType {
 	case privilege.Table:
 		tables := make([]doltdb.TableName, 0, len(node.Targets.Tables)+len(node.Targets.InSchema))
@@ -66,6 +72,22 @@ func nodeGrant(ctx *Context, node *tree.Grant) (vitess.Statement, error) {
 				Schema: schema,
 			})
 		}
+		if len(node.PrivsWithCols) > 0 {
+			for _, table := range tables {
+				if len(table.Name) == 0 {
+					return nil, fmt.Errorf("column privileges may only be granted on specific tables")
+				}
+			}
+			privileges, err := convertColumnPrivileges(node.PrivsWithCols)
+			if err != nil {
+				return nil, err
+			}
+			grantColumn = &pgnodes.GrantColumn{
+				Privileges: privileges,
+				Tables:     tables,
+			}
+			break
+		}
 		privileges, err := convertPrivilegeKinds(auth.PrivilegeObject_TABLE, node.Privileges)
 		if err != nil {
 			return nil, err
@@ -92,14 +114,53 @@ func nodeGrant(ctx *Context, node *tree.Grant) (vitess.Statement, error) {
 			Privileges: privileges,
 			Databases:  node.Targets.Databases.ToStrings(),
 		}
+	case privilege.Sequence:
+		privileges, err := convertPrivilegeKinds(auth.PrivilegeObject_SEQUENCE, node.Privileges)
+		if err != nil {
+			return nil, err
+		}
+		grantSequence = &pgnodes.GrantSequence{
+			Privileges: privileges,
+			Sequences:  convertSequenceTargets(node.Targets),
+		}
+	case privilege.Function, privilege.Procedure, privilege.Routine:
+		privileges, err := convertPrivilegeKinds(auth.PrivilegeObject_FUNCTION, node.Privileges)
+		if err != nil {
+			return nil, err
+		}
+		functions, err := convertFunctionTargets(ctx, node.Targets)
+		if err != nil {
+			return nil, err
+		}
+		grantFunction = &pgnodes.GrantFunction{
+			Privileges: privileges,
+			Functions:  functions,
+		}
+	case privilege.Type, privilege.Domain:
+		privileges, err := convertPrivilegeKinds(auth.PrivilegeObject_TYPE, node.Privileges)
+		if err != nil {
+			return nil, err
+		}
+		types, err := convertTypeTargets(node.Targets)
+		if err != nil {
+			return nil, err
+		}
+		grantType = &pgnodes.GrantType{
+			Privileges: privileges,
+			Types:      types,
+		}
 	default:
 		return nil, fmt.Errorf("this form of GRANT is not yet supported")
 	}
 	return vitess.InjectedStatement{
 		Statement: &pgnodes.Grant{
 			GrantTable:      grantTable,
+			GrantColumn:     grantColumn,
 			GrantSchema:     grantSchema,
 			GrantDatabase:   grantDatabase,
+			GrantSequence:   grantSequence,
+			GrantFunction:   grantFunction,
+			GrantType:       grantType,
 			GrantRole:       nil,
 			ToRoles:         node.Grantees,
 			WithGrantOption: node.WithGrantOption,
@@ -109,6 +170,79 @@ func nodeGrant(ctx *Context, node *tree.Grant) (vitess.Statement, error) {
 	}, nil
 }
 
+// convertColumnPrivileges converts privileges that apply to specific columns from their parser representation to the
+// server representation.
+func convertColumnPrivileges(privsWithCols []tree.PrivForCols) ([]pgnodes.ColumnPrivileges, error) {
+	var columnPrivileges []pgnodes.ColumnPrivileges
+	for _, privWithCols := range privsWithCols {
+		privileges, err := convertPrivilegeKinds(auth.PrivilegeObject_TABLE_COLUMN, []privilege.Kind{privWithCols.Privilege})
+		if err != nil {
+			return nil, err
+		}
+		columns := privWithCols.ColNames.ToStrings()
+		for _, p := range privileges {
+			columnPrivileges = append(columnPrivileges, pgnodes.ColumnPrivileges{
+				Privilege: p,
+				Columns:   columns,
+			})
+		}
+	}
+	return columnPrivileges, nil
+}
+
+// convertSequenceTargets converts the sequences from a target list. Sequences that are given through ALL SEQUENCES IN
+// SCHEMA have an empty name.
+func convertSequenceTargets(targets tree.TargetList) []doltdb.TableName {
+	sequences := make([]doltdb.TableName, 0, len(targets.Sequences)+len(targets.InSchema))
+	for _, sequence := range targets.Sequences {
+		sequences = append(sequences, doltdb.TableName{Name: string(sequence), Schema: ""})
+	}
+	for _, schema := range targets.InSchema {
+		sequences = append(sequences, doltdb.TableName{Name: "", Schema: schema})
+	}
+	return sequences
+}
+
+// convertFunctionTargets converts the functions, procedures, and routines from a target list. Functions that are given
+// through ALL FUNCTIONS IN SCHEMA have an empty name.
+func convertFunctionTargets(ctx *Context, targets tree.TargetList) ([]pgnodes.FunctionTarget, error) {
+	functions := make([]pgnodes.FunctionTarget, 0, len(targets.Routines)+len(targets.InSchema))
+	for _, routine := range targets.Routines {
+		var arguments []pgtypes.DoltgresType
+		for _, arg := range routine.Args {
+			_, argType, err := nodeResolvableTypeReference(ctx, arg.Type)
+			if err != nil {
+				return nil, err
+			}
+			arguments = append(arguments, argType)
+		}
+		functions = append(functions, pgnodes.FunctionTarget{
+			Name:      doltdb.TableName{Name: string(routine.Name), Schema: ""},
+			Arguments: arguments,
+		})
+	}
+	for _, schema := range targets.InSchema {
+		functions = append(functions, pgnodes.FunctionTarget{Name: doltdb.TableName{Name: "", Schema: schema}})
+	}
+	return functions, nil
+}
+
+// convertTypeTargets converts the types and domains from a target list.
+func convertTypeTargets(targets tree.TargetList) ([]doltdb.TableName, error) {
+	types := make([]doltdb.TableName, 0, len(targets.Types)+len(targets.Names))
+	for _, typ := range targets.Types {
+		if typ.NumParts > 2 {
+			return nil, fmt.Errorf("cross-database references are not implemented: %s", typ.String())
+		}
+		types = append(types, doltdb.TableName{Name: typ.Parts[0], Schema: typ.Parts[1]})
+	}
+	// Domains are given as names
+	for _, name := range targets.Names {
+		types = append(types, doltdb.TableName{Name: name, Schema: ""})
+	}
+	return types, nil
+}
+
 // convertPrivilegeKind converts a privilege from its parser representation to the server representation.
 func convertPrivilegeKinds(object auth.PrivilegeObject, kinds []privilege.Kind) ([]auth.Privilege, error) {
 	privileges := make([]auth.Privilege, len(kinds))
@@ -152,7 +286,12 @@ func convertPrivilegeKinds(object auth.PrivilegeObject, kinds []privilege.Kind)
 	}
 	for _, p := range privileges {
 		if !object.IsValid(p) {
-			return nil, fmt.Errorf("invalid privilege type %s for relation", p.String())
+			switch object {
+			case auth.PrivilegeObject_TABLE, auth.PrivilegeObject_TABLE_COLUMN:
+				return nil, fmt.Errorf("invalid privilege type %s for relation", p.String())
+			default:
+				return nil, fmt.Errorf("invalid privilege type %s for %s", p.String(), strings.ToLower(object.String()))
+			}
 		}
 	}
 	return privileges, nil
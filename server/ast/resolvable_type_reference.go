@@ -95,6 +95,8 @@ func nodeResolvableTypeReference(ctx *Context, typ tree.ResolvableTypeReference)
 				resolvedType = pgtypes.Int32
 			case oid.T_int8:
 				resolvedType = pgtypes.Int64
+			case oid.T_inet:
+				resolvedType = pgtypes.Inet
 			case oid.T_interval:
 				resolvedType = pgtypes.Interval
 			case oid.T_json:
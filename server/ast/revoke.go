@@ -32,8 +32,12 @@ func nodeRevoke(ctx *Context, node *tree.Revoke) (vitess.Statement, error) {
 		return nil, nil
 	}
 	var revokeTable *pgnodes.RevokeTable
+	var revokeColumn *pgnodes.RevokeColumn
 	var revokeSchema *pgnodes.RevokeSchema
 	var revokeDatabase *pgnodes.RevokeDatabase
+	var revokeSequence *pgnodes.RevokeSequence
+	var revokeFunction *pgnodes.RevokeFunction
+	var revokeType *pgnodes.RevokeType
 	switch node.Targets.TargetType {
 	case privilege.Table:
 		tables := make([]doltdb.TableName, len(node.Targets.Tables)+len(node.Targets.InSchema))
@@ -66,6 +70,22 @@ func nodeRevoke(ctx *Context, node *tree.Revoke) (vitess.Statement, error) {
 				Schema: schema,
 			})
 		}
+		if len(node.PrivsWithCols) > 0 {
+			for _, table := range tables {
+				if len(table.Name) == 0 {
+					return nil, fmt.Errorf("column privileges may only be revoked on specific tables")
+				}
+			}
+			privileges, err := convertColumnPrivileges(node.PrivsWithCols)
+			if err != nil {
+				return nil, err
+			}
+			revokeColumn = &pgnodes.RevokeColumn{
+				Privileges: privileges,
+				Tables:     tables,
+			}
+			break
+		}
 		privileges, err := convertPrivilegeKinds(auth.PrivilegeObject_TABLE, node.Privileges)
 		if err != nil {
 			return nil, err
@@ -92,14 +112,53 @@ func nodeRevoke(ctx *Context, node *tree.Revoke) (vitess.Statement, error) {
 			Privileges: privileges,
 			Databases:  node.Targets.Databases.ToStrings(),
 		}
+	case privilege.Sequence:
+		privileges, err := convertPrivilegeKinds(auth.PrivilegeObject_SEQUENCE, node.Privileges)
+		if err != nil {
+			return nil, err
+		}
+		revokeSequence = &pgnodes.RevokeSequence{
+			Privileges: privileges,
+			Sequences:  convertSequenceTargets(node.Targets),
+		}
+	case privilege.Function, privilege.Procedure, privilege.Routine:
+		privileges, err := convertPrivilegeKinds(auth.PrivilegeObject_FUNCTION, node.Privileges)
+		if err != nil {
+			return nil, err
+		}
+		functions, err := convertFunctionTargets(ctx, node.Targets)
+		if err != nil {
+			return nil, err
+		}
+		revokeFunction = &pgnodes.RevokeFunction{
+			Privileges: privileges,
+			Functions:  functions,
+		}
+	case privilege.Type, privilege.Domain:
+		privileges, err := convertPrivilegeKinds(auth.PrivilegeObject_TYPE, node.Privileges)
+		if err != nil {
+			return nil, err
+		}
+		types, err := convertTypeTargets(node.Targets)
+		if err != nil {
+			return nil, err
+		}
+		revokeType = &pgnodes.RevokeType{
+			Privileges: privileges,
+			Types:      types,
+		}
 	default:
 		return nil, fmt.Errorf("this form of REVOKE is not yet supported")
 	}
 	return vitess.InjectedStatement{
 		Statement: &pgnodes.Revoke{
 			RevokeTable:    revokeTable,
+			RevokeColumn:   revokeColumn,
 			RevokeSchema:   revokeSchema,
 			RevokeDatabase: revokeDatabase,
+			RevokeSequence: revokeSequence,
+			RevokeFunction: revokeFunction,
+			RevokeType:     revokeType,
 			RevokeRole:     nil,
 			FromRoles:      node.Grantees,
 			GrantedBy:      node.GrantedBy,
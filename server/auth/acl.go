@@ -0,0 +1,246 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// aclPrivilegeOrder is the order that Postgres displays privileges within an ACL item.
+var aclPrivilegeOrder = []Privilege{
+	Privilege_INSERT,
+	Privilege_SELECT,
+	Privilege_UPDATE,
+	Privilege_DELETE,
+	Privilege_TRUNCATE,
+	Privilege_REFERENCES,
+	Privilege_TRIGGER,
+	Privilege_EXECUTE,
+	Privilege_USAGE,
+	Privilege_CREATE,
+	Privilege_TEMPORARY,
+	Privilege_CONNECT,
+	Privilege_SET,
+	Privilege_ALTER_SYSTEM,
+}
+
+// aclItemKey identifies a single item within an ACL.
+type aclItemKey struct {
+	grantee RoleID
+	grantor RoleID
+}
+
+// aclBuilder gathers privileges so that they may be displayed as an ACL (such as in pg_class.relacl). Each item takes
+// the form `grantee=privileges/grantor`, where an empty grantee represents PUBLIC.
+type aclBuilder struct {
+	owner RoleID
+	items map[aclItemKey]map[Privilege]bool
+}
+
+// newACLBuilder returns a new *aclBuilder. The owner's item is always displayed first.
+func newACLBuilder(owner RoleID) *aclBuilder {
+	return &aclBuilder{
+		owner: owner,
+		items: make(map[aclItemKey]map[Privilege]bool),
+	}
+}
+
+// add adds the privilege to the item for the given grantee and grantor.
+func (b *aclBuilder) add(grantee RoleID, grantor RoleID, privilege Privilege, withGrantOption bool) {
+	key := aclItemKey{grantee: grantee, grantor: grantor}
+	privileges, ok := b.items[key]
+	if !ok {
+		privileges = make(map[Privilege]bool)
+		b.items[key] = privileges
+	}
+	privileges[privilege] = privileges[privilege] || withGrantOption
+}
+
+// addPrivilegeMap adds all of the privileges from a privilege map to the given grantee.
+func (b *aclBuilder) addPrivilegeMap(grantee RoleID, privileges map[Privilege]map[GrantedPrivilege]bool) {
+	for privilege, grantedMap := range privileges {
+		for grantedPrivilege, withGrantOption := range grantedMap {
+			grantor := grantedPrivilege.GrantedBy
+			if !grantor.IsValid() {
+				grantor = b.owner
+			}
+			b.add(grantee, grantor, privilege, withGrantOption)
+		}
+	}
+}
+
+// addOwner adds the owner's implicit privileges for the given object.
+func (b *aclBuilder) addOwner(object PrivilegeObject) {
+	if !b.owner.IsValid() {
+		return
+	}
+	for _, privilege := range object.AllPrivileges() {
+		b.add(b.owner, b.owner, privilege, false)
+	}
+}
+
+// build returns the ACL items, which may be used as a text array. The owner's items are displayed first, followed by
+// the rest of the items sorted by their grantee and grantor names.
+func (b *aclBuilder) build() []any {
+	keys := make([]aclItemKey, 0, len(b.items))
+	for key := range b.items {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if isOwnerI, isOwnerJ := keys[i].grantee == b.owner, keys[j].grantee == b.owner; isOwnerI != isOwnerJ {
+			return isOwnerI
+		}
+		if granteeI, granteeJ := aclRoleName(keys[i].grantee), aclRoleName(keys[j].grantee); granteeI != granteeJ {
+			return granteeI < granteeJ
+		}
+		return aclRoleName(keys[i].grantor) < aclRoleName(keys[j].grantor)
+	})
+	acl := make([]any, len(keys))
+	for i, key := range keys {
+		sb := strings.Builder{}
+		sb.WriteString(aclRoleName(key.grantee))
+		sb.WriteRune('=')
+		privileges := b.items[key]
+		for _, privilege := range aclPrivilegeOrder {
+			if withGrantOption, ok := privileges[privilege]; ok {
+				sb.WriteString(privilege.ACLAbbreviation())
+				if withGrantOption {
+					sb.WriteRune('*')
+				}
+			}
+		}
+		sb.WriteRune('/')
+		sb.WriteString(aclRoleName(key.grantor))
+		acl[i] = sb.String()
+	}
+	return acl
+}
+
+// GetTableACL returns the ACL for the given table, for display in pg_class.relacl. Returns nil if no privileges have
+// been granted on the table, which represents the default privileges.
+func GetTableACL(table doltdb.TableName) []any {
+	builder := newACLBuilder(firstOwner(OwnershipKey{
+		PrivilegeObject: PrivilegeObject_TABLE,
+		Schema:          table.Schema,
+		Name:            table.Name,
+	}))
+	for _, value := range globalDatabase.tablePrivileges.Data {
+		if value.Key.Table == table || (value.Key.Table.Name == "" && value.Key.Table.Schema == table.Schema) {
+			builder.addPrivilegeMap(value.Key.Role, value.Privileges)
+		}
+	}
+	if len(builder.items) == 0 {
+		return nil
+	}
+	builder.addOwner(PrivilegeObject_TABLE)
+	return builder.build()
+}
+
+// GetSequenceACL returns the ACL for the given sequence, for display in pg_class.relacl. Returns nil if no privileges
+// have been granted on the sequence, which represents the default privileges.
+func GetSequenceACL(sequence doltdb.TableName) []any {
+	builder := newACLBuilder(firstOwner(OwnershipKey{
+		PrivilegeObject: PrivilegeObject_SEQUENCE,
+		Schema:          sequence.Schema,
+		Name:            sequence.Name,
+	}))
+	for _, value := range globalDatabase.sequencePrivileges.Data {
+		if value.Key.Sequence == sequence || (value.Key.Sequence.Name == "" && value.Key.Sequence.Schema == sequence.Schema) {
+			builder.addPrivilegeMap(value.Key.Role, value.Privileges)
+		}
+	}
+	if len(builder.items) == 0 {
+		return nil
+	}
+	builder.addOwner(PrivilegeObject_SEQUENCE)
+	return builder.build()
+}
+
+// GetFunctionACL returns the ACL for the given overload of a function, which is identified by its argument types, for
+// display in pg_proc.proacl. Returns nil if the function still has its default privileges.
+func GetFunctionACL(schema string, name string, arguments string) []any {
+	owner := firstOwner(OwnershipKey{
+		PrivilegeObject: PrivilegeObject_FUNCTION,
+		Schema:          schema,
+		Name:            name,
+	})
+	if !owner.IsValid() {
+		// Built-in functions are owned by the bootstrap superuser
+		owner = bootstrapSuperUser()
+	}
+	builder := newACLBuilder(owner)
+	for _, value := range globalDatabase.functionPrivileges.Data {
+		if value.Key.Schema == schema && ((value.Key.Name == name && value.Key.Arguments == arguments) || value.Key.Name == "") {
+			builder.addPrivilegeMap(value.Key.Role, value.Privileges)
+		}
+	}
+	public := globalDatabase.rolesByName["public"]
+	_, revokedFunction := globalDatabase.functionPrivileges.RevokedDefaults[FunctionPrivilegeKey{
+		Role:      public,
+		Schema:    schema,
+		Name:      name,
+		Arguments: arguments,
+	}]
+	_, revokedSchema := globalDatabase.functionPrivileges.RevokedDefaults[FunctionPrivilegeKey{Role: public, Schema: schema}]
+	revoked := revokedFunction || revokedSchema
+	if len(builder.items) == 0 && !revoked {
+		return nil
+	}
+	if !revoked {
+		builder.add(public, owner, Privilege_EXECUTE, false)
+	}
+	builder.addOwner(PrivilegeObject_FUNCTION)
+	return builder.build()
+}
+
+// GetDefaultPrivilegeACL returns the ACL for the given default privileges, for display in pg_default_acl.defaclacl.
+func GetDefaultPrivilegeACL(value DefaultPrivilegeValue) []any {
+	builder := newACLBuilder(value.Key.Role)
+	for privilege, withGrantOption := range value.Privileges {
+		builder.add(value.Key.Grantee, value.Key.Role, privilege, withGrantOption)
+	}
+	return builder.build()
+}
+
+// aclRoleName returns the name of the role as it is displayed within an ACL. PUBLIC is represented by an empty name.
+func aclRoleName(role RoleID) string {
+	name := globalDatabase.rolesByID[role].Name
+	if name == "public" {
+		return ""
+	}
+	return name
+}
+
+// bootstrapSuperUser returns the first superuser that was created, which owns all built-in objects.
+func bootstrapSuperUser() RoleID {
+	var superUser RoleID
+	for id, role := range globalDatabase.rolesByID {
+		if role.IsSuperUser && (!superUser.IsValid() || id < superUser) {
+			superUser = id
+		}
+	}
+	return superUser
+}
+
+// firstOwner returns the first owner matching the given key, or an invalid role if there are no owners.
+func firstOwner(key OwnershipKey) RoleID {
+	if owners := GetOwners(key); len(owners) > 0 {
+		return owners[0]
+	}
+	return 0
+}
@@ -204,6 +204,11 @@ func (h *AuthorizationHandler) HandleAuth(ctx *sql.Context, aqs sql.Authorizatio
 			}
 			for _, privilege := range privileges {
 				if !HasTablePrivilege(roleTableKey, privilege) && !HasTablePrivilege(publicTableKey, privilege) {
+					// Column privileges are checked against the referenced columns during analysis, so we only need
+					// to verify that the privilege was granted on at least one column here.
+					if HasAnyColumnPrivilege(roleTableKey, privilege) || HasAnyColumnPrivilege(publicTableKey, privilege) {
+						continue
+					}
 					return fmt.Errorf("permission denied for table %s", auth.TargetNames[i+2])
 				}
 			}
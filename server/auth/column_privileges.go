@@ -0,0 +1,322 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"sort"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// ColumnPrivileges contains the privileges given to a role on a table's column.
+type ColumnPrivileges struct {
+	Data map[ColumnPrivilegeKey]ColumnPrivilegeValue
+}
+
+// ColumnPrivilegeKey points to a specific column object.
+type ColumnPrivilegeKey struct {
+	Role   RoleID
+	Table  doltdb.TableName
+	Column string
+}
+
+// ColumnPrivilegeValue is the value associated with the ColumnPrivilegeKey.
+type ColumnPrivilegeValue struct {
+	Key        ColumnPrivilegeKey
+	Privileges map[Privilege]map[GrantedPrivilege]bool
+}
+
+// ColumnPrivilegeGrant is a single privilege that has been granted on a column. This is used when displaying column
+// privileges, such as in information_schema.column_privileges.
+type ColumnPrivilegeGrant struct {
+	Grantor         string
+	Grantee         string
+	Table           doltdb.TableName
+	Column          string
+	Privilege       Privilege
+	WithGrantOption bool
+}
+
+// NewColumnPrivileges returns a new *ColumnPrivileges.
+func NewColumnPrivileges() *ColumnPrivileges {
+	return &ColumnPrivileges{make(map[ColumnPrivilegeKey]ColumnPrivilegeValue)}
+}
+
+// AddColumnPrivilege adds the given column privilege to the global database.
+func AddColumnPrivilege(key ColumnPrivilegeKey, privilege GrantedPrivilege, withGrantOption bool) {
+	columnPrivilegeValue, ok := globalDatabase.columnPrivileges.Data[key]
+	if !ok {
+		columnPrivilegeValue = ColumnPrivilegeValue{
+			Key:        key,
+			Privileges: make(map[Privilege]map[GrantedPrivilege]bool),
+		}
+		globalDatabase.columnPrivileges.Data[key] = columnPrivilegeValue
+	}
+	privilegeMap, ok := columnPrivilegeValue.Privileges[privilege.Privilege]
+	if !ok {
+		privilegeMap = make(map[GrantedPrivilege]bool)
+		columnPrivilegeValue.Privileges[privilege.Privilege] = privilegeMap
+	}
+	privilegeMap[privilege] = withGrantOption
+}
+
+// ColumnPrivilegesExist returns whether any column privileges have been granted. This allows callers to skip column
+// checks entirely in the common case where only table-level privileges are in use.
+func ColumnPrivilegesExist() bool {
+	return len(globalDatabase.columnPrivileges.Data) > 0
+}
+
+// GetColumnPrivilegeGrants returns all column privileges that are visible to the given role, sorted by table, column,
+// grantee, and privilege. Privileges are visible when the role (or one of its groups) is the grantor or grantee, or when
+// they've been granted to the public role. Superusers are able to see all privileges.
+func GetColumnPrivilegeGrants(role RoleID) []ColumnPrivilegeGrant {
+	visible := map[RoleID]struct{}{role: {}}
+	for _, group := range GetAllGroupsWithMember(role, false) {
+		visible[group] = struct{}{}
+	}
+	if public, ok := globalDatabase.rolesByName["public"]; ok {
+		visible[public] = struct{}{}
+	}
+	isSuperUser := IsSuperUser(role)
+	var grants []ColumnPrivilegeGrant
+	for _, value := range globalDatabase.columnPrivileges.Data {
+		_, granteeVisible := visible[value.Key.Role]
+		for privilege, privilegeMap := range value.Privileges {
+			for grantedPrivilege, withGrantOption := range privilegeMap {
+				if _, grantorVisible := visible[grantedPrivilege.GrantedBy]; !isSuperUser && !granteeVisible && !grantorVisible {
+					continue
+				}
+				grants = append(grants, ColumnPrivilegeGrant{
+					Grantor:         globalDatabase.rolesByID[grantedPrivilege.GrantedBy].Name,
+					Grantee:         globalDatabase.rolesByID[value.Key.Role].Name,
+					Table:           value.Key.Table,
+					Column:          value.Key.Column,
+					Privilege:       privilege,
+					WithGrantOption: withGrantOption,
+				})
+			}
+		}
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		if grants[i].Table.Schema != grants[j].Table.Schema {
+			return grants[i].Table.Schema < grants[j].Table.Schema
+		}
+		if grants[i].Table.Name != grants[j].Table.Name {
+			return grants[i].Table.Name < grants[j].Table.Name
+		}
+		if grants[i].Column != grants[j].Column {
+			return grants[i].Column < grants[j].Column
+		}
+		if grants[i].Grantee != grants[j].Grantee {
+			return grants[i].Grantee < grants[j].Grantee
+		}
+		if grants[i].Privilege != grants[j].Privilege {
+			return grants[i].Privilege.String() < grants[j].Privilege.String()
+		}
+		return grants[i].Grantor < grants[j].Grantor
+	})
+	return grants
+}
+
+// HasColumnPrivilege checks whether the user has the given privilege on the associated column. Privileges that are
+// granted on the table apply to all of its columns.
+func HasColumnPrivilege(key ColumnPrivilegeKey, privilege Privilege) bool {
+	if HasTablePrivilege(TablePrivilegeKey{Role: key.Role, Table: key.Table}, privilege) {
+		return true
+	}
+	if columnPrivilegeValue, ok := globalDatabase.columnPrivileges.Data[key]; ok {
+		if privilegeMap, ok := columnPrivilegeValue.Privileges[privilege]; ok && len(privilegeMap) > 0 {
+			return true
+		}
+	}
+	for _, group := range GetAllGroupsWithMember(key.Role, true) {
+		if HasColumnPrivilege(ColumnPrivilegeKey{
+			Role:   group,
+			Table:  key.Table,
+			Column: key.Column,
+		}, privilege) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnyColumnPrivilege checks whether the user has the given privilege on at least one column of the associated table.
+// This does not take table-level privileges into account.
+func HasAnyColumnPrivilege(key TablePrivilegeKey, privilege Privilege) bool {
+	for columnKey, columnPrivilegeValue := range globalDatabase.columnPrivileges.Data {
+		if columnKey.Role != key.Role || columnKey.Table != key.Table {
+			continue
+		}
+		if privilegeMap, ok := columnPrivilegeValue.Privileges[privilege]; ok && len(privilegeMap) > 0 {
+			return true
+		}
+	}
+	for _, group := range GetAllGroupsWithMember(key.Role, true) {
+		if HasAnyColumnPrivilege(TablePrivilegeKey{
+			Role:  group,
+			Table: key.Table,
+		}, privilege) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasColumnPrivilegeGrantOption checks whether the user has WITH GRANT OPTION for the given privilege on the associated
+// column. Returns the role that has WITH GRANT OPTION, or an invalid role if WITH GRANT OPTION is not available.
+func HasColumnPrivilegeGrantOption(key ColumnPrivilegeKey, privilege Privilege) RoleID {
+	if returnedID := HasTablePrivilegeGrantOption(TablePrivilegeKey{
+		Role:  key.Role,
+		Table: key.Table,
+	}, privilege); returnedID.IsValid() {
+		return returnedID
+	}
+	if columnPrivilegeValue, ok := globalDatabase.columnPrivileges.Data[key]; ok {
+		if privilegeMap, ok := columnPrivilegeValue.Privileges[privilege]; ok {
+			for _, withGrantOption := range privilegeMap {
+				if withGrantOption {
+					return key.Role
+				}
+			}
+		}
+	}
+	for _, group := range GetAllGroupsWithMember(key.Role, true) {
+		if returnedID := HasColumnPrivilegeGrantOption(ColumnPrivilegeKey{
+			Role:   group,
+			Table:  key.Table,
+			Column: key.Column,
+		}, privilege); returnedID.IsValid() {
+			return returnedID
+		}
+	}
+	return 0
+}
+
+// RemoveColumnPrivilege removes the privilege from the global database. If `grantOptionOnly` is true, then only the WITH
+// GRANT OPTION portion is revoked. If `grantOptionOnly` is false, then the full privilege is removed. If the GrantedBy
+// field contains a valid RoleID, then only the privilege associated with that granter is removed. Otherwise, the
+// privilege is completely removed for the grantee.
+func RemoveColumnPrivilege(key ColumnPrivilegeKey, privilege GrantedPrivilege, grantOptionOnly bool) {
+	if columnPrivilegeValue, ok := globalDatabase.columnPrivileges.Data[key]; ok {
+		if privilegeMap, ok := columnPrivilegeValue.Privileges[privilege.Privilege]; ok {
+			if grantOptionOnly {
+				if privilege.GrantedBy.IsValid() {
+					if _, ok = privilegeMap[privilege]; ok {
+						privilegeMap[privilege] = false
+					}
+				} else {
+					for privilegeMapKey := range privilegeMap {
+						privilegeMap[privilegeMapKey] = false
+					}
+				}
+			} else {
+				if privilege.GrantedBy.IsValid() {
+					delete(privilegeMap, privilege)
+				} else {
+					privilegeMap = nil
+				}
+				if len(privilegeMap) == 0 {
+					delete(columnPrivilegeValue.Privileges, privilege.Privilege)
+				}
+			}
+		}
+		if len(columnPrivilegeValue.Privileges) == 0 {
+			delete(globalDatabase.columnPrivileges.Data, key)
+		}
+	}
+}
+
+// RemoveTableColumnPrivileges removes the privilege from every column of the given table. Revoking a privilege on a
+// table also revokes that privilege on each of the table's columns. If the table name is empty, then the privilege is
+// removed from the columns of every table in the schema.
+func RemoveTableColumnPrivileges(key TablePrivilegeKey, privilege GrantedPrivilege, grantOptionOnly bool) {
+	var columnKeys []ColumnPrivilegeKey
+	for columnKey := range globalDatabase.columnPrivileges.Data {
+		if columnKey.Role != key.Role || columnKey.Table.Schema != key.Table.Schema {
+			continue
+		}
+		if len(key.Table.Name) > 0 && columnKey.Table.Name != key.Table.Name {
+			continue
+		}
+		columnKeys = append(columnKeys, columnKey)
+	}
+	for _, columnKey := range columnKeys {
+		RemoveColumnPrivilege(columnKey, privilege, grantOptionOnly)
+	}
+}
+
+// serialize writes the ColumnPrivileges to the given writer.
+func (cp *ColumnPrivileges) serialize(writer *utils.Writer) {
+	// Version 0
+	// Write the total number of values
+	writer.Uint64(uint64(len(cp.Data)))
+	for _, value := range cp.Data {
+		// Write the key
+		writer.Uint64(uint64(value.Key.Role))
+		writer.String(value.Key.Table.Name)
+		writer.String(value.Key.Table.Schema)
+		writer.String(value.Key.Column)
+		// Write the total number of privileges
+		writer.Uint64(uint64(len(value.Privileges)))
+		for privilege, privilegeMap := range value.Privileges {
+			writer.String(string(privilege))
+			// Write the number of granted privileges
+			writer.Uint32(uint32(len(privilegeMap)))
+			for grantedPrivilege, withGrantOption := range privilegeMap {
+				writer.Uint64(uint64(grantedPrivilege.GrantedBy))
+				writer.Bool(withGrantOption)
+			}
+		}
+	}
+}
+
+// deserialize reads the ColumnPrivileges from the given reader.
+func (cp *ColumnPrivileges) deserialize(version uint32, reader *utils.Reader) {
+	cp.Data = make(map[ColumnPrivilegeKey]ColumnPrivilegeValue)
+	switch version {
+	case 0:
+		// Read the total number of values
+		dataCount := reader.Uint64()
+		for dataIdx := uint64(0); dataIdx < dataCount; dataIdx++ {
+			// Read the key
+			cpv := ColumnPrivilegeValue{Privileges: make(map[Privilege]map[GrantedPrivilege]bool)}
+			cpv.Key.Role = RoleID(reader.Uint64())
+			cpv.Key.Table.Name = reader.String()
+			cpv.Key.Table.Schema = reader.String()
+			cpv.Key.Column = reader.String()
+			// Read the total number of privileges
+			privilegeCount := reader.Uint64()
+			for privilegeIdx := uint64(0); privilegeIdx < privilegeCount; privilegeIdx++ {
+				privilege := Privilege(reader.String())
+				// Read the number of granted privileges
+				grantedCount := reader.Uint32()
+				grantedMap := make(map[GrantedPrivilege]bool)
+				for grantedIdx := uint32(0); grantedIdx < grantedCount; grantedIdx++ {
+					grantedPrivilege := GrantedPrivilege{}
+					grantedPrivilege.Privilege = privilege
+					grantedPrivilege.GrantedBy = RoleID(reader.Uint64())
+					grantedMap[grantedPrivilege] = reader.Bool()
+				}
+				cpv.Privileges[privilege] = grantedMap
+			}
+			cp.Data[cpv.Key] = cpv
+		}
+	default:
+		panic("unexpected version in ColumnPrivileges")
+	}
+}
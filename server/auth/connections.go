@@ -0,0 +1,76 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"sync"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+)
+
+// liveConnections tracks the number of open connections for each role and database, so that connection limits may be
+// enforced. These are not persisted, as they only apply to the currently running server.
+var liveConnections = struct {
+	mutex     sync.Mutex
+	roles     map[RoleID]int32
+	databases map[string]int32
+}{
+	roles:     make(map[RoleID]int32),
+	databases: make(map[string]int32),
+}
+
+// AcquireConnection records a new connection for the given role to the given database. Returns an error if the
+// database does not allow connections, or if the connection would exceed the connection limit of either the role or
+// the database. Superusers are not subject to connection limits. The returned function must be called once the
+// connection has closed.
+func AcquireConnection(roleName string, database string) (release func(), err error) {
+	var role Role
+	var setting DatabaseSetting
+	LockRead(func() {
+		role = GetRole(roleName)
+		setting = GetDatabaseSetting(database)
+	})
+	if !setting.AllowConnections {
+		return nil, pgerror.Newf(pgcode.ObjectNotInPrerequisiteState, `database "%s" is not currently accepting connections`, database)
+	}
+
+	liveConnections.mutex.Lock()
+	defer liveConnections.mutex.Unlock()
+	if !role.IsSuperUser {
+		if setting.ConnectionLimit >= 0 && liveConnections.databases[database] >= setting.ConnectionLimit {
+			return nil, pgerror.Newf(pgcode.TooManyConnections, `too many connections for database "%s"`, database)
+		}
+		if role.IsValid() && role.ConnectionLimit >= 0 && liveConnections.roles[role.ID()] >= role.ConnectionLimit {
+			return nil, pgerror.Newf(pgcode.TooManyConnections, `too many connections for role "%s"`, role.Name)
+		}
+	}
+	liveConnections.databases[database]++
+	liveConnections.roles[role.ID()]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			liveConnections.mutex.Lock()
+			defer liveConnections.mutex.Unlock()
+			if liveConnections.databases[database]--; liveConnections.databases[database] <= 0 {
+				delete(liveConnections.databases, database)
+			}
+			if liveConnections.roles[role.ID()]--; liveConnections.roles[role.ID()] <= 0 {
+				delete(liveConnections.roles, role.ID())
+			}
+		})
+	}, nil
+}
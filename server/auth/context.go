@@ -14,7 +14,14 @@
 
 package auth
 
-import "github.com/dolthub/doltgresql/utils"
+import (
+	"context"
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/utils"
+)
 
 // AuthContext contains the auth portion of the context when converting from the Postgres AST to the Vitess AST.
 type AuthContext struct {
@@ -48,3 +55,36 @@ func (ctx *AuthContext) PopAuthType() string {
 	}
 	return ctx.authType.Pop()
 }
+
+// internalContextKey is the key that marks a context as belonging to an operation that the server runs on its own
+// behalf.
+type internalContextKey struct{}
+
+// NewInternalContext returns a context for an operation that the server runs on its own behalf, such as replication.
+// Such operations are not subject to privilege checks, even when they do not run as a role.
+func NewInternalContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internalContextKey{}, true)
+}
+
+// IsInternalContext returns whether the context belongs to an operation that the server runs on its own behalf.
+func IsInternalContext(ctx context.Context) bool {
+	isInternal, _ := ctx.Value(internalContextKey{}).(bool)
+	return isInternal
+}
+
+// GetSessionRole returns the role that the context's session runs as, for use by privilege checks. Operations that the
+// server runs on its own behalf do not run as a role, so |isInternal| is true for them and the role is not set. Returns
+// an error if the session's role does not exist. This takes the read lock, so it must not be called while the lock is
+// held.
+func GetSessionRole(ctx *sql.Context) (role Role, isInternal bool, err error) {
+	if IsInternalContext(ctx) {
+		return Role{}, true, nil
+	}
+	LockRead(func() {
+		role = GetRole(ctx.Client().User)
+	})
+	if !role.IsValid() {
+		return Role{}, false, fmt.Errorf(`role "%s" does not exist`, ctx.Client().User)
+	}
+	return role, false, nil
+}
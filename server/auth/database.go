@@ -42,7 +42,16 @@ type Database struct {
 	databasePrivileges *DatabasePrivileges
 	schemaPrivileges   *SchemaPrivileges
 	tablePrivileges    *TablePrivileges
+	columnPrivileges   *ColumnPrivileges
+	sequencePrivileges *SequencePrivileges
+	functionPrivileges *FunctionPrivileges
+	typePrivileges     *TypePrivileges
+	defaultPrivileges  *DefaultPrivileges
 	roleMembership     *RoleMembership
+	databaseSettings   *DatabaseSettings
+	// unownedSequences is set when the database was loaded from a format that did not record the owners of
+	// sequences, meaning that existing sequences must be given an owner.
+	unownedSequences bool
 }
 
 // ClearDatabase clears the internal database, leaving only the default users. This is primarily for use by tests.
@@ -53,7 +62,15 @@ func ClearDatabase() {
 	clear(globalDatabase.databasePrivileges.Data)
 	clear(globalDatabase.schemaPrivileges.Data)
 	clear(globalDatabase.tablePrivileges.Data)
+	clear(globalDatabase.columnPrivileges.Data)
+	clear(globalDatabase.sequencePrivileges.Data)
+	clear(globalDatabase.functionPrivileges.Data)
+	clear(globalDatabase.functionPrivileges.RevokedDefaults)
+	clear(globalDatabase.typePrivileges.Data)
+	clear(globalDatabase.typePrivileges.RevokedDefaults)
+	clear(globalDatabase.defaultPrivileges.Data)
 	clear(globalDatabase.roleMembership.Data)
+	clear(globalDatabase.databaseSettings.Data)
 	dbInitDefault()
 }
 
@@ -138,7 +155,13 @@ func dbInit(dEnv *env.DoltEnv) {
 		databasePrivileges: NewDatabasePrivileges(),
 		schemaPrivileges:   NewSchemaPrivileges(),
 		tablePrivileges:    NewTablePrivileges(),
+		columnPrivileges:   NewColumnPrivileges(),
+		sequencePrivileges: NewSequencePrivileges(),
+		functionPrivileges: NewFunctionPrivileges(),
+		typePrivileges:     NewTypePrivileges(),
+		defaultPrivileges:  NewDefaultPrivileges(),
 		roleMembership:     NewRoleMembership(),
+		databaseSettings:   NewDatabaseSettings(),
 	}
 	globalLock = &sync.RWMutex{}
 	if dEnv != nil {
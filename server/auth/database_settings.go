@@ -0,0 +1,92 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// DatabaseSettings contains the connection settings of each database.
+type DatabaseSettings struct {
+	Data map[string]DatabaseSetting
+}
+
+// DatabaseSetting contains the connection settings of a single database.
+type DatabaseSetting struct {
+	AllowConnections bool  // datallowconn
+	ConnectionLimit  int32 // datconnlimit
+}
+
+// NewDatabaseSettings returns a new *DatabaseSettings.
+func NewDatabaseSettings() *DatabaseSettings {
+	return &DatabaseSettings{make(map[string]DatabaseSetting)}
+}
+
+// DefaultDatabaseSetting returns the settings of a database that did not specify any.
+func DefaultDatabaseSetting() DatabaseSetting {
+	return DatabaseSetting{
+		AllowConnections: true,
+		ConnectionLimit:  -1,
+	}
+}
+
+// GetDatabaseSetting returns the settings of the given database. Databases that have not had any settings set will
+// return the default settings.
+func GetDatabaseSetting(name string) DatabaseSetting {
+	if setting, ok := globalDatabase.databaseSettings.Data[name]; ok {
+		return setting
+	}
+	return DefaultDatabaseSetting()
+}
+
+// SetDatabaseSetting sets the settings of the given database, overwriting any existing settings.
+func SetDatabaseSetting(name string, setting DatabaseSetting) {
+	if setting == DefaultDatabaseSetting() {
+		delete(globalDatabase.databaseSettings.Data, name)
+		return
+	}
+	globalDatabase.databaseSettings.Data[name] = setting
+}
+
+// serialize writes the DatabaseSettings to the given writer.
+func (ds *DatabaseSettings) serialize(writer *utils.Writer) {
+	// Version 0
+	// Write the total number of values
+	writer.Uint64(uint64(len(ds.Data)))
+	for name, setting := range ds.Data {
+		writer.String(name)
+		writer.Bool(setting.AllowConnections)
+		writer.Int32(setting.ConnectionLimit)
+	}
+}
+
+// deserialize reads the DatabaseSettings from the given reader.
+func (ds *DatabaseSettings) deserialize(version uint32, reader *utils.Reader) {
+	ds.Data = make(map[string]DatabaseSetting)
+	switch version {
+	case 0:
+		// Read the total number of values
+		dataCount := reader.Uint64()
+		for dataIdx := uint64(0); dataIdx < dataCount; dataIdx++ {
+			name := reader.String()
+			setting := DatabaseSetting{}
+			setting.AllowConnections = reader.Bool()
+			setting.ConnectionLimit = reader.Int32()
+			ds.Data[name] = setting
+		}
+	default:
+		panic("unexpected version in DatabaseSettings")
+	}
+}
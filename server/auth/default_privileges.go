@@ -0,0 +1,189 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"sort"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// DefaultPrivileges contains the privileges that are automatically granted on objects when they are created.
+type DefaultPrivileges struct {
+	Data map[DefaultPrivilegeKey]DefaultPrivilegeValue
+}
+
+// DefaultPrivilegeKey points to the default privileges for objects that are created by a specific role. An empty
+// schema refers to objects created in any schema.
+type DefaultPrivilegeKey struct {
+	Role            RoleID
+	Schema          string
+	PrivilegeObject PrivilegeObject
+	Grantee         RoleID
+}
+
+// DefaultPrivilegeValue is the value associated with the DefaultPrivilegeKey. Each privilege maps to whether it is
+// granted WITH GRANT OPTION.
+type DefaultPrivilegeValue struct {
+	Key        DefaultPrivilegeKey
+	Privileges map[Privilege]bool
+}
+
+// NewDefaultPrivileges returns a new *DefaultPrivileges.
+func NewDefaultPrivileges() *DefaultPrivileges {
+	return &DefaultPrivileges{make(map[DefaultPrivilegeKey]DefaultPrivilegeValue)}
+}
+
+// AddDefaultPrivilege adds the given default privilege to the global database.
+func AddDefaultPrivilege(key DefaultPrivilegeKey, privilege Privilege, withGrantOption bool) {
+	defaultPrivilegeValue, ok := globalDatabase.defaultPrivileges.Data[key]
+	if !ok {
+		defaultPrivilegeValue = DefaultPrivilegeValue{
+			Key:        key,
+			Privileges: make(map[Privilege]bool),
+		}
+		globalDatabase.defaultPrivileges.Data[key] = defaultPrivilegeValue
+	}
+	defaultPrivilegeValue.Privileges[privilege] = withGrantOption
+}
+
+// ApplyDefaultPrivileges grants the default privileges for the given object, which was just created by the owner. This
+// only applies to tables, sequences, functions, and types.
+func ApplyDefaultPrivileges(object PrivilegeObject, schema string, name string, owner RoleID) {
+	for _, value := range globalDatabase.defaultPrivileges.Data {
+		if value.Key.Role != owner || value.Key.PrivilegeObject != object ||
+			(len(value.Key.Schema) > 0 && value.Key.Schema != schema) {
+			continue
+		}
+		for privilege, withGrantOption := range value.Privileges {
+			grantedPrivilege := GrantedPrivilege{
+				Privilege: privilege,
+				GrantedBy: owner,
+			}
+			switch object {
+			case PrivilegeObject_TABLE:
+				AddTablePrivilege(TablePrivilegeKey{
+					Role:  value.Key.Grantee,
+					Table: doltdb.TableName{Name: name, Schema: schema},
+				}, grantedPrivilege, withGrantOption)
+			case PrivilegeObject_SEQUENCE:
+				AddSequencePrivilege(SequencePrivilegeKey{
+					Role:     value.Key.Grantee,
+					Sequence: doltdb.TableName{Name: name, Schema: schema},
+				}, grantedPrivilege, withGrantOption)
+			case PrivilegeObject_FUNCTION:
+				AddFunctionPrivilege(FunctionPrivilegeKey{
+					Role:   value.Key.Grantee,
+					Schema: schema,
+					Name:   name,
+				}, grantedPrivilege, withGrantOption)
+			case PrivilegeObject_TYPE, PrivilegeObject_DOMAIN:
+				AddTypePrivilege(TypePrivilegeKey{
+					Role:   value.Key.Grantee,
+					Schema: schema,
+					Name:   name,
+				}, grantedPrivilege, withGrantOption)
+			}
+		}
+	}
+}
+
+// GetAllDefaultPrivileges returns all default privileges, sorted by their keys.
+func GetAllDefaultPrivileges() []DefaultPrivilegeValue {
+	values := make([]DefaultPrivilegeValue, 0, len(globalDatabase.defaultPrivileges.Data))
+	for _, value := range globalDatabase.defaultPrivileges.Data {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		a, b := values[i].Key, values[j].Key
+		if a.Role != b.Role {
+			return a.Role < b.Role
+		}
+		if a.Schema != b.Schema {
+			return a.Schema < b.Schema
+		}
+		if a.PrivilegeObject != b.PrivilegeObject {
+			return a.PrivilegeObject < b.PrivilegeObject
+		}
+		return a.Grantee < b.Grantee
+	})
+	return values
+}
+
+// RemoveDefaultPrivilege removes the default privilege from the global database. If `grantOptionOnly` is true, then
+// only the WITH GRANT OPTION portion is revoked.
+func RemoveDefaultPrivilege(key DefaultPrivilegeKey, privilege Privilege, grantOptionOnly bool) {
+	if defaultPrivilegeValue, ok := globalDatabase.defaultPrivileges.Data[key]; ok {
+		if _, ok = defaultPrivilegeValue.Privileges[privilege]; ok {
+			if grantOptionOnly {
+				defaultPrivilegeValue.Privileges[privilege] = false
+			} else {
+				delete(defaultPrivilegeValue.Privileges, privilege)
+			}
+		}
+		if len(defaultPrivilegeValue.Privileges) == 0 {
+			delete(globalDatabase.defaultPrivileges.Data, key)
+		}
+	}
+}
+
+// serialize writes the DefaultPrivileges to the given writer.
+func (dp *DefaultPrivileges) serialize(writer *utils.Writer) {
+	// Version 0
+	// Write the total number of values
+	writer.Uint64(uint64(len(dp.Data)))
+	for _, value := range dp.Data {
+		// Write the key
+		writer.Uint64(uint64(value.Key.Role))
+		writer.String(value.Key.Schema)
+		writer.Byte(byte(value.Key.PrivilegeObject))
+		writer.Uint64(uint64(value.Key.Grantee))
+		// Write the total number of privileges
+		writer.Uint64(uint64(len(value.Privileges)))
+		for privilege, withGrantOption := range value.Privileges {
+			writer.String(string(privilege))
+			writer.Bool(withGrantOption)
+		}
+	}
+}
+
+// deserialize reads the DefaultPrivileges from the given reader.
+func (dp *DefaultPrivileges) deserialize(version uint32, reader *utils.Reader) {
+	dp.Data = make(map[DefaultPrivilegeKey]DefaultPrivilegeValue)
+	switch version {
+	case 0:
+		// Read the total number of values
+		dataCount := reader.Uint64()
+		for dataIdx := uint64(0); dataIdx < dataCount; dataIdx++ {
+			// Read the key
+			dpv := DefaultPrivilegeValue{Privileges: make(map[Privilege]bool)}
+			dpv.Key.Role = RoleID(reader.Uint64())
+			dpv.Key.Schema = reader.String()
+			dpv.Key.PrivilegeObject = PrivilegeObject(reader.Byte())
+			dpv.Key.Grantee = RoleID(reader.Uint64())
+			// Read the total number of privileges
+			privilegeCount := reader.Uint64()
+			for privilegeIdx := uint64(0); privilegeIdx < privilegeCount; privilegeIdx++ {
+				privilege := Privilege(reader.String())
+				dpv.Privileges[privilege] = reader.Bool()
+			}
+			dp.Data[dpv.Key] = dpv
+		}
+	default:
+		panic("unexpected version in DefaultPrivileges")
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import "github.com/dolthub/doltgresql/utils"
+
+// FunctionPrivileges contains the privileges given to a role on a function.
+type FunctionPrivileges struct {
+	Data map[FunctionPrivilegeKey]FunctionPrivilegeValue
+	// RevokedDefaults contains the functions that have had their default PUBLIC privileges revoked. The key's role
+	// will always be the PUBLIC role.
+	RevokedDefaults map[FunctionPrivilegeKey]struct{}
+}
+
+// FunctionPrivilegeKey points to a specific function object. As functions may be overloaded, each overload is identified
+// by its argument types, which are formatted as they'd appear in the function's signature (such as "integer, text").
+// An empty name refers to all functions in the schema.
+type FunctionPrivilegeKey struct {
+	Role      RoleID
+	Schema    string
+	Name      string
+	Arguments string
+}
+
+// FunctionPrivilegeValue is the value associated with the FunctionPrivilegeKey.
+type FunctionPrivilegeValue struct {
+	Key        FunctionPrivilegeKey
+	Privileges map[Privilege]map[GrantedPrivilege]bool
+}
+
+// NewFunctionPrivileges returns a new *FunctionPrivileges.
+func NewFunctionPrivileges() *FunctionPrivileges {
+	return &FunctionPrivileges{
+		Data:            make(map[FunctionPrivilegeKey]FunctionPrivilegeValue),
+		RevokedDefaults: make(map[FunctionPrivilegeKey]struct{}),
+	}
+}
+
+// AddFunctionPrivilege adds the given function privilege to the global database.
+func AddFunctionPrivilege(key FunctionPrivilegeKey, privilege GrantedPrivilege, withGrantOption bool) {
+	functionPrivilegeValue, ok := globalDatabase.functionPrivileges.Data[key]
+	if !ok {
+		functionPrivilegeValue = FunctionPrivilegeValue{
+			Key:        key,
+			Privileges: make(map[Privilege]map[GrantedPrivilege]bool),
+		}
+		globalDatabase.functionPrivileges.Data[key] = functionPrivilegeValue
+	}
+	privilegeMap, ok := functionPrivilegeValue.Privileges[privilege.Privilege]
+	if !ok {
+		privilegeMap = make(map[GrantedPrivilege]bool)
+		functionPrivilegeValue.Privileges[privilege.Privilege] = privilegeMap
+	}
+	privilegeMap[privilege] = withGrantOption
+	// Granting the privilege back to PUBLIC restores the default
+	delete(globalDatabase.functionPrivileges.RevokedDefaults, key)
+}
+
+// FunctionDefaultsRevoked returns whether the default PUBLIC privileges have been revoked from any function. As every
+// role may execute functions by default, callers may skip function checks entirely when this returns false.
+func FunctionDefaultsRevoked() bool {
+	return len(globalDatabase.functionPrivileges.RevokedDefaults) > 0
+}
+
+// HasFunctionPrivilege checks whether the user has the given privilege on the associated function.
+func HasFunctionPrivilege(key FunctionPrivilegeKey, privilege Privilege) bool {
+	if IsSuperUser(key.Role) || IsOwner(OwnershipKey{
+		PrivilegeObject: PrivilegeObject_FUNCTION,
+		Schema:          key.Schema,
+		Name:            key.Name,
+	}, key.Role) {
+		return true
+	}
+	// PUBLIC may execute all functions unless the default has been explicitly revoked
+	if globalDatabase.rolesByID[key.Role].Name == "public" && PrivilegeObject_FUNCTION.IsDefaultPublicPrivilege(privilege) {
+		_, revoked := globalDatabase.functionPrivileges.RevokedDefaults[key]
+		_, revokedSchema := globalDatabase.functionPrivileges.RevokedDefaults[FunctionPrivilegeKey{
+			Role:   key.Role,
+			Schema: key.Schema,
+		}]
+		if !revoked && !revokedSchema {
+			return true
+		}
+	}
+	return hasExplicitFunctionPrivilege(key, privilege)
+}
+
+// hasExplicitFunctionPrivilege checks whether the user has been granted the given privilege on the associated function,
+// ignoring any default privileges.
+func hasExplicitFunctionPrivilege(key FunctionPrivilegeKey, privilege Privilege) bool {
+	// Privileges may have been provided to all functions in the schema space, which are saved with an empty name
+	for _, schemaKey := range []FunctionPrivilegeKey{key, {Role: key.Role, Schema: key.Schema}} {
+		if functionPrivilegeValue, ok := globalDatabase.functionPrivileges.Data[schemaKey]; ok {
+			if privilegeMap, ok := functionPrivilegeValue.Privileges[privilege]; ok && len(privilegeMap) > 0 {
+				return true
+			}
+		}
+	}
+	for _, group := range GetAllGroupsWithMember(key.Role, true) {
+		if HasFunctionPrivilege(FunctionPrivilegeKey{
+			Role:      group,
+			Schema:    key.Schema,
+			Name:      key.Name,
+			Arguments: key.Arguments,
+		}, privilege) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasFunctionPrivilegeGrantOption checks whether the user has WITH GRANT OPTION for the given privilege on the
+// associated function. Returns the role that has WITH GRANT OPTION, or an invalid role if WITH GRANT OPTION is not
+// available.
+func HasFunctionPrivilegeGrantOption(key FunctionPrivilegeKey, privilege Privilege) RoleID {
+	ownershipKey := OwnershipKey{
+		PrivilegeObject: PrivilegeObject_FUNCTION,
+		Schema:          key.Schema,
+		Name:            key.Name,
+	}
+	if IsSuperUser(key.Role) {
+		owners := GetOwners(ownershipKey)
+		if len(owners) == 0 {
+			// Built-in functions do not have an owner
+			return key.Role
+		}
+		return owners[0]
+	} else if IsOwner(ownershipKey, key.Role) {
+		return key.Role
+	}
+	for _, schemaKey := range []FunctionPrivilegeKey{key, {Role: key.Role, Schema: key.Schema}} {
+		if functionPrivilegeValue, ok := globalDatabase.functionPrivileges.Data[schemaKey]; ok {
+			if privilegeMap, ok := functionPrivilegeValue.Privileges[privilege]; ok {
+				for _, withGrantOption := range privilegeMap {
+					if withGrantOption {
+						return key.Role
+					}
+				}
+			}
+		}
+	}
+	for _, group := range GetAllGroupsWithMember(key.Role, true) {
+		if returnedID := HasFunctionPrivilegeGrantOption(FunctionPrivilegeKey{
+			Role:      group,
+			Schema:    key.Schema,
+			Name:      key.Name,
+			Arguments: key.Arguments,
+		}, privilege); returnedID.IsValid() {
+			return returnedID
+		}
+	}
+	return 0
+}
+
+// RemoveFunctionPrivilege removes the privilege from the global database. If `grantOptionOnly` is true, then only the
+// WITH GRANT OPTION portion is revoked. If `grantOptionOnly` is false, then the full privilege is removed. If the
+// GrantedBy field contains a valid RoleID, then only the privilege associated with that granter is removed. Otherwise,
+// the privilege is completely removed for the grantee. Revoking a default privilege from PUBLIC also revokes the
+// default.
+func RemoveFunctionPrivilege(key FunctionPrivilegeKey, privilege GrantedPrivilege, grantOptionOnly bool) {
+	if !grantOptionOnly && globalDatabase.rolesByID[key.Role].Name == "public" &&
+		PrivilegeObject_FUNCTION.IsDefaultPublicPrivilege(privilege.Privilege) {
+		globalDatabase.functionPrivileges.RevokedDefaults[key] = struct{}{}
+	}
+	if functionPrivilegeValue, ok := globalDatabase.functionPrivileges.Data[key]; ok {
+		removeGrantedPrivilege(functionPrivilegeValue.Privileges, privilege, grantOptionOnly)
+		if len(functionPrivilegeValue.Privileges) == 0 {
+			delete(globalDatabase.functionPrivileges.Data, key)
+		}
+	}
+}
+
+// serialize writes the FunctionPrivileges to the given writer.
+func (fp *FunctionPrivileges) serialize(writer *utils.Writer) {
+	// Version 0
+	// Write the total number of values
+	writer.Uint64(uint64(len(fp.Data)))
+	for _, value := range fp.Data {
+		// Write the key
+		writer.Uint64(uint64(value.Key.Role))
+		writer.String(value.Key.Schema)
+		writer.String(value.Key.Name)
+		writer.String(value.Key.Arguments)
+		serializePrivilegeMap(writer, value.Privileges)
+	}
+	// Write the revoked defaults
+	writer.Uint64(uint64(len(fp.RevokedDefaults)))
+	for key := range fp.RevokedDefaults {
+		writer.Uint64(uint64(key.Role))
+		writer.String(key.Schema)
+		writer.String(key.Name)
+		writer.String(key.Arguments)
+	}
+}
+
+// deserialize reads the FunctionPrivileges from the given reader.
+func (fp *FunctionPrivileges) deserialize(version uint32, reader *utils.Reader) {
+	fp.Data = make(map[FunctionPrivilegeKey]FunctionPrivilegeValue)
+	fp.RevokedDefaults = make(map[FunctionPrivilegeKey]struct{})
+	switch version {
+	case 0:
+		// Read the total number of values
+		dataCount := reader.Uint64()
+		for dataIdx := uint64(0); dataIdx < dataCount; dataIdx++ {
+			// Read the key
+			fpv := FunctionPrivilegeValue{}
+			fpv.Key.Role = RoleID(reader.Uint64())
+			fpv.Key.Schema = reader.String()
+			fpv.Key.Name = reader.String()
+			fpv.Key.Arguments = reader.String()
+			fpv.Privileges = deserializePrivilegeMap(reader)
+			fp.Data[fpv.Key] = fpv
+		}
+		// Read the revoked defaults
+		revokedCount := reader.Uint64()
+		for revokedIdx := uint64(0); revokedIdx < revokedCount; revokedIdx++ {
+			key := FunctionPrivilegeKey{}
+			key.Role = RoleID(reader.Uint64())
+			key.Schema = reader.String()
+			key.Name = reader.String()
+			key.Arguments = reader.String()
+			fp.RevokedDefaults[key] = struct{}{}
+		}
+	default:
+		panic("unexpected version in FunctionPrivileges")
+	}
+}
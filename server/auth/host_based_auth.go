@@ -0,0 +1,365 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// HostBasedAuthConnectionType is the type of connection that a host-based authentication rule applies to.
+type HostBasedAuthConnectionType string
+
+const (
+	HostBasedAuthConnectionType_Local     HostBasedAuthConnectionType = "local"
+	HostBasedAuthConnectionType_Host      HostBasedAuthConnectionType = "host"
+	HostBasedAuthConnectionType_HostSSL   HostBasedAuthConnectionType = "hostssl"
+	HostBasedAuthConnectionType_HostNoSSL HostBasedAuthConnectionType = "hostnossl"
+)
+
+// HostBasedAuthMethod is the authentication method that is selected by a host-based authentication rule.
+type HostBasedAuthMethod string
+
+const (
+	HostBasedAuthMethod_Trust       HostBasedAuthMethod = "trust"
+	HostBasedAuthMethod_Reject      HostBasedAuthMethod = "reject"
+	HostBasedAuthMethod_ScramSha256 HostBasedAuthMethod = "scram-sha-256"
+	HostBasedAuthMethod_MD5         HostBasedAuthMethod = "md5"
+	HostBasedAuthMethod_Password    HostBasedAuthMethod = "password"
+	HostBasedAuthMethod_Cert        HostBasedAuthMethod = "cert"
+)
+
+// HostBasedAuthRule is a single rule for host-based authentication, which is equivalent to a line in Postgres'
+// pg_hba.conf file: https://www.postgresql.org/docs/15/auth-pg-hba-conf.html
+type HostBasedAuthRule struct {
+	LineNumber int32
+	Type       HostBasedAuthConnectionType
+	Databases  []string
+	Users      []string
+	Address    string // Empty for local rules
+	Netmask    string // Only set when the address is an IP address
+	Method     HostBasedAuthMethod
+	Options    map[string]string
+	network    *net.IPNet // nil when the rule matches all addresses
+}
+
+// HostBasedAuthConnection contains the information of an incoming connection that is matched against the host-based
+// authentication rules.
+type HostBasedAuthConnection struct {
+	IsLocal  bool   // Whether the connection was made over a Unix-domain socket
+	IsSSL    bool   // Whether the connection is encrypted using SSL
+	IP       net.IP // nil for local connections
+	Database string
+	User     string
+}
+
+// hostBasedAuthRules contains the host-based authentication rules that are currently loaded. These are loaded from the
+// server's configuration, and are therefore not persisted alongside the rest of the authorization data.
+var hostBasedAuthRules = struct {
+	mutex sync.RWMutex
+	rules []HostBasedAuthRule
+}{
+	rules: DefaultHostBasedAuthRules(),
+}
+
+// DefaultHostBasedAuthRules returns the rules that are used when the server has not been configured with any, which
+// require SCRAM-SHA-256 authentication for every connection.
+func DefaultHostBasedAuthRules() []HostBasedAuthRule {
+	return []HostBasedAuthRule{
+		{
+			LineNumber: 1,
+			Type:       HostBasedAuthConnectionType_Local,
+			Databases:  []string{"all"},
+			Users:      []string{"all"},
+			Method:     HostBasedAuthMethod_ScramSha256,
+		},
+		{
+			LineNumber: 2,
+			Type:       HostBasedAuthConnectionType_Host,
+			Databases:  []string{"all"},
+			Users:      []string{"all"},
+			Address:    "all",
+			Method:     HostBasedAuthMethod_ScramSha256,
+		},
+	}
+}
+
+// NewHostBasedAuthRule returns a new HostBasedAuthRule after validating the given fields. The line number is the
+// position of the rule, starting from 1, as rules are evaluated in order. The netmask may only be given when the
+// address is an IP address without a CIDR mask length.
+func NewHostBasedAuthRule(lineNumber int32, connType string, databases []string, users []string, address string,
+	netmask string, method string, options map[string]string) (HostBasedAuthRule, error) {
+	rule := HostBasedAuthRule{
+		LineNumber: lineNumber,
+		Type:       HostBasedAuthConnectionType(strings.ToLower(connType)),
+		Databases:  databases,
+		Users:      users,
+		Method:     HostBasedAuthMethod(strings.ToLower(method)),
+		Options:    options,
+	}
+	switch rule.Type {
+	case HostBasedAuthConnectionType_Local:
+		if len(address) > 0 || len(netmask) > 0 {
+			return HostBasedAuthRule{}, fmt.Errorf("host-based authentication rule %d: local rules may not specify an address", lineNumber)
+		}
+	case HostBasedAuthConnectionType_Host, HostBasedAuthConnectionType_HostSSL, HostBasedAuthConnectionType_HostNoSSL:
+		var err error
+		if rule.Address, rule.Netmask, rule.network, err = parseHostBasedAuthAddress(address, netmask); err != nil {
+			return HostBasedAuthRule{}, fmt.Errorf("host-based authentication rule %d: %w", lineNumber, err)
+		}
+	default:
+		return HostBasedAuthRule{}, fmt.Errorf(`host-based authentication rule %d: invalid connection type "%s"`, lineNumber, connType)
+	}
+	if len(rule.Databases) == 0 {
+		return HostBasedAuthRule{}, fmt.Errorf("host-based authentication rule %d: no database specified", lineNumber)
+	}
+	if len(rule.Users) == 0 {
+		return HostBasedAuthRule{}, fmt.Errorf("host-based authentication rule %d: no user specified", lineNumber)
+	}
+	switch rule.Method {
+	case HostBasedAuthMethod_Trust, HostBasedAuthMethod_Reject, HostBasedAuthMethod_ScramSha256,
+		HostBasedAuthMethod_MD5, HostBasedAuthMethod_Password:
+	case HostBasedAuthMethod_Cert:
+		if rule.Type != HostBasedAuthConnectionType_HostSSL {
+			return HostBasedAuthRule{}, fmt.Errorf("host-based authentication rule %d: cert authentication is only supported on hostssl connections", lineNumber)
+		}
+	default:
+		return HostBasedAuthRule{}, fmt.Errorf(`host-based authentication rule %d: invalid authentication method "%s"`, lineNumber, method)
+	}
+	for option, value := range rule.Options {
+		switch option {
+		case "clientcert":
+			if value != "verify-ca" && value != "verify-full" {
+				return HostBasedAuthRule{}, fmt.Errorf(`host-based authentication rule %d: invalid value for clientcert: "%s"`, lineNumber, value)
+			}
+			if rule.Type != HostBasedAuthConnectionType_HostSSL {
+				return HostBasedAuthRule{}, fmt.Errorf("host-based authentication rule %d: clientcert can only be configured for hostssl rows", lineNumber)
+			}
+			if rule.Method == HostBasedAuthMethod_Cert && value != "verify-full" {
+				return HostBasedAuthRule{}, fmt.Errorf(`host-based authentication rule %d: clientcert only accepts "verify-full" when using cert authentication`, lineNumber)
+			}
+		default:
+			return HostBasedAuthRule{}, fmt.Errorf(`host-based authentication rule %d: unrecognized authentication option name: "%s"`, lineNumber, option)
+		}
+	}
+	return rule, nil
+}
+
+// GetHostBasedAuthRules returns the host-based authentication rules that are currently loaded.
+func GetHostBasedAuthRules() []HostBasedAuthRule {
+	hostBasedAuthRules.mutex.RLock()
+	defer hostBasedAuthRules.mutex.RUnlock()
+	rules := make([]HostBasedAuthRule, len(hostBasedAuthRules.rules))
+	copy(rules, hostBasedAuthRules.rules)
+	return rules
+}
+
+// SetHostBasedAuthRules replaces the currently loaded host-based authentication rules. If no rules are given, then the
+// default rules are loaded.
+func SetHostBasedAuthRules(rules []HostBasedAuthRule) {
+	if len(rules) == 0 {
+		rules = DefaultHostBasedAuthRules()
+	}
+	hostBasedAuthRules.mutex.Lock()
+	defer hostBasedAuthRules.mutex.Unlock()
+	hostBasedAuthRules.rules = rules
+}
+
+// MatchHostBasedAuthRule returns the first rule that matches the given connection. Returns false if no rules match, in
+// which case the connection must be rejected.
+func MatchHostBasedAuthRule(conn HostBasedAuthConnection) (HostBasedAuthRule, bool) {
+	hostBasedAuthRules.mutex.RLock()
+	defer hostBasedAuthRules.mutex.RUnlock()
+	var matchedRule HostBasedAuthRule
+	var matched bool
+	LockRead(func() {
+		for _, rule := range hostBasedAuthRules.rules {
+			if rule.matchesConnectionType(conn) && rule.matchesAddress(conn) &&
+				rule.matchesDatabase(conn) && rule.matchesUser(conn) {
+				matchedRule = rule
+				matched = true
+				return
+			}
+		}
+	})
+	return matchedRule, matched
+}
+
+// Host returns the host of the connection as displayed in authentication errors.
+func (conn HostBasedAuthConnection) Host() string {
+	if conn.IsLocal || conn.IP == nil {
+		return "[local]"
+	}
+	return conn.IP.String()
+}
+
+// Encryption returns the encryption of the connection as displayed in authentication errors.
+func (conn HostBasedAuthConnection) Encryption() string {
+	if conn.IsSSL {
+		return "SSL encryption"
+	}
+	return "no encryption"
+}
+
+// matchesConnectionType returns whether the rule's connection type matches the connection.
+func (rule HostBasedAuthRule) matchesConnectionType(conn HostBasedAuthConnection) bool {
+	switch rule.Type {
+	case HostBasedAuthConnectionType_Local:
+		return conn.IsLocal
+	case HostBasedAuthConnectionType_Host:
+		return !conn.IsLocal
+	case HostBasedAuthConnectionType_HostSSL:
+		return !conn.IsLocal && conn.IsSSL
+	case HostBasedAuthConnectionType_HostNoSSL:
+		return !conn.IsLocal && !conn.IsSSL
+	default:
+		return false
+	}
+}
+
+// matchesAddress returns whether the rule's address matches the connection's address. Local rules do not have an
+// address, and therefore always match.
+func (rule HostBasedAuthRule) matchesAddress(conn HostBasedAuthConnection) bool {
+	if rule.network == nil {
+		return true
+	}
+	return conn.IP != nil && rule.network.Contains(conn.IP)
+}
+
+// matchesDatabase returns whether any of the rule's databases match the connection's database. This must be called
+// while holding the read lock of the global database, as role membership may be checked.
+func (rule HostBasedAuthRule) matchesDatabase(conn HostBasedAuthConnection) bool {
+	for _, database := range rule.Databases {
+		switch database {
+		case "all":
+			return true
+		case "sameuser":
+			if conn.Database == conn.User {
+				return true
+			}
+		case "samerole", "samegroup":
+			if isHostBasedAuthRoleMember(conn.User, conn.Database) {
+				return true
+			}
+		case "replication":
+			// Replication connections are not supported, so this never matches
+		default:
+			if database == conn.Database {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesUser returns whether any of the rule's users match the connection's user. A user starting with a plus sign
+// matches all roles that are members of the named role. This must be called while holding the read lock of the global
+// database, as role membership may be checked.
+func (rule HostBasedAuthRule) matchesUser(conn HostBasedAuthConnection) bool {
+	for _, user := range rule.Users {
+		if user == "all" || user == conn.User {
+			return true
+		}
+		if strings.HasPrefix(user, "+") && isHostBasedAuthRoleMember(conn.User, user[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// isHostBasedAuthRoleMember returns whether the member is the same as the group, or is a direct or indirect member of
+// the group. Unlike IsRoleAMember, superusers are not automatically considered members of every group, which matches
+// the behavior of Postgres.
+func isHostBasedAuthRoleMember(member string, group string) bool {
+	memberID, ok := globalDatabase.rolesByName[member]
+	if !ok {
+		return false
+	}
+	groupID, ok := globalDatabase.rolesByName[group]
+	if !ok {
+		return false
+	}
+	var isMember func(RoleID) bool
+	isMember = func(roleID RoleID) bool {
+		if roleID == groupID {
+			return true
+		}
+		// Postgres does not allow for circular role membership, so we can recursively check without worry
+		for parentID := range globalDatabase.roleMembership.Data[roleID] {
+			if isMember(parentID) {
+				return true
+			}
+		}
+		return false
+	}
+	return isMember(memberID)
+}
+
+// parseHostBasedAuthAddress parses the address and netmask of a rule. Returns the address and netmask as they should
+// be displayed, along with the network that the address represents. The network will be nil if all addresses match.
+func parseHostBasedAuthAddress(address string, netmask string) (string, string, *net.IPNet, error) {
+	switch address {
+	case "all":
+		if len(netmask) > 0 {
+			return "", "", nil, fmt.Errorf(`a netmask may not be specified with the address "all"`)
+		}
+		return address, "", nil, nil
+	case "":
+		return "", "", nil, fmt.Errorf("no address specified")
+	}
+	var ip net.IP
+	var mask net.IPMask
+	if strings.Contains(address, "/") {
+		if len(netmask) > 0 {
+			return "", "", nil, fmt.Errorf(`a netmask may not be specified with the CIDR address "%s"`, address)
+		}
+		var network *net.IPNet
+		var err error
+		ip, network, err = net.ParseCIDR(address)
+		if err != nil {
+			return "", "", nil, fmt.Errorf(`invalid CIDR address "%s"`, address)
+		}
+		mask = network.Mask
+	} else {
+		if ip = net.ParseIP(address); ip == nil {
+			return "", "", nil, fmt.Errorf(`invalid IP address "%s"`, address)
+		}
+		if len(netmask) == 0 {
+			return "", "", nil, fmt.Errorf(`the IP address "%s" must have a CIDR mask length or a netmask`, address)
+		}
+		maskIP := net.ParseIP(netmask)
+		if maskIP == nil {
+			return "", "", nil, fmt.Errorf(`invalid netmask "%s"`, netmask)
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+			maskIP = maskIP.To4()
+			if maskIP == nil {
+				return "", "", nil, fmt.Errorf(`the netmask "%s" does not match the IP address "%s"`, netmask, address)
+			}
+		}
+		mask = net.IPMask(maskIP)
+		if ones, bits := mask.Size(); ones == 0 && bits == 0 {
+			return "", "", nil, fmt.Errorf(`invalid netmask "%s"`, netmask)
+		}
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	network := &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+	return ip.String(), net.IP(mask).String(), network, nil
+}
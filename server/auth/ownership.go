@@ -14,7 +14,11 @@
 
 package auth
 
-import "github.com/dolthub/doltgresql/utils"
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+
+	"github.com/dolthub/doltgresql/utils"
+)
 
 // Ownership holds all of the data related to the ownership of roles and database objects.
 type Ownership struct {
@@ -100,6 +104,63 @@ func RemoveOwner(key OwnershipKey, role RoleID) {
 	}
 }
 
+// HasUnownedSequences returns whether the database was loaded from a format that did not record the owners of
+// sequences, in which case OwnUnownedSequence should be called for every existing sequence.
+func HasUnownedSequences() bool {
+	return globalDatabase.unownedSequences
+}
+
+// OwnUnownedSequence gives a sequence that does not have an owner the owners of the table that owns it. Sequences that
+// are not owned by a table are given the owners of their schema, and as the role that created them is not known, PUBLIC
+// retains the access that it had before privileges were checked on sequences. Sequences that already have an owner are
+// unchanged.
+func OwnUnownedSequence(schema string, sequence string, ownerTable string) {
+	sequenceKey := OwnershipKey{
+		PrivilegeObject: PrivilegeObject_SEQUENCE,
+		Schema:          schema,
+		Name:            sequence,
+	}
+	if len(GetOwners(sequenceKey)) > 0 {
+		return
+	}
+	if len(ownerTable) > 0 {
+		if owners := GetOwners(OwnershipKey{
+			PrivilegeObject: PrivilegeObject_TABLE,
+			Schema:          schema,
+			Name:            ownerTable,
+		}); len(owners) > 0 {
+			for _, owner := range owners {
+				AddOwner(sequenceKey, owner)
+			}
+			return
+		}
+	}
+	owners := GetOwners(OwnershipKey{
+		PrivilegeObject: PrivilegeObject_SCHEMA,
+		Schema:          schema,
+	})
+	if len(owners) == 0 {
+		owners = []RoleID{bootstrapSuperUser()}
+	}
+	for _, owner := range owners {
+		AddOwner(sequenceKey, owner)
+	}
+	for _, privilege := range PrivilegeObject_SEQUENCE.AllPrivileges() {
+		AddSequencePrivilege(SequencePrivilegeKey{
+			Role:     globalDatabase.rolesByName["public"],
+			Sequence: doltdb.TableName{Name: sequence, Schema: schema},
+		}, GrantedPrivilege{
+			Privilege: privilege,
+			GrantedBy: owners[0],
+		}, false)
+	}
+}
+
+// ClearUnownedSequences marks that every existing sequence has been given an owner.
+func ClearUnownedSequences() {
+	globalDatabase.unownedSequences = false
+}
+
 // normalize accounts for and corrects any potential variation for specific object types.
 func (key OwnershipKey) normalize() OwnershipKey {
 	if key.PrivilegeObject == PrivilegeObject_SCHEMA {
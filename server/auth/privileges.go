@@ -215,6 +215,16 @@ func (po PrivilegeObject) DefaultPublicPrivileges() []Privilege {
 	}
 }
 
+// IsDefaultPublicPrivilege returns whether the given Privilege is granted to PUBLIC by default for the PrivilegeObject.
+func (po PrivilegeObject) IsDefaultPublicPrivilege(privilege Privilege) bool {
+	for _, poPriv := range po.DefaultPublicPrivileges() {
+		if privilege == poPriv {
+			return true
+		}
+	}
+	return false
+}
+
 // IsValid returns whether the given Privilege is valid for the PrivilegeObject, as not all privileges are valid for all
 // objects.
 func (po PrivilegeObject) IsValid(privilege Privilege) bool {
@@ -261,3 +271,33 @@ func (po PrivilegeObject) String() string {
 		return "UNKNOWN"
 	}
 }
+
+// removeGrantedPrivilege removes the privilege from the given privilege map. If `grantOptionOnly` is true, then only
+// the WITH GRANT OPTION portion is revoked. If the GrantedBy field contains a valid RoleID, then only the privilege
+// associated with that granter is modified. Otherwise, the privilege is modified for all granters.
+func removeGrantedPrivilege(privileges map[Privilege]map[GrantedPrivilege]bool, privilege GrantedPrivilege, grantOptionOnly bool) {
+	privilegeMap, ok := privileges[privilege.Privilege]
+	if !ok {
+		return
+	}
+	if grantOptionOnly {
+		if privilege.GrantedBy.IsValid() {
+			if _, ok = privilegeMap[privilege]; ok {
+				privilegeMap[privilege] = false
+			}
+		} else {
+			for privilegeMapKey := range privilegeMap {
+				privilegeMap[privilegeMapKey] = false
+			}
+		}
+	} else {
+		if privilege.GrantedBy.IsValid() {
+			delete(privilegeMap, privilege)
+		} else {
+			privilegeMap = nil
+		}
+		if len(privilegeMap) == 0 {
+			delete(privileges, privilege.Privilege)
+		}
+	}
+}
@@ -165,3 +165,18 @@ func (membership *RoleMembership) deserialize(version uint32, reader *utils.Read
 		panic("unexpected version in RoleMembership")
 	}
 }
+
+// HasPrivilegesOfRole returns whether the member is a superuser, or has the privileges of the group with the given
+// name. This is used for the predefined roles, such as pg_write_server_files, that grant abilities rather than
+// privileges on objects.
+func HasPrivilegesOfRole(member RoleID, groupName string) bool {
+	if IsSuperUser(member) {
+		return true
+	}
+	group, ok := globalDatabase.rolesByName[groupName]
+	if !ok {
+		return false
+	}
+	groupID, inheritsPrivileges, _ := IsRoleAMember(member, group)
+	return groupID.IsValid() && inheritsPrivileges
+}
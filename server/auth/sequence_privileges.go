@@ -0,0 +1,245 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// SequencePrivileges contains the privileges given to a role on a sequence.
+type SequencePrivileges struct {
+	Data map[SequencePrivilegeKey]SequencePrivilegeValue
+}
+
+// SequencePrivilegeKey points to a specific sequence object.
+type SequencePrivilegeKey struct {
+	Role     RoleID
+	Sequence doltdb.TableName
+}
+
+// SequencePrivilegeValue is the value associated with the SequencePrivilegeKey.
+type SequencePrivilegeValue struct {
+	Key        SequencePrivilegeKey
+	Privileges map[Privilege]map[GrantedPrivilege]bool
+}
+
+// NewSequencePrivileges returns a new *SequencePrivileges.
+func NewSequencePrivileges() *SequencePrivileges {
+	return &SequencePrivileges{make(map[SequencePrivilegeKey]SequencePrivilegeValue)}
+}
+
+// AddSequencePrivilege adds the given sequence privilege to the global database.
+func AddSequencePrivilege(key SequencePrivilegeKey, privilege GrantedPrivilege, withGrantOption bool) {
+	sequencePrivilegeValue, ok := globalDatabase.sequencePrivileges.Data[key]
+	if !ok {
+		sequencePrivilegeValue = SequencePrivilegeValue{
+			Key:        key,
+			Privileges: make(map[Privilege]map[GrantedPrivilege]bool),
+		}
+		globalDatabase.sequencePrivileges.Data[key] = sequencePrivilegeValue
+	}
+	privilegeMap, ok := sequencePrivilegeValue.Privileges[privilege.Privilege]
+	if !ok {
+		privilegeMap = make(map[GrantedPrivilege]bool)
+		sequencePrivilegeValue.Privileges[privilege.Privilege] = privilegeMap
+	}
+	privilegeMap[privilege] = withGrantOption
+}
+
+// HasSequencePrivilege checks whether the user has the given privilege on the associated sequence.
+func HasSequencePrivilege(key SequencePrivilegeKey, privilege Privilege) bool {
+	if IsSuperUser(key.Role) || IsOwner(OwnershipKey{
+		PrivilegeObject: PrivilegeObject_SEQUENCE,
+		Schema:          key.Sequence.Schema,
+		Name:            key.Sequence.Name,
+	}, key.Role) {
+		return true
+	}
+	// If a sequence name was provided, then we also want to search for privileges provided to all sequences in the schema
+	// space. Since those are saved with an empty sequence name, we can easily do another search by removing the sequence.
+	if len(key.Sequence.Name) > 0 {
+		if ok := HasSequencePrivilege(SequencePrivilegeKey{
+			Role:     key.Role,
+			Sequence: doltdb.TableName{Name: "", Schema: key.Sequence.Schema},
+		}, privilege); ok {
+			return true
+		}
+	}
+	if sequencePrivilegeValue, ok := globalDatabase.sequencePrivileges.Data[key]; ok {
+		if privilegeMap, ok := sequencePrivilegeValue.Privileges[privilege]; ok && len(privilegeMap) > 0 {
+			return true
+		}
+	}
+	for _, group := range GetAllGroupsWithMember(key.Role, true) {
+		if HasSequencePrivilege(SequencePrivilegeKey{
+			Role:     group,
+			Sequence: key.Sequence,
+		}, privilege) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasSequencePrivilegeGrantOption checks whether the user has WITH GRANT OPTION for the given privilege on the
+// associated sequence. Returns the role that has WITH GRANT OPTION, or an invalid role if WITH GRANT OPTION is not
+// available.
+func HasSequencePrivilegeGrantOption(key SequencePrivilegeKey, privilege Privilege) RoleID {
+	ownershipKey := OwnershipKey{
+		PrivilegeObject: PrivilegeObject_SEQUENCE,
+		Schema:          key.Sequence.Schema,
+		Name:            key.Sequence.Name,
+	}
+	if IsSuperUser(key.Role) {
+		owners := GetOwners(ownershipKey)
+		if len(owners) == 0 {
+			// This may happen if the privilege file is deleted
+			return key.Role
+		}
+		// Although there may be multiple owners, we'll only return the first one.
+		// Postgres already allows for non-determinism with multiple membership paths, so this is fine.
+		return owners[0]
+	} else if IsOwner(ownershipKey, key.Role) {
+		return key.Role
+	}
+	// If a sequence name was provided, then we also want to search for privileges provided to all sequences in the schema
+	// space. Since those are saved with an empty sequence name, we can easily do another search by removing the sequence.
+	if len(key.Sequence.Name) > 0 {
+		if returnedID := HasSequencePrivilegeGrantOption(SequencePrivilegeKey{
+			Role:     key.Role,
+			Sequence: doltdb.TableName{Name: "", Schema: key.Sequence.Schema},
+		}, privilege); returnedID.IsValid() {
+			return returnedID
+		}
+	}
+	if sequencePrivilegeValue, ok := globalDatabase.sequencePrivileges.Data[key]; ok {
+		if privilegeMap, ok := sequencePrivilegeValue.Privileges[privilege]; ok {
+			for _, withGrantOption := range privilegeMap {
+				if withGrantOption {
+					return key.Role
+				}
+			}
+		}
+	}
+	for _, group := range GetAllGroupsWithMember(key.Role, true) {
+		if returnedID := HasSequencePrivilegeGrantOption(SequencePrivilegeKey{
+			Role:     group,
+			Sequence: key.Sequence,
+		}, privilege); returnedID.IsValid() {
+			return returnedID
+		}
+	}
+	return 0
+}
+
+// RemoveSequencePrivilege removes the privilege from the global database. If `grantOptionOnly` is true, then only the
+// WITH GRANT OPTION portion is revoked. If `grantOptionOnly` is false, then the full privilege is removed. If the
+// GrantedBy field contains a valid RoleID, then only the privilege associated with that granter is removed. Otherwise,
+// the privilege is completely removed for the grantee.
+func RemoveSequencePrivilege(key SequencePrivilegeKey, privilege GrantedPrivilege, grantOptionOnly bool) {
+	if sequencePrivilegeValue, ok := globalDatabase.sequencePrivileges.Data[key]; ok {
+		if privilegeMap, ok := sequencePrivilegeValue.Privileges[privilege.Privilege]; ok {
+			if grantOptionOnly {
+				// This is provided when we only want to revoke the WITH GRANT OPTION, and not the privilege itself.
+				// If a role is provided in GRANTED BY, then we specifically delete the option associated with that role.
+				// If no role was given, then we'll remove WITH GRANT OPTION from all of the associated roles.
+				if privilege.GrantedBy.IsValid() {
+					if _, ok = privilegeMap[privilege]; ok {
+						privilegeMap[privilege] = false
+					}
+				} else {
+					for privilegeMapKey := range privilegeMap {
+						privilegeMap[privilegeMapKey] = false
+					}
+				}
+			} else {
+				// If a role is provided in GRANTED BY, then we specifically delete the privilege associated with that role.
+				// If no role was given, then we'll delete the privileges granted by all roles.
+				if privilege.GrantedBy.IsValid() {
+					delete(privilegeMap, privilege)
+				} else {
+					privilegeMap = nil
+				}
+				if len(privilegeMap) == 0 {
+					delete(sequencePrivilegeValue.Privileges, privilege.Privilege)
+				}
+			}
+		}
+		if len(sequencePrivilegeValue.Privileges) == 0 {
+			delete(globalDatabase.sequencePrivileges.Data, key)
+		}
+	}
+}
+
+// serialize writes the SequencePrivileges to the given writer.
+func (sp *SequencePrivileges) serialize(writer *utils.Writer) {
+	// Version 0
+	// Write the total number of values
+	writer.Uint64(uint64(len(sp.Data)))
+	for _, value := range sp.Data {
+		// Write the key
+		writer.Uint64(uint64(value.Key.Role))
+		writer.String(value.Key.Sequence.Name)
+		writer.String(value.Key.Sequence.Schema)
+		// Write the total number of privileges
+		writer.Uint64(uint64(len(value.Privileges)))
+		for privilege, privilegeMap := range value.Privileges {
+			writer.String(string(privilege))
+			// Write the number of granted privileges
+			writer.Uint32(uint32(len(privilegeMap)))
+			for grantedPrivilege, withGrantOption := range privilegeMap {
+				writer.Uint64(uint64(grantedPrivilege.GrantedBy))
+				writer.Bool(withGrantOption)
+			}
+		}
+	}
+}
+
+// deserialize reads the SequencePrivileges from the given reader.
+func (sp *SequencePrivileges) deserialize(version uint32, reader *utils.Reader) {
+	sp.Data = make(map[SequencePrivilegeKey]SequencePrivilegeValue)
+	switch version {
+	case 0:
+		// Read the total number of values
+		dataCount := reader.Uint64()
+		for dataIdx := uint64(0); dataIdx < dataCount; dataIdx++ {
+			// Read the key
+			spv := SequencePrivilegeValue{Privileges: make(map[Privilege]map[GrantedPrivilege]bool)}
+			spv.Key.Role = RoleID(reader.Uint64())
+			spv.Key.Sequence.Name = reader.String()
+			spv.Key.Sequence.Schema = reader.String()
+			// Read the total number of privileges
+			privilegeCount := reader.Uint64()
+			for privilegeIdx := uint64(0); privilegeIdx < privilegeCount; privilegeIdx++ {
+				privilege := Privilege(reader.String())
+				// Read the number of granted privileges
+				grantedCount := reader.Uint32()
+				grantedMap := make(map[GrantedPrivilege]bool)
+				for grantedIdx := uint32(0); grantedIdx < grantedCount; grantedIdx++ {
+					grantedPrivilege := GrantedPrivilege{}
+					grantedPrivilege.Privilege = privilege
+					grantedPrivilege.GrantedBy = RoleID(reader.Uint64())
+					grantedMap[grantedPrivilege] = reader.Bool()
+				}
+				spv.Privileges[privilege] = grantedMap
+			}
+			sp.Data[spv.Key] = spv
+		}
+	default:
+		panic("unexpected version in SequencePrivileges")
+	}
+}
@@ -34,7 +34,7 @@ func PersistChanges() error {
 func (db *Database) serialize() []byte {
 	writer := utils.NewWriter(16384)
 	// Write the version
-	writer.Uint32(0)
+	writer.Uint32(3)
 	// Write the roles
 	writer.Uint32(uint32(len(db.rolesByID)))
 	for _, role := range db.rolesByID {
@@ -50,6 +50,18 @@ func (db *Database) serialize() []byte {
 	db.tablePrivileges.serialize(writer)
 	// Write the role chain
 	db.roleMembership.serialize(writer)
+	// Write the database settings
+	db.databaseSettings.serialize(writer)
+	// Write the column privileges
+	db.columnPrivileges.serialize(writer)
+	// Write the sequence privileges
+	db.sequencePrivileges.serialize(writer)
+	// Write the function privileges
+	db.functionPrivileges.serialize(writer)
+	// Write the type privileges
+	db.typePrivileges.serialize(writer)
+	// Write the default privileges
+	db.defaultPrivileges.serialize(writer)
 	return writer.Data()
 }
 
@@ -60,9 +72,17 @@ func (db *Database) deserialize(data []byte) error {
 	}
 	reader := utils.NewReader(data)
 	version := reader.Uint32()
+	// Sequence owners were not recorded before version 3
+	db.unownedSequences = version < 3
 	switch version {
 	case 0:
 		return db.deserializeV0(reader)
+	case 1:
+		return db.deserializeV1(reader)
+	case 2:
+		return db.deserializeV2(reader)
+	case 3:
+		return db.deserializeV3(reader)
 	default:
 		return fmt.Errorf("Authorization database format %d is not supported, please upgrade Doltgres", version)
 	}
@@ -90,5 +110,88 @@ func (db *Database) deserializeV0(reader *utils.Reader) error {
 	db.tablePrivileges.deserialize(0, reader)
 	// Read the role chain
 	db.roleMembership.deserialize(0, reader)
+	// Database settings were added in version 1, so there are none to read
+	clear(db.databaseSettings.Data)
+	// Column privileges were added in version 2, so there are none to read
+	clear(db.columnPrivileges.Data)
+	// Sequence, function, type, and default privileges were added in version 3, so there are none to read
+	clear(db.sequencePrivileges.Data)
+	clear(db.functionPrivileges.Data)
+	clear(db.functionPrivileges.RevokedDefaults)
+	clear(db.typePrivileges.Data)
+	clear(db.typePrivileges.RevokedDefaults)
+	clear(db.defaultPrivileges.Data)
 	return nil
 }
+
+// deserializeV1 creates a Database from a byte slice. Expects a reader that has already read the version.
+func (db *Database) deserializeV1(reader *utils.Reader) error {
+	if err := db.deserializeV0(reader); err != nil {
+		return err
+	}
+	// Read the database settings
+	db.databaseSettings.deserialize(0, reader)
+	return nil
+}
+
+// deserializeV2 creates a Database from a byte slice. Expects a reader that has already read the version.
+func (db *Database) deserializeV2(reader *utils.Reader) error {
+	if err := db.deserializeV1(reader); err != nil {
+		return err
+	}
+	// Read the column privileges
+	db.columnPrivileges.deserialize(0, reader)
+	return nil
+}
+
+// deserializeV3 creates a Database from a byte slice. Expects a reader that has already read the version.
+func (db *Database) deserializeV3(reader *utils.Reader) error {
+	if err := db.deserializeV2(reader); err != nil {
+		return err
+	}
+	// Read the sequence privileges
+	db.sequencePrivileges.deserialize(0, reader)
+	// Read the function privileges
+	db.functionPrivileges.deserialize(0, reader)
+	// Read the type privileges
+	db.typePrivileges.deserialize(0, reader)
+	// Read the default privileges
+	db.defaultPrivileges.deserialize(0, reader)
+	return nil
+}
+
+// serializePrivilegeMap writes the given privileges, along with their granters, to the given writer.
+func serializePrivilegeMap(writer *utils.Writer, privileges map[Privilege]map[GrantedPrivilege]bool) {
+	// Write the total number of privileges
+	writer.Uint64(uint64(len(privileges)))
+	for privilege, privilegeMap := range privileges {
+		writer.String(string(privilege))
+		// Write the number of granted privileges
+		writer.Uint32(uint32(len(privilegeMap)))
+		for grantedPrivilege, withGrantOption := range privilegeMap {
+			writer.Uint64(uint64(grantedPrivilege.GrantedBy))
+			writer.Bool(withGrantOption)
+		}
+	}
+}
+
+// deserializePrivilegeMap reads the privileges written by serializePrivilegeMap from the given reader.
+func deserializePrivilegeMap(reader *utils.Reader) map[Privilege]map[GrantedPrivilege]bool {
+	privileges := make(map[Privilege]map[GrantedPrivilege]bool)
+	// Read the total number of privileges
+	privilegeCount := reader.Uint64()
+	for privilegeIdx := uint64(0); privilegeIdx < privilegeCount; privilegeIdx++ {
+		privilege := Privilege(reader.String())
+		// Read the number of granted privileges
+		grantedCount := reader.Uint32()
+		grantedMap := make(map[GrantedPrivilege]bool)
+		for grantedIdx := uint32(0); grantedIdx < grantedCount; grantedIdx++ {
+			grantedPrivilege := GrantedPrivilege{}
+			grantedPrivilege.Privilege = privilege
+			grantedPrivilege.GrantedBy = RoleID(reader.Uint64())
+			grantedMap[grantedPrivilege] = reader.Bool()
+		}
+		privileges[privilege] = grantedMap
+	}
+	return privileges
+}
@@ -0,0 +1,249 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import "github.com/dolthub/doltgresql/utils"
+
+// TypePrivileges contains the privileges given to a role on a type.
+type TypePrivileges struct {
+	Data map[TypePrivilegeKey]TypePrivilegeValue
+	// RevokedDefaults contains the types that have had their default PUBLIC privileges revoked. The key's role
+	// will always be the PUBLIC role.
+	RevokedDefaults map[TypePrivilegeKey]struct{}
+}
+
+// TypePrivilegeKey points to a specific type object. An empty name refers to all types in the schema.
+type TypePrivilegeKey struct {
+	Role   RoleID
+	Schema string
+	Name   string
+}
+
+// TypePrivilegeValue is the value associated with the TypePrivilegeKey.
+type TypePrivilegeValue struct {
+	Key        TypePrivilegeKey
+	Privileges map[Privilege]map[GrantedPrivilege]bool
+}
+
+// NewTypePrivileges returns a new *TypePrivileges.
+func NewTypePrivileges() *TypePrivileges {
+	return &TypePrivileges{
+		Data:            make(map[TypePrivilegeKey]TypePrivilegeValue),
+		RevokedDefaults: make(map[TypePrivilegeKey]struct{}),
+	}
+}
+
+// AddTypePrivilege adds the given type privilege to the global database.
+func AddTypePrivilege(key TypePrivilegeKey, privilege GrantedPrivilege, withGrantOption bool) {
+	typePrivilegeValue, ok := globalDatabase.typePrivileges.Data[key]
+	if !ok {
+		typePrivilegeValue = TypePrivilegeValue{
+			Key:        key,
+			Privileges: make(map[Privilege]map[GrantedPrivilege]bool),
+		}
+		globalDatabase.typePrivileges.Data[key] = typePrivilegeValue
+	}
+	privilegeMap, ok := typePrivilegeValue.Privileges[privilege.Privilege]
+	if !ok {
+		privilegeMap = make(map[GrantedPrivilege]bool)
+		typePrivilegeValue.Privileges[privilege.Privilege] = privilegeMap
+	}
+	privilegeMap[privilege] = withGrantOption
+	// Granting the privilege back to PUBLIC restores the default
+	delete(globalDatabase.typePrivileges.RevokedDefaults, key)
+}
+
+// TypeDefaultsRevoked returns whether the default PUBLIC privileges have been revoked from any type. As every
+// role may use types by default, callers may skip type checks entirely when this returns false.
+func TypeDefaultsRevoked() bool {
+	return len(globalDatabase.typePrivileges.RevokedDefaults) > 0
+}
+
+// HasTypePrivilege checks whether the user has the given privilege on the associated type.
+func HasTypePrivilege(key TypePrivilegeKey, privilege Privilege) bool {
+	if IsSuperUser(key.Role) || isTypeOwner(key) {
+		return true
+	}
+	// PUBLIC may use all types unless the default has been explicitly revoked
+	if globalDatabase.rolesByID[key.Role].Name == "public" && PrivilegeObject_TYPE.IsDefaultPublicPrivilege(privilege) {
+		_, revoked := globalDatabase.typePrivileges.RevokedDefaults[key]
+		_, revokedSchema := globalDatabase.typePrivileges.RevokedDefaults[TypePrivilegeKey{
+			Role:   key.Role,
+			Schema: key.Schema,
+			Name:   "",
+		}]
+		if !revoked && !revokedSchema {
+			return true
+		}
+	}
+	return hasExplicitTypePrivilege(key, privilege)
+}
+
+// isTypeOwner returns whether the key's role owns the type. Domains are also types, so their ownership is included.
+func isTypeOwner(key TypePrivilegeKey) bool {
+	for _, object := range []PrivilegeObject{PrivilegeObject_TYPE, PrivilegeObject_DOMAIN} {
+		if IsOwner(OwnershipKey{
+			PrivilegeObject: object,
+			Schema:          key.Schema,
+			Name:            key.Name,
+		}, key.Role) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasExplicitTypePrivilege checks whether the user has been granted the given privilege on the associated type,
+// ignoring any default privileges.
+func hasExplicitTypePrivilege(key TypePrivilegeKey, privilege Privilege) bool {
+	// Privileges may have been provided to all types in the schema space, which are saved with an empty name
+	for _, name := range []string{key.Name, ""} {
+		if typePrivilegeValue, ok := globalDatabase.typePrivileges.Data[TypePrivilegeKey{
+			Role:   key.Role,
+			Schema: key.Schema,
+			Name:   name,
+		}]; ok {
+			if privilegeMap, ok := typePrivilegeValue.Privileges[privilege]; ok && len(privilegeMap) > 0 {
+				return true
+			}
+		}
+	}
+	for _, group := range GetAllGroupsWithMember(key.Role, true) {
+		if HasTypePrivilege(TypePrivilegeKey{
+			Role:   group,
+			Schema: key.Schema,
+			Name:   key.Name,
+		}, privilege) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTypePrivilegeGrantOption checks whether the user has WITH GRANT OPTION for the given privilege on the
+// associated type. Returns the role that has WITH GRANT OPTION, or an invalid role if WITH GRANT OPTION is not
+// available.
+func HasTypePrivilegeGrantOption(key TypePrivilegeKey, privilege Privilege) RoleID {
+	if IsSuperUser(key.Role) {
+		for _, object := range []PrivilegeObject{PrivilegeObject_TYPE, PrivilegeObject_DOMAIN} {
+			if owners := GetOwners(OwnershipKey{
+				PrivilegeObject: object,
+				Schema:          key.Schema,
+				Name:            key.Name,
+			}); len(owners) > 0 {
+				return owners[0]
+			}
+		}
+		// Built-in types do not have an owner
+		return key.Role
+	} else if isTypeOwner(key) {
+		return key.Role
+	}
+	for _, name := range []string{key.Name, ""} {
+		if typePrivilegeValue, ok := globalDatabase.typePrivileges.Data[TypePrivilegeKey{
+			Role:   key.Role,
+			Schema: key.Schema,
+			Name:   name,
+		}]; ok {
+			if privilegeMap, ok := typePrivilegeValue.Privileges[privilege]; ok {
+				for _, withGrantOption := range privilegeMap {
+					if withGrantOption {
+						return key.Role
+					}
+				}
+			}
+		}
+	}
+	for _, group := range GetAllGroupsWithMember(key.Role, true) {
+		if returnedID := HasTypePrivilegeGrantOption(TypePrivilegeKey{
+			Role:   group,
+			Schema: key.Schema,
+			Name:   key.Name,
+		}, privilege); returnedID.IsValid() {
+			return returnedID
+		}
+	}
+	return 0
+}
+
+// RemoveTypePrivilege removes the privilege from the global database. If `grantOptionOnly` is true, then only the
+// WITH GRANT OPTION portion is revoked. If `grantOptionOnly` is false, then the full privilege is removed. If the
+// GrantedBy field contains a valid RoleID, then only the privilege associated with that granter is removed. Otherwise,
+// the privilege is completely removed for the grantee. Revoking a default privilege from PUBLIC also revokes the
+// default.
+func RemoveTypePrivilege(key TypePrivilegeKey, privilege GrantedPrivilege, grantOptionOnly bool) {
+	if !grantOptionOnly && globalDatabase.rolesByID[key.Role].Name == "public" &&
+		PrivilegeObject_TYPE.IsDefaultPublicPrivilege(privilege.Privilege) {
+		globalDatabase.typePrivileges.RevokedDefaults[key] = struct{}{}
+	}
+	if typePrivilegeValue, ok := globalDatabase.typePrivileges.Data[key]; ok {
+		removeGrantedPrivilege(typePrivilegeValue.Privileges, privilege, grantOptionOnly)
+		if len(typePrivilegeValue.Privileges) == 0 {
+			delete(globalDatabase.typePrivileges.Data, key)
+		}
+	}
+}
+
+// serialize writes the TypePrivileges to the given writer.
+func (tp *TypePrivileges) serialize(writer *utils.Writer) {
+	// Version 0
+	// Write the total number of values
+	writer.Uint64(uint64(len(tp.Data)))
+	for _, value := range tp.Data {
+		// Write the key
+		writer.Uint64(uint64(value.Key.Role))
+		writer.String(value.Key.Schema)
+		writer.String(value.Key.Name)
+		serializePrivilegeMap(writer, value.Privileges)
+	}
+	// Write the revoked defaults
+	writer.Uint64(uint64(len(tp.RevokedDefaults)))
+	for key := range tp.RevokedDefaults {
+		writer.Uint64(uint64(key.Role))
+		writer.String(key.Schema)
+		writer.String(key.Name)
+	}
+}
+
+// deserialize reads the TypePrivileges from the given reader.
+func (tp *TypePrivileges) deserialize(version uint32, reader *utils.Reader) {
+	tp.Data = make(map[TypePrivilegeKey]TypePrivilegeValue)
+	tp.RevokedDefaults = make(map[TypePrivilegeKey]struct{})
+	switch version {
+	case 0:
+		// Read the total number of values
+		dataCount := reader.Uint64()
+		for dataIdx := uint64(0); dataIdx < dataCount; dataIdx++ {
+			// Read the key
+			tpv := TypePrivilegeValue{}
+			tpv.Key.Role = RoleID(reader.Uint64())
+			tpv.Key.Schema = reader.String()
+			tpv.Key.Name = reader.String()
+			tpv.Privileges = deserializePrivilegeMap(reader)
+			tp.Data[tpv.Key] = tpv
+		}
+		// Read the revoked defaults
+		revokedCount := reader.Uint64()
+		for revokedIdx := uint64(0); revokedIdx < revokedCount; revokedIdx++ {
+			key := TypePrivilegeKey{}
+			key.Role = RoleID(reader.Uint64())
+			key.Schema = reader.String()
+			key.Name = reader.String()
+			tp.RevokedDefaults[key] = struct{}{}
+		}
+	default:
+		panic("unexpected version in TypePrivileges")
+	}
+}
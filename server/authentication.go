@@ -0,0 +1,219 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pgx/v5/pgproto3"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/auth/rfc5802"
+)
+
+// handleAuthentication handles authentication for the given user. The host-based authentication rules determine which
+// authentication method is used for the connection.
+func (h *ConnectionHandler) handleAuthentication(startupMessage *pgproto3.StartupMessage) error {
+	var username string
+	var host string
+	var ok bool
+	if username, ok = startupMessage.Parameters["user"]; ok && len(username) > 0 {
+		if h.Conn().RemoteAddr().Network() == "unix" {
+			host = "localhost"
+		} else {
+			host, _, _ = net.SplitHostPort(h.Conn().RemoteAddr().String())
+			if len(host) == 0 {
+				host = "localhost"
+			}
+		}
+	} else {
+		username = "postgres"
+		host = "localhost"
+	}
+	h.mysqlConn.User = username
+	h.mysqlConn.UserData = sql.MysqlConnectionUser{
+		User: username,
+		Host: host,
+	}
+	// Currently, regression tests disable authentication, since we can't just replay the messages due to nonces.
+	if !EnableAuthentication {
+		return h.send(&pgproto3.AuthenticationOk{})
+	}
+
+	hbaConn := h.hostBasedAuthConnection(startupMessage)
+	rule, ok := auth.MatchHostBasedAuthRule(hbaConn)
+	if !ok {
+		return h.sendAuthenticationError(pgerror.Newf(pgcode.InvalidAuthorizationSpecification,
+			`no pg_hba.conf entry for host "%s", user "%s", database "%s", %s`,
+			hbaConn.Host(), hbaConn.User, hbaConn.Database, hbaConn.Encryption()))
+	}
+	if clientCert, ok := rule.Options["clientcert"]; ok && rule.Method != auth.HostBasedAuthMethod_Cert {
+		if err := h.verifyClientCertificate(username, clientCert); err != nil {
+			return h.sendAuthenticationError(err)
+		}
+	}
+	switch rule.Method {
+	case auth.HostBasedAuthMethod_Trust:
+		return h.handleTrustAuthentication(username)
+	case auth.HostBasedAuthMethod_Reject:
+		return h.sendAuthenticationError(pgerror.Newf(pgcode.InvalidAuthorizationSpecification,
+			`pg_hba.conf rejects connection for host "%s", user "%s", database "%s", %s`,
+			hbaConn.Host(), hbaConn.User, hbaConn.Database, hbaConn.Encryption()))
+	case auth.HostBasedAuthMethod_Password:
+		return h.handlePasswordAuthentication(username)
+	case auth.HostBasedAuthMethod_Cert:
+		return h.handleCertAuthentication(username)
+	default:
+		// Passwords are only stored as SCRAM secrets, so Postgres also uses SCRAM-SHA-256 for md5 rules in this case
+		return h.handleScramAuthentication(username)
+	}
+}
+
+// hostBasedAuthConnection returns the connection information that is matched against the host-based authentication
+// rules.
+func (h *ConnectionHandler) hostBasedAuthConnection(startupMessage *pgproto3.StartupMessage) auth.HostBasedAuthConnection {
+	database, _ := h.startupDatabase(startupMessage)
+	hbaConn := auth.HostBasedAuthConnection{
+		Database: database,
+		User:     h.mysqlConn.User,
+	}
+	_, hbaConn.IsSSL = h.Conn().(*tls.Conn)
+	if h.Conn().RemoteAddr().Network() == "unix" {
+		hbaConn.IsLocal = true
+	} else if host, _, err := net.SplitHostPort(h.Conn().RemoteAddr().String()); err == nil {
+		hbaConn.IP = net.ParseIP(host)
+	}
+	return hbaConn
+}
+
+// handleTrustAuthentication accepts the connection without a password, as long as the role is allowed to log in.
+func (h *ConnectionHandler) handleTrustAuthentication(username string) error {
+	var role auth.Role
+	var exists bool
+	auth.LockRead(func() {
+		role = auth.GetRole(username)
+		exists = auth.RoleExists(username)
+	})
+	if !exists {
+		return h.sendAuthenticationError(pgerror.Newf(pgcode.InvalidAuthorizationSpecification, `role "%s" does not exist`, username))
+	}
+	if !role.CanLogin {
+		return h.sendAuthenticationError(pgerror.Newf(pgcode.InvalidAuthorizationSpecification, `role "%s" is not permitted to log in`, username))
+	}
+	return h.send(&pgproto3.AuthenticationOk{})
+}
+
+// handlePasswordAuthentication authenticates the given user using a cleartext password.
+func (h *ConnectionHandler) handlePasswordAuthentication(username string) error {
+	if err := h.send(&pgproto3.AuthenticationCleartextPassword{}); err != nil {
+		return err
+	}
+	if err := h.backend.SetAuthType(pgproto3.AuthTypeCleartextPassword); err != nil {
+		return err
+	}
+	message, err := h.backend.Receive()
+	if err != nil {
+		return err
+	}
+	passwordMessage, ok := message.(*pgproto3.PasswordMessage)
+	if !ok {
+		return fmt.Errorf("unknown message type encountered during password authentication: %T", message)
+	}
+	var role auth.Role
+	auth.LockRead(func() {
+		role = auth.GetRole(username)
+	})
+	if err = verifyCleartextPassword(role, passwordMessage.Password); err != nil {
+		return h.sendAuthenticationError(pgerror.WithCandidateCode(err, pgcode.InvalidPassword))
+	}
+	return h.send(&pgproto3.AuthenticationOk{})
+}
+
+// handleCertAuthentication authenticates the given user using the client's SSL certificate. The certificate must have
+// been verified during the SSL handshake, and its common name must match the user.
+func (h *ConnectionHandler) handleCertAuthentication(username string) error {
+	if err := h.verifyClientCertificate(username, ""); err != nil {
+		return h.sendAuthenticationError(err)
+	}
+	var role auth.Role
+	var exists bool
+	auth.LockRead(func() {
+		role = auth.GetRole(username)
+		exists = auth.RoleExists(username)
+	})
+	if !exists || !role.CanLogin {
+		return h.sendAuthenticationError(pgerror.Newf(pgcode.InvalidAuthorizationSpecification,
+			`certificate authentication failed for user "%s"`, username))
+	}
+	return h.send(&pgproto3.AuthenticationOk{})
+}
+
+// verifyClientCertificate verifies that the client presented a certificate that was signed by one of the configured
+// certificate authorities. |clientCert| is the value of the "clientcert" option of the matched rule, and is empty when
+// verifying for cert authentication. The certificate's common name must match the given user, except when only the
+// certificate authority is verified ("verify-ca").
+func (h *ConnectionHandler) verifyClientCertificate(username string, clientCert string) error {
+	tlsConn, ok := h.Conn().(*tls.Conn)
+	if !ok {
+		return pgerror.New(pgcode.InvalidAuthorizationSpecification, "connection requires a valid client certificate")
+	}
+	state := tlsConn.ConnectionState()
+	if len(state.VerifiedChains) == 0 || len(state.PeerCertificates) == 0 {
+		return pgerror.New(pgcode.InvalidAuthorizationSpecification, "connection requires a valid client certificate")
+	}
+	if clientCert == "verify-ca" {
+		return nil
+	}
+	if commonName := state.PeerCertificates[0].Subject.CommonName; commonName != username {
+		if len(clientCert) > 0 {
+			return pgerror.Newf(pgcode.InvalidAuthorizationSpecification,
+				`certificate validation (clientcert=verify-full) failed for user "%s": CN mismatch`, username)
+		}
+		return pgerror.Newf(pgcode.InvalidAuthorizationSpecification,
+			`certificate authentication failed for user "%s"`, username)
+	}
+	return nil
+}
+
+// sendAuthenticationError sends the given error to the client as a FATAL error, and returns the error.
+func (h *ConnectionHandler) sendAuthenticationError(err error) error {
+	_ = h.send(errorResponse(err, ErrorResponseSeverity_Fatal))
+	return err
+}
+
+// verifyCleartextPassword verifies that the given cleartext password matches the stored password of the role.
+func verifyCleartextPassword(role auth.Role, password string) error {
+	if !role.CanLogin || role.Password == nil {
+		return fmt.Errorf(`password authentication failed for user "%s"`, role.Name)
+	}
+	// The password is no longer valid once the "valid until" time has passed
+	if role.ValidUntil != nil && time.Now().After(*role.ValidUntil) {
+		return fmt.Errorf(`password authentication failed for user "%s"`, role.Name)
+	}
+	saltedPassword, err := rfc5802.SaltedPassword(password, role.Password.Salt, role.Password.Iterations)
+	if err != nil {
+		return fmt.Errorf(`password authentication failed for user "%s"`, role.Name)
+	}
+	if !rfc5802.StoredKey(rfc5802.ClientKey(saltedPassword)).Equals(role.Password.StoredKey) {
+		return fmt.Errorf(`password authentication failed for user "%s"`, role.Name)
+	}
+	return nil
+}
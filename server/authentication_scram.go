@@ -16,15 +16,19 @@ package server
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"fmt"
-	"net"
+	"hash"
 	"strings"
+	"time"
 
 	"github.com/dolthub/doltgresql/server/auth"
 	"github.com/dolthub/doltgresql/server/auth/rfc5802"
 
-	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/jackc/pgx/v5/pgproto3"
 )
 
@@ -50,15 +54,23 @@ const (
 	SASLBindingFlag_Used                   SASLBindingFlag = "p"
 )
 
+// SASLChannelBinding_TLSServerEndPoint is the only channel binding type that is supported, which is defined in RFC-5929:
+// https://datatracker.ietf.org/doc/html/rfc5929#section-4
+const SASLChannelBinding_TLSServerEndPoint = "tls-server-end-point"
+
 // SASLInitial is the structured form of the input given by *pgproto3.SASLInitialResponse.
 type SASLInitial struct {
-	Flag     SASLBindingFlag
-	BindName string // Only set when Flag is SASLBindingFlag_Used
-	Binding  string // Base64 encoding of cbind-input
-	Authzid  string // Authorization ID, currently ignored in favor of the startup message's username
-	Username string // Prepared using SASLprep, currently ignored in favor of the startup message's username
-	Nonce    string
-	RawData  []byte // The bytes that were received in the message
+	Mechanism string
+	Flag      SASLBindingFlag
+	BindName  string // Only set when Flag is SASLBindingFlag_Used
+	Binding   string // Base64 encoding of cbind-input
+	Authzid   string // Authorization ID, currently ignored in favor of the startup message's username
+	Username  string // Prepared using SASLprep, currently ignored in favor of the startup message's username
+	Nonce     string
+	RawData   []byte // The bytes that were received in the message
+	// ChannelBindingData is the channel binding data of the connection, which is only set when Flag is
+	// SASLBindingFlag_Used. This is not received from the client, but is used to verify the client's binding.
+	ChannelBindingData []byte
 }
 
 // SASLContinue is the structured form of the output for *pgproto3.SASLInitialResponse.
@@ -76,42 +88,23 @@ type SASLResponse struct {
 	RawData     []byte // The bytes that were received in the message
 }
 
-// handleAuthentication handles authentication for the given user
-func (h *ConnectionHandler) handleAuthentication(startupMessage *pgproto3.StartupMessage) error {
-	var username string
-	var host string
-	var ok bool
-	if username, ok = startupMessage.Parameters["user"]; ok && len(username) > 0 {
-		if h.Conn().RemoteAddr().Network() == "unix" {
-			host = "localhost"
-		} else {
-			host, _, _ = net.SplitHostPort(h.Conn().RemoteAddr().String())
-			if len(host) == 0 {
-				host = "localhost"
-			}
-		}
-	} else {
-		username = "postgres"
-		host = "localhost"
-	}
-	h.mysqlConn.User = username
-	h.mysqlConn.UserData = sql.MysqlConnectionUser{
-		User: username,
-		Host: host,
+// handleScramAuthentication authenticates the given user using SCRAM-SHA-256.
+func (h *ConnectionHandler) handleScramAuthentication(username string) error {
+	// Channel binding is only available for SSL connections, so we only offer SCRAM-SHA-256-PLUS on them
+	channelBindingData, err := h.tlsServerEndPoint()
+	if err != nil {
+		return err
 	}
-	// Currently, regression tests disable authentication, since we can't just replay the messages due to nonces.
-	if !EnableAuthentication {
-		return h.send(&pgproto3.AuthenticationOk{})
+	authMechanisms := []string{SASLMechanism_SCRAM_SHA_256}
+	if channelBindingData != nil {
+		authMechanisms = []string{SASLMechanism_SCRAM_SHA_256_PLUS, SASLMechanism_SCRAM_SHA_256}
 	}
-	// We only support one mechanism for now.
-	if err := h.send(&pgproto3.AuthenticationSASL{
-		AuthMechanisms: []string{
-			SASLMechanism_SCRAM_SHA_256,
-		},
+	if err = h.send(&pgproto3.AuthenticationSASL{
+		AuthMechanisms: authMechanisms,
 	}); err != nil {
 		return err
 	}
-	if err := h.backend.SetAuthType(pgproto3.AuthTypeSASL); err != nil {
+	if err = h.backend.SetAuthType(pgproto3.AuthTypeSASL); err != nil {
 		return err
 	}
 	// Even though we can determine whether the role exists at this point, we delay the actual error for additional security.
@@ -127,6 +120,9 @@ func (h *ConnectionHandler) handleAuthentication(startupMessage *pgproto3.Startu
 		switch response := initialResponse.(type) {
 		case *pgproto3.SASLInitialResponse:
 			saslInitial, err = readSASLInitial(response)
+			if err == nil {
+				err = saslInitial.bindChannel(channelBindingData)
+			}
 			if err != nil {
 				_ = h.send(&pgproto3.ErrorResponse{
 					Severity: "FATAL",
@@ -186,10 +182,10 @@ func (h *ConnectionHandler) handleAuthentication(startupMessage *pgproto3.Startu
 
 // readSASLInitial reads the initial SASL response from the client.
 func readSASLInitial(r *pgproto3.SASLInitialResponse) (SASLInitial, error) {
-	if r.AuthMechanism != SASLMechanism_SCRAM_SHA_256 {
+	if r.AuthMechanism != SASLMechanism_SCRAM_SHA_256 && r.AuthMechanism != SASLMechanism_SCRAM_SHA_256_PLUS {
 		return SASLInitial{}, fmt.Errorf("SASL mechanism not supported: %s", r.AuthMechanism)
 	}
-	saslInitial := SASLInitial{}
+	saslInitial := SASLInitial{Mechanism: r.AuthMechanism}
 	sections := strings.Split(string(r.Data), ",")
 	if len(sections) < 3 {
 		return SASLInitial{}, fmt.Errorf("invalid SASLInitialResponse: too few sections")
@@ -214,6 +210,17 @@ func readSASLInitial(r *pgproto3.SASLInitialResponse) (SASLInitial, error) {
 	default:
 		return SASLInitial{}, fmt.Errorf("invalid SASLInitialResponse: malformed gs2-cbind-flag options (%c)", gs2CbindFlag[0])
 	}
+	// The mechanism determines whether the client must use channel binding
+	if saslInitial.Mechanism == SASLMechanism_SCRAM_SHA_256_PLUS {
+		if saslInitial.Flag != SASLBindingFlag_Used {
+			return SASLInitial{}, fmt.Errorf("invalid SASLInitialResponse: channel binding is required for %s", SASLMechanism_SCRAM_SHA_256_PLUS)
+		}
+		if saslInitial.BindName != SASLChannelBinding_TLSServerEndPoint {
+			return SASLInitial{}, fmt.Errorf("invalid SASLInitialResponse: unsupported channel binding type: %s", saslInitial.BindName)
+		}
+	} else if saslInitial.Flag == SASLBindingFlag_Used {
+		return SASLInitial{}, fmt.Errorf("invalid SASLInitialResponse: channel binding is not supported for %s", SASLMechanism_SCRAM_SHA_256)
+	}
 
 	// authzid is the second section
 	authzid := sections[1]
@@ -289,6 +296,30 @@ func readSASLResponse(gs2EncodedHeader string, nonce string, r *pgproto3.SASLRes
 	return saslResponse, nil
 }
 
+// tlsServerEndPoint returns the channel binding data for the "tls-server-end-point" channel binding type, which is the
+// hash of the server's certificate. Returns nil if the connection does not use SSL.
+func (h *ConnectionHandler) tlsServerEndPoint() ([]byte, error) {
+	if _, ok := h.Conn().(*tls.Conn); !ok || len(certificate.Certificate) == 0 {
+		return nil, nil
+	}
+	cert, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	// The hash function matches the certificate's signature algorithm, except that MD5 and SHA-1 are replaced by SHA-256
+	var hasher hash.Hash
+	switch cert.SignatureAlgorithm {
+	case x509.SHA384WithRSA, x509.SHA384WithRSAPSS, x509.ECDSAWithSHA384:
+		hasher = sha512.New384()
+	case x509.SHA512WithRSA, x509.SHA512WithRSAPSS, x509.ECDSAWithSHA512:
+		hasher = sha512.New()
+	default:
+		hasher = sha256.New()
+	}
+	hasher.Write(cert.Raw)
+	return hasher.Sum(nil), nil
+}
+
 // verifySASLClientProof verifies that the proof given by the client in valid. Returns the base64-encoded
 // ServerSignature, which verifies (to the client) that the server has proper access to the client's authentication
 // information.
@@ -296,7 +327,10 @@ func verifySASLClientProof(user auth.Role, saslInitial SASLInitial, saslContinue
 	if !user.CanLogin || user.Password == nil {
 		return "", fmt.Errorf(`password authentication failed for user "%s"`, user.Name)
 	}
-	// TODO: check the "valid until" time
+	// The password is no longer valid once the "valid until" time has passed
+	if user.ValidUntil != nil && time.Now().After(*user.ValidUntil) {
+		return "", fmt.Errorf(`password authentication failed for user "%s"`, user.Name)
+	}
 	clientProof := rfc5802.Base64ToOctetString(saslResponse.ClientProof)
 	authMessage := fmt.Sprintf("%s,%s,%s", saslInitial.MessageBare(), saslContinue.Encode().Data, saslResponse.MessageWithoutProof())
 	clientSignature := rfc5802.ClientSignature(user.Password.StoredKey, authMessage)
@@ -314,7 +348,25 @@ func verifySASLClientProof(user auth.Role, saslInitial SASLInitial, saslContinue
 
 // Base64Header returns the base64-encoded GS2 header and channel binding data.
 func (si SASLInitial) Base64Header() string {
-	return base64.StdEncoding.EncodeToString(si.base64HeaderBytes())
+	return base64.StdEncoding.EncodeToString(append(si.base64HeaderBytes(), si.ChannelBindingData...))
+}
+
+// bindChannel sets the channel binding data of the connection, which is nil when the connection does not support
+// channel binding. Returns an error if the client's use of channel binding is invalid for the connection.
+func (si *SASLInitial) bindChannel(channelBindingData []byte) error {
+	switch si.Flag {
+	case SASLBindingFlag_Used:
+		if channelBindingData == nil {
+			return fmt.Errorf("invalid SASLInitialResponse: channel binding is not supported by this connection")
+		}
+		si.ChannelBindingData = channelBindingData
+	case SASLBindingFlag_AssumedNoServerSupport:
+		// The client believes that we do not support channel binding, which may point to a downgrade attack
+		if channelBindingData != nil {
+			return fmt.Errorf("SCRAM channel binding negotiation error: the client does not support channel binding, but the server does")
+		}
+	}
+	return nil
 }
 
 // MessageBare returns the message without the GS2 header.
@@ -18,9 +18,11 @@ package cast
 func Init() {
 	initBool()
 	initChar()
+	initCidr()
 	initDate()
 	initFloat32()
 	initFloat64()
+	initInet()
 	initInt16()
 	initInt32()
 	initInt64()
@@ -28,6 +30,8 @@ func Init() {
 	initInterval()
 	initJson()
 	initJsonB()
+	initMacAddr()
+	initMacAddr8()
 	initName()
 	initNumeric()
 	initOid()
@@ -0,0 +1,46 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cast
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initMacAddr8 handles all casts that are built-in. This comprises only the "From" types.
+func initMacAddr8() {
+	macAddr8Implicit()
+}
+
+// macAddr8Implicit registers all implicit casts. This comprises only the "From" types.
+func macAddr8Implicit() {
+	framework.MustAddImplicitTypeCast(framework.TypeCast{
+		FromType: pgtypes.MacAddr8,
+		ToType:   pgtypes.MacAddr,
+		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
+			addr := val.(net.HardwareAddr)
+			// Only addresses that were converted from a 6 byte address may be converted back
+			if addr[3] != 0xff || addr[4] != 0xfe {
+				return nil, fmt.Errorf("macaddr8 data out of range to convert to macaddr")
+			}
+			return net.HardwareAddr{addr[0], addr[1], addr[2], addr[5], addr[6], addr[7]}, nil
+		},
+	})
+}
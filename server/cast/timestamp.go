@@ -19,7 +19,6 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 
-	"github.com/dolthub/doltgresql/postgres/parser/pgdate"
 	"github.com/dolthub/doltgresql/postgres/parser/timeofday"
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
@@ -37,14 +36,15 @@ func timestampAssignment() {
 		FromType: pgtypes.Timestamp,
 		ToType:   pgtypes.Date,
 		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
-			return pgdate.MakeDateFromTime(val.(time.Time))
+			t := val.(time.Time)
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
 		},
 	})
 	framework.MustAddAssignmentTypeCast(framework.TypeCast{
 		FromType: pgtypes.Timestamp,
 		ToType:   pgtypes.Time,
 		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
-			return timeofday.FromTime(val.(time.Time)), nil
+			return timeofday.FromTime(val.(time.Time)).ToTime(), nil
 		},
 	})
 }
@@ -62,8 +62,13 @@ func timestampImplicit() {
 		FromType: pgtypes.Timestamp,
 		ToType:   pgtypes.TimestampTZ,
 		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
-			// TODO: check
-			return val.(time.Time), nil
+			// The wall time is interpreted within the server's time zone
+			loc, err := pgtypes.GetServerLocation(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t := val.(time.Time)
+			return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc), nil
 		},
 	})
 }
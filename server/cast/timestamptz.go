@@ -19,7 +19,6 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 
-	"github.com/dolthub/doltgresql/postgres/parser/pgdate"
 	"github.com/dolthub/doltgresql/postgres/parser/timeofday"
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
@@ -37,22 +36,36 @@ func timestampTZAssignment() {
 		FromType: pgtypes.TimestampTZ,
 		ToType:   pgtypes.Date,
 		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
-			return pgdate.MakeDateFromTime(val.(time.Time))
+			loc, err := pgtypes.GetServerLocation(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t := val.(time.Time).In(loc)
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
 		},
 	})
 	framework.MustAddAssignmentTypeCast(framework.TypeCast{
 		FromType: pgtypes.TimestampTZ,
 		ToType:   pgtypes.Time,
 		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
-			return timeofday.FromTime(val.(time.Time)), nil
+			loc, err := pgtypes.GetServerLocation(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return timeofday.FromTime(val.(time.Time).In(loc)).ToTime(), nil
 		},
 	})
 	framework.MustAddAssignmentTypeCast(framework.TypeCast{
 		FromType: pgtypes.TimestampTZ,
 		ToType:   pgtypes.Timestamp,
 		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
-			// TODO: check
-			return val.(time.Time), nil
+			// The result is the wall time within the server's time zone
+			loc, err := pgtypes.GetServerLocation(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t := val.(time.Time).In(loc)
+			return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC), nil
 		},
 	})
 	framework.MustAddAssignmentTypeCast(framework.TypeCast{
@@ -84,10 +84,13 @@ type copyFromStdinState struct {
 }
 
 type PortalData struct {
-	Query        ConvertedQuery
-	IsEmptyQuery bool
-	Fields       []pgproto3.FieldDescription
-	BoundPlan    sql.Node
+	Query             ConvertedQuery
+	IsEmptyQuery      bool
+	Fields            []pgproto3.FieldDescription
+	BoundPlan         sql.Node
+	ResultFormatCodes []int16
+	// Cursor is set once the portal has been partially executed, and holds the rows that have not yet been returned.
+	Cursor *Cursor
 }
 
 type PreparedStatementData struct {
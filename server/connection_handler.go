@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -35,12 +36,16 @@ import (
 	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/text/encoding"
 
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/core/dataloader"
 	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 	"github.com/dolthub/doltgresql/server/ast"
+	"github.com/dolthub/doltgresql/server/auth"
 	pgexprs "github.com/dolthub/doltgresql/server/expression"
 	"github.com/dolthub/doltgresql/server/node"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
@@ -54,11 +59,22 @@ type ConnectionHandler struct {
 	portals            map[string]PortalData
 	doltgresHandler    *DoltgresHandler
 	backend            *pgproto3.Backend
-	pgTypeMap          *pgtype.Map
 	waitForSync        bool
+	// inFailedTransaction is set when an error occurs within an explicit transaction block. While set, all statements
+	// other than those that end the transaction are rejected, which matches the behavior of Postgres.
+	inFailedTransaction bool
 	// copyFromStdinState is set when this connection is in the COPY FROM STDIN mode, meaning it is waiting on
 	// COPY DATA messages from the client to import data into tables.
 	copyFromStdinState *copyFromStdinState
+	// releaseConnection releases this connection from the connection limits of its role and database. This is set
+	// once the connection has been accepted.
+	releaseConnection func()
+	// session is the connection's session, which is cached once retrieved so that status checks, such as those made
+	// for every ReadyForQuery message, do not need to create a new context. This is cleared when the session is reset.
+	session sql.Session
+	// clientCAs are the certificate authorities of the listener that accepted this connection, which are used to verify
+	// client certificates. Client certificates are not requested when this is nil.
+	clientCAs *x509.CertPool
 }
 
 // Set this env var to disable panic handling in the connection, which is useful when debugging a panic
@@ -74,7 +90,7 @@ func init() {
 }
 
 // NewConnectionHandler returns a new ConnectionHandler for the connection provided
-func NewConnectionHandler(conn net.Conn, handler mysql.Handler) *ConnectionHandler {
+func NewConnectionHandler(conn net.Conn, handler mysql.Handler, clientCAs *x509.CertPool) *ConnectionHandler {
 	mysqlConn := &mysql.Conn{
 		Conn:        conn,
 		PrepareData: make(map[uint32]*mysql.PrepareData),
@@ -104,7 +120,7 @@ func NewConnectionHandler(conn net.Conn, handler mysql.Handler) *ConnectionHandl
 		portals:            portals,
 		doltgresHandler:    doltgresHandler,
 		backend:            pgproto3.NewBackend(conn, conn),
-		pgTypeMap:          pgtype.NewMap(),
+		clientCAs:          clientCAs,
 	}
 }
 
@@ -139,6 +155,7 @@ func (h *ConnectionHandler) HandleConnection() {
 				fmt.Println(returnErr.Error())
 			}
 
+			h.destroyAllPortals()
 			h.doltgresHandler.ConnectionClosed(h.mysqlConn)
 			if err := h.Conn().Close(); err != nil {
 				fmt.Printf("Failed to properly close connection:\n%v\n", err)
@@ -146,6 +163,11 @@ func (h *ConnectionHandler) HandleConnection() {
 		}()
 	}
 	h.doltgresHandler.NewConnection(h.mysqlConn)
+	defer func() {
+		if h.releaseConnection != nil {
+			h.releaseConnection()
+		}
+	}()
 
 	if proceed, err := h.handleStartup(); err != nil || !proceed {
 		returnErr = err
@@ -194,6 +216,9 @@ func (h *ConnectionHandler) handleStartup() (bool, error) {
 		if err = h.handleAuthentication(sm); err != nil {
 			return false, err
 		}
+		if err = h.acquireConnection(sm); err != nil {
+			return false, err
+		}
 		if err = h.sendClientStartupMessages(); err != nil {
 			return false, err
 		}
@@ -201,7 +226,7 @@ func (h *ConnectionHandler) handleStartup() (bool, error) {
 			return false, err
 		}
 		return true, h.send(&pgproto3.ReadyForQuery{
-			TxStatus: byte(ReadyForQueryTransactionIndicator_Idle),
+			TxStatus: byte(h.transactionStatus()),
 		})
 	case *pgproto3.SSLRequest:
 		hasCertificate := len(certificate.Certificate) > 0
@@ -217,9 +242,16 @@ func (h *ConnectionHandler) handleStartup() (bool, error) {
 		// This involves swapping out our underlying net connection for a new one.
 		// We can't start in SSL mode, as the client does not attempt the handshake until after our response.
 		if hasCertificate {
-			h.setConn(tls.Server(h.Conn(), &tls.Config{
+			tlsConfig := &tls.Config{
 				Certificates: []tls.Certificate{certificate},
-			}))
+			}
+			// Client certificates are verified when given, while host-based authentication determines whether they
+			// are required.
+			if h.clientCAs != nil {
+				tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+				tlsConfig.ClientCAs = h.clientCAs
+			}
+			h.setConn(tls.Server(h.Conn(), tlsConfig))
 		}
 		return h.handleStartup()
 	case *pgproto3.GSSEncRequest:
@@ -254,14 +286,33 @@ func (h *ConnectionHandler) sendClientStartupMessages() error {
 	})
 }
 
+// acquireConnection checks that the database in the startup message accepts connections, and that neither the role nor
+// the database have reached their connection limit.
+func (h *ConnectionHandler) acquireConnection(startupMessage *pgproto3.StartupMessage) error {
+	db, _ := h.startupDatabase(startupMessage)
+	release, err := auth.AcquireConnection(h.mysqlConn.User, db)
+	if err != nil {
+		_ = h.send(errorResponse(err, ErrorResponseSeverity_Fatal))
+		return err
+	}
+	h.releaseConnection = release
+	return nil
+}
+
+// startupDatabase returns the database that the startup message connects to, along with whether it was specified.
+// When a database is not specified, the database with the same name as the user is used.
+func (h *ConnectionHandler) startupDatabase(startupMessage *pgproto3.StartupMessage) (string, bool) {
+	db, ok := startupMessage.Parameters["database"]
+	if !ok || len(db) == 0 {
+		return h.mysqlConn.User, false
+	}
+	return db, true
+}
+
 // chooseInitialDatabase attempts to choose the initial database for the connection,
 // if one is specified in the startup message provided
 func (h *ConnectionHandler) chooseInitialDatabase(startupMessage *pgproto3.StartupMessage) error {
-	db, ok := startupMessage.Parameters["database"]
-	dbSpecified := ok && len(db) > 0
-	if !dbSpecified {
-		db = h.mysqlConn.User
-	}
+	db, dbSpecified := h.startupDatabase(startupMessage)
 	useStmt := fmt.Sprintf("SET database TO '%s';", db)
 	parsed, err := sql.GlobalParser.ParseSimple(useStmt)
 	if err != nil {
@@ -371,8 +422,8 @@ func (h *ConnectionHandler) handleMessage(msg pgproto3.Message) (stop, endOfMess
 	case *pgproto3.Close:
 		if message.ObjectType == 'S' {
 			delete(h.preparedStatements, message.Name)
-		} else {
-			delete(h.portals, message.Name)
+		} else if err = h.destroyPortal(message.Name); err != nil {
+			return false, false, err
 		}
 		return false, false, h.send(&pgproto3.CloseComplete{})
 	case *pgproto3.CopyData:
@@ -390,29 +441,37 @@ func (h *ConnectionHandler) handleMessage(msg pgproto3.Message) (stop, endOfMess
 // expected as part of this query, in which case the server will send a READY FOR QUERY message back to the client so
 // that it can send its next query.
 func (h *ConnectionHandler) handleQuery(message *pgproto3.Query) (endOfMessages bool, err error) {
-	handled, err := h.handledPSQLCommands(message.String)
-	if handled || err != nil {
-		return true, err
-	}
+	// The special commands are never transaction control statements, so they're rejected when the transaction has failed
+	if !h.inFailedTransaction {
+		handled, err := h.handledPSQLCommands(message.String)
+		if handled || err != nil {
+			return true, err
+		}
 
-	// TODO: Remove this once we support `SELECT * FROM function()` syntax
-	// Github issue: https://github.com/dolthub/doltgresql/issues/464
-	handled, err = h.handledWorkbenchCommands(message.String)
-	if handled || err != nil {
-		return true, err
+		// TODO: Remove this once we support `SELECT * FROM function()` syntax
+		// Github issue: https://github.com/dolthub/doltgresql/issues/464
+		handled, err = h.handledWorkbenchCommands(message.String)
+		if handled || err != nil {
+			return true, err
+		}
 	}
 
 	query, err := h.convertQuery(message.String)
 	if err != nil {
 		return true, err
 	}
+	if query, err = h.checkFailedTransaction(query); err != nil {
+		return true, err
+	}
 
 	// A query message destroys the unnamed statement and the unnamed portal
 	delete(h.preparedStatements, "")
-	delete(h.portals, "")
+	if err = h.destroyPortal(""); err != nil {
+		return true, err
+	}
 
 	// Certain statement types get handled directly by the handler instead of being passed to the engine
-	handled, endOfMessages, err = h.handleQueryOutsideEngine(query)
+	handled, endOfMessages, err := h.handleQueryOutsideEngine(query)
 	if handled {
 		return endOfMessages, err
 	}
@@ -440,6 +499,9 @@ func (h *ConnectionHandler) handleQueryOutsideEngine(query ConvertedQuery) (hand
 			if injectedStmt.Stdin {
 				return true, false, h.handleCopyFromStdinQuery(injectedStmt, h.Conn())
 			}
+			return true, true, h.handleCopyFromFile(query, injectedStmt)
+		case *node.CopyTo:
+			return true, true, h.handleCopyTo(query, injectedStmt)
 		}
 	}
 	return false, true, nil
@@ -454,6 +516,9 @@ func (h *ConnectionHandler) handleParse(message *pgproto3.Parse) error {
 	if err != nil {
 		return err
 	}
+	if _, err = h.checkFailedTransaction(query); err != nil {
+		return err
+	}
 
 	if query.AST == nil {
 		// special case: empty query
@@ -524,13 +589,16 @@ func (h *ConnectionHandler) handleDescribe(message *pgproto3.Describe) error {
 func (h *ConnectionHandler) handleBind(message *pgproto3.Bind) error {
 	h.waitForSync = true
 
-	// TODO: a named portal object lasts till the end of the current transaction, unless explicitly destroyed
-	//  we need to destroy the named portal as a side effect of the transaction ending
+	// A named portal lasts until the end of the current transaction unless it's explicitly destroyed, which is handled
+	// by endOfMessages. Binding to an existing portal replaces it.
 	logrus.Tracef("binding portal %q to prepared statement %s", message.DestinationPortal, message.PreparedStatement)
 	preparedData, ok := h.preparedStatements[message.PreparedStatement]
 	if !ok {
 		return fmt.Errorf("prepared statement %s does not exist", message.PreparedStatement)
 	}
+	if err := h.destroyPortal(message.DestinationPortal); err != nil {
+		return err
+	}
 
 	if preparedData.Query.AST == nil {
 		// special case: empty query
@@ -541,12 +609,16 @@ func (h *ConnectionHandler) handleBind(message *pgproto3.Bind) error {
 		return h.send(&pgproto3.BindComplete{})
 	}
 
+	if len(message.ResultFormatCodes) > 1 && len(message.ResultFormatCodes) != len(preparedData.ReturnFields) {
+		return pgerror.Newf(pgcode.ProtocolViolation, "bind message has %d result formats but query has %d columns",
+			len(message.ResultFormatCodes), len(preparedData.ReturnFields))
+	}
 	bindVars, err := h.convertBindParameters(preparedData.BindVarTypes, message.ParameterFormatCodes, message.Parameters)
 	if err != nil {
 		return err
 	}
 
-	analyzedPlan, fields, err := h.doltgresHandler.ComBind(context.Background(), h.mysqlConn, preparedData.Query.String, preparedData.Query.AST, bindVars)
+	analyzedPlan, fields, err := h.doltgresHandler.ComBind(context.Background(), h.mysqlConn, preparedData.Query.String, preparedData.Query.AST, bindVars, message.ResultFormatCodes)
 	if err != nil {
 		return err
 	}
@@ -557,9 +629,10 @@ func (h *ConnectionHandler) handleBind(message *pgproto3.Bind) error {
 	}
 
 	h.portals[message.DestinationPortal] = PortalData{
-		Query:     preparedData.Query,
-		Fields:    fields,
-		BoundPlan: boundPlan,
+		Query:             preparedData.Query,
+		Fields:            fields,
+		BoundPlan:         boundPlan,
+		ResultFormatCodes: message.ResultFormatCodes,
 	}
 	return h.send(&pgproto3.BindComplete{})
 }
@@ -568,7 +641,6 @@ func (h *ConnectionHandler) handleBind(message *pgproto3.Bind) error {
 func (h *ConnectionHandler) handleExecute(message *pgproto3.Execute) error {
 	h.waitForSync = true
 
-	// TODO: implement the RowMax
 	portalData, ok := h.portals[message.Portal]
 	if !ok {
 		return fmt.Errorf("portal %s does not exist", message.Portal)
@@ -581,24 +653,89 @@ func (h *ConnectionHandler) handleExecute(message *pgproto3.Execute) error {
 		return h.send(&pgproto3.EmptyQueryResponse{})
 	}
 
+	if checkedQuery, err := h.checkFailedTransaction(query); err != nil {
+		return err
+	} else if checkedQuery.StatementTag != query.StatementTag {
+		// The bound plan does not apply to the replacement query, so we run the replacement query directly
+		return h.query(checkedQuery)
+	}
+
 	// Certain statement types get handled directly by the handler instead of being passed to the engine
 	handled, _, err := h.handleQueryOutsideEngine(query)
 	if handled {
 		return err
 	}
 
+	// A row limit requires the portal to keep its rows between Execute messages, so we read them through a cursor
+	if portalData.Cursor == nil && message.MaxRows > 0 && returnsRow(query.StatementTag) {
+		portalData.Cursor, err = h.doltgresHandler.ComOpenCursor(context.Background(), h.mysqlConn, query.String, portalData.BoundPlan, portalData.ResultFormatCodes)
+		if err != nil {
+			return err
+		}
+		h.portals[message.Portal] = portalData
+	}
+	if portalData.Cursor != nil {
+		return h.executeCursor(message.Portal, portalData, message.MaxRows)
+	}
+
 	// |rowsAffected| gets altered by the callback below
 	rowsAffected := int32(0)
 
 	callback := h.spoolRowsCallback(query.StatementTag, &rowsAffected, true)
-	err = h.doltgresHandler.ComExecuteBound(context.Background(), h.mysqlConn, query.String, portalData.BoundPlan, callback)
+	err = h.doltgresHandler.ComExecuteBound(context.Background(), h.mysqlConn, query.String, portalData.BoundPlan, portalData.ResultFormatCodes, callback)
 	if err != nil {
 		return err
 	}
+	h.clearFailedTransaction(query)
 
 	return h.send(makeCommandComplete(query.StatementTag, rowsAffected))
 }
 
+// executeCursor sends up to |maxRows| rows from the portal's cursor, with a |maxRows| of zero sending all remaining rows.
+// If rows remain afterward, then the portal is suspended and a later Execute message resumes from where it left off.
+func (h *ConnectionHandler) executeCursor(portalName string, portalData PortalData, maxRows uint32) error {
+	result, done, err := portalData.Cursor.Next(maxRows)
+	if err != nil {
+		delete(h.portals, portalName)
+		return err
+	}
+
+	// |rowsAffected| gets altered by the callback below
+	rowsAffected := int32(0)
+	callback := h.spoolRowsCallback(portalData.Query.StatementTag, &rowsAffected, true)
+	if err = callback(result); err != nil {
+		return err
+	}
+	if !done {
+		return h.send(&pgproto3.PortalSuspended{})
+	}
+	return h.send(makeCommandComplete(portalData.Query.StatementTag, rowsAffected))
+}
+
+// destroyPortal closes the cursor of the given portal if it has one, and then removes the portal. Destroying a portal
+// that does not exist is not an error.
+func (h *ConnectionHandler) destroyPortal(name string) error {
+	portalData, ok := h.portals[name]
+	if !ok {
+		return nil
+	}
+	delete(h.portals, name)
+	if portalData.Cursor != nil {
+		return portalData.Cursor.Close()
+	}
+	return nil
+}
+
+// destroyAllPortals destroys every portal, which occurs when a transaction ends. Any errors are logged rather than
+// returned, since the transaction has already ended.
+func (h *ConnectionHandler) destroyAllPortals() {
+	for name := range h.portals {
+		if err := h.destroyPortal(name); err != nil {
+			logrus.Warnf("error closing portal %q: %s", name, err.Error())
+		}
+	}
+}
+
 func makeCommandComplete(tag string, rows int32) *pgproto3.CommandComplete {
 	switch tag {
 	case "INSERT", "DELETE", "UPDATE", "MERGE", "SELECT", "CREATE TABLE AS", "MOVE", "FETCH", "COPY":
@@ -628,8 +765,9 @@ func (h *ConnectionHandler) handleCopyData(message *pgproto3.CopyData) (stop boo
 // handleCopyDataHelper is a helper function that should only be invoked by handleCopyData. handleCopyData wraps this
 // function so that it can capture any returned error message and store it in the saved state.
 func (h *ConnectionHandler) handleCopyDataHelper(message *pgproto3.CopyData) (stop bool, endOfMessages bool, err error) {
+	// The COPY may have failed before the client received the error, so the remaining copy messages are ignored
 	if h.copyFromStdinState == nil {
-		return false, true, fmt.Errorf("COPY DATA message received without a COPY FROM STDIN operation in progress")
+		return false, false, nil
 	}
 
 	// Grab a sql.Context and ensure the session has a transaction started, otherwise the copied data
@@ -644,41 +782,7 @@ func (h *ConnectionHandler) handleCopyDataHelper(message *pgproto3.CopyData) (st
 
 	dataLoader := h.copyFromStdinState.dataLoader
 	if dataLoader == nil {
-		copyFromStdinNode := h.copyFromStdinState.copyFromStdinNode
-		if copyFromStdinNode == nil {
-			return false, false, fmt.Errorf("no COPY FROM STDIN node found")
-		}
-
-		// TODO: It would be better to get the table from the copyFromStdinNode – not by calling core.GetSqlTableFromContext
-		table, err := core.GetSqlTableFromContext(sqlCtx, copyFromStdinNode.DatabaseName, copyFromStdinNode.TableName)
-		if err != nil {
-			return false, true, err
-		}
-		if table == nil {
-			return false, true, fmt.Errorf(`relation "%s" does not exist`, copyFromStdinNode.TableName.String())
-		}
-		insertableTable, ok := table.(sql.InsertableTable)
-		if !ok {
-			return false, true, fmt.Errorf(`table "%s" is read-only`, copyFromStdinNode.TableName.String())
-		}
-
-		switch copyFromStdinNode.CopyOptions.CopyFormat {
-		case tree.CopyFormatText:
-			dataLoader, err = dataloader.NewTabularDataLoader(sqlCtx, insertableTable, copyFromStdinNode.CopyOptions.Delimiter, "", copyFromStdinNode.CopyOptions.Header)
-		case tree.CopyFormatCsv:
-			dataLoader, err = dataloader.NewCsvDataLoader(sqlCtx, insertableTable, copyFromStdinNode.CopyOptions.Delimiter, copyFromStdinNode.CopyOptions.Header)
-		case tree.CopyFormatBinary:
-			err = fmt.Errorf("BINARY format is not supported for COPY FROM")
-		default:
-			err = fmt.Errorf("unknown format specified for COPY FROM: %v",
-				copyFromStdinNode.CopyOptions.CopyFormat)
-		}
-
-		if err != nil {
-			return false, false, err
-		}
-
-		h.copyFromStdinState.dataLoader = dataLoader
+		return false, false, fmt.Errorf("no data loader found for COPY FROM STDIN operation")
 	}
 
 	byteReader := bytes.NewReader(message.Data)
@@ -697,9 +801,9 @@ func (h *ConnectionHandler) handleCopyDataHelper(message *pgproto3.CopyData) (st
 // |endOfMessages| is true if no more COPY DATA messages are expected, and the server should tell the client that it is
 // ready for the next query, and |err| contains any error that occurred while processing the COPY DATA message.
 func (h *ConnectionHandler) handleCopyDone(_ *pgproto3.CopyDone) (stop bool, endOfMessages bool, err error) {
+	// The COPY may have failed before the client received the error, so the remaining copy messages are ignored
 	if h.copyFromStdinState == nil {
-		return false, true,
-			fmt.Errorf("COPY DONE message received without a COPY FROM STDIN operation in progress")
+		return false, false, nil
 	}
 
 	// If there was a previous error returned from processing a CopyData message, then don't return an error here
@@ -750,9 +854,9 @@ func (h *ConnectionHandler) handleCopyDone(_ *pgproto3.CopyDone) (stop bool, end
 // COPY DATA messages are expected, and the server should tell the client that it is ready for the next query, and
 // |err| contains any error that occurred while processing the COPY DATA message.
 func (h *ConnectionHandler) handleCopyFail(_ *pgproto3.CopyFail) (stop bool, endOfMessages bool, err error) {
+	// The COPY may have failed before the client received the error, so the remaining copy messages are ignored
 	if h.copyFromStdinState == nil {
-		return false, true,
-			fmt.Errorf("COPY FAIL message received without a COPY FROM STDIN operation in progress")
+		return false, false, nil
 	}
 
 	dataLoader := h.copyFromStdinState.dataLoader
@@ -798,20 +902,29 @@ func (h *ConnectionHandler) deallocatePreparedStatement(name string, preparedSta
 
 // convertBindParameters handles the conversion from bind parameters to variable values.
 func (h *ConnectionHandler) convertBindParameters(types []uint32, formatCodes []int16, values [][]byte) (map[string]sqlparser.Expr, error) {
+	if len(formatCodes) > 1 && len(formatCodes) != len(values) {
+		return nil, pgerror.Newf(pgcode.ProtocolViolation, "bind message has %d parameter formats but %d parameters", len(formatCodes), len(values))
+	}
 	bindings := make(map[string]sqlparser.Expr, len(values))
 	for i := range values {
 		typ := types[i]
-		var bindVarString string
-		// We'll rely on a library to decode each format, which will deal with text and binary representations for us
-		if err := h.pgTypeMap.Scan(typ, formatCodes[i], values[i], &bindVarString); err != nil {
-			return nil, err
-		}
-
 		pgTyp, ok := pgtypes.OidToBuildInDoltgresType[typ]
 		if !ok {
 			return nil, fmt.Errorf("unhandled oid type: %v", typ)
 		}
-		v, err := pgTyp.IoInput(nil, bindVarString)
+		format, err := formatCodeAt(formatCodes, i)
+		if err != nil {
+			return nil, err
+		}
+
+		var v any
+		if values[i] == nil {
+			v = nil
+		} else if format == pgtype.BinaryFormatCode {
+			v, err = pgTyp.IoReceive(nil, values[i])
+		} else {
+			v, err = pgTyp.IoInput(nil, string(values[i]))
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -833,6 +946,7 @@ func (h *ConnectionHandler) query(query ConvertedQuery) error {
 		}
 		return err
 	}
+	h.clearFailedTransaction(query)
 
 	return h.send(makeCommandComplete(query.StatementTag, rowsAffected))
 }
@@ -997,22 +1111,104 @@ func (h *ConnectionHandler) endOfMessages(err error) {
 	if err != nil {
 		h.sendError(err)
 	}
+	status := h.transactionStatus()
+	if err != nil && status == ReadyForQueryTransactionIndicator_TransactionBlock {
+		h.inFailedTransaction = true
+		status = ReadyForQueryTransactionIndicator_FailedTransactionBlock
+	}
+	// Portals only last until the end of the transaction, which has ended if we're no longer within a transaction block
+	if status == ReadyForQueryTransactionIndicator_Idle {
+		h.destroyAllPortals()
+	}
 	if sendErr := h.send(&pgproto3.ReadyForQuery{
-		TxStatus: byte(ReadyForQueryTransactionIndicator_Idle),
+		TxStatus: byte(status),
 	}); sendErr != nil {
 		// We panic here for the same reason as above.
 		panic(sendErr)
 	}
 }
 
+// transactionStatus returns the status of the session's transaction. A failed transaction is reset once the session
+// is no longer within an explicit transaction block.
+func (h *ConnectionHandler) transactionStatus() ReadyForQueryTransactionIndicator {
+	session, err := h.getSession()
+	if err != nil || !session.GetIgnoreAutoCommit() {
+		h.inFailedTransaction = false
+		return ReadyForQueryTransactionIndicator_Idle
+	}
+	if h.inFailedTransaction {
+		return ReadyForQueryTransactionIndicator_FailedTransactionBlock
+	}
+	return ReadyForQueryTransactionIndicator_TransactionBlock
+}
+
+// checkServerFilePrivileges returns an error if the connection's role is neither a superuser, nor has the privileges
+// of the given predefined role, which is required to access files on the server. The action is used in the error.
+func (h *ConnectionHandler) checkServerFilePrivileges(predefinedRole string, action string) error {
+	session, err := h.getSession()
+	if err != nil {
+		return err
+	}
+	var hasPrivileges bool
+	auth.LockRead(func() {
+		role := auth.GetRole(session.Client().User)
+		hasPrivileges = auth.HasPrivilegesOfRole(role.ID(), predefinedRole)
+	})
+	if !hasPrivileges {
+		return pgerror.Newf(pgcode.InsufficientPrivilege,
+			"must be superuser or have privileges of the %s role to %s", predefinedRole, action)
+	}
+	return nil
+}
+
+// getSession returns the connection's session. The session is only retrieved from the session manager the first time
+// that this is called, with the same session being returned afterward.
+func (h *ConnectionHandler) getSession() (sql.Session, error) {
+	if h.session != nil {
+		return h.session, nil
+	}
+	sqlCtx, err := h.doltgresHandler.NewContext(context.Background(), h.mysqlConn, "")
+	if err != nil {
+		return nil, err
+	}
+	h.session = sqlCtx.Session
+	return h.session, nil
+}
+
+// checkFailedTransaction checks whether the given query may run, returning an error if the current transaction has
+// failed and the query does not end the transaction. A COMMIT within a failed transaction is replaced by a ROLLBACK,
+// which is returned in place of the original query.
+func (h *ConnectionHandler) checkFailedTransaction(query ConvertedQuery) (ConvertedQuery, error) {
+	if !h.inFailedTransaction {
+		return query, nil
+	}
+	switch query.AST.(type) {
+	case *sqlparser.Rollback, *sqlparser.RollbackSavepoint:
+		return query, nil
+	case *sqlparser.Commit:
+		return ConvertedQuery{
+			String:       "ROLLBACK",
+			AST:          &sqlparser.Rollback{},
+			StatementTag: "ROLLBACK",
+		}, nil
+	default:
+		return query, pgerror.New(pgcode.InFailedSQLTransaction,
+			"current transaction is aborted, commands ignored until end of transaction block")
+	}
+}
+
+// clearFailedTransaction clears the failed state of the current transaction if the given query, which has executed
+// successfully, rolled back to a savepoint. Rolling back the entire transaction is handled by transactionStatus.
+func (h *ConnectionHandler) clearFailedTransaction(query ConvertedQuery) {
+	if _, ok := query.AST.(*sqlparser.RollbackSavepoint); ok {
+		h.inFailedTransaction = false
+	}
+}
+
 // sendError sends the given error to the client. This should generally never be called directly.
 func (h *ConnectionHandler) sendError(err error) {
 	fmt.Println(err.Error())
-	if sendErr := h.send(&pgproto3.ErrorResponse{
-		Severity: string(ErrorResponseSeverity_Error),
-		Code:     "XX000", // internal_error for now
-		Message:  err.Error(),
-	}); sendErr != nil {
+	if sendErr := h.send(errorResponse(err, ErrorResponseSeverity_Error)); sendErr != nil {
 		// If we're unable to send anything to the connection, then there's something wrong with the connection and
 		// we should terminate it. This will be caught in HandleConnection's defer block.
 		panic(sendErr)
@@ -1051,6 +1247,8 @@ func (h *ConnectionHandler) convertQuery(query string) (ConvertedQuery, error) {
 
 // discardAll handles the DISCARD ALL command
 func (h *ConnectionHandler) discardAll(query ConvertedQuery) error {
+	// The reset replaces the session, so the cached session must be retrieved again
+	h.session = nil
 	err := h.doltgresHandler.ComResetConnection(h.mysqlConn)
 	if err != nil {
 		return err
@@ -1074,12 +1272,191 @@ func (h *ConnectionHandler) handleCopyFromStdinQuery(copyFrom *node.CopyFrom, co
 		return err
 	}
 
+	copyInResponse := &pgproto3.CopyInResponse{
+		OverallFormat: 0,
+	}
+	if copyFrom.CopyOptions.CopyFormat == tree.CopyFormatBinary {
+		// The binary format requires every column to use the binary format code
+		columnCount := len(copyFrom.Columns)
+		if columnCount == 0 {
+			table, err := core.GetSqlTableFromContext(sqlCtx, copyFrom.DatabaseName, copyFrom.TableName)
+			if err != nil {
+				return err
+			}
+			columnCount = len(table.Schema())
+		}
+		copyInResponse.OverallFormat = 1
+		copyInResponse.ColumnFormatCodes = make([]uint16, columnCount)
+		for i := range copyInResponse.ColumnFormatCodes {
+			copyInResponse.ColumnFormatCodes[i] = 1
+		}
+	}
+
+	// The data loader is created before the client begins sending data, so that any errors are returned immediately
+	if err = startTransaction(sqlCtx); err != nil {
+		return err
+	}
+	dataLoader, err := copyFrom.NewDataLoader(sqlCtx, h.doltgresHandler.e.Analyzer.Catalog)
+	if err != nil {
+		return err
+	}
 	h.copyFromStdinState = &copyFromStdinState{
 		copyFromStdinNode: copyFrom,
+		dataLoader:        dataLoader,
 	}
 
-	return h.send(&pgproto3.CopyInResponse{
-		OverallFormat: 0,
+	return h.send(copyInResponse)
+}
+
+// handleCopyFromFile handles the COPY FROM statement when the data is read from a file on the server. The file is
+// loaded at the Doltgres layer using the same DataLoader as COPY FROM STDIN, rather than by the engine.
+func (h *ConnectionHandler) handleCopyFromFile(query ConvertedQuery, copyFrom *node.CopyFrom) error {
+	// Reading a file on the server is restricted, as it's done using the server's own operating system user
+	if err := h.checkServerFilePrivileges("pg_read_server_files", "COPY from a file"); err != nil {
+		return err
+	}
+	sqlCtx, err := h.doltgresHandler.NewContext(context.Background(), h.mysqlConn, query.String)
+	if err != nil {
+		return err
+	}
+	if err = copyFrom.Validate(sqlCtx); err != nil {
+		return err
+	}
+	file, err := os.Open(copyFrom.File)
+	if err != nil {
+		return fmt.Errorf(`could not open file "%s" for reading: %s`, copyFrom.File, err.Error())
+	}
+	defer file.Close()
+
+	if err = startTransaction(sqlCtx); err != nil {
+		return err
+	}
+	dataLoader, err := copyFrom.NewDataLoader(sqlCtx, h.doltgresHandler.e.Analyzer.Catalog)
+	if err != nil {
+		return err
+	}
+	if err = dataLoader.LoadChunk(sqlCtx, bufio.NewReader(file)); err != nil {
+		_ = dataLoader.Abort(sqlCtx)
+		return err
+	}
+	loadDataResults, err := dataLoader.Finish(sqlCtx)
+	if err != nil {
+		return err
+	}
+
+	// If we aren't in an explicit/user managed transaction, we need to commit the transaction
+	if !sqlCtx.GetIgnoreAutoCommit() {
+		txSession, ok := sqlCtx.Session.(sql.TransactionSession)
+		if !ok {
+			return fmt.Errorf("session does not implement sql.TransactionSession")
+		}
+		if err = txSession.CommitTransaction(sqlCtx, txSession.GetTransaction()); err != nil {
+			return err
+		}
+	}
+	return h.send(&pgproto3.CommandComplete{
+		CommandTag: []byte(fmt.Sprintf("COPY %d", loadDataResults.RowsLoaded)),
+	})
+}
+
+// handleCopyTo handles the COPY TO statement at the Doltgres layer, since the rows are either streamed to the client
+// using COPY DATA messages, or written to a file. The rows come from running the statement's query on the engine.
+func (h *ConnectionHandler) handleCopyTo(query ConvertedQuery, copyTo *node.CopyTo) (err error) {
+	var fileWriter *bufio.Writer
+	if !copyTo.Stdout {
+		// Writing to a file on the server is restricted, as it's done using the server's own operating system user
+		if err = h.checkServerFilePrivileges("pg_write_server_files", "COPY to a file"); err != nil {
+			return err
+		}
+		file, openErr := os.Create(copyTo.File)
+		if openErr != nil {
+			return fmt.Errorf(`could not open file "%s" for writing: %s`, copyTo.File, openErr.Error())
+		}
+		defer func() {
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+		fileWriter = bufio.NewWriter(file)
+	}
+	var encoder *encoding.Encoder
+	if copyTo.CopyOptions.Encoding != nil {
+		enc, err := dataloader.LookupEncoding(*copyTo.CopyOptions.Encoding)
+		if err != nil {
+			return err
+		}
+		if enc != nil {
+			encoder = enc.NewEncoder()
+		}
+	}
+	write := func(data []byte) error {
+		if len(data) == 0 {
+			return nil
+		}
+		if encoder != nil {
+			var err error
+			if data, err = encoder.Bytes(data); err != nil {
+				return pgerror.Newf(pgcode.UntranslatableCharacter, `character cannot be converted to encoding "%s"`, *copyTo.CopyOptions.Encoding)
+			}
+		}
+		if fileWriter != nil {
+			_, err := fileWriter.Write(data)
+			return err
+		}
+		return h.send(&pgproto3.CopyData{Data: data})
+	}
+
+	// The columns come from the query's schema, so that they're known even when the query returns no rows
+	analyzedPlan, fields, err := h.doltgresHandler.ComBind(context.Background(), h.mysqlConn, copyTo.QueryString, copyTo.Query, nil, nil)
+	if err != nil {
+		return err
+	}
+	columnNames := make([]string, len(fields))
+	for i, field := range fields {
+		columnNames[i] = string(field.Name)
+	}
+	if err = copyTo.ValidateColumns(columnNames); err != nil {
+		return err
+	}
+	if err = h.sendCopyOutResponse(copyTo, len(columnNames)); err != nil {
+		return err
+	}
+	if err = write(copyTo.EncodeHeader(columnNames)); err != nil {
+		return err
+	}
+	rowCount := int32(0)
+	callback := func(res *Result) error {
+		for _, row := range res.Rows {
+			if err := write(copyTo.EncodeRow(columnNames, row.val)); err != nil {
+				return err
+			}
+			rowCount++
+		}
+		return nil
+	}
+	if err = h.doltgresHandler.ComExecuteBound(context.Background(), h.mysqlConn, copyTo.QueryString, analyzedPlan, nil, callback); err != nil {
+		return err
+	}
+	if fileWriter != nil {
+		if err = fileWriter.Flush(); err != nil {
+			return err
+		}
+	} else if err = h.send(&pgproto3.CopyDone{}); err != nil {
+		return err
+	}
+	return h.send(makeCommandComplete(query.StatementTag, rowCount))
+}
+
+// sendCopyOutResponse sends the COPY OUT RESPONSE message when copying to the client, which informs the client that
+// COPY DATA messages follow. Nothing is sent when copying to a file.
+func (h *ConnectionHandler) sendCopyOutResponse(copyTo *node.CopyTo, columnCount int) error {
+	if !copyTo.Stdout {
+		return nil
+	}
+	// The TEXT and CSV formats are both textual, so every column uses the text format code
+	return h.send(&pgproto3.CopyOutResponse{
+		OverallFormat:     0,
+		ColumnFormatCodes: make([]uint16, columnCount),
 	})
 }
 
@@ -0,0 +1,86 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pgx/v5/pgproto3"
+)
+
+// Cursor holds the live row iterator of a portal, which allows a portal to return its rows over multiple Execute
+// messages. The iterator is closed once all rows have been read, or when the portal is destroyed.
+type Cursor struct {
+	ctx    *sql.Context
+	cancel context.CancelFunc
+	query  string
+	schema sql.Schema
+	iter   sql.RowIter
+	fields []pgproto3.FieldDescription
+	closed bool
+}
+
+// Next reads up to |maxRows| rows from the cursor, with a |maxRows| of zero reading all remaining rows. |done| is true
+// when the cursor has no more rows, in which case the cursor has been closed. Reading from a closed cursor returns no
+// rows.
+func (c *Cursor) Next(maxRows uint32) (result *Result, done bool, err error) {
+	result = &Result{Fields: c.fields}
+	if c.closed {
+		return result, true, nil
+	}
+	// The query is only in the process list while rows are being read, so that a suspended portal does not hold the
+	// connection's query between messages
+	queryCtx, err := c.ctx.ProcessList.BeginQuery(c.ctx, c.query)
+	if err != nil {
+		_ = c.Close()
+		return nil, true, err
+	}
+	defer c.ctx.ProcessList.EndQuery(queryCtx)
+	for maxRows == 0 || uint32(len(result.Rows)) < maxRows {
+		// The context is canceled when the query is killed, which not every iterator checks on its own
+		if err = queryCtx.Err(); err != nil {
+			_ = c.Close()
+			return nil, true, err
+		}
+		row, err := c.iter.Next(queryCtx)
+		if err == io.EOF {
+			return result, true, c.Close()
+		} else if err != nil {
+			_ = c.Close()
+			return nil, true, err
+		}
+
+		outputRow, err := rowToBytes(queryCtx, c.schema, c.fields, row)
+		if err != nil {
+			_ = c.Close()
+			return nil, true, err
+		}
+		result.Rows = append(result.Rows, Row{outputRow})
+		result.RowsAffected++
+	}
+	return result, false, nil
+}
+
+// Close closes the underlying row iterator and cancels the cursor's context. It is safe to call Close multiple times.
+func (c *Cursor) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	defer c.cancel()
+	return c.iter.Close(c.ctx)
+}
@@ -34,8 +34,12 @@ import (
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/sirupsen/logrus"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	"github.com/dolthub/doltgresql/server/functions"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
@@ -77,7 +81,7 @@ type DoltgresHandler struct {
 var _ Handler = &DoltgresHandler{}
 
 // ComBind implements the Handler interface.
-func (h *DoltgresHandler) ComBind(ctx context.Context, c *mysql.Conn, query string, parsedQuery mysql.ParsedQuery, bindVars map[string]sqlparser.Expr) (mysql.BoundQuery, []pgproto3.FieldDescription, error) {
+func (h *DoltgresHandler) ComBind(ctx context.Context, c *mysql.Conn, query string, parsedQuery mysql.ParsedQuery, bindVars map[string]sqlparser.Expr, formatCodes []int16) (mysql.BoundQuery, []pgproto3.FieldDescription, error) {
 	sqlCtx, err := h.sm.NewContextWithQuery(ctx, c, query)
 	if err != nil {
 		return nil, nil, err
@@ -93,22 +97,58 @@ func (h *DoltgresHandler) ComBind(ctx context.Context, c *mysql.Conn, query stri
 		return nil, nil, err
 	}
 
-	return queryPlan, schemaToFieldDescriptions(sqlCtx, queryPlan.Schema()), nil
+	fields, err := schemaToFieldDescriptions(sqlCtx, queryPlan.Schema(), formatCodes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return queryPlan, fields, nil
 }
 
 // ComExecuteBound implements the Handler interface.
-func (h *DoltgresHandler) ComExecuteBound(ctx context.Context, conn *mysql.Conn, query string, boundQuery mysql.BoundQuery, callback func(*Result) error) error {
+func (h *DoltgresHandler) ComExecuteBound(ctx context.Context, conn *mysql.Conn, query string, boundQuery mysql.BoundQuery, formatCodes []int16, callback func(*Result) error) error {
 	analyzedPlan, ok := boundQuery.(sql.Node)
 	if !ok {
 		return fmt.Errorf("boundQuery must be a sql.Node, but got %T", boundQuery)
 	}
 
-	err := h.doQuery(ctx, conn, query, nil, analyzedPlan, h.executeBoundPlan, callback)
+	return h.doQuery(ctx, conn, query, nil, analyzedPlan, h.executeBoundPlan, formatCodes, callback)
+}
+
+// ComOpenCursor implements the Handler interface.
+func (h *DoltgresHandler) ComOpenCursor(ctx context.Context, conn *mysql.Conn, query string, boundQuery mysql.BoundQuery, formatCodes []int16) (*Cursor, error) {
+	analyzedPlan, ok := boundQuery.(sql.Node)
+	if !ok {
+		return nil, fmt.Errorf("boundQuery must be a sql.Node, but got %T", boundQuery)
+	}
+	sqlCtx, err := h.sm.NewContextWithQuery(ctx, conn, query)
 	if err != nil {
-		err = sql.CastSQLError(err)
+		return nil, err
 	}
+	// The cursor outlives this message, so it has its own context rather than holding the connection's query in the
+	// process list. Each read from the cursor is tracked in the process list instead.
+	cursorCtx, cancel := context.WithCancel(sqlCtx)
+	sqlCtx = sqlCtx.WithContext(cursorCtx)
 
-	return err
+	schema, rowIter, _, err := h.executeBoundPlan(sqlCtx, query, nil, analyzedPlan)
+	if err != nil {
+		cancel()
+		sqlCtx.GetLogger().WithError(err).Warn("error running query")
+		return nil, err
+	}
+	fields, err := schemaToFieldDescriptions(sqlCtx, schema, formatCodes)
+	if err != nil {
+		_ = rowIter.Close(sqlCtx)
+		cancel()
+		return nil, err
+	}
+	return &Cursor{
+		ctx:    sqlCtx,
+		cancel: cancel,
+		query:  query,
+		schema: schema,
+		iter:   rowIter,
+		fields: fields,
+	}, nil
 }
 
 // ComPrepareParsed implements the Handler interface.
@@ -124,7 +164,6 @@ func (h *DoltgresHandler) ComPrepareParsed(ctx context.Context, c *mysql.Conn, q
 			fmt.Printf("unable to prepare query: %+v\n", err)
 		}
 		logrus.WithField("query", query).Errorf("unable to prepare query: %s", err.Error())
-		err := sql.CastSQLError(err)
 		return nil, nil, err
 	}
 
@@ -139,18 +178,18 @@ func (h *DoltgresHandler) ComPrepareParsed(ctx context.Context, c *mysql.Conn, q
 			},
 		}
 	} else {
-		fields = schemaToFieldDescriptions(sqlCtx, analyzed.Schema())
+		// The format codes are not yet known for prepared statements, so all fields use the text format
+		fields, err = schemaToFieldDescriptions(sqlCtx, analyzed.Schema(), nil)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 	return analyzed, fields, nil
 }
 
 // ComQuery implements the Handler interface.
 func (h *DoltgresHandler) ComQuery(ctx context.Context, c *mysql.Conn, query string, parsed sqlparser.Statement, callback func(*Result) error) error {
-	err := h.doQuery(ctx, c, query, parsed, nil, h.executeQuery, callback)
-	if err != nil {
-		err = sql.CastSQLError(err)
-	}
-	return err
+	return h.doQuery(ctx, c, query, parsed, nil, h.executeQuery, nil, callback)
 }
 
 // ComResetConnection implements the Handler interface.
@@ -176,6 +215,7 @@ func (h *DoltgresHandler) ComResetConnection(c *mysql.Conn) error {
 func (h *DoltgresHandler) ConnectionClosed(c *mysql.Conn) {
 	defer h.sm.RemoveConn(c)
 	defer h.e.CloseSession(c.ConnectionID)
+	defer functions.ClearTransactionTimestamp(c.ConnectionID)
 
 	h.maybeReleaseAllLocks(c)
 
@@ -198,11 +238,16 @@ func (h *DoltgresHandler) NewContext(ctx context.Context, c *mysql.Conn, query s
 
 var queryLoggingRegex = regexp.MustCompile(`[\r\n\t ]+`)
 
-func (h *DoltgresHandler) doQuery(ctx context.Context, c *mysql.Conn, query string, parsed sqlparser.Statement, analyzedPlan sql.Node, queryExec QueryExecutor, callback func(*Result) error) error {
+func (h *DoltgresHandler) doQuery(ctx context.Context, c *mysql.Conn, query string, parsed sqlparser.Statement, analyzedPlan sql.Node, queryExec QueryExecutor, formatCodes []int16, callback func(*Result) error) error {
 	sqlCtx, err := h.sm.NewContextWithQuery(ctx, c, query)
 	if err != nil {
 		return err
 	}
+	// Statements outside of an explicit transaction block each start a new transaction, so they reset the time that is
+	// returned by now() and similar functions. Statements within a block keep the time from when the block began.
+	if !sqlCtx.GetIgnoreAutoCommit() {
+		functions.SetTransactionTimestamp(c.ConnectionID, sqlCtx.QueryTime())
+	}
 
 	start := time.Now()
 	var queryStrToLog string
@@ -248,12 +293,17 @@ func (h *DoltgresHandler) doQuery(ctx context.Context, c *mysql.Conn, query stri
 		r, err = resultForOkIter(sqlCtx, rowIter)
 	} else if schema == nil {
 		r, err = resultForEmptyIter(sqlCtx, rowIter)
-	} else if analyzer.FlagIsSet(qFlags, sql.QFlagMax1Row) {
-		resultFields := schemaToFieldDescriptions(sqlCtx, schema)
-		r, err = resultForMax1RowIter(sqlCtx, schema, rowIter, resultFields)
 	} else {
-		resultFields := schemaToFieldDescriptions(sqlCtx, schema)
-		r, processedAtLeastOneBatch, err = h.resultForDefaultIter(sqlCtx, schema, rowIter, callback, resultFields)
+		var resultFields []pgproto3.FieldDescription
+		resultFields, err = schemaToFieldDescriptions(sqlCtx, schema, formatCodes)
+		if err != nil {
+			return err
+		}
+		if analyzer.FlagIsSet(qFlags, sql.QFlagMax1Row) {
+			r, err = resultForMax1RowIter(sqlCtx, schema, rowIter, resultFields)
+		} else {
+			r, processedAtLeastOneBatch, err = h.resultForDefaultIter(sqlCtx, schema, rowIter, callback, resultFields)
+		}
 	}
 	if err != nil {
 		return err
@@ -317,15 +367,31 @@ func nodeReturnsOkResultSchema(node sql.Node) bool {
 	return types.IsOkResultSchema(node.Schema())
 }
 
-func schemaToFieldDescriptions(ctx *sql.Context, s sql.Schema) []pgproto3.FieldDescription {
+// schemaToFieldDescriptions returns the field descriptions for the given schema. The |formatCodes| follow the same rules
+// as the result format codes of a Bind message, so an empty slice means that every field uses the text format.
+func schemaToFieldDescriptions(ctx *sql.Context, s sql.Schema, formatCodes []int16) ([]pgproto3.FieldDescription, error) {
+	if len(formatCodes) > 1 && len(formatCodes) != len(s) {
+		// The Bind message has already been validated against the statement's description. We plan the statement
+		// again on every Bind though, so the schema may have changed since the client described the statement (such
+		// as a column being dropped). The portal's description tells the client which formats are in use, so we fall
+		// back to the text format rather than rejecting the statement.
+		formatCodes = nil
+	}
 	fields := make([]pgproto3.FieldDescription, len(s))
 	for i, c := range s {
 		var oid uint32
 		var err error
-		if doltgresType, ok := c.Type.(pgtypes.DoltgresType); ok {
+		colType := c.Type
+		if _, ok := colType.(pgtypes.ResolvableType); ok {
+			// User-defined types are resolved during analysis, which has not yet happened for statements that are
+			// only being prepared, so we describe them using their text representation.
+			colType = pgtypes.Text
+		}
+		doltgresType, isDoltgresType := colType.(pgtypes.DoltgresType)
+		if isDoltgresType {
 			oid = doltgresType.OID()
 		} else {
-			oid, err = VitessTypeToObjectID(c.Type.Type())
+			oid, err = VitessTypeToObjectID(colType.Type())
 			if err != nil {
 				panic(err)
 			}
@@ -335,19 +401,48 @@ func schemaToFieldDescriptions(ctx *sql.Context, s sql.Schema) []pgproto3.FieldD
 		// Currently, will be zero (text) or one (binary).
 		// In a RowDescription returned from the statement variant of Describe,
 		// the format code is not yet known and will always be zero.
+		format, err := formatCodeAt(formatCodes, i)
+		if err != nil {
+			return nil, err
+		}
+		if !isDoltgresType && format != pgtype.TextFormatCode {
+			// Only our own types define a binary representation, so the value is sent using the built-in type that
+			// matches its OID
+			if _, ok := pgtypes.OidToBuildInDoltgresType[oid]; !ok {
+				return nil, pgerror.Newf(pgcode.FeatureNotSupported, `binary format is not supported for column "%s"`, c.Name)
+			}
+		}
 
 		fields[i] = pgproto3.FieldDescription{
 			Name:                 []byte(c.Name),
 			TableOID:             uint32(0),
 			TableAttributeNumber: uint16(0),
 			DataTypeOID:          oid,
-			DataTypeSize:         int16(c.Type.MaxTextResponseByteLength(ctx)),
+			DataTypeSize:         int16(colType.MaxTextResponseByteLength(ctx)),
 			TypeModifier:         int32(-1), // TODO: used for domain type, which we don't support yet
-			Format:               int16(0),
+			Format:               format,
 		}
 	}
 
-	return fields
+	return fields, nil
+}
+
+// formatCodeAt returns the format code for the value at the given index. Following the rules of the Bind message, no
+// format codes means that every value uses the text format, and a single format code applies to every value.
+func formatCodeAt(formatCodes []int16, index int) (int16, error) {
+	var format int16
+	switch len(formatCodes) {
+	case 0:
+		return pgtype.TextFormatCode, nil
+	case 1:
+		format = formatCodes[0]
+	default:
+		format = formatCodes[index]
+	}
+	if format != pgtype.TextFormatCode && format != pgtype.BinaryFormatCode {
+		return 0, pgerror.Newf(pgcode.InvalidParameterValue, "unsupported format code: %d", format)
+	}
+	return format, nil
 }
 
 // resultForOkIter reads a maximum of one result row from a result iterator.
@@ -400,7 +495,7 @@ func resultForMax1RowIter(ctx *sql.Context, schema sql.Schema, iter sql.RowIter,
 		return nil, err
 	}
 
-	outputRow, err := rowToBytes(ctx, schema, row)
+	outputRow, err := rowToBytes(ctx, schema, resultFields, row)
 	if err != nil {
 		return nil, err
 	}
@@ -501,7 +596,7 @@ func (h *DoltgresHandler) resultForDefaultIter(ctx *sql.Context, schema sql.Sche
 					continue
 				}
 
-				outputRow, err := rowToBytes(ctx, schema, row)
+				outputRow, err := rowToBytes(ctx, schema, resultFields, row)
 				if err != nil {
 					return err
 				}
@@ -540,7 +635,8 @@ func (h *DoltgresHandler) resultForDefaultIter(ctx *sql.Context, schema sql.Sche
 	return
 }
 
-func rowToBytes(ctx *sql.Context, s sql.Schema, row sql.Row) ([][]byte, error) {
+// rowToBytes converts each value of the given row to its wire representation, using the format of its field.
+func rowToBytes(ctx *sql.Context, s sql.Schema, fields []pgproto3.FieldDescription, row sql.Row) ([][]byte, error) {
 	if len(row) == 0 {
 		return nil, nil
 	}
@@ -552,6 +648,12 @@ func rowToBytes(ctx *sql.Context, s sql.Schema, row sql.Row) ([][]byte, error) {
 	for i, v := range row {
 		if v == nil {
 			o[i] = nil
+		} else if fields[i].Format == pgtype.BinaryFormatCode {
+			val, err := valueToBinary(ctx, s[i].Type, fields[i].DataTypeOID, v)
+			if err != nil {
+				return nil, err
+			}
+			o[i] = val
 		} else {
 			val, err := s[i].Type.SQL(ctx, []byte{}, v)
 			if err != nil {
@@ -562,3 +664,25 @@ func rowToBytes(ctx *sql.Context, s sql.Schema, row sql.Row) ([][]byte, error) {
 	}
 	return o, nil
 }
+
+// valueToBinary returns the binary representation of the given value. Values of types that are not our own, such as
+// those from Dolt's system tables, are read from their text representation by the built-in type that matches the OID
+// of their field, which then defines their binary representation.
+func valueToBinary(ctx *sql.Context, typ sql.Type, typOid uint32, val any) ([]byte, error) {
+	if doltgresType, ok := typ.(pgtypes.DoltgresType); ok {
+		return doltgresType.IoSend(ctx, val)
+	}
+	builtInType, ok := pgtypes.OidToBuildInDoltgresType[typOid]
+	if !ok {
+		return nil, fmt.Errorf("binary format is not supported for type %s", typ.String())
+	}
+	text, err := typ.SQL(ctx, []byte{}, val)
+	if err != nil {
+		return nil, err
+	}
+	builtInVal, err := builtInType.IoInput(ctx, text.ToString())
+	if err != nil {
+		return nil, err
+	}
+	return builtInType.IoSend(ctx, builtInVal)
+}
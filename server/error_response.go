@@ -0,0 +1,387 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	stderrors "errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	cerrors "github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// errorKindMapping maps an error kind to its SQLSTATE. If |fields| is set, then it is given the arguments that were
+// used to construct the error, so that it may fill in any additional fields of the response.
+type errorKindMapping struct {
+	kind   *errors.Kind
+	code   pgcode.Code
+	fields func(args []string, response *pgproto3.ErrorResponse)
+}
+
+// errorKindMappings contains the mappings for all known error kinds. The first matching kind is used, so more specific
+// kinds should come before more general ones.
+var errorKindMappings = []errorKindMapping{
+	{kind: sql.ErrPrimaryKeyViolation, code: pgcode.UniqueViolation},
+	{kind: sql.ErrUniqueKeyViolation, code: pgcode.UniqueViolation},
+	{kind: sql.ErrDuplicateEntry, code: pgcode.UniqueViolation, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.ConstraintName = args[0]
+	}},
+	{kind: sql.ErrForeignKeyChildViolation, code: pgcode.ForeignKeyViolation, fields: foreignKeyViolationFields(false)},
+	{kind: sql.ErrForeignKeyParentViolation, code: pgcode.ForeignKeyViolation, fields: foreignKeyViolationFields(true)},
+	{kind: sql.ErrTruncateReferencedFromForeignKey, code: pgcode.FeatureNotSupported, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.Detail = fmt.Sprintf(`Table "%s" references "%s".`, args[2], args[0])
+		response.Hint = fmt.Sprintf(`Truncate table "%s" at the same time, or use TRUNCATE ... CASCADE.`, args[2])
+		response.TableName = args[0]
+		response.ConstraintName = args[1]
+	}},
+	{kind: sql.ErrInsertIntoNonNullableProvidedNull, code: pgcode.NotNullViolation, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.ColumnName = args[0]
+	}},
+	{kind: sql.ErrInsertIntoNonNullableDefaultNullColumn, code: pgcode.NotNullViolation, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.ColumnName = args[0]
+	}},
+	{kind: sql.ErrCheckConstraintViolated, code: pgcode.CheckViolation, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.ConstraintName = args[0]
+	}},
+	{kind: sql.ErrTableNotFound, code: pgcode.UndefinedTable, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.TableName = args[0]
+	}},
+	{kind: sql.ErrViewDoesNotExist, code: pgcode.UndefinedTable, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.SchemaName = args[0]
+		response.TableName = args[1]
+	}},
+	{kind: sql.ErrTableAlreadyExists, code: pgcode.DuplicateRelation, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.TableName = args[0]
+	}},
+	{kind: sql.ErrTableColumnNotFound, code: pgcode.UndefinedColumn, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.TableName = args[0]
+		response.ColumnName = args[1]
+	}},
+	{kind: sql.ErrColumnNotFound, code: pgcode.UndefinedColumn, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.ColumnName = args[0]
+	}},
+	{kind: sql.ErrUnknownColumn, code: pgcode.UndefinedColumn, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.ColumnName = args[0]
+	}},
+	{kind: sql.ErrKeyColumnDoesNotExist, code: pgcode.UndefinedColumn},
+	{kind: sql.ErrUnknownIndexColumn, code: pgcode.UndefinedColumn},
+	{kind: sql.ErrColumnExists, code: pgcode.DuplicateColumn, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.ColumnName = args[0]
+	}},
+	{kind: sql.ErrDuplicateColumn, code: pgcode.DuplicateColumn, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.ColumnName = args[0]
+	}},
+	{kind: sql.ErrColumnSpecifiedTwice, code: pgcode.DuplicateColumn},
+	{kind: sql.ErrAmbiguousColumnName, code: pgcode.AmbiguousColumn, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.ColumnName = args[0]
+	}},
+	{kind: sql.ErrDuplicateAliasOrTable, code: pgcode.DuplicateAlias},
+	{kind: sql.ErrDuplicateKey, code: pgcode.DuplicateRelation},
+	{kind: sql.ErrDatabaseNotFound, code: pgcode.InvalidCatalogName},
+	{kind: sql.ErrDatabaseExists, code: pgcode.DuplicateDatabase},
+	{kind: sql.ErrDatabaseSchemaNotFound, code: pgcode.InvalidSchemaName, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.SchemaName = args[0]
+	}},
+	{kind: sql.ErrDatabaseSchemaExists, code: pgcode.DuplicateSchema, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.SchemaName = args[0]
+	}},
+	{kind: sql.ErrFunctionNotFound, code: pgcode.UndefinedFunction},
+	{kind: sql.ErrStoredProcedureDoesNotExist, code: pgcode.UndefinedFunction},
+	{kind: sql.ErrTriggerDoesNotExist, code: pgcode.UndefinedObject},
+	{kind: sql.ErrForeignKeyNotFound, code: pgcode.UndefinedObject, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.ConstraintName = args[0]
+		response.TableName = args[1]
+	}},
+	{kind: pgtypes.ErrTypeDoesNotExist, code: pgcode.UndefinedObject, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.DataTypeName = args[0]
+	}},
+	{kind: pgtypes.ErrTypeAlreadyExists, code: pgcode.DuplicateObject, fields: func(args []string, response *pgproto3.ErrorResponse) {
+		response.DataTypeName = args[0]
+	}},
+	{kind: sql.ErrExpectedSingleRow, code: pgcode.CardinalityViolation},
+	{kind: sql.ErrInvalidOperandColumns, code: pgcode.Syntax},
+	{kind: sql.ErrNonAggregatedColumnWithoutGroupBy, code: pgcode.Grouping},
+	{kind: sql.ErrValueOutOfRange, code: pgcode.NumericValueOutOfRange},
+	{kind: gmstypes.ErrLengthBeyondLimit, code: pgcode.StringDataRightTruncation},
+	{kind: sql.ErrInvalidValue, code: pgcode.InvalidTextRepresentation},
+	{kind: sql.ErrReadOnlyTransaction, code: pgcode.ReadOnlySQLTransaction},
+	{kind: sql.ErrReadOnly, code: pgcode.ReadOnlySQLTransaction},
+	{kind: sql.ErrLockDeadlock, code: pgcode.DeadlockDetected},
+	{kind: sql.ErrSyntaxError, code: pgcode.Syntax},
+	{kind: sql.ErrUnsupportedSyntax, code: pgcode.FeatureNotSupported},
+	{kind: sql.ErrUnsupportedFeature, code: pgcode.FeatureNotSupported},
+	{kind: sql.ErrDatabaseAccessDeniedForUser, code: pgcode.InsufficientPrivilege},
+	{kind: sql.ErrTableAccessDeniedForUser, code: pgcode.InsufficientPrivilege},
+	{kind: sql.ErrPrivilegeCheckFailed, code: pgcode.InsufficientPrivilege},
+}
+
+// errorSentinelMappings maps sentinel errors, which are compared using errors.Is, to their SQLSTATE.
+var errorSentinelMappings = []struct {
+	err  error
+	code pgcode.Code
+}{
+	{err: dsess.ErrRetryTransaction, code: pgcode.SerializationFailure},
+	{err: dsess.ErrUnresolvedConflictsCommit, code: pgcode.SerializationFailure},
+	{err: dsess.ErrUnresolvedConstraintViolationsCommit, code: pgcode.SerializationFailure},
+}
+
+// errorMessageMappings maps the messages of errors created without a kind, which is common for errors that mirror
+// Postgres' own wording, to their SQLSTATE. These are only consulted when no other mapping matches.
+var errorMessageMappings = []struct {
+	pattern *regexp.Regexp
+	code    pgcode.Code
+}{
+	{pattern: regexp.MustCompile(`^invalid input syntax for`), code: pgcode.InvalidTextRepresentation},
+	{pattern: regexp.MustCompile(`^((date/time|date|time) field value|timestamp|interval|origin) out of range|^value for "[^"]*" in source string is out of range`), code: pgcode.DatetimeFieldOverflow},
+	{pattern: regexp.MustCompile(`^(input|smallint|integer|bigint|numeric|real|double precision) out of range|^value .* is out of range for type`), code: pgcode.NumericValueOutOfRange},
+	{pattern: regexp.MustCompile(`^value too long for type`), code: pgcode.StringDataRightTruncation},
+	{pattern: regexp.MustCompile(`^division by zero`), code: pgcode.DivisionByZero},
+	{pattern: regexp.MustCompile(`^permission denied`), code: pgcode.InsufficientPrivilege},
+	{pattern: regexp.MustCompile(`^role "[^"]*" does not exist`), code: pgcode.UndefinedObject},
+	{pattern: regexp.MustCompile(`^function .* does not exist`), code: pgcode.UndefinedFunction},
+	{pattern: regexp.MustCompile(`^relation "[^"]*" does not exist`), code: pgcode.UndefinedTable},
+	{pattern: regexp.MustCompile(`^prepared statement .* does not exist`), code: pgcode.InvalidSQLStatementName},
+	{pattern: regexp.MustCompile(`^portal .* does not exist`), code: pgcode.InvalidCursorName},
+	{pattern: regexp.MustCompile(`not yet supported`), code: pgcode.FeatureNotSupported},
+}
+
+// kindArgumentsRegexps caches the regular expressions used by kindArguments.
+var kindArgumentsRegexps = make(map[*errors.Kind]*regexp.Regexp)
+
+// formatVerbRegex matches the formatting verbs that are used in error kind messages.
+var formatVerbRegex = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+func init() {
+	for _, mapping := range errorKindMappings {
+		pattern := formatVerbRegex.ReplaceAllStringFunc(regexp.QuoteMeta(mapping.kind.Message), func(verb string) string {
+			if verb == "%%" {
+				return "%"
+			}
+			return "(.*?)"
+		})
+		// QuoteMeta does not escape the percent sign, so the verbs are replaced after quoting
+		kindArgumentsRegexps[mapping.kind] = regexp.MustCompile("^" + pattern + "$")
+	}
+}
+
+// errorResponse translates the given error into an ErrorResponse with the given severity. This maps errors from
+// go-mysql-server, Dolt, and Doltgres to their proper SQLSTATE codes, and fills in any additional fields that may be
+// determined from the error.
+func errorResponse(err error, severity ErrorResponseSeverity) *pgproto3.ErrorResponse {
+	response := &pgproto3.ErrorResponse{
+		Severity: string(severity),
+		Code:     pgcode.Internal.String(),
+		Message:  err.Error(),
+	}
+	// Errors that have been converted to MySQL errors only retain their MySQL error number, so we map from that
+	if sqlErr := (*mysql.SQLError)(nil); stderrors.As(err, &sqlErr) {
+		response.Message = sqlErr.Message
+		response.Code = mysqlErrorNumberToCode(sqlErr.Num).String()
+		return response
+	}
+	// Errors that were created with a Postgres code take precedence, since the code was chosen explicitly. Errors that
+	// occur while writing rows (such as failed checks) are wrapped by the engine, so we look beneath the wrapper.
+	if pgErr := sql.UnwrapError(err); pgerror.HasCandidateCode(pgErr) || cerrors.HasType(pgErr, (*pgerror.Error)(nil)) {
+		response.Code = pgerror.GetPGCode(pgErr).String()
+		response.Detail = cerrors.FlattenDetails(pgErr)
+		response.Hint = cerrors.FlattenHints(pgErr)
+		response.Position = pgerror.GetPosition(pgErr)
+		fillResponseFields(pgErr, response)
+		return response
+	}
+	if code, ok := mapErrorChain(err, response); ok {
+		response.Code = code.String()
+	} else {
+		for _, mapping := range errorSentinelMappings {
+			if stderrors.Is(err, mapping.err) {
+				response.Code = mapping.code.String()
+				break
+			}
+		}
+	}
+	if response.Code == pgcode.Internal.String() {
+		for _, mapping := range errorMessageMappings {
+			if mapping.pattern.MatchString(response.Message) {
+				response.Code = mapping.code.String()
+				break
+			}
+		}
+	}
+	fillResponseFields(err, response)
+	return response
+}
+
+// mapErrorChain walks the chain of errors, returning the code for the first error that matches a known error kind.
+// Any fields that may be determined from the matching error are written to the response.
+func mapErrorChain(err error, response *pgproto3.ErrorResponse) (pgcode.Code, bool) {
+	for err != nil {
+		switch e := err.(type) {
+		case sql.WrappedInsertError:
+			err = e.Cause
+			continue
+		case sql.WrappedTypeConversionError:
+			err = e.Err
+			continue
+		case sql.UniqueKeyError:
+			response.Detail = fmt.Sprintf("Key %s already exists.", formatKeyString(e.Error()))
+			return pgcode.UniqueViolation, true
+		case *errors.Error:
+			for _, mapping := range errorKindMappings {
+				if mapping.kind.Is(e) {
+					// Unique key errors wrap a UniqueKeyError, which contains the details that we're interested in
+					if cause := e.Cause(); cause != nil {
+						if _, ok := mapErrorChain(cause, response); ok {
+							return mapping.code, true
+						}
+					}
+					if mapping.fields != nil {
+						if args := kindArguments(mapping.kind, e); args != nil {
+							mapping.fields(args, response)
+						}
+					}
+					return mapping.code, true
+				}
+			}
+			err = e.Cause()
+			continue
+		}
+		err = stderrors.Unwrap(err)
+	}
+	return pgcode.Code{}, false
+}
+
+// kindArguments returns the arguments that were used to construct the given error of the given kind. Returns nil if
+// the arguments could not be determined.
+func kindArguments(kind *errors.Kind, err *errors.Error) []string {
+	// Find the error within the chain that was directly created by the kind, as the message only applies to it
+	for !kindIsDirect(kind, err) {
+		cause, ok := err.Cause().(*errors.Error)
+		if !ok {
+			return nil
+		}
+		err = cause
+	}
+	message := err.Error()
+	if cause := err.Cause(); cause != nil {
+		message = strings.TrimSuffix(message, ": "+cause.Error())
+	}
+	matches := kindArgumentsRegexps[kind].FindStringSubmatch(message)
+	if matches == nil {
+		return nil
+	}
+	args := matches[1:]
+	for i, arg := range args {
+		if unquoted, uErr := strconv.Unquote(arg); uErr == nil {
+			args[i] = unquoted
+		} else {
+			args[i] = strings.Trim(arg, "`'")
+		}
+	}
+	return args
+}
+
+// kindIsDirect returns whether the given error was created by the given kind, rather than by one of its causes.
+func kindIsDirect(kind *errors.Kind, err *errors.Error) bool {
+	if !kind.Is(err) {
+		return false
+	}
+	cause := err.Cause()
+	return cause == nil || !kind.Is(cause)
+}
+
+// foreignKeyViolationFields returns the function that fills in the fields for foreign key violations. |isParent|
+// determines whether the violation was caused by modifying the parent (referenced) table.
+func foreignKeyViolationFields(isParent bool) func(args []string, response *pgproto3.ErrorResponse) {
+	return func(args []string, response *pgproto3.ErrorResponse) {
+		fkName, tableName, referencedTableName, key := args[0], args[1], args[2], formatKeyString(args[3])
+		response.ConstraintName = fkName
+		if isParent {
+			response.TableName = referencedTableName
+			response.Detail = fmt.Sprintf(`Key %s is still referenced from table "%s".`, key, tableName)
+		} else {
+			response.TableName = tableName
+			response.Detail = fmt.Sprintf(`Key %s is not present in table "%s".`, key, referencedTableName)
+		}
+	}
+}
+
+// formatKeyString converts the bracketed key strings used by go-mysql-server and Dolt into the parenthesized form used
+// by Postgres.
+func formatKeyString(key string) string {
+	if strings.HasPrefix(key, "[") && strings.HasSuffix(key, "]") {
+		key = key[1 : len(key)-1]
+	}
+	return "(" + key + ")"
+}
+
+// fillResponseFields fills in any object-name fields that were attached directly to the error. Fields that were
+// attached directly take precedence over any that were inferred.
+func fillResponseFields(err error, response *pgproto3.ErrorResponse) {
+	if value := pgerror.GetField(err, pgerror.Field_SchemaName); len(value) > 0 {
+		response.SchemaName = value
+	}
+	if value := pgerror.GetField(err, pgerror.Field_TableName); len(value) > 0 {
+		response.TableName = value
+	}
+	if value := pgerror.GetField(err, pgerror.Field_ColumnName); len(value) > 0 {
+		response.ColumnName = value
+	}
+	if value := pgerror.GetField(err, pgerror.Field_DataTypeName); len(value) > 0 {
+		response.DataTypeName = value
+	}
+	if value := pgerror.GetField(err, pgerror.Field_ConstraintName); len(value) > 0 {
+		response.ConstraintName = value
+	}
+}
+
+// mysqlErrorNumberToCode maps MySQL error numbers to their closest SQLSTATE.
+func mysqlErrorNumberToCode(num int) pgcode.Code {
+	switch num {
+	case mysql.ERDupEntry:
+		return pgcode.UniqueViolation
+	case mysql.ErNoReferencedRow2, mysql.ERRowIsReferenced2:
+		return pgcode.ForeignKeyViolation
+	case mysql.ERBadNullError:
+		return pgcode.NotNullViolation
+	case mysql.ERNoSuchTable:
+		return pgcode.UndefinedTable
+	case mysql.ERBadFieldError, mysql.ERKeyColumnDoesNotExist:
+		return pgcode.UndefinedColumn
+	case mysql.ERBadDb:
+		return pgcode.InvalidCatalogName
+	case mysql.ERDbCreateExists:
+		return pgcode.DuplicateDatabase
+	case mysql.ERLockDeadlock:
+		return pgcode.SerializationFailure
+	case mysql.ERSubqueryNo1Row:
+		return pgcode.CardinalityViolation
+	case mysql.ERQueryInterrupted:
+		return pgcode.QueryCanceled
+	default:
+		return pgcode.Internal
+	}
+}
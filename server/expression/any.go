@@ -189,20 +189,28 @@ func (a *expressionAnyExpr) eval(ctx *sql.Context, row sql.Row, left interface{}
 	if len(rightValues) == 0 {
 		return nil, nil
 	}
+	// Multidimensional arrays are compared against every element
+	rightValues = pgtypes.ArrayFlatten(rightValues)
 
 	// Next we'll assign our evaluated values to the expressions that the comparison function reference
 	a.staticLiteral.value = left
+	hasNull := false
 	for _, rightValue := range rightValues {
 		a.arrayLiteral.value = rightValue
 		result, err := a.compFunc.Eval(ctx, row)
 		if err != nil {
 			return nil, err
 		}
-		if result.(bool) {
+		// A NULL comparison makes the result NULL rather than false, unless another comparison is true
+		if result == nil {
+			hasNull = true
+		} else if result.(bool) {
 			return true, nil
 		}
 	}
-
+	if hasNull {
+		return nil, nil
+	}
 	return false, nil
 }
 
@@ -25,10 +25,12 @@ import (
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
-// Array represents an ARRAY[...] expression.
+// Array represents an ARRAY[...] expression. When the elements are themselves arrays, such as ARRAY[[1,2],[3,4]], then
+// this constructs a multidimensional array.
 type Array struct {
-	children    []sql.Expression
-	coercedType pgtypes.DoltgresArrayType
+	children         []sql.Expression
+	coercedType      pgtypes.DoltgresArrayType
+	multidimensional bool
 }
 
 var _ vitess.Injectable = (*Array)(nil)
@@ -55,6 +57,9 @@ func (array *Array) Children() []sql.Expression {
 
 // Eval implements the sql.Expression interface.
 func (array *Array) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	if array.multidimensional {
+		return array.evalMultidimensional(ctx, row)
+	}
 	resultTyp := array.coercedType.BaseType()
 	values := make([]any, len(array.children))
 	for i, expr := range array.children {
@@ -91,6 +96,51 @@ func (array *Array) Eval(ctx *sql.Context, row sql.Row) (any, error) {
 	return values, nil
 }
 
+// evalMultidimensional evaluates an array whose elements are sub-arrays, with each sub-array becoming a new dimension.
+func (array *Array) evalMultidimensional(ctx *sql.Context, row sql.Row) (any, error) {
+	values := make([]any, 0, len(array.children))
+	hasEmpty := false
+	for _, expr := range array.children {
+		val, err := expr.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		doltgresType, ok := expr.Type().(pgtypes.DoltgresType)
+		if !ok {
+			return nil, fmt.Errorf("expected DoltgresType, but got %s", expr.Type().String())
+		}
+		if val != nil {
+			castFunc := framework.GetImplicitCast(doltgresType.BaseID(), array.coercedType.BaseID())
+			if castFunc == nil {
+				if doltgresType.BaseID() == pgtypes.DoltgresTypeBaseID_Unknown {
+					castFunc = framework.UnknownLiteralCast
+				} else {
+					return nil, fmt.Errorf("cannot find cast function from %s to %s", doltgresType.String(), array.coercedType.String())
+				}
+			}
+			if val, err = castFunc(ctx, val, array.coercedType); err != nil {
+				return nil, err
+			}
+		}
+		// NULL and empty sub-arrays are only valid when all of the sub-arrays are NULL or empty
+		if val == nil || len(val.([]any)) == 0 {
+			hasEmpty = true
+			continue
+		}
+		values = append(values, val)
+	}
+	if hasEmpty {
+		if len(values) == 0 {
+			return []any{}, nil
+		}
+		return nil, fmt.Errorf("multidimensional arrays must have array expressions with matching dimensions")
+	}
+	if _, err := pgtypes.ArrayDimensions(values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
 // IsNullable implements the sql.Expression interface.
 func (array *Array) IsNullable() bool {
 	// TODO: verify if this is actually nullable
@@ -132,13 +182,14 @@ func (array *Array) Type() sql.Type {
 
 // WithChildren implements the sql.Expression interface.
 func (array *Array) WithChildren(children ...sql.Expression) (sql.Expression, error) {
-	resultType, err := array.getTargetType(children...)
+	resultType, multidimensional, err := array.getTargetType(children...)
 	if err != nil {
 		return nil, err
 	}
 	return &Array{
-		children:    children,
-		coercedType: resultType,
+		children:         children,
+		coercedType:      resultType,
+		multidimensional: multidimensional,
 	}, nil
 }
 
@@ -155,23 +206,43 @@ func (array *Array) WithResolvedChildren(children []any) (any, error) {
 	return array.WithChildren(newExpressions...)
 }
 
-// getTargetType returns the evaluated type for this expression.
+// getTargetType returns the evaluated type for this expression, along with whether the elements are sub-arrays.
 // Returns the "anyarray" type if the type combination is invalid.
-func (array *Array) getTargetType(children ...sql.Expression) (pgtypes.DoltgresArrayType, error) {
+func (array *Array) getTargetType(children ...sql.Expression) (pgtypes.DoltgresArrayType, bool, error) {
 	var childrenTypes []pgtypes.DoltgresTypeBaseID
+	multidimensional := false
 	for _, child := range children {
 		if child != nil {
 			childType, ok := child.Type().(pgtypes.DoltgresType)
 			if !ok {
 				// We use "anyarray" as the indeterminate/invalid type
-				return pgtypes.AnyArray, nil
+				return pgtypes.AnyArray, false, nil
+			}
+			// The unknown type is also an array type, but it's treated as an element rather than a sub-array
+			if arrayType, ok := childType.(pgtypes.DoltgresArrayType); ok && childType.BaseID() != pgtypes.DoltgresTypeBaseID_Unknown {
+				multidimensional = true
+				childrenTypes = append(childrenTypes, arrayType.BaseType().BaseID())
+			} else {
+				childrenTypes = append(childrenTypes, childType.BaseID())
 			}
-			childrenTypes = append(childrenTypes, childType.BaseID())
 		}
 	}
 	targetType, err := framework.FindCommonType(childrenTypes)
 	if err != nil {
-		return nil, fmt.Errorf("ARRAY %s", err.Error())
+		return nil, false, fmt.Errorf("ARRAY %s", err.Error())
+	}
+	if multidimensional {
+		// Any elements that are not arrays must be unknown literals, which are parsed as array literals
+		for _, child := range children {
+			if child == nil {
+				continue
+			}
+			childType := child.Type().(pgtypes.DoltgresType)
+			if _, ok := childType.(pgtypes.DoltgresArrayType); !ok {
+				return nil, false, fmt.Errorf("ARRAY types %s and %s cannot be matched",
+					targetType.GetRepresentativeType().ToArrayType().String(), childType.String())
+			}
+		}
 	}
-	return targetType.GetRepresentativeType().ToArrayType(), nil
+	return targetType.GetRepresentativeType().ToArrayType(), multidimensional, nil
 }
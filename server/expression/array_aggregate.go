@@ -0,0 +1,151 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// ArrayAggregate converts the result of GMS's JSON_ARRAYAGG aggregate function into a Doltgres array, which is used to
+// implement array_agg. The aggregate's result has a json type, so the element type is taken from a second aggregate
+// (ANY_VALUE) over the same argument, which is never evaluated. Aggregating arrays produces an array with an
+// additional dimension.
+type ArrayAggregate struct {
+	child       sql.Expression
+	typeChild   sql.Expression
+	elementType pgtypes.DoltgresType
+}
+
+var _ vitess.Injectable = (*ArrayAggregate)(nil)
+var _ sql.Expression = (*ArrayAggregate)(nil)
+
+// NewArrayAggregate returns a new *ArrayAggregate.
+func NewArrayAggregate() *ArrayAggregate {
+	return &ArrayAggregate{
+		child:       nil,
+		typeChild:   nil,
+		elementType: nil,
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (a *ArrayAggregate) Children() []sql.Expression {
+	return []sql.Expression{a.child, a.typeChild}
+}
+
+// Eval implements the sql.Expression interface.
+func (a *ArrayAggregate) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	val, err := a.child.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	doc, ok := val.(types.JSONDocument)
+	if !ok {
+		return nil, fmt.Errorf("unexpected aggregate result of type `%T`", val)
+	}
+	values, ok := doc.Val.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected aggregate result of type `%T`", doc.Val)
+	}
+	// Aggregating zero rows returns NULL
+	if len(values) == 0 {
+		return nil, nil
+	}
+	if _, ok = a.elementType.(pgtypes.DoltgresArrayType); ok && a.elementType.BaseID() != pgtypes.DoltgresTypeBaseID_Unknown {
+		for _, value := range values {
+			if value == nil {
+				return nil, fmt.Errorf("cannot accumulate null arrays")
+			} else if len(value.([]any)) == 0 {
+				return nil, fmt.Errorf("cannot accumulate empty arrays")
+			}
+		}
+		if _, err = pgtypes.ArrayDimensions(values); err != nil {
+			return nil, fmt.Errorf("cannot accumulate arrays of different dimensionality")
+		}
+	}
+	return values, nil
+}
+
+// IsNullable implements the sql.Expression interface.
+func (a *ArrayAggregate) IsNullable() bool {
+	return true
+}
+
+// Resolved implements the sql.Expression interface.
+func (a *ArrayAggregate) Resolved() bool {
+	return a.child != nil && a.child.Resolved() && a.typeChild != nil && a.typeChild.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (a *ArrayAggregate) String() string {
+	if a.child == nil {
+		return "ARRAY_AGG(?)"
+	}
+	return a.child.String()
+}
+
+// Type implements the sql.Expression interface.
+func (a *ArrayAggregate) Type() sql.Type {
+	if a.elementType == nil {
+		return pgtypes.AnyArray
+	}
+	// Arrays are aggregated into an array of the same type with an additional dimension
+	if arrayType, ok := a.elementType.(pgtypes.DoltgresArrayType); ok && a.elementType.BaseID() != pgtypes.DoltgresTypeBaseID_Unknown {
+		return arrayType
+	}
+	return a.elementType.ToArrayType()
+}
+
+// WithChildren implements the sql.Expression interface.
+func (a *ArrayAggregate) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 2)
+	}
+	elementType, ok := children[1].Type().(pgtypes.DoltgresType)
+	if !ok {
+		return nil, fmt.Errorf("could not determine polymorphic type because input has type unknown")
+	}
+	// Unknown literals are treated as text, which shares the same value representation
+	if elementType.BaseID() == pgtypes.DoltgresTypeBaseID_Unknown {
+		elementType = pgtypes.Text
+	}
+	return &ArrayAggregate{
+		child:       children[0],
+		typeChild:   children[1],
+		elementType: elementType,
+	}, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (a *ArrayAggregate) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 2 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `2` but got `%d`", len(children))
+	}
+	newExpressions := make([]sql.Expression, len(children))
+	for i, resolvedChild := range children {
+		resolvedExpression, ok := resolvedChild.(sql.Expression)
+		if !ok {
+			return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", resolvedChild)
+		}
+		newExpressions[i] = resolvedExpression
+	}
+	return a.WithChildren(newExpressions...)
+}
@@ -54,6 +54,12 @@ func (ac *AssignmentCast) Eval(ctx *sql.Context, row sql.Row) (any, error) {
 	if err != nil || val == nil {
 		return val, err
 	}
+	if compositeType, ok := ac.fromType.(pgtypes.CompositeType); ok {
+		return castFromComposite(ctx, val, compositeType, ac.toType, framework.GetAssignmentCast)
+	}
+	if rangeType, ok := ac.fromType.(pgtypes.RangeType); ok {
+		return castFromRange(ctx, val, rangeType, ac.toType)
+	}
 	castFunc := framework.GetAssignmentCast(ac.fromType.BaseID(), ac.toType.BaseID())
 	if castFunc == nil {
 		if ac.fromType.BaseID() == pgtypes.DoltgresTypeBaseID_Unknown {
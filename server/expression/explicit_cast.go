@@ -56,6 +56,11 @@ func NewExplicitCast(expr sql.Expression, toType pgtypes.DoltgresType) *Explicit
 	}
 }
 
+// CastToType returns the type that the child is being cast to.
+func (c *ExplicitCast) CastToType() pgtypes.DoltgresType {
+	return c.castToType
+}
+
 // Children implements the sql.Expression interface.
 func (c *ExplicitCast) Children() []sql.Expression {
 	return []sql.Expression{c.sqlChild}
@@ -86,6 +91,12 @@ func (c *ExplicitCast) Eval(ctx *sql.Context, row sql.Row) (any, error) {
 	if val == nil {
 		return nil, nil
 	}
+	if compositeType, ok := fromType.(pgtypes.CompositeType); ok {
+		return castFromComposite(ctx, val, compositeType, c.castToType, framework.GetExplicitCast)
+	}
+	if rangeType, ok := fromType.(pgtypes.RangeType); ok {
+		return castFromRange(ctx, val, rangeType, c.castToType)
+	}
 
 	castFunction := framework.GetExplicitCast(fromType.BaseID(), c.castToType.BaseID())
 	if castFunction == nil {
@@ -129,6 +140,9 @@ func (c *ExplicitCast) Resolved() bool {
 
 // String implements the sql.Expression interface.
 func (c *ExplicitCast) String() string {
+	if c.sqlChild == nil {
+		return "?::" + c.castToType.String()
+	}
 	return c.sqlChild.String() + "::" + c.castToType.String()
 }
 
@@ -162,3 +176,22 @@ func (c *ExplicitCast) WithResolvedChildren(children []any) (any, error) {
 		castToType: c.castToType,
 	}, nil
 }
+
+// castFromRange casts the given range value to the target type. All range types share the same base ID, so the cast
+// functions cannot distinguish between them, and therefore ranges are handled here.
+func castFromRange(ctx *sql.Context, val any, fromType pgtypes.RangeType, toType pgtypes.DoltgresType) (any, error) {
+	if val == nil {
+		return nil, nil
+	}
+	if toType.BaseID().GetTypeCategory() == pgtypes.TypeCategory_StringTypes {
+		str, err := fromType.IoOutput(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		return toType.IoInput(ctx, str)
+	}
+	if toRange, ok := toType.(pgtypes.RangeType); ok && toRange.Oid == fromType.Oid {
+		return val, nil
+	}
+	return nil, fmt.Errorf("cannot cast type %s to %s", fromType.String(), toType.String())
+}
@@ -0,0 +1,231 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// FieldAccess represents the (E).x expression, which selects a single attribute from a composite value.
+type FieldAccess struct {
+	child     sql.Expression
+	fieldName string
+}
+
+var _ vitess.Injectable = (*FieldAccess)(nil)
+var _ sql.Expression = (*FieldAccess)(nil)
+
+// NewFieldAccessInjectable returns an incomplete *FieldAccess that must be resolved through the vitess.Injectable
+// interface.
+func NewFieldAccessInjectable(fieldName string) *FieldAccess {
+	return &FieldAccess{
+		child:     nil,
+		fieldName: fieldName,
+	}
+}
+
+// NewFieldAccess returns a new *FieldAccess expression.
+func NewFieldAccess(child sql.Expression, fieldName string) (*FieldAccess, error) {
+	fa := &FieldAccess{
+		child:     child,
+		fieldName: fieldName,
+	}
+	if hasUnresolvedType(child) {
+		// The type will be validated once it has been resolved
+		return fa, nil
+	}
+	if _, _, err := fa.attribute(); err != nil {
+		return nil, err
+	}
+	return fa, nil
+}
+
+// Children implements the sql.Expression interface.
+func (f *FieldAccess) Children() []sql.Expression {
+	return []sql.Expression{f.child}
+}
+
+// Eval implements the sql.Expression interface.
+func (f *FieldAccess) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	idx, _, err := f.attribute()
+	if err != nil {
+		return nil, err
+	}
+	val, err := f.child.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	vals, ok := val.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a composite value but received `%T`", val)
+	}
+	if idx >= len(vals) {
+		return nil, nil
+	}
+	return vals[idx], nil
+}
+
+// FieldName returns the name of the attribute that is being accessed.
+func (f *FieldAccess) FieldName() string {
+	return f.fieldName
+}
+
+// IsNullable implements the sql.Expression interface.
+func (f *FieldAccess) IsNullable() bool {
+	return true
+}
+
+// Resolved implements the sql.Expression interface.
+func (f *FieldAccess) Resolved() bool {
+	return f.child != nil && f.child.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (f *FieldAccess) String() string {
+	if f.child == nil {
+		return fmt.Sprintf("(?).%s", f.fieldName)
+	}
+	return fmt.Sprintf("(%s).%s", f.child.String(), f.fieldName)
+}
+
+// Type implements the sql.Expression interface.
+func (f *FieldAccess) Type() sql.Type {
+	_, attrType, err := f.attribute()
+	if err != nil {
+		return pgtypes.Unknown
+	}
+	return attrType
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *FieldAccess) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewFieldAccess(children[0], f.fieldName)
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (f *FieldAccess) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 1 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `1` but got `%d`", len(children))
+	}
+	resolvedExpression, ok := children[0].(sql.Expression)
+	if !ok {
+		return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", children[0])
+	}
+	return NewFieldAccess(resolvedExpression, f.fieldName)
+}
+
+// attribute returns the index and type of the accessed attribute.
+func (f *FieldAccess) attribute() (int, pgtypes.DoltgresType, error) {
+	compositeType, ok := f.child.Type().(pgtypes.CompositeType)
+	if !ok {
+		return 0, nil, fmt.Errorf("column notation .%s applied to type %s, which is not a composite type", f.fieldName, f.child.Type().String())
+	}
+	idx := compositeType.AttributeIndex(f.fieldName)
+	if idx == -1 {
+		return 0, nil, fmt.Errorf(`column "%s" not found in data type %s`, f.fieldName, compositeType.String())
+	}
+	return idx, compositeType.Attributes[idx].Type, nil
+}
+
+// hasUnresolvedType returns whether the type of the given expression depends on a type that has not yet been resolved.
+func hasUnresolvedType(expr sql.Expression) bool {
+	switch expr := expr.(type) {
+	case *FieldAccess:
+		return hasUnresolvedType(expr.child)
+	default:
+		_, ok := expr.Type().(pgtypes.ResolvableType)
+		return ok
+	}
+}
+
+// FieldStar represents the (E).* expression, which expands to every attribute of a composite value. This is expanded
+// into a FieldAccess for each attribute by the analyzer, and therefore cannot be evaluated directly.
+type FieldStar struct {
+	child sql.Expression
+}
+
+var _ vitess.Injectable = (*FieldStar)(nil)
+var _ sql.Expression = (*FieldStar)(nil)
+
+// NewFieldStarInjectable returns an incomplete *FieldStar that must be resolved through the vitess.Injectable interface.
+func NewFieldStarInjectable() *FieldStar {
+	return &FieldStar{}
+}
+
+// Child returns the expression whose attributes will be expanded.
+func (f *FieldStar) Child() sql.Expression {
+	return f.child
+}
+
+// Children implements the sql.Expression interface.
+func (f *FieldStar) Children() []sql.Expression {
+	return []sql.Expression{f.child}
+}
+
+// Eval implements the sql.Expression interface.
+func (f *FieldStar) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	return nil, fmt.Errorf("(E).* is only supported in the select list")
+}
+
+// IsNullable implements the sql.Expression interface.
+func (f *FieldStar) IsNullable() bool {
+	return true
+}
+
+// Resolved implements the sql.Expression interface.
+func (f *FieldStar) Resolved() bool {
+	return f.child != nil && f.child.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (f *FieldStar) String() string {
+	if f.child == nil {
+		return "(?).*"
+	}
+	return fmt.Sprintf("(%s).*", f.child.String())
+}
+
+// Type implements the sql.Expression interface.
+func (f *FieldStar) Type() sql.Type {
+	return f.child.Type()
+}
+
+// WithChildren implements the sql.Expression interface.
+func (f *FieldStar) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return &FieldStar{child: children[0]}, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (f *FieldStar) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 1 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `1` but got `%d`", len(children))
+	}
+	resolvedExpression, ok := children[0].(sql.Expression)
+	if !ok {
+		return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", children[0])
+	}
+	return &FieldStar{child: resolvedExpression}, nil
+}
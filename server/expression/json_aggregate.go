@@ -0,0 +1,165 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// JsonAggregate converts the result of GMS's JSON_ARRAYAGG and JSON_OBJECTAGG aggregate functions into a Doltgres json
+// or jsonb value. This is used to implement json_agg, jsonb_agg, and jsonb_object_agg, whose aggregated values have
+// already been converted to json or jsonb by the child aggregate's argument (using to_json or to_jsonb).
+type JsonAggregate struct {
+	child sql.Expression
+	jsonb bool
+}
+
+var _ vitess.Injectable = (*JsonAggregate)(nil)
+var _ sql.Expression = (*JsonAggregate)(nil)
+
+// NewJsonAggregate returns a new *JsonAggregate. Returns jsonb if jsonb is true, else returns json.
+func NewJsonAggregate(jsonb bool) *JsonAggregate {
+	return &JsonAggregate{
+		child: nil,
+		jsonb: jsonb,
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (j *JsonAggregate) Children() []sql.Expression {
+	return []sql.Expression{j.child}
+}
+
+// Eval implements the sql.Expression interface.
+func (j *JsonAggregate) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	val, err := j.child.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	doc, ok := val.(types.JSONDocument)
+	if !ok {
+		return nil, fmt.Errorf("unexpected aggregate result of type `%T`", val)
+	}
+	switch aggVal := doc.Val.(type) {
+	case []any:
+		// Aggregating zero rows returns NULL
+		if len(aggVal) == 0 {
+			return nil, nil
+		}
+		if j.jsonb {
+			array := make(pgtypes.JsonValueArray, len(aggVal))
+			for i, element := range aggVal {
+				if element == nil {
+					array[i] = pgtypes.JsonValueNull(0)
+				} else {
+					array[i] = element.(pgtypes.JsonDocument).Value
+				}
+			}
+			return pgtypes.JsonDocument{Value: array}, nil
+		}
+		sb := strings.Builder{}
+		sb.WriteRune('[')
+		for i, element := range aggVal {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			if element == nil {
+				sb.WriteString("null")
+			} else {
+				sb.WriteString(element.(string))
+			}
+		}
+		sb.WriteRune(']')
+		return sb.String(), nil
+	case map[string]any:
+		if !j.jsonb {
+			return nil, fmt.Errorf("json_object_agg is not yet supported")
+		}
+		keys := make([]string, 0, len(aggVal))
+		for key := range aggVal {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		items := make([]pgtypes.JsonValueObjectItem, len(keys))
+		for i, key := range keys {
+			items[i].Key = key
+			if value := aggVal[key]; value == nil {
+				items[i].Value = pgtypes.JsonValueNull(0)
+			} else {
+				items[i].Value = value.(pgtypes.JsonDocument).Value
+			}
+		}
+		return pgtypes.JsonDocument{Value: pgtypes.NewJsonValueObject(items)}, nil
+	default:
+		return nil, fmt.Errorf("unexpected aggregate result of type `%T`", doc.Val)
+	}
+}
+
+// IsNullable implements the sql.Expression interface.
+func (j *JsonAggregate) IsNullable() bool {
+	return true
+}
+
+// Resolved implements the sql.Expression interface.
+func (j *JsonAggregate) Resolved() bool {
+	return j.child != nil && j.child.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (j *JsonAggregate) String() string {
+	if j.child == nil {
+		return "JSON_AGGREGATE(?)"
+	}
+	return j.child.String()
+}
+
+// Type implements the sql.Expression interface.
+func (j *JsonAggregate) Type() sql.Type {
+	if j.jsonb {
+		return pgtypes.JsonB
+	}
+	return pgtypes.Json
+}
+
+// WithChildren implements the sql.Expression interface.
+func (j *JsonAggregate) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(children), 1)
+	}
+	return &JsonAggregate{
+		child: children[0],
+		jsonb: j.jsonb,
+	}, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (j *JsonAggregate) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 1 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `1` but got `%d`", len(children))
+	}
+	child, ok := children[0].(sql.Expression)
+	if !ok {
+		return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", children[0])
+	}
+	return j.WithChildren(child)
+}
@@ -0,0 +1,237 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// JsonBuild represents the json_build_array, jsonb_build_array, json_build_object, and jsonb_build_object functions.
+// These are variadic functions that accept arguments of any type, and each argument is converted according to its own
+// type, so they're implemented as expressions rather than as functions.
+type JsonBuild struct {
+	children   []sql.Expression
+	childTypes []pgtypes.DoltgresType
+	object     bool
+	jsonb      bool
+}
+
+var _ vitess.Injectable = (*JsonBuild)(nil)
+var _ sql.Expression = (*JsonBuild)(nil)
+
+// NewJsonBuildArray returns a new *JsonBuild that builds an array. Returns jsonb if jsonb is true, else returns json.
+func NewJsonBuildArray(jsonb bool) *JsonBuild {
+	return &JsonBuild{
+		object: false,
+		jsonb:  jsonb,
+	}
+}
+
+// NewJsonBuildObject returns a new *JsonBuild that builds an object from alternating keys and values. Returns jsonb if
+// jsonb is true, else returns json.
+func NewJsonBuildObject(jsonb bool) *JsonBuild {
+	return &JsonBuild{
+		object: true,
+		jsonb:  jsonb,
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (j *JsonBuild) Children() []sql.Expression {
+	return j.children
+}
+
+// Eval implements the sql.Expression interface.
+func (j *JsonBuild) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	values := make([]any, len(j.children))
+	for i, child := range j.children {
+		var err error
+		values[i], err = child.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !j.object {
+		if j.jsonb {
+			array := make(pgtypes.JsonValueArray, len(values))
+			for i, value := range values {
+				var err error
+				if array[i], err = pgtypes.JsonValueFromValue(ctx, j.childTypes[i], value); err != nil {
+					return nil, err
+				}
+			}
+			return pgtypes.JsonDocument{Value: array}, nil
+		}
+		sb := strings.Builder{}
+		sb.WriteRune('[')
+		for i, value := range values {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			str, err := pgtypes.JsonTextFromValue(ctx, j.childTypes[i], value)
+			if err != nil {
+				return nil, err
+			}
+			sb.WriteString(str)
+		}
+		sb.WriteRune(']')
+		return sb.String(), nil
+	}
+
+	if len(values)%2 != 0 {
+		return nil, fmt.Errorf("argument list must have even number of elements")
+	}
+	keys := make([]string, len(values)/2)
+	for i := range keys {
+		key, keyType := values[i*2], j.childTypes[i*2]
+		if key == nil {
+			return nil, fmt.Errorf("argument %d cannot be null", i*2+1)
+		}
+		switch keyType.(type) {
+		case pgtypes.DoltgresArrayType, pgtypes.CompositeType:
+			return nil, fmt.Errorf("key value must be scalar, not array, composite, or json")
+		}
+		switch keyType.BaseID() {
+		case pgtypes.DoltgresTypeBaseID_Json, pgtypes.DoltgresTypeBaseID_JsonB:
+			return nil, fmt.Errorf("key value must be scalar, not array, composite, or json")
+		}
+		var err error
+		if keys[i], err = keyType.IoOutput(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+	if j.jsonb {
+		items := make([]pgtypes.JsonValueObjectItem, len(keys))
+		for i, key := range keys {
+			value, err := pgtypes.JsonValueFromValue(ctx, j.childTypes[i*2+1], values[i*2+1])
+			if err != nil {
+				return nil, err
+			}
+			items[i] = pgtypes.JsonValueObjectItem{Key: key, Value: value}
+		}
+		return pgtypes.JsonDocument{Value: pgtypes.NewJsonValueObject(items)}, nil
+	}
+	sb := strings.Builder{}
+	sb.WriteRune('{')
+	for i, key := range keys {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		keyStr, err := pgtypes.JsonTextFromValue(ctx, pgtypes.Text, key)
+		if err != nil {
+			return nil, err
+		}
+		valueStr, err := pgtypes.JsonTextFromValue(ctx, j.childTypes[i*2+1], values[i*2+1])
+		if err != nil {
+			return nil, err
+		}
+		sb.WriteString(keyStr)
+		sb.WriteString(" : ")
+		sb.WriteString(valueStr)
+	}
+	sb.WriteRune('}')
+	return sb.String(), nil
+}
+
+// IsNullable implements the sql.Expression interface.
+func (j *JsonBuild) IsNullable() bool {
+	return false
+}
+
+// Resolved implements the sql.Expression interface.
+func (j *JsonBuild) Resolved() bool {
+	for _, child := range j.children {
+		if child == nil || !child.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements the sql.Expression interface.
+func (j *JsonBuild) String() string {
+	sb := strings.Builder{}
+	if j.jsonb {
+		sb.WriteString("jsonb")
+	} else {
+		sb.WriteString("json")
+	}
+	if j.object {
+		sb.WriteString("_build_object(")
+	} else {
+		sb.WriteString("_build_array(")
+	}
+	for i, child := range j.children {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(child.String())
+	}
+	sb.WriteRune(')')
+	return sb.String()
+}
+
+// Type implements the sql.Expression interface.
+func (j *JsonBuild) Type() sql.Type {
+	if j.jsonb {
+		return pgtypes.JsonB
+	}
+	return pgtypes.Json
+}
+
+// WithChildren implements the sql.Expression interface.
+func (j *JsonBuild) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	childTypes := make([]pgtypes.DoltgresType, len(children))
+	newChildren := make([]sql.Expression, len(children))
+	for i, child := range children {
+		if childType, ok := child.Type().(pgtypes.DoltgresType); ok {
+			newChildren[i] = child
+			childTypes[i] = childType
+			// Unknown literals are treated as text, which shares the same value representation
+			if childType.BaseID() == pgtypes.DoltgresTypeBaseID_Unknown {
+				childTypes[i] = pgtypes.Text
+			}
+		} else {
+			gmsCast := NewGMSCast(child)
+			newChildren[i] = gmsCast
+			childTypes[i] = gmsCast.DoltgresType()
+		}
+	}
+	return &JsonBuild{
+		children:   newChildren,
+		childTypes: childTypes,
+		object:     j.object,
+		jsonb:      j.jsonb,
+	}, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (j *JsonBuild) WithResolvedChildren(children []any) (any, error) {
+	newExpressions := make([]sql.Expression, len(children))
+	for i, resolvedChild := range children {
+		resolvedExpression, ok := resolvedChild.(sql.Expression)
+		if !ok {
+			return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", resolvedChild)
+		}
+		newExpressions[i] = resolvedExpression
+	}
+	return j.WithChildren(newExpressions...)
+}
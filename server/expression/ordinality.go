@@ -0,0 +1,83 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// Ordinality represents the column added by WITH ORDINALITY to a function in the FROM clause, which numbers the rows
+// returned by the function starting from 1. The value is produced by the node that evaluates the set-returning
+// functions, and therefore cannot be evaluated directly.
+type Ordinality struct{}
+
+var _ vitess.Injectable = (*Ordinality)(nil)
+var _ sql.Expression = (*Ordinality)(nil)
+
+// NewOrdinality returns a new *Ordinality.
+func NewOrdinality() *Ordinality {
+	return &Ordinality{}
+}
+
+// Children implements the sql.Expression interface.
+func (o *Ordinality) Children() []sql.Expression {
+	return nil
+}
+
+// Eval implements the sql.Expression interface.
+func (o *Ordinality) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	return nil, fmt.Errorf("WITH ORDINALITY is only supported for functions in the FROM clause")
+}
+
+// IsNullable implements the sql.Expression interface.
+func (o *Ordinality) IsNullable() bool {
+	return false
+}
+
+// Resolved implements the sql.Expression interface.
+func (o *Ordinality) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Expression interface.
+func (o *Ordinality) String() string {
+	return "ordinality"
+}
+
+// Type implements the sql.Expression interface.
+func (o *Ordinality) Type() sql.Type {
+	return pgtypes.Int64
+}
+
+// WithChildren implements the sql.Expression interface.
+func (o *Ordinality) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(o, len(children), 0)
+	}
+	return o, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (o *Ordinality) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return o, nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// Record represents a ROW(...) expression, which evaluates to an anonymous record.
+type Record struct {
+	children   []sql.Expression
+	recordType pgtypes.CompositeType
+}
+
+var _ vitess.Injectable = (*Record)(nil)
+var _ sql.Expression = (*Record)(nil)
+
+// NewRecord returns a new *Record.
+func NewRecord() *Record {
+	return &Record{
+		children:   nil,
+		recordType: pgtypes.NewAnonymousRecordType(nil),
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (r *Record) Children() []sql.Expression {
+	return r.children
+}
+
+// Eval implements the sql.Expression interface.
+func (r *Record) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	values := make([]any, len(r.children))
+	for i, child := range r.children {
+		var err error
+		values[i], err = child.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// IsNullable implements the sql.Expression interface.
+func (r *Record) IsNullable() bool {
+	return false
+}
+
+// Resolved implements the sql.Expression interface.
+func (r *Record) Resolved() bool {
+	for _, child := range r.children {
+		if child == nil || !child.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements the sql.Expression interface.
+func (r *Record) String() string {
+	sb := strings.Builder{}
+	sb.WriteString("ROW(")
+	for i, child := range r.children {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(child.String())
+	}
+	sb.WriteRune(')')
+	return sb.String()
+}
+
+// Type implements the sql.Expression interface.
+func (r *Record) Type() sql.Type {
+	return r.recordType
+}
+
+// WithChildren implements the sql.Expression interface.
+func (r *Record) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	attrTypes := make([]pgtypes.DoltgresType, len(children))
+	newChildren := make([]sql.Expression, len(children))
+	for i, child := range children {
+		if childType, ok := child.Type().(pgtypes.DoltgresType); ok {
+			newChildren[i] = child
+			attrTypes[i] = childType
+			// Unknown literals within a record are treated as text, which shares the same value representation
+			if childType.BaseID() == pgtypes.DoltgresTypeBaseID_Unknown {
+				attrTypes[i] = pgtypes.Text
+			}
+		} else {
+			// Records store Doltgres values, so GMS expressions must be converted
+			gmsCast := NewGMSCast(child)
+			newChildren[i] = gmsCast
+			attrTypes[i] = gmsCast.DoltgresType()
+		}
+	}
+	return &Record{
+		children:   newChildren,
+		recordType: pgtypes.NewAnonymousRecordType(attrTypes),
+	}, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (r *Record) WithResolvedChildren(children []any) (any, error) {
+	newExpressions := make([]sql.Expression, len(children))
+	for i, resolvedChild := range children {
+		resolvedExpression, ok := resolvedChild.(sql.Expression)
+		if !ok {
+			return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", resolvedChild)
+		}
+		newExpressions[i] = resolvedExpression
+	}
+	return r.WithChildren(newExpressions...)
+}
+
+// castFromComposite casts the given composite value to the target type. Casting to another composite type casts each
+// attribute using the cast returned by getCast, while casting to a string type uses the record's text representation.
+func castFromComposite(ctx *sql.Context, val any, fromType pgtypes.CompositeType, toType pgtypes.DoltgresType,
+	getCast func(pgtypes.DoltgresTypeBaseID, pgtypes.DoltgresTypeBaseID) framework.TypeCastFunction) (any, error) {
+	if val == nil {
+		return nil, nil
+	}
+	if toType.BaseID().GetTypeCategory() == pgtypes.TypeCategory_StringTypes {
+		str, err := fromType.IoOutput(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		return toType.IoInput(ctx, str)
+	}
+	toComposite, ok := toType.(pgtypes.CompositeType)
+	if !ok {
+		return nil, fmt.Errorf("cannot cast type %s to %s", fromType.String(), toType.String())
+	}
+	vals := val.([]any)
+	if len(vals) != len(fromType.Attributes) || len(vals) != len(toComposite.Attributes) {
+		return nil, fmt.Errorf("cannot cast type %s to %s", fromType.String(), toType.String())
+	}
+	newVals := make([]any, len(vals))
+	for i, attrVal := range vals {
+		if attrVal == nil {
+			continue
+		}
+		attrFromType := fromType.Attributes[i].Type
+		attrToType := toComposite.Attributes[i].Type
+		castFunc := getCast(attrFromType.BaseID(), attrToType.BaseID())
+		if castFunc == nil {
+			if attrFromType.BaseID() != pgtypes.DoltgresTypeBaseID_Unknown {
+				return nil, fmt.Errorf("cannot cast type %s to %s in column %d", attrFromType.String(), attrToType.String(), i+1)
+			}
+			castFunc = framework.UnknownLiteralCast
+		}
+		var err error
+		newVals[i], err = castFunc(ctx, attrVal, attrToType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newVals, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// RowSecurityPolicy represents the combined row-level security policies that apply to a table. When used as a filter,
+// rows that do not satisfy the policies are hidden. When used as a check, new rows that do not satisfy the policies
+// return an error.
+type RowSecurityPolicy struct {
+	child   sql.Expression
+	table   string
+	isCheck bool
+}
+
+var _ sql.Expression = (*RowSecurityPolicy)(nil)
+
+// NewRowSecurityFilter returns a new *RowSecurityPolicy that filters rows from the given table.
+func NewRowSecurityFilter(child sql.Expression, table string) *RowSecurityPolicy {
+	return &RowSecurityPolicy{
+		child:   child,
+		table:   table,
+		isCheck: false,
+	}
+}
+
+// NewRowSecurityCheck returns a new *RowSecurityPolicy that verifies new rows for the given table.
+func NewRowSecurityCheck(child sql.Expression, table string) *RowSecurityPolicy {
+	return &RowSecurityPolicy{
+		child:   child,
+		table:   table,
+		isCheck: true,
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (r *RowSecurityPolicy) Children() []sql.Expression {
+	return []sql.Expression{r.child}
+}
+
+// Eval implements the sql.Expression interface.
+func (r *RowSecurityPolicy) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	val, err := r.child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	passed := false
+	if val != nil {
+		passed, err = sql.ConvertToBool(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !passed {
+		if r.isCheck {
+			return nil, pgerror.Newf(pgcode.InsufficientPrivilege,
+				`new row violates row-level security policy for table "%s"`, r.table)
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+// IsCheck returns whether this policy verifies new rows, rather than filtering existing rows.
+func (r *RowSecurityPolicy) IsCheck() bool {
+	return r.isCheck
+}
+
+// IsNullable implements the sql.Expression interface.
+func (r *RowSecurityPolicy) IsNullable() bool {
+	return false
+}
+
+// Resolved implements the sql.Expression interface.
+func (r *RowSecurityPolicy) Resolved() bool {
+	return r.child.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (r *RowSecurityPolicy) String() string {
+	return r.child.String()
+}
+
+// Table returns the name of the table that the policies belong to.
+func (r *RowSecurityPolicy) Table() string {
+	return r.table
+}
+
+// Type implements the sql.Expression interface.
+func (r *RowSecurityPolicy) Type() sql.Type {
+	return pgtypes.Bool
+}
+
+// WithChildren implements the sql.Expression interface.
+func (r *RowSecurityPolicy) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), 1)
+	}
+	return &RowSecurityPolicy{
+		child:   children[0],
+		table:   r.table,
+		isCheck: r.isCheck,
+	}, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SetReturningValue references the current value of a set-returning function that has been moved into a ProjectSet
+// node. ProjectSet appends the value of each of its functions to the end of the rows that it returns, so the value is
+// located relative to the end of the row rather than the beginning. This allows the reference to remain valid
+// regardless of how many columns of the outer scopes have been prepended to the row. This is also used by the nodes
+// above the projection to reference the projection's output, rather than evaluating the function again.
+type SetReturningValue struct {
+	function sql.Expression
+	index    int
+	count    int
+}
+
+var _ sql.Expression = (*SetReturningValue)(nil)
+
+// NewSetReturningValue returns a new *SetReturningValue, which references the value at the given index out of the
+// given number of values that were appended to the end of the row.
+func NewSetReturningValue(function sql.Expression, index int, count int) *SetReturningValue {
+	return &SetReturningValue{
+		function: function,
+		index:    index,
+		count:    count,
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (s *SetReturningValue) Children() []sql.Expression {
+	return nil
+}
+
+// Eval implements the sql.Expression interface.
+func (s *SetReturningValue) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	idx := len(row) - s.count + s.index
+	if idx < 0 || idx >= len(row) {
+		return nil, fmt.Errorf("the value of the set-returning function %s is missing from the row", s.function.String())
+	}
+	return row[idx], nil
+}
+
+// IsNullable implements the sql.Expression interface.
+func (s *SetReturningValue) IsNullable() bool {
+	return true
+}
+
+// Resolved implements the sql.Expression interface.
+func (s *SetReturningValue) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Expression interface.
+func (s *SetReturningValue) String() string {
+	return s.function.String()
+}
+
+// Type implements the sql.Expression interface.
+func (s *SetReturningValue) Type() sql.Type {
+	return s.function.Type()
+}
+
+// WithChildren implements the sql.Expression interface.
+func (s *SetReturningValue) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 0)
+	}
+	return s, nil
+}
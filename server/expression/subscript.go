@@ -0,0 +1,312 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// SubscriptBounds describes a single subscript of a Subscript expression, such as [1] or [2:3]. A subscript that is
+// not a slice only has a lower bound. Slices may omit either bound, such as [:3] or [2:].
+type SubscriptBounds struct {
+	Slice    bool
+	HasLower bool
+	HasUpper bool
+}
+
+// Subscript represents the E[...] expression, which fetches a single element or a slice from an array. The children
+// are the array, followed by every bound that is present, in the order that they're written.
+type Subscript struct {
+	children   []sql.Expression
+	subscripts []SubscriptBounds
+}
+
+var _ vitess.Injectable = (*Subscript)(nil)
+var _ sql.Expression = (*Subscript)(nil)
+
+// NewSubscriptInjectable returns an incomplete *Subscript that must be resolved through the vitess.Injectable
+// interface.
+func NewSubscriptInjectable(subscripts []SubscriptBounds) *Subscript {
+	return &Subscript{
+		children:   nil,
+		subscripts: subscripts,
+	}
+}
+
+// NewSubscript returns a new *Subscript expression.
+func NewSubscript(children []sql.Expression, subscripts []SubscriptBounds) (*Subscript, error) {
+	boundCount := 0
+	for _, subscript := range subscripts {
+		if subscript.HasLower {
+			boundCount++
+		}
+		if subscript.HasUpper {
+			boundCount++
+		}
+	}
+	if len(children) != boundCount+1 {
+		return nil, fmt.Errorf("invalid subscript child count, expected `%d` but got `%d`", boundCount+1, len(children))
+	}
+	s := &Subscript{
+		children:   children,
+		subscripts: subscripts,
+	}
+	if hasUnresolvedType(children[0]) {
+		// The type will be validated once it has been resolved
+		return s, nil
+	}
+	if _, err := s.arrayType(); err != nil {
+		return nil, err
+	}
+	for _, bound := range children[1:] {
+		if _, err := subscriptCast(bound); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Children implements the sql.Expression interface.
+func (s *Subscript) Children() []sql.Expression {
+	return s.children
+}
+
+// Eval implements the sql.Expression interface.
+func (s *Subscript) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	val, err := s.children[0].Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	array, ok := val.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected an array value but received `%T`", val)
+	}
+	// Evaluate all of the bounds, with any NULL bound resulting in NULL
+	bounds := make([]int32, len(s.children)-1)
+	for i, child := range s.children[1:] {
+		boundVal, err := child.Eval(ctx, row)
+		if err != nil || boundVal == nil {
+			return nil, err
+		}
+		castFunc, err := subscriptCast(child)
+		if err != nil {
+			return nil, err
+		}
+		boundVal, err = castFunc(ctx, boundVal, pgtypes.Int32)
+		if err != nil {
+			return nil, err
+		}
+		bounds[i] = boundVal.(int32)
+	}
+	dims, err := pgtypes.ArrayDimensions(array)
+	if err != nil {
+		return nil, err
+	}
+	if len(array) == 0 {
+		dims = nil
+	}
+
+	if !s.isSlice() {
+		if len(bounds) != len(dims) {
+			return nil, nil
+		}
+		var element any = array
+		for i, bound := range bounds {
+			if bound < 1 || int(bound) > dims[i] {
+				return nil, nil
+			}
+			element = element.([]any)[bound-1]
+		}
+		return element, nil
+	}
+
+	// When any subscript is a slice, then all subscripts are treated as slices, with a lone subscript being the upper
+	// bound. Dimensions without a subscript are included in their entirety.
+	if len(s.subscripts) > len(dims) {
+		return []any{}, nil
+	}
+	ranges := make([][2]int, len(dims))
+	boundIdx := 0
+	for i, dim := range dims {
+		ranges[i] = [2]int{1, dim}
+		if i >= len(s.subscripts) {
+			continue
+		}
+		subscript := s.subscripts[i]
+		if subscript.Slice {
+			if subscript.HasLower {
+				ranges[i][0] = int(bounds[boundIdx])
+				boundIdx++
+			}
+			if subscript.HasUpper {
+				ranges[i][1] = int(bounds[boundIdx])
+				boundIdx++
+			}
+		} else {
+			ranges[i][1] = int(bounds[boundIdx])
+			boundIdx++
+		}
+		ranges[i][0] = max(ranges[i][0], 1)
+		ranges[i][1] = min(ranges[i][1], dim)
+		if ranges[i][0] > ranges[i][1] {
+			return []any{}, nil
+		}
+	}
+	return sliceArray(array, ranges), nil
+}
+
+// IsNullable implements the sql.Expression interface.
+func (s *Subscript) IsNullable() bool {
+	return true
+}
+
+// Resolved implements the sql.Expression interface.
+func (s *Subscript) Resolved() bool {
+	for _, child := range s.children {
+		if child == nil || !child.Resolved() {
+			return false
+		}
+	}
+	return len(s.children) > 0
+}
+
+// String implements the sql.Expression interface.
+func (s *Subscript) String() string {
+	sb := strings.Builder{}
+	if len(s.children) == 0 {
+		sb.WriteString("(?)")
+	} else {
+		sb.WriteString(s.children[0].String())
+	}
+	childIdx := 1
+	writeBound := func() {
+		if childIdx < len(s.children) {
+			sb.WriteString(s.children[childIdx].String())
+		} else {
+			sb.WriteRune('?')
+		}
+		childIdx++
+	}
+	for _, subscript := range s.subscripts {
+		sb.WriteRune('[')
+		if subscript.HasLower {
+			writeBound()
+		}
+		if subscript.Slice {
+			sb.WriteRune(':')
+			if subscript.HasUpper {
+				writeBound()
+			}
+		}
+		sb.WriteRune(']')
+	}
+	return sb.String()
+}
+
+// Type implements the sql.Expression interface.
+func (s *Subscript) Type() sql.Type {
+	arrayType, err := s.arrayType()
+	if err != nil {
+		return pgtypes.Unknown
+	}
+	if s.isSlice() {
+		return arrayType
+	}
+	return arrayType.BaseType()
+}
+
+// WithChildren implements the sql.Expression interface.
+func (s *Subscript) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewSubscript(children, s.subscripts)
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (s *Subscript) WithResolvedChildren(children []any) (any, error) {
+	newExpressions := make([]sql.Expression, len(children))
+	for i, resolvedChild := range children {
+		resolvedExpression, ok := resolvedChild.(sql.Expression)
+		if !ok {
+			return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", resolvedChild)
+		}
+		newExpressions[i] = resolvedExpression
+	}
+	return NewSubscript(newExpressions, s.subscripts)
+}
+
+// arrayType returns the type of the array that is being subscripted.
+func (s *Subscript) arrayType() (pgtypes.DoltgresArrayType, error) {
+	if len(s.children) == 0 || s.children[0] == nil {
+		return nil, fmt.Errorf("subscript has not been resolved")
+	}
+	childType := s.children[0].Type()
+	if domainType, ok := childType.(pgtypes.DomainType); ok {
+		childType = domainType.AsType
+	}
+	arrayType, ok := childType.(pgtypes.DoltgresArrayType)
+	if !ok || arrayType.BaseID() == pgtypes.DoltgresTypeBaseID_Unknown {
+		return nil, fmt.Errorf("cannot subscript type %s because it does not support subscripting", childType.String())
+	}
+	return arrayType, nil
+}
+
+// isSlice returns whether any of the subscripts are slices, which determines whether the result is an array.
+func (s *Subscript) isSlice() bool {
+	for _, subscript := range s.subscripts {
+		if subscript.Slice {
+			return true
+		}
+	}
+	return false
+}
+
+// subscriptCast returns the cast that converts the given subscript bound to an integer.
+func subscriptCast(bound sql.Expression) (framework.TypeCastFunction, error) {
+	boundType, ok := bound.Type().(pgtypes.DoltgresType)
+	if !ok {
+		// A NULL literal does not have a Doltgres type, but it always evaluates to NULL, so it's never actually cast
+		if types.IsNull(bound) {
+			return framework.UnknownLiteralCast, nil
+		}
+		return nil, fmt.Errorf("array subscript must have type integer")
+	}
+	if boundType.BaseID() == pgtypes.DoltgresTypeBaseID_Unknown {
+		return framework.UnknownLiteralCast, nil
+	}
+	castFunc := framework.GetAssignmentCast(boundType.BaseID(), pgtypes.DoltgresTypeBaseID_Int32)
+	if castFunc == nil {
+		return nil, fmt.Errorf("array subscript must have type integer")
+	}
+	return castFunc, nil
+}
+
+// sliceArray returns the portion of the array within the given inclusive ranges, with one range per dimension.
+func sliceArray(array []any, ranges [][2]int) []any {
+	sliced := make([]any, 0, ranges[0][1]-ranges[0][0]+1)
+	for _, element := range array[ranges[0][0]-1 : ranges[0][1]] {
+		if len(ranges) > 1 {
+			element = sliceArray(element.([]any), ranges[1:])
+		}
+		sliced = append(sliced, element)
+	}
+	return sliced
+}
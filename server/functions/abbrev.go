@@ -0,0 +1,70 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initAbbrev registers the functions to the catalog.
+func initAbbrev() {
+	framework.RegisterFunction(abbrev_cidr)
+	framework.RegisterFunction(abbrev_inet)
+}
+
+// abbrev_cidr represents the PostgreSQL function of the same name, taking the same parameters.
+var abbrev_cidr = framework.Function1{
+	Name:       "abbrev",
+	Return:     pgtypes.Text,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.Cidr},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		prefix := val.(netip.Prefix)
+		if !prefix.Addr().Is4() {
+			return prefix.String(), nil
+		}
+		// IPv4 networks only display the octets that are covered by the netmask
+		addr := prefix.Addr().As4()
+		octets := make([]string, 0, 4)
+		for i := 0; i < prefix.Bits()/8; i++ {
+			octets = append(octets, fmt.Sprint(addr[i]))
+		}
+		if remaining := prefix.Bits() % 8; remaining > 0 {
+			octets = append(octets, fmt.Sprint(addr[prefix.Bits()/8]&byte(0xff<<(8-remaining))))
+		}
+		if len(octets) == 0 {
+			octets = append(octets, "0")
+		}
+		return fmt.Sprintf("%s/%d", strings.Join(octets, "."), prefix.Bits()), nil
+	},
+}
+
+// abbrev_inet represents the PostgreSQL function of the same name, taking the same parameters.
+var abbrev_inet = framework.Function1{
+	Name:       "abbrev",
+	Return:     pgtypes.Text,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.Inet},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		return pgtypes.Inet.IoOutput(ctx, val)
+	},
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initArrayPosition registers the functions to the catalog.
+func initArrayPosition() {
+	framework.RegisterFunction(array_position_anyarray_anyelement)
+	framework.RegisterFunction(array_position_anyarray_anyelement_int32)
+}
+
+// array_position_anyarray_anyelement represents the PostgreSQL function of the same name, taking the same parameters.
+var array_position_anyarray_anyelement = framework.Function2{
+	Name:       "array_position",
+	Return:     pgtypes.Int32,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyElement},
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val1 == nil {
+			return nil, nil
+		}
+		return arrayPosition(t[0], val1.([]any), val2, 1)
+	},
+}
+
+// array_position_anyarray_anyelement_int32 represents the PostgreSQL function of the same name, taking the same
+// parameters.
+var array_position_anyarray_anyelement_int32 = framework.Function3{
+	Name:       "array_position",
+	Return:     pgtypes.Int32,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyElement, pgtypes.Int32},
+	Callable: func(ctx *sql.Context, t [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		if val1 == nil {
+			return nil, nil
+		}
+		if val3 == nil {
+			return nil, fmt.Errorf("initial position must not be null")
+		}
+		return arrayPosition(t[0], val1.([]any), val2, val3.(int32))
+	},
+}
+
+// arrayPosition returns the position of the first element that is not distinct from the given value, beginning the
+// search at the given position. Returns NULL if the value is not found.
+func arrayPosition(arrayType pgtypes.DoltgresType, array []any, value any, start int32) (any, error) {
+	if arrayIsMultidimensional(array) {
+		return nil, fmt.Errorf("searching for elements in multidimensional arrays is not supported")
+	}
+	baseType := arrayType.ToArrayType().BaseType()
+	for i := max(int(start), 1); i <= len(array); i++ {
+		if equal, err := arrayElementsNotDistinct(baseType, array[i-1], value); err != nil {
+			return nil, err
+		} else if equal {
+			return int32(i), nil
+		}
+	}
+	return nil, nil
+}
+
+// arrayElementsNotDistinct returns whether the two elements are not distinct, meaning that they're either equal, or
+// are both NULL.
+func arrayElementsNotDistinct(baseType pgtypes.DoltgresType, element any, value any) (bool, error) {
+	if element == nil || value == nil {
+		return element == nil && value == nil, nil
+	}
+	res, err := baseType.Compare(element, value)
+	return res == 0, err
+}
+
+// arrayIsMultidimensional returns whether the array has more than one dimension.
+func arrayIsMultidimensional(array []any) bool {
+	if len(array) == 0 {
+		return false
+	}
+	_, ok := array[0].([]any)
+	return ok
+}
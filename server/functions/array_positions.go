@@ -0,0 +1,55 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initArrayPositions registers the functions to the catalog.
+func initArrayPositions() {
+	framework.RegisterFunction(array_positions_anyarray_anyelement)
+}
+
+// array_positions_anyarray_anyelement represents the PostgreSQL function of the same name, taking the same parameters.
+var array_positions_anyarray_anyelement = framework.Function2{
+	Name:       "array_positions",
+	Return:     pgtypes.Int32Array,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyElement},
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val1 == nil {
+			return nil, nil
+		}
+		array := val1.([]any)
+		if arrayIsMultidimensional(array) {
+			return nil, fmt.Errorf("searching for elements in multidimensional arrays is not supported")
+		}
+		baseType := t[0].ToArrayType().BaseType()
+		positions := make([]any, 0)
+		for i, element := range array {
+			if equal, err := arrayElementsNotDistinct(baseType, element, val2); err != nil {
+				return nil, err
+			} else if equal {
+				positions = append(positions, int32(i+1))
+			}
+		}
+		return positions, nil
+	},
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initArrayReplace registers the functions to the catalog.
+func initArrayReplace() {
+	framework.RegisterFunction(array_replace_anyarray_anyelement_anyelement)
+}
+
+// array_replace_anyarray_anyelement_anyelement represents the PostgreSQL function of the same name, taking the same
+// parameters.
+var array_replace_anyarray_anyelement_anyelement = framework.Function3{
+	Name:       "array_replace",
+	Return:     pgtypes.AnyArray,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyElement, pgtypes.AnyElement},
+	Callable: func(ctx *sql.Context, t [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		if val1 == nil {
+			return nil, nil
+		}
+		return arrayReplace(t[0].ToArrayType().BaseType(), val1.([]any), val2, val3)
+	},
+}
+
+// arrayReplace returns a copy of the array with every element that is not distinct from the search value replaced.
+// Multidimensional arrays retain their shape.
+func arrayReplace(baseType pgtypes.DoltgresType, array []any, search any, replace any) ([]any, error) {
+	returnArray := make([]any, len(array))
+	for i, element := range array {
+		if subArray, ok := element.([]any); ok {
+			var err error
+			if returnArray[i], err = arrayReplace(baseType, subArray, search, replace); err != nil {
+				return nil, err
+			}
+		} else if equal, err := arrayElementsNotDistinct(baseType, element, search); err != nil {
+			return nil, err
+		} else if equal {
+			returnArray[i] = replace
+		} else {
+			returnArray[i] = element
+		}
+	}
+	return returnArray, nil
+}
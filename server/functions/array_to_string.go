@@ -68,7 +68,7 @@ var array_to_string_anyarray_text_text = framework.Function3{
 func getStringArrFromAnyArray(ctx *sql.Context, anyArrayType pgtypes.DoltgresType, arr []any, delimiter string, nullEntry any) (string, error) {
 	baseType := anyArrayType.ToArrayType().BaseType()
 	strs := make([]string, 0)
-	for _, el := range arr {
+	for _, el := range pgtypes.ArrayFlatten(arr) {
 		if el != nil {
 			v, err := baseType.IoOutput(ctx, el)
 			if err != nil {
@@ -0,0 +1,106 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// These functions can be gathered using the following query from a Postgres 15 instance:
+// SELECT * FROM pg_operator o WHERE o.oprleft = 'anyarray'::regtype ORDER BY o.oprcode::varchar;
+
+// initArray registers the functions to the catalog.
+func initArray() {
+	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONContainsLeft, arraycontained)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONContainsRight, arraycontains)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryOverlaps, arrayoverlap)
+}
+
+// arraycontained represents the PostgreSQL function of the same name, taking the same parameters.
+var arraycontained = framework.Function2{
+	Name:       "arraycontained",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyArray},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return arrayContains(t[1].ToArrayType().BaseType(), val2.([]any), val1.([]any))
+	},
+}
+
+// arraycontains represents the PostgreSQL function of the same name, taking the same parameters.
+var arraycontains = framework.Function2{
+	Name:       "arraycontains",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyArray},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return arrayContains(t[0].ToArrayType().BaseType(), val1.([]any), val2.([]any))
+	},
+}
+
+// arrayoverlap represents the PostgreSQL function of the same name, taking the same parameters.
+var arrayoverlap = framework.Function2{
+	Name:       "arrayoverlap",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyArray},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		baseType := t[0].ToArrayType().BaseType()
+		elements := pgtypes.ArrayFlatten(val2.([]any))
+		for _, element1 := range pgtypes.ArrayFlatten(val1.([]any)) {
+			found, err := arrayHasElement(baseType, elements, element1)
+			if err != nil || found {
+				return found, err
+			}
+		}
+		return false, nil
+	},
+}
+
+// arrayContains returns whether every element of the contained array is also an element of the container array,
+// without regard to dimensions or duplicates. NULL elements are never equal to any element.
+func arrayContains(baseType pgtypes.DoltgresType, container []any, contained []any) (bool, error) {
+	elements := pgtypes.ArrayFlatten(container)
+	for _, element := range pgtypes.ArrayFlatten(contained) {
+		found, err := arrayHasElement(baseType, elements, element)
+		if err != nil || !found {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// arrayHasElement returns whether the elements contain a value that is equal to the given value.
+func arrayHasElement(baseType pgtypes.DoltgresType, elements []any, value any) (bool, error) {
+	if value == nil {
+		return false, nil
+	}
+	for _, element := range elements {
+		if element == nil {
+			continue
+		}
+		res, err := baseType.Compare(element, value)
+		if err != nil {
+			return false, err
+		}
+		if res == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
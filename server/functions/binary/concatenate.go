@@ -15,6 +15,7 @@
 package binary
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -29,11 +30,14 @@ import (
 // initBinaryConcatenate registers the functions to the catalog.
 func initBinaryConcatenate() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryConcatenate, anytextcat)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryConcatenate, array_append_anyarray_anyelement)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryConcatenate, array_cat_anyarray_anyarray)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryConcatenate, array_prepend_anyelement_anyarray)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryConcatenate, byteacat)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryConcatenate, jsonb_concat)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryConcatenate, textanycat)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryConcatenate, textcat)
-	// TODO: array_append, array_cat, array_prepend, bitcat, tsquery_or, tsvector_concat
+	// TODO: bitcat, tsquery_or, tsvector_concat
 }
 
 // anytextcat represents the PostgreSQL function of the same name, taking the same parameters.
@@ -52,6 +56,121 @@ var anytextcat = framework.Function2{
 	},
 }
 
+// array_append_anyarray_anyelement represents the PostgreSQL function of the same name, taking the same parameters.
+var array_append_anyarray_anyelement = framework.Function2{
+	Name:       "array_append",
+	Return:     pgtypes.AnyArray,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyElement},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val1 == nil {
+			return []any{val2}, nil
+		}
+		array := val1.([]any)
+		if len(array) > 0 {
+			if _, ok := array[0].([]any); ok {
+				return nil, fmt.Errorf("argument must be empty or one-dimensional array")
+			}
+		}
+		returnArray := make([]any, len(array)+1)
+		copy(returnArray, array)
+		returnArray[len(returnArray)-1] = val2
+		return returnArray, nil
+	},
+}
+
+// array_cat_anyarray_anyarray represents the PostgreSQL function of the same name, taking the same parameters.
+var array_cat_anyarray_anyarray = framework.Function2{
+	Name:       "array_cat",
+	Return:     pgtypes.AnyArray,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyArray},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val1 == nil {
+			return val2, nil
+		} else if val2 == nil {
+			return val1, nil
+		}
+		return arrayCat(val1.([]any), val2.([]any))
+	},
+}
+
+// arrayCat concatenates the two arrays. Arrays with the same number of dimensions are concatenated along the first
+// dimension, while an array with one fewer dimension than the other is added as a new element of the first dimension.
+func arrayCat(array1 []any, array2 []any) ([]any, error) {
+	if len(array1) == 0 {
+		return array2, nil
+	} else if len(array2) == 0 {
+		return array1, nil
+	}
+	dims1, err := pgtypes.ArrayDimensions(array1)
+	if err != nil {
+		return nil, err
+	}
+	dims2, err := pgtypes.ArrayDimensions(array2)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(dims1) == len(dims2):
+		if !arrayDimensionsEqual(dims1[1:], dims2[1:]) {
+			return nil, fmt.Errorf("cannot concatenate incompatible arrays")
+		}
+		returnArray := make([]any, 0, len(array1)+len(array2))
+		returnArray = append(returnArray, array1...)
+		return append(returnArray, array2...), nil
+	case len(dims1)+1 == len(dims2):
+		if !arrayDimensionsEqual(dims1, dims2[1:]) {
+			return nil, fmt.Errorf("cannot concatenate incompatible arrays")
+		}
+		returnArray := make([]any, 0, len(array2)+1)
+		returnArray = append(returnArray, array1)
+		return append(returnArray, array2...), nil
+	case len(dims1) == len(dims2)+1:
+		if !arrayDimensionsEqual(dims1[1:], dims2) {
+			return nil, fmt.Errorf("cannot concatenate incompatible arrays")
+		}
+		returnArray := make([]any, 0, len(array1)+1)
+		returnArray = append(returnArray, array1...)
+		return append(returnArray, array2), nil
+	default:
+		return nil, fmt.Errorf("cannot concatenate incompatible arrays")
+	}
+}
+
+// arrayDimensionsEqual returns whether both sets of dimensions are the same.
+func arrayDimensionsEqual(dims1 []int, dims2 []int) bool {
+	if len(dims1) != len(dims2) {
+		return false
+	}
+	for i := range dims1 {
+		if dims1[i] != dims2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// array_prepend_anyelement_anyarray represents the PostgreSQL function of the same name, taking the same parameters.
+var array_prepend_anyelement_anyarray = framework.Function2{
+	Name:       "array_prepend",
+	Return:     pgtypes.AnyArray,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyElement, pgtypes.AnyArray},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val2 == nil {
+			return []any{val1}, nil
+		}
+		array := val2.([]any)
+		if len(array) > 0 {
+			if _, ok := array[0].([]any); ok {
+				return nil, fmt.Errorf("argument must be empty or one-dimensional array")
+			}
+		}
+		returnArray := make([]any, len(array)+1)
+		returnArray[0] = val1
+		copy(returnArray[1:], array)
+		return returnArray, nil
+	},
+}
+
 // byteacat represents the PostgreSQL function of the same name, taking the same parameters.
 var byteacat = framework.Function2{
 	Name:       "byteacat",
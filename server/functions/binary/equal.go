@@ -15,6 +15,9 @@
 package binary
 
 import (
+	"fmt"
+	"net"
+	"net/netip"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -38,6 +41,7 @@ func initBinaryEqual() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, date_eq)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, date_eq_timestamp)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, date_eq_timestamptz)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, enum_eq)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, float4eq)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, float48eq)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, float84eq)
@@ -53,10 +57,15 @@ func initBinaryEqual() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, int8eq)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, interval_eq)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, jsonb_eq)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, macaddr8_eq)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, macaddr_eq)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, nameeq)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, nameeqtext)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, network_eq)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, numeric_eq)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, oideq)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, range_eq)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, record_eq)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, texteqname)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, text_eq)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryEqual, time_eq)
@@ -156,6 +165,18 @@ var date_eq_timestamptz = framework.Function2{
 	},
 }
 
+// enum_eq represents the PostgreSQL function of the same name, taking the same parameters.
+var enum_eq = framework.Function2{
+	Name:       "enum_eq",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyEnum, pgtypes.AnyEnum},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := t[0].Compare(val1.(string), val2.(string))
+		return res == 0, err
+	},
+}
+
 // float4eq represents the PostgreSQL function of the same name, taking the same parameters.
 var float4eq = framework.Function2{
 	Name:       "float4eq",
@@ -336,6 +357,30 @@ var jsonb_eq = framework.Function2{
 	},
 }
 
+// macaddr8_eq represents the PostgreSQL function of the same name, taking the same parameters.
+var macaddr8_eq = framework.Function2{
+	Name:       "macaddr8_eq",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.MacAddr8, pgtypes.MacAddr8},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.MacAddr8.Compare(val1.(net.HardwareAddr), val2.(net.HardwareAddr))
+		return res == 0, err
+	},
+}
+
+// macaddr_eq represents the PostgreSQL function of the same name, taking the same parameters.
+var macaddr_eq = framework.Function2{
+	Name:       "macaddr_eq",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.MacAddr, pgtypes.MacAddr},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.MacAddr.Compare(val1.(net.HardwareAddr), val2.(net.HardwareAddr))
+		return res == 0, err
+	},
+}
+
 // nameeq represents the PostgreSQL function of the same name, taking the same parameters.
 var nameeq = framework.Function2{
 	Name:       "nameeq",
@@ -360,6 +405,18 @@ var nameeqtext = framework.Function2{
 	},
 }
 
+// network_eq represents the PostgreSQL function of the same name, taking the same parameters.
+var network_eq = framework.Function2{
+	Name:       "network_eq",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Inet, pgtypes.Inet},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.Inet.Compare(val1.(netip.Prefix), val2.(netip.Prefix))
+		return res == 0, err
+	},
+}
+
 // numeric_eq represents the PostgreSQL function of the same name, taking the same parameters.
 var numeric_eq = framework.Function2{
 	Name:       "numeric_eq",
@@ -384,6 +441,33 @@ var oideq = framework.Function2{
 	},
 }
 
+// range_eq represents the PostgreSQL function of the same name, taking the same parameters.
+var range_eq = framework.Function2{
+	Name:       "range_eq",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyRange, pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := t[0].Compare(val1, val2)
+		return res == 0, err
+	},
+}
+
+// record_eq represents the PostgreSQL function of the same name, taking the same parameters.
+var record_eq = framework.Function2{
+	Name:       "record_eq",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Record, pgtypes.Record},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, isNull, err := compareRecords(t[0], val1, val2, true)
+		if err != nil || isNull {
+			return nil, err
+		}
+		return res == 0, nil
+	},
+}
+
 // texteqname represents the PostgreSQL function of the same name, taking the same parameters.
 var texteqname = framework.Function2{
 	Name:       "texteqname",
@@ -540,3 +624,33 @@ var xideq = framework.Function2{
 		return res == 0, err
 	},
 }
+
+// compareRecords compares the two records attribute by attribute, returning the result of the first pair of attributes
+// that are not equal. The comparison is null if a pair containing a NULL is reached before the result is determined.
+// When skipNulls is true, pairs containing a NULL are skipped, so that an unequal pair later in the record still
+// determines the result, which is how equality is defined for records.
+func compareRecords(t pgtypes.DoltgresType, val1 any, val2 any, skipNulls bool) (res int, isNull bool, err error) {
+	compositeType, ok := t.(pgtypes.CompositeType)
+	if !ok {
+		return 0, false, fmt.Errorf("expected a composite type but received %s", t.String())
+	}
+	vals1 := val1.([]any)
+	vals2 := val2.([]any)
+	if len(vals1) != len(vals2) {
+		return 0, false, fmt.Errorf("cannot compare record types with different numbers of columns")
+	}
+	for i := range vals1 {
+		if vals1[i] == nil || vals2[i] == nil {
+			if !skipNulls {
+				return 0, true, nil
+			}
+			isNull = true
+			continue
+		}
+		res, err = compositeType.Attributes[i].Type.Compare(vals1[i], vals2[i])
+		if err != nil || res != 0 {
+			return res, false, err
+		}
+	}
+	return 0, isNull, nil
+}
@@ -15,6 +15,8 @@
 package binary
 
 import (
+	"net"
+	"net/netip"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -38,6 +40,7 @@ func initBinaryGreaterThan() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, date_gt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, date_gt_timestamp)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, date_gt_timestamptz)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, enum_gt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, float4gt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, float48gt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, float84gt)
@@ -53,10 +56,15 @@ func initBinaryGreaterThan() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, int8gt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, interval_gt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, jsonb_gt)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, macaddr8_gt)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, macaddr_gt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, namegt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, namegttext)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, network_gt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, numeric_gt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, oidgt)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, range_gt)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, record_gt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, textgtname)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, text_gt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterThan, time_gt)
@@ -154,6 +162,18 @@ var date_gt_timestamptz = framework.Function2{
 	},
 }
 
+// enum_gt represents the PostgreSQL function of the same name, taking the same parameters.
+var enum_gt = framework.Function2{
+	Name:       "enum_gt",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyEnum, pgtypes.AnyEnum},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := t[0].Compare(val1.(string), val2.(string))
+		return res == 1, err
+	},
+}
+
 // float4gt represents the PostgreSQL function of the same name, taking the same parameters.
 var float4gt = framework.Function2{
 	Name:       "float4gt",
@@ -334,6 +354,30 @@ var jsonb_gt = framework.Function2{
 	},
 }
 
+// macaddr8_gt represents the PostgreSQL function of the same name, taking the same parameters.
+var macaddr8_gt = framework.Function2{
+	Name:       "macaddr8_gt",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.MacAddr8, pgtypes.MacAddr8},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.MacAddr8.Compare(val1.(net.HardwareAddr), val2.(net.HardwareAddr))
+		return res == 1, err
+	},
+}
+
+// macaddr_gt represents the PostgreSQL function of the same name, taking the same parameters.
+var macaddr_gt = framework.Function2{
+	Name:       "macaddr_gt",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.MacAddr, pgtypes.MacAddr},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.MacAddr.Compare(val1.(net.HardwareAddr), val2.(net.HardwareAddr))
+		return res == 1, err
+	},
+}
+
 // namegt represents the PostgreSQL function of the same name, taking the same parameters.
 var namegt = framework.Function2{
 	Name:       "namegt",
@@ -358,6 +402,18 @@ var namegttext = framework.Function2{
 	},
 }
 
+// network_gt represents the PostgreSQL function of the same name, taking the same parameters.
+var network_gt = framework.Function2{
+	Name:       "network_gt",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Inet, pgtypes.Inet},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.Inet.Compare(val1.(netip.Prefix), val2.(netip.Prefix))
+		return res == 1, err
+	},
+}
+
 // numeric_gt represents the PostgreSQL function of the same name, taking the same parameters.
 var numeric_gt = framework.Function2{
 	Name:       "numeric_gt",
@@ -382,6 +438,33 @@ var oidgt = framework.Function2{
 	},
 }
 
+// range_gt represents the PostgreSQL function of the same name, taking the same parameters.
+var range_gt = framework.Function2{
+	Name:       "range_gt",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyRange, pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := t[0].Compare(val1, val2)
+		return res == 1, err
+	},
+}
+
+// record_gt represents the PostgreSQL function of the same name, taking the same parameters.
+var record_gt = framework.Function2{
+	Name:       "record_gt",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Record, pgtypes.Record},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, isNull, err := compareRecords(t[0], val1, val2, false)
+		if err != nil || isNull {
+			return nil, err
+		}
+		return res == 1, nil
+	},
+}
+
 // textgtname represents the PostgreSQL function of the same name, taking the same parameters.
 var textgtname = framework.Function2{
 	Name:       "textgtname",
@@ -15,6 +15,8 @@
 package binary
 
 import (
+	"net"
+	"net/netip"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -38,6 +40,7 @@ func initBinaryGreaterOrEqual() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, date_ge)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, date_ge_timestamp)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, date_ge_timestamptz)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, enum_ge)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, float4ge)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, float48ge)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, float84ge)
@@ -53,10 +56,15 @@ func initBinaryGreaterOrEqual() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, int8ge)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, interval_ge)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, jsonb_ge)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, macaddr8_ge)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, macaddr_ge)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, namege)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, namegetext)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, network_ge)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, numeric_ge)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, oidge)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, range_ge)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, record_ge)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, textgename)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, text_ge)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryGreaterOrEqual, time_ge)
@@ -154,6 +162,18 @@ var date_ge_timestamptz = framework.Function2{
 	},
 }
 
+// enum_ge represents the PostgreSQL function of the same name, taking the same parameters.
+var enum_ge = framework.Function2{
+	Name:       "enum_ge",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyEnum, pgtypes.AnyEnum},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := t[0].Compare(val1.(string), val2.(string))
+		return res >= 0, err
+	},
+}
+
 // float4ge represents the PostgreSQL function of the same name, taking the same parameters.
 var float4ge = framework.Function2{
 	Name:       "float4ge",
@@ -334,6 +354,30 @@ var jsonb_ge = framework.Function2{
 	},
 }
 
+// macaddr8_ge represents the PostgreSQL function of the same name, taking the same parameters.
+var macaddr8_ge = framework.Function2{
+	Name:       "macaddr8_ge",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.MacAddr8, pgtypes.MacAddr8},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.MacAddr8.Compare(val1.(net.HardwareAddr), val2.(net.HardwareAddr))
+		return res >= 0, err
+	},
+}
+
+// macaddr_ge represents the PostgreSQL function of the same name, taking the same parameters.
+var macaddr_ge = framework.Function2{
+	Name:       "macaddr_ge",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.MacAddr, pgtypes.MacAddr},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.MacAddr.Compare(val1.(net.HardwareAddr), val2.(net.HardwareAddr))
+		return res >= 0, err
+	},
+}
+
 // namege represents the PostgreSQL function of the same name, taking the same parameters.
 var namege = framework.Function2{
 	Name:       "namege",
@@ -358,6 +402,18 @@ var namegetext = framework.Function2{
 	},
 }
 
+// network_ge represents the PostgreSQL function of the same name, taking the same parameters.
+var network_ge = framework.Function2{
+	Name:       "network_ge",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Inet, pgtypes.Inet},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.Inet.Compare(val1.(netip.Prefix), val2.(netip.Prefix))
+		return res >= 0, err
+	},
+}
+
 // numeric_ge represents the PostgreSQL function of the same name, taking the same parameters.
 var numeric_ge = framework.Function2{
 	Name:       "numeric_ge",
@@ -382,6 +438,33 @@ var oidge = framework.Function2{
 	},
 }
 
+// range_ge represents the PostgreSQL function of the same name, taking the same parameters.
+var range_ge = framework.Function2{
+	Name:       "range_ge",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyRange, pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := t[0].Compare(val1, val2)
+		return res >= 0, err
+	},
+}
+
+// record_ge represents the PostgreSQL function of the same name, taking the same parameters.
+var record_ge = framework.Function2{
+	Name:       "record_ge",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Record, pgtypes.Record},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, isNull, err := compareRecords(t[0], val1, val2, false)
+		if err != nil || isNull {
+			return nil, err
+		}
+		return res >= 0, nil
+	},
+}
+
 // textgename represents the PostgreSQL function of the same name, taking the same parameters.
 var textgename = framework.Function2{
 	Name:       "textgename",
@@ -16,6 +16,7 @@ package binary
 
 // Init initializes all binary operators in this package.
 func Init() {
+	initArray()
 	initBinaryBitAnd()
 	initBinaryBitOr()
 	initBinaryBitXor()
@@ -34,4 +35,7 @@ func Init() {
 	initBinaryShiftLeft()
 	initBinaryShiftRight()
 	initJSON()
+	initNetwork()
+	initRange()
+	initRegex()
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/functions/jsonpath"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
@@ -47,6 +48,8 @@ func initJSON() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONTopLevel, jsonb_exists)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONTopLevelAny, jsonb_exists_any)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONTopLevelAll, jsonb_exists_all)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONPathExists, jsonb_path_exists_opr)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONPathMatch, jsonb_path_match_opr)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryMinus, jsonb_delete_text)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryMinus, jsonb_delete_text_array)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryMinus, jsonb_delete_int32)
@@ -266,6 +269,12 @@ var jsonb_extract_path = framework.Function2{
 					// We don't return the error here, a bad parse is treated as an object key which isn't valid
 					return nil, nil
 				}
+				if idx < 0 {
+					idx += len(currentValue)
+				}
+				if idx < 0 || idx >= len(currentValue) {
+					return nil, nil
+				}
 				value = currentValue[idx]
 			default:
 				return nil, nil
@@ -319,7 +328,7 @@ var jsonb_contains = framework.Function2{
 	Parameters: [2]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.JsonB},
 	Strict:     true,
 	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
-		return nil, fmt.Errorf("JSON contains is not yet supported")
+		return jsonbContains(val1.(pgtypes.JsonDocument).Value, val2.(pgtypes.JsonDocument).Value, true), nil
 	},
 }
 
@@ -453,8 +462,8 @@ var jsonb_delete_text = framework.Function2{
 	Return:     pgtypes.JsonB,
 	Parameters: [2]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
 	Strict:     true,
-	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
-		return nil, fmt.Errorf("JSON deletions are not yet supported")
+	Callable: func(ctx *sql.Context, dt [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return jsonb_delete_text_array.Callable(ctx, dt, val1, []any{val2})
 	},
 }
 
@@ -465,7 +474,36 @@ var jsonb_delete_text_array = framework.Function2{
 	Parameters: [2]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.TextArray},
 	Strict:     true,
 	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
-		return nil, fmt.Errorf("JSON deletions are not yet supported")
+		keys := make(map[string]struct{})
+		for _, key := range val2.([]any) {
+			// NULL keys cannot match anything, so they're ignored
+			if key != nil {
+				keys[key.(string)] = struct{}{}
+			}
+		}
+		switch value := val1.(pgtypes.JsonDocument).Value.(type) {
+		case pgtypes.JsonValueObject:
+			items := make([]pgtypes.JsonValueObjectItem, 0, len(value.Items))
+			for _, item := range value.Items {
+				if _, ok := keys[item.Key]; !ok {
+					items = append(items, pgtypes.JsonValueObjectItem{Key: item.Key, Value: pgtypes.JsonValueCopy(item.Value)})
+				}
+			}
+			return pgtypes.JsonDocument{Value: pgtypes.NewJsonValueObject(items)}, nil
+		case pgtypes.JsonValueArray:
+			array := make(pgtypes.JsonValueArray, 0, len(value))
+			for _, element := range value {
+				if str, ok := element.(pgtypes.JsonValueString); ok {
+					if _, ok = keys[string(str)]; ok {
+						continue
+					}
+				}
+				array = append(array, pgtypes.JsonValueCopy(element))
+			}
+			return pgtypes.JsonDocument{Value: array}, nil
+		default:
+			return nil, fmt.Errorf("cannot delete from scalar")
+		}
 	},
 }
 
@@ -476,6 +514,124 @@ var jsonb_delete_int32 = framework.Function2{
 	Parameters: [2]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Int32},
 	Strict:     true,
 	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
-		return nil, fmt.Errorf("JSON deletions are not yet supported")
+		switch value := val1.(pgtypes.JsonDocument).Value.(type) {
+		case pgtypes.JsonValueArray:
+			idx := int(val2.(int32))
+			if idx < 0 {
+				idx += len(value)
+			}
+			array := make(pgtypes.JsonValueArray, 0, len(value))
+			for i, element := range value {
+				if i != idx {
+					array = append(array, pgtypes.JsonValueCopy(element))
+				}
+			}
+			return pgtypes.JsonDocument{Value: array}, nil
+		case pgtypes.JsonValueObject:
+			return nil, fmt.Errorf("cannot delete from object using integer index")
+		default:
+			return nil, fmt.Errorf("cannot delete from scalar")
+		}
+	},
+}
+
+// jsonb_path_exists_opr represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_path_exists_opr = framework.Function2{
+	Name:       "jsonb_path_exists_opr",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		path, err := jsonpath.Parse(val2.(string))
+		if err != nil {
+			return nil, err
+		}
+		// The operator suppresses errors that occur while evaluating the path
+		items, err := path.Execute(val1.(pgtypes.JsonDocument).Value, nil)
+		if err != nil {
+			return nil, nil
+		}
+		return len(items) > 0, nil
+	},
+}
+
+// jsonb_path_match_opr represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_path_match_opr = framework.Function2{
+	Name:       "jsonb_path_match_opr",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		path, err := jsonpath.Parse(val2.(string))
+		if err != nil {
+			return nil, err
+		}
+		// The operator suppresses errors that occur while evaluating the path
+		items, err := path.Execute(val1.(pgtypes.JsonDocument).Value, nil)
+		if err != nil || len(items) != 1 {
+			return nil, nil
+		}
+		if result, ok := items[0].(pgtypes.JsonValueBoolean); ok {
+			return bool(result), nil
+		}
+		return nil, nil
 	},
 }
+
+// jsonbContains returns whether the container contains the contained value, following PostgreSQL's rules for the @>
+// operator. Objects contain an object when every key of the contained object is present and its value is contained.
+// Arrays contain an array when every element of the contained array is contained by some element. At the top level
+// only, an array may also contain a scalar that matches one of its elements.
+func jsonbContains(container pgtypes.JsonValue, contained pgtypes.JsonValue, topLevel bool) bool {
+	switch container := container.(type) {
+	case pgtypes.JsonValueObject:
+		containedObject, ok := contained.(pgtypes.JsonValueObject)
+		if !ok {
+			return false
+		}
+		for _, item := range containedObject.Items {
+			idx, ok := container.Index[item.Key]
+			if !ok || !jsonbContains(container.Items[idx].Value, item.Value, false) {
+				return false
+			}
+		}
+		return true
+	case pgtypes.JsonValueArray:
+		switch contained := contained.(type) {
+		case pgtypes.JsonValueArray:
+			for _, containedElement := range contained {
+				found := false
+				for _, element := range container {
+					if jsonbContains(element, containedElement, false) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return false
+				}
+			}
+			return true
+		case pgtypes.JsonValueObject:
+			return false
+		default:
+			if !topLevel {
+				return false
+			}
+			for _, element := range container {
+				if jsonbContains(element, contained, false) {
+					return true
+				}
+			}
+			return false
+		}
+	default:
+		switch contained.(type) {
+		case pgtypes.JsonValueObject, pgtypes.JsonValueArray:
+			return false
+		default:
+			return pgtypes.JsonValueTypeName(container) == pgtypes.JsonValueTypeName(contained) &&
+				pgtypes.JsonValueCompare(container, contained) == 0
+		}
+	}
+}
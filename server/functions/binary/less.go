@@ -15,6 +15,8 @@
 package binary
 
 import (
+	"net"
+	"net/netip"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -38,6 +40,7 @@ func initBinaryLessThan() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, date_lt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, date_lt_timestamp)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, date_lt_timestamptz)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, enum_lt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, float4lt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, float48lt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, float84lt)
@@ -53,10 +56,15 @@ func initBinaryLessThan() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, int8lt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, interval_lt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, jsonb_lt)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, macaddr8_lt)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, macaddr_lt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, namelt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, namelttext)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, network_lt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, numeric_lt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, oidlt)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, range_lt)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, record_lt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, textltname)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, text_lt)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessThan, time_lt)
@@ -154,6 +162,18 @@ var date_lt_timestamptz = framework.Function2{
 	},
 }
 
+// enum_lt represents the PostgreSQL function of the same name, taking the same parameters.
+var enum_lt = framework.Function2{
+	Name:       "enum_lt",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyEnum, pgtypes.AnyEnum},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := t[0].Compare(val1.(string), val2.(string))
+		return res == -1, err
+	},
+}
+
 // float4lt represents the PostgreSQL function of the same name, taking the same parameters.
 var float4lt = framework.Function2{
 	Name:       "float4lt",
@@ -334,6 +354,30 @@ var jsonb_lt = framework.Function2{
 	},
 }
 
+// macaddr8_lt represents the PostgreSQL function of the same name, taking the same parameters.
+var macaddr8_lt = framework.Function2{
+	Name:       "macaddr8_lt",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.MacAddr8, pgtypes.MacAddr8},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.MacAddr8.Compare(val1.(net.HardwareAddr), val2.(net.HardwareAddr))
+		return res == -1, err
+	},
+}
+
+// macaddr_lt represents the PostgreSQL function of the same name, taking the same parameters.
+var macaddr_lt = framework.Function2{
+	Name:       "macaddr_lt",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.MacAddr, pgtypes.MacAddr},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.MacAddr.Compare(val1.(net.HardwareAddr), val2.(net.HardwareAddr))
+		return res == -1, err
+	},
+}
+
 // namelt represents the PostgreSQL function of the same name, taking the same parameters.
 var namelt = framework.Function2{
 	Name:       "namelt",
@@ -358,6 +402,18 @@ var namelttext = framework.Function2{
 	},
 }
 
+// network_lt represents the PostgreSQL function of the same name, taking the same parameters.
+var network_lt = framework.Function2{
+	Name:       "network_lt",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Inet, pgtypes.Inet},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.Inet.Compare(val1.(netip.Prefix), val2.(netip.Prefix))
+		return res == -1, err
+	},
+}
+
 // numeric_lt represents the PostgreSQL function of the same name, taking the same parameters.
 var numeric_lt = framework.Function2{
 	Name:       "numeric_lt",
@@ -382,6 +438,33 @@ var oidlt = framework.Function2{
 	},
 }
 
+// range_lt represents the PostgreSQL function of the same name, taking the same parameters.
+var range_lt = framework.Function2{
+	Name:       "range_lt",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyRange, pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := t[0].Compare(val1, val2)
+		return res == -1, err
+	},
+}
+
+// record_lt represents the PostgreSQL function of the same name, taking the same parameters.
+var record_lt = framework.Function2{
+	Name:       "record_lt",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Record, pgtypes.Record},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, isNull, err := compareRecords(t[0], val1, val2, false)
+		if err != nil || isNull {
+			return nil, err
+		}
+		return res == -1, nil
+	},
+}
+
 // textltname represents the PostgreSQL function of the same name, taking the same parameters.
 var textltname = framework.Function2{
 	Name:       "textltname",
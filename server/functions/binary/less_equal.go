@@ -15,6 +15,8 @@
 package binary
 
 import (
+	"net"
+	"net/netip"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -38,6 +40,7 @@ func initBinaryLessOrEqual() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, date_le)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, date_le_timestamp)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, date_le_timestamptz)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, enum_le)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, float4le)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, float48le)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, float84le)
@@ -53,10 +56,15 @@ func initBinaryLessOrEqual() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, int8le)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, interval_le)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, jsonb_le)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, macaddr8_le)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, macaddr_le)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, namele)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, nameletext)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, network_le)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, numeric_le)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, oidle)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, range_le)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, record_le)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, textlename)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, text_le)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryLessOrEqual, time_le)
@@ -154,6 +162,18 @@ var date_le_timestamptz = framework.Function2{
 	},
 }
 
+// enum_le represents the PostgreSQL function of the same name, taking the same parameters.
+var enum_le = framework.Function2{
+	Name:       "enum_le",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyEnum, pgtypes.AnyEnum},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := t[0].Compare(val1.(string), val2.(string))
+		return res <= 0, err
+	},
+}
+
 // float4le represents the PostgreSQL function of the same name, taking the same parameters.
 var float4le = framework.Function2{
 	Name:       "float4le",
@@ -334,6 +354,30 @@ var jsonb_le = framework.Function2{
 	},
 }
 
+// macaddr8_le represents the PostgreSQL function of the same name, taking the same parameters.
+var macaddr8_le = framework.Function2{
+	Name:       "macaddr8_le",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.MacAddr8, pgtypes.MacAddr8},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.MacAddr8.Compare(val1.(net.HardwareAddr), val2.(net.HardwareAddr))
+		return res <= 0, err
+	},
+}
+
+// macaddr_le represents the PostgreSQL function of the same name, taking the same parameters.
+var macaddr_le = framework.Function2{
+	Name:       "macaddr_le",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.MacAddr, pgtypes.MacAddr},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.MacAddr.Compare(val1.(net.HardwareAddr), val2.(net.HardwareAddr))
+		return res <= 0, err
+	},
+}
+
 // namele represents the PostgreSQL function of the same name, taking the same parameters.
 var namele = framework.Function2{
 	Name:       "namele",
@@ -358,6 +402,18 @@ var nameletext = framework.Function2{
 	},
 }
 
+// network_le represents the PostgreSQL function of the same name, taking the same parameters.
+var network_le = framework.Function2{
+	Name:       "network_le",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Inet, pgtypes.Inet},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.Inet.Compare(val1.(netip.Prefix), val2.(netip.Prefix))
+		return res <= 0, err
+	},
+}
+
 // numeric_le represents the PostgreSQL function of the same name, taking the same parameters.
 var numeric_le = framework.Function2{
 	Name:       "numeric_le",
@@ -382,6 +438,33 @@ var oidle = framework.Function2{
 	},
 }
 
+// range_le represents the PostgreSQL function of the same name, taking the same parameters.
+var range_le = framework.Function2{
+	Name:       "range_le",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyRange, pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := t[0].Compare(val1, val2)
+		return res <= 0, err
+	},
+}
+
+// record_le represents the PostgreSQL function of the same name, taking the same parameters.
+var record_le = framework.Function2{
+	Name:       "record_le",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Record, pgtypes.Record},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, isNull, err := compareRecords(t[0], val1, val2, false)
+		if err != nil || isNull {
+			return nil, err
+		}
+		return res <= 0, nil
+	},
+}
+
 // textlename represents the PostgreSQL function of the same name, taking the same parameters.
 var textlename = framework.Function2{
 	Name:       "textlename",
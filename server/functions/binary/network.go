@@ -0,0 +1,45 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"net/netip"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// These functions can be gathered using the following query from a Postgres 15 instance:
+// SELECT * FROM pg_operator o WHERE o.oprleft = 'inet'::regtype AND o.oprright = 'inet'::regtype ORDER BY o.oprcode::varchar;
+// Comparison operators, along with << and >>, are registered alongside the other functions for those operators. The
+// <<= and >>= operators are rewritten as function calls by the parser, so they're registered as regular functions.
+
+// initNetwork registers the functions to the catalog.
+func initNetwork() {
+	framework.RegisterBinaryFunction(framework.Operator_BinaryOverlaps, network_overlap)
+}
+
+// network_overlap represents the PostgreSQL function of the same name, taking the same parameters.
+var network_overlap = framework.Function2{
+	Name:       "network_overlap",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Inet, pgtypes.Inet},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return pgtypes.NetworkAddressOverlaps(val1.(netip.Prefix), val2.(netip.Prefix)), nil
+	},
+}
@@ -15,6 +15,8 @@
 package binary
 
 import (
+	"net"
+	"net/netip"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -38,6 +40,7 @@ func initBinaryNotEqual() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, date_ne)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, date_ne_timestamp)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, date_ne_timestamptz)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, enum_ne)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, float4ne)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, float48ne)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, float84ne)
@@ -53,10 +56,15 @@ func initBinaryNotEqual() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, int8ne)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, interval_ne)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, jsonb_ne)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, macaddr8_ne)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, macaddr_ne)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, namene)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, namenetext)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, network_ne)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, numeric_ne)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, oidne)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, range_ne)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, record_ne)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, textnename)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, text_ne)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryNotEqual, time_ne)
@@ -156,6 +164,18 @@ var date_ne_timestamptz = framework.Function2{
 	},
 }
 
+// enum_ne represents the PostgreSQL function of the same name, taking the same parameters.
+var enum_ne = framework.Function2{
+	Name:       "enum_ne",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyEnum, pgtypes.AnyEnum},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := t[0].Compare(val1.(string), val2.(string))
+		return res != 0, err
+	},
+}
+
 // float4ne represents the PostgreSQL function of the same name, taking the same parameters.
 var float4ne = framework.Function2{
 	Name:       "float4ne",
@@ -336,6 +356,30 @@ var jsonb_ne = framework.Function2{
 	},
 }
 
+// macaddr8_ne represents the PostgreSQL function of the same name, taking the same parameters.
+var macaddr8_ne = framework.Function2{
+	Name:       "macaddr8_ne",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.MacAddr8, pgtypes.MacAddr8},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.MacAddr8.Compare(val1.(net.HardwareAddr), val2.(net.HardwareAddr))
+		return res != 0, err
+	},
+}
+
+// macaddr_ne represents the PostgreSQL function of the same name, taking the same parameters.
+var macaddr_ne = framework.Function2{
+	Name:       "macaddr_ne",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.MacAddr, pgtypes.MacAddr},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.MacAddr.Compare(val1.(net.HardwareAddr), val2.(net.HardwareAddr))
+		return res != 0, err
+	},
+}
+
 // namene represents the PostgreSQL function of the same name, taking the same parameters.
 var namene = framework.Function2{
 	Name:       "namene",
@@ -360,6 +404,18 @@ var namenetext = framework.Function2{
 	},
 }
 
+// network_ne represents the PostgreSQL function of the same name, taking the same parameters.
+var network_ne = framework.Function2{
+	Name:       "network_ne",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Inet, pgtypes.Inet},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := pgtypes.Inet.Compare(val1.(netip.Prefix), val2.(netip.Prefix))
+		return res != 0, err
+	},
+}
+
 // numeric_ne represents the PostgreSQL function of the same name, taking the same parameters.
 var numeric_ne = framework.Function2{
 	Name:       "numeric_ne",
@@ -384,6 +440,33 @@ var oidne = framework.Function2{
 	},
 }
 
+// range_ne represents the PostgreSQL function of the same name, taking the same parameters.
+var range_ne = framework.Function2{
+	Name:       "range_ne",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyRange, pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, err := t[0].Compare(val1, val2)
+		return res != 0, err
+	},
+}
+
+// record_ne represents the PostgreSQL function of the same name, taking the same parameters.
+var record_ne = framework.Function2{
+	Name:       "record_ne",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Record, pgtypes.Record},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		res, isNull, err := compareRecords(t[0], val1, val2, true)
+		if err != nil || isNull {
+			return nil, err
+		}
+		return res != 0, nil
+	},
+}
+
 // textnename represents the PostgreSQL function of the same name, taking the same parameters.
 var textnename = framework.Function2{
 	Name:       "textnename",
@@ -0,0 +1,90 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// These functions can be gathered using the following query from a Postgres 15 instance:
+// SELECT * FROM pg_operator o WHERE o.oprleft = 'anyrange'::regtype OR o.oprright = 'anyrange'::regtype ORDER BY o.oprcode::varchar;
+// Comparison operators, along with << and >>, are registered alongside the other functions for those operators.
+
+// initRange registers the functions to the catalog.
+func initRange() {
+	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONContainsLeft, elem_contained_by_range)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONContainsLeft, range_contained_by)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONContainsRight, range_contains)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONContainsRight, range_contains_elem)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryOverlaps, range_overlaps)
+}
+
+// elem_contained_by_range represents the PostgreSQL function of the same name, taking the same parameters.
+var elem_contained_by_range = framework.Function2{
+	Name:       "elem_contained_by_range",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyElement, pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return t[1].(pgtypes.RangeType).ContainsElement(val2.(pgtypes.RangeValue), val1)
+	},
+}
+
+// range_contained_by represents the PostgreSQL function of the same name, taking the same parameters.
+var range_contained_by = framework.Function2{
+	Name:       "range_contained_by",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyRange, pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return t[0].(pgtypes.RangeType).Contains(val2.(pgtypes.RangeValue), val1.(pgtypes.RangeValue))
+	},
+}
+
+// range_contains represents the PostgreSQL function of the same name, taking the same parameters.
+var range_contains = framework.Function2{
+	Name:       "range_contains",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyRange, pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return t[0].(pgtypes.RangeType).Contains(val1.(pgtypes.RangeValue), val2.(pgtypes.RangeValue))
+	},
+}
+
+// range_contains_elem represents the PostgreSQL function of the same name, taking the same parameters.
+var range_contains_elem = framework.Function2{
+	Name:       "range_contains_elem",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyRange, pgtypes.AnyElement},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return t[0].(pgtypes.RangeType).ContainsElement(val1.(pgtypes.RangeValue), val2)
+	},
+}
+
+// range_overlaps represents the PostgreSQL function of the same name, taking the same parameters.
+var range_overlaps = framework.Function2{
+	Name:       "range_overlaps",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyRange, pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return t[0].(pgtypes.RangeType).Overlaps(val1.(pgtypes.RangeValue), val2.(pgtypes.RangeValue))
+	},
+}
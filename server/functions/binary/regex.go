@@ -0,0 +1,122 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// These functions can be gathered using the following query from a Postgres 15 instance:
+// SELECT * FROM pg_operator o WHERE o.oprname IN ('~', '~*', '!~', '!~*', '~~*', '!~~*') AND o.oprleft = 'text'::regtype ORDER BY o.oprcode::varchar;
+// SIMILAR TO is rewritten by the parser to use the ~ operator along with similar_to_escape.
+
+// initRegex registers the functions to the catalog.
+func initRegex() {
+	framework.RegisterBinaryFunction(framework.Operator_BinaryRegexMatch, textregexeq)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryRegexIMatch, texticregexeq)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryNotRegexMatch, textregexne)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryNotRegexIMatch, texticregexne)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryILike, texticlike)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryNotILike, texticnlike)
+}
+
+// textregexeq represents the PostgreSQL function of the same name, taking the same parameters.
+var textregexeq = framework.Function2{
+	Name:       "textregexeq",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return regexMatches(val1.(string), val2.(string), "")
+	},
+}
+
+// texticregexeq represents the PostgreSQL function of the same name, taking the same parameters.
+var texticregexeq = framework.Function2{
+	Name:       "texticregexeq",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return regexMatches(val1.(string), val2.(string), "i")
+	},
+}
+
+// textregexne represents the PostgreSQL function of the same name, taking the same parameters.
+var textregexne = framework.Function2{
+	Name:       "textregexne",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		matches, err := regexMatches(val1.(string), val2.(string), "")
+		return !matches, err
+	},
+}
+
+// texticregexne represents the PostgreSQL function of the same name, taking the same parameters.
+var texticregexne = framework.Function2{
+	Name:       "texticregexne",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		matches, err := regexMatches(val1.(string), val2.(string), "i")
+		return !matches, err
+	},
+}
+
+// texticlike represents the PostgreSQL function of the same name, taking the same parameters.
+var texticlike = framework.Function2{
+	Name:       "texticlike",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		re, err := functions.LikeToRegex(val2.(string), `\`, true)
+		if err != nil {
+			return nil, err
+		}
+		return re.MatchString(val1.(string)), nil
+	},
+}
+
+// texticnlike represents the PostgreSQL function of the same name, taking the same parameters.
+var texticnlike = framework.Function2{
+	Name:       "texticnlike",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		re, err := functions.LikeToRegex(val2.(string), `\`, true)
+		if err != nil {
+			return nil, err
+		}
+		return !re.MatchString(val1.(string)), nil
+	},
+}
+
+// regexMatches returns whether the PostgreSQL regular expression, compiled with the given flags, matches the string.
+func regexMatches(str string, pattern string, flags string) (bool, error) {
+	re, _, err := functions.CompileRegex(pattern, flags)
+	if err != nil {
+		return false, err
+	}
+	return re.MatchString(str), nil
+}
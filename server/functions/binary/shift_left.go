@@ -15,6 +15,8 @@
 package binary
 
 import (
+	"net/netip"
+
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/server/functions/framework"
@@ -29,6 +31,8 @@ func initBinaryShiftLeft() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryShiftLeft, int2shl)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryShiftLeft, int4shl)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryShiftLeft, int8shl)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryShiftLeft, network_sub)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryShiftLeft, range_before)
 }
 
 // int2shl represents the PostgreSQL function of the same name, taking the same parameters.
@@ -63,3 +67,25 @@ var int8shl = framework.Function2{
 		return int64(val1.(int64) << int64(val2.(int32))), nil
 	},
 }
+
+// network_sub represents the PostgreSQL function of the same name, taking the same parameters.
+var network_sub = framework.Function2{
+	Name:       "network_sub",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Inet, pgtypes.Inet},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return pgtypes.NetworkAddressContains(val2.(netip.Prefix), val1.(netip.Prefix), true), nil
+	},
+}
+
+// range_before represents the PostgreSQL function of the same name, taking the same parameters.
+var range_before = framework.Function2{
+	Name:       "range_before",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyRange, pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return t[0].(pgtypes.RangeType).StrictlyLeft(val1.(pgtypes.RangeValue), val2.(pgtypes.RangeValue))
+	},
+}
@@ -15,6 +15,8 @@
 package binary
 
 import (
+	"net/netip"
+
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/server/functions/framework"
@@ -29,6 +31,8 @@ func initBinaryShiftRight() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryShiftRight, int2shr)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryShiftRight, int4shr)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryShiftRight, int8shr)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryShiftRight, network_sup)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryShiftRight, range_after)
 }
 
 // int2shr represents the PostgreSQL function of the same name, taking the same parameters.
@@ -63,3 +67,25 @@ var int8shr = framework.Function2{
 		return int64(val1.(int64) >> int64(val2.(int32))), nil
 	},
 }
+
+// network_sup represents the PostgreSQL function of the same name, taking the same parameters.
+var network_sup = framework.Function2{
+	Name:       "network_sup",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Inet, pgtypes.Inet},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return pgtypes.NetworkAddressContains(val1.(netip.Prefix), val2.(netip.Prefix), true), nil
+	},
+}
+
+// range_after represents the PostgreSQL function of the same name, taking the same parameters.
+var range_after = framework.Function2{
+	Name:       "range_after",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyRange, pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return t[0].(pgtypes.RangeType).StrictlyLeft(val2.(pgtypes.RangeValue), val1.(pgtypes.RangeValue))
+	},
+}
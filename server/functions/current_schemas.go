@@ -45,6 +45,11 @@ var current_schemas = framework.Function1{
 			return nil, err
 		}
 		for _, schema := range searchPaths {
+			// The default search_path ends with an empty element so that user-space Dolt tables may be found, which is
+			// not an actual schema
+			if len(schema) == 0 {
+				continue
+			}
 			schemas = append(schemas, schema)
 		}
 		return schemas, nil
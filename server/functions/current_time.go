@@ -0,0 +1,70 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/timeofday"
+	"github.com/dolthub/doltgresql/postgres/parser/timetz"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initCurrentTime registers the functions to the catalog.
+func initCurrentTime() {
+	framework.RegisterFunction(current_time)
+	framework.RegisterFunction(current_time_int32)
+}
+
+// current_time represents the PostgreSQL function of the same name, taking the same parameters.
+var current_time = framework.Function0{
+	Name:               "current_time",
+	Return:             pgtypes.TimeTZ,
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context) (any, error) {
+		return currentTimeTZ(ctx, TransactionTimestamp(ctx))
+	},
+}
+
+// current_time_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var current_time_int32 = framework.Function1{
+	Name:               "current_time",
+	Return:             pgtypes.TimeTZ,
+	Parameters:         [1]pgtypes.DoltgresType{pgtypes.Int32},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		t, err := roundTimeToPrecision(TransactionTimestamp(ctx), val1.(int32))
+		if err != nil {
+			return nil, err
+		}
+		return currentTimeTZ(ctx, t)
+	},
+}
+
+// currentTimeTZ returns the time of day of the given time as a time with time zone, using the server's time zone.
+func currentTimeTZ(ctx *sql.Context, t time.Time) (time.Time, error) {
+	loc, err := pgtypes.GetServerLocation(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t = t.In(loc)
+	_, offset := t.Zone()
+	return timetz.MakeTimeTZ(timeofday.FromTime(t), int32(-offset)).ToTime(), nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initCurrentTimestamp registers the functions to the catalog.
+func initCurrentTimestamp() {
+	framework.RegisterFunction(current_timestamp)
+	framework.RegisterFunction(current_timestamp_int32)
+}
+
+// current_timestamp represents the PostgreSQL function of the same name, taking the same parameters.
+var current_timestamp = framework.Function0{
+	Name:               "current_timestamp",
+	Return:             pgtypes.TimestampTZ,
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context) (any, error) {
+		return TransactionTimestamp(ctx), nil
+	},
+}
+
+// current_timestamp_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var current_timestamp_int32 = framework.Function1{
+	Name:               "current_timestamp",
+	Return:             pgtypes.TimestampTZ,
+	Parameters:         [1]pgtypes.DoltgresType{pgtypes.Int32},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		return roundTimeToPrecision(TransactionTimestamp(ctx), val1.(int32))
+	},
+}
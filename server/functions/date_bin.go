@@ -0,0 +1,79 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDateBin registers the functions to the catalog.
+func initDateBin() {
+	framework.RegisterFunction(date_bin_interval_timestamp_timestamp)
+	framework.RegisterFunction(date_bin_interval_timestamptz_timestamptz)
+}
+
+// date_bin_interval_timestamp_timestamp represents the PostgreSQL date/time function, taking {interval, timestamp without time zone, timestamp without time zone}
+var date_bin_interval_timestamp_timestamp = framework.Function3{
+	Name:       "date_bin",
+	Return:     pgtypes.Timestamp,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Interval, pgtypes.Timestamp, pgtypes.Timestamp},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+		return dateBin(val1.(duration.Duration), val2.(time.Time), val3.(time.Time))
+	},
+}
+
+// date_bin_interval_timestamptz_timestamptz represents the PostgreSQL date/time function, taking {interval, timestamp with time zone, timestamp with time zone}
+var date_bin_interval_timestamptz_timestamptz = framework.Function3{
+	Name:       "date_bin",
+	Return:     pgtypes.TimestampTZ,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Interval, pgtypes.TimestampTZ, pgtypes.TimestampTZ},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+		return dateBin(val1.(duration.Duration), val2.(time.Time), val3.(time.Time))
+	},
+}
+
+// dateBin returns the start of the bin containing the source time, where the bins are of the given stride and are
+// aligned to the origin.
+func dateBin(stride duration.Duration, source time.Time, origin time.Time) (time.Time, error) {
+	if isInfiniteTime(source) {
+		return source, nil
+	}
+	if isInfiniteTime(origin) {
+		return time.Time{}, fmt.Errorf("origin out of range")
+	}
+	if stride.Months != 0 {
+		return time.Time{}, fmt.Errorf("timestamps cannot be binned into intervals containing months or years")
+	}
+	strideMicros := stride.Days*duration.SecsPerDay*duration.MillisPerSec*duration.MicrosPerMilli + stride.Nanos()/NanosPerMicro
+	if strideMicros <= 0 {
+		return time.Time{}, fmt.Errorf("stride must be greater than zero")
+	}
+	diff := source.UnixMicro() - origin.UnixMicro()
+	delta := diff - diff%strideMicros
+	// Values before the origin must be placed in the bin that begins before them
+	if diff < 0 && diff%strideMicros != 0 {
+		delta -= strideMicros
+	}
+	return duration.AddMicros(origin, delta), nil
+}
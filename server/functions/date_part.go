@@ -0,0 +1,114 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDatePart registers the functions to the catalog.
+func initDatePart() {
+	framework.RegisterFunction(date_part_text_date)
+	framework.RegisterFunction(date_part_text_time)
+	framework.RegisterFunction(date_part_text_timetz)
+	framework.RegisterFunction(date_part_text_timestamp)
+	framework.RegisterFunction(date_part_text_timestamptz)
+	framework.RegisterFunction(date_part_text_interval)
+}
+
+// date_part_text_date represents the PostgreSQL date/time function, taking {text, date}
+var date_part_text_date = framework.Function2{
+	Name:               "date_part",
+	Return:             pgtypes.Float64,
+	Parameters:         [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Date},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, paramsAndReturn [3]pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		return datePart(extract_text_date.Callable(ctx, paramsAndReturn, val1, val2))
+	},
+}
+
+// date_part_text_time represents the PostgreSQL date/time function, taking {text, time without time zone}
+var date_part_text_time = framework.Function2{
+	Name:               "date_part",
+	Return:             pgtypes.Float64,
+	Parameters:         [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Time},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, paramsAndReturn [3]pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		return datePart(extract_text_time.Callable(ctx, paramsAndReturn, val1, val2))
+	},
+}
+
+// date_part_text_timetz represents the PostgreSQL date/time function, taking {text, time with time zone}
+var date_part_text_timetz = framework.Function2{
+	Name:               "date_part",
+	Return:             pgtypes.Float64,
+	Parameters:         [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.TimeTZ},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, paramsAndReturn [3]pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		return datePart(extract_text_timetz.Callable(ctx, paramsAndReturn, val1, val2))
+	},
+}
+
+// date_part_text_timestamp represents the PostgreSQL date/time function, taking {text, timestamp without time zone}
+var date_part_text_timestamp = framework.Function2{
+	Name:               "date_part",
+	Return:             pgtypes.Float64,
+	Parameters:         [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Timestamp},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, paramsAndReturn [3]pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		return datePart(extract_text_timestamp.Callable(ctx, paramsAndReturn, val1, val2))
+	},
+}
+
+// date_part_text_timestamptz represents the PostgreSQL date/time function, taking {text, timestamp with time zone}
+var date_part_text_timestamptz = framework.Function2{
+	Name:               "date_part",
+	Return:             pgtypes.Float64,
+	Parameters:         [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.TimestampTZ},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, paramsAndReturn [3]pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		return datePart(extract_text_timestamptz.Callable(ctx, paramsAndReturn, val1, val2))
+	},
+}
+
+// date_part_text_interval represents the PostgreSQL date/time function, taking {text, interval}
+var date_part_text_interval = framework.Function2{
+	Name:               "date_part",
+	Return:             pgtypes.Float64,
+	Parameters:         [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Interval},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, paramsAndReturn [3]pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		return datePart(extract_text_interval.Callable(ctx, paramsAndReturn, val1, val2))
+	},
+}
+
+// datePart converts the numeric result of extract into the double precision result of date_part.
+func datePart(val any, err error) (any, error) {
+	if err != nil || val == nil {
+		return nil, err
+	}
+	f, _ := val.(decimal.Decimal).Float64()
+	return f, nil
+}
@@ -0,0 +1,196 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDateTrunc registers the functions to the catalog.
+func initDateTrunc() {
+	framework.RegisterFunction(date_trunc_text_timestamp)
+	framework.RegisterFunction(date_trunc_text_timestamptz)
+	framework.RegisterFunction(date_trunc_text_timestamptz_text)
+	framework.RegisterFunction(date_trunc_text_interval)
+}
+
+// date_trunc_text_timestamp represents the PostgreSQL date/time function, taking {text, timestamp without time zone}
+var date_trunc_text_timestamp = framework.Function2{
+	Name:       "date_trunc",
+	Return:     pgtypes.Timestamp,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Timestamp},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		return truncateTime(val1.(string), val2.(time.Time), "timestamp without time zone")
+	},
+}
+
+// date_trunc_text_timestamptz represents the PostgreSQL date/time function, taking {text, timestamp with time zone}
+var date_trunc_text_timestamptz = framework.Function2{
+	Name:               "date_trunc",
+	Return:             pgtypes.TimestampTZ,
+	Parameters:         [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.TimestampTZ},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		loc, err := pgtypes.GetServerLocation(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return truncateTime(val1.(string), val2.(time.Time).In(loc), "timestamp with time zone")
+	},
+}
+
+// date_trunc_text_timestamptz_text represents the PostgreSQL date/time function, taking {text, timestamp with time zone, text}
+var date_trunc_text_timestamptz_text = framework.Function3{
+	Name:       "date_trunc",
+	Return:     pgtypes.TimestampTZ,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Text, pgtypes.TimestampTZ, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+		loc, err := loadTimeZone(val3.(string))
+		if err != nil {
+			return nil, err
+		}
+		return truncateTime(val1.(string), val2.(time.Time).In(loc), "timestamp with time zone")
+	},
+}
+
+// date_trunc_text_interval represents the PostgreSQL date/time function, taking {text, interval}
+var date_trunc_text_interval = framework.Function2{
+	Name:       "date_trunc",
+	Return:     pgtypes.Interval,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Interval},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		field := val1.(string)
+		dur := val2.(duration.Duration)
+		nanos := dur.Nanos()
+		switch strings.ToLower(field) {
+		case "microsecond", "microseconds":
+			return duration.MakeDuration(nanos-nanos%NanosPerMicro, dur.Days, dur.Months), nil
+		case "millisecond", "milliseconds":
+			return duration.MakeDuration(nanos-nanos%NanosPerMilli, dur.Days, dur.Months), nil
+		case "second", "seconds":
+			return duration.MakeDuration(nanos-nanos%NanosPerSec, dur.Days, dur.Months), nil
+		case "minute", "minutes":
+			return duration.MakeDuration(nanos-nanos%(NanosPerSec*duration.SecsPerMinute), dur.Days, dur.Months), nil
+		case "hour", "hours":
+			return duration.MakeDuration(nanos-nanos%(NanosPerSec*duration.SecsPerHour), dur.Days, dur.Months), nil
+		case "day", "days":
+			return duration.MakeDuration(0, dur.Days, dur.Months), nil
+		case "month", "months":
+			return duration.MakeDuration(0, 0, dur.Months), nil
+		case "quarter":
+			return duration.MakeDuration(0, 0, dur.Months-dur.Months%3), nil
+		case "year", "years":
+			return duration.MakeDuration(0, 0, dur.Months-dur.Months%12), nil
+		case "decade", "decades":
+			return duration.MakeDuration(0, 0, dur.Months-dur.Months%120), nil
+		case "century", "centuries":
+			return duration.MakeDuration(0, 0, dur.Months-dur.Months%1200), nil
+		case "millennium", "millenniums":
+			return duration.MakeDuration(0, 0, dur.Months-dur.Months%12000), nil
+		case "week", "weeks":
+			return nil, ErrUnitNotSupported.New(field, "interval")
+		default:
+			return nil, fmt.Errorf(`unit "%s" not recognized for type interval`, field)
+		}
+	},
+}
+
+// truncateTime truncates the given time to the precision specified by the field. The truncation is performed on the
+// wall time within the time's location. Infinite values are returned unchanged.
+func truncateTime(field string, t time.Time, typeName string) (time.Time, error) {
+	if isInfiniteTime(t) {
+		return t, nil
+	}
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+	nsec := t.Nanosecond()
+	loc := t.Location()
+	switch strings.ToLower(field) {
+	case "microsecond", "microseconds":
+		return time.Date(year, month, day, hour, minute, sec, nsec-nsec%NanosPerMicro, loc), nil
+	case "millisecond", "milliseconds":
+		return time.Date(year, month, day, hour, minute, sec, nsec-nsec%NanosPerMilli, loc), nil
+	case "second", "seconds":
+		return time.Date(year, month, day, hour, minute, sec, 0, loc), nil
+	case "minute", "minutes":
+		return time.Date(year, month, day, hour, minute, 0, 0, loc), nil
+	case "hour", "hours":
+		return time.Date(year, month, day, hour, 0, 0, 0, loc), nil
+	case "day", "days":
+		return time.Date(year, month, day, 0, 0, 0, 0, loc), nil
+	case "week", "weeks":
+		// Weeks begin on Monday, as defined by ISO 8601
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, loc), nil
+	case "month", "months":
+		return time.Date(year, month, 1, 0, 0, 0, 0, loc), nil
+	case "quarter":
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, loc), nil
+	case "year", "years":
+		return time.Date(year, 1, 1, 0, 0, 0, 0, loc), nil
+	case "decade", "decades":
+		if year > 0 {
+			year = (year / 10) * 10
+		} else {
+			year = -((8 - (year - 1)) / 10) * 10
+		}
+		return time.Date(year, 1, 1, 0, 0, 0, 0, loc), nil
+	case "century", "centuries":
+		// Centuries begin with the year ending in 01, so the 21st century begins in 2001
+		if year > 0 {
+			year = ((year+99)/100)*100 - 99
+		} else {
+			year = -((99-(year-1))/100)*100 + 1
+		}
+		return time.Date(year, 1, 1, 0, 0, 0, 0, loc), nil
+	case "millennium", "millenniums":
+		if year > 0 {
+			year = ((year+999)/1000)*1000 - 999
+		} else {
+			year = -((999-(year-1))/1000)*1000 + 1
+		}
+		return time.Date(year, 1, 1, 0, 0, 0, 0, loc), nil
+	default:
+		return time.Time{}, fmt.Errorf(`unit "%s" not recognized for type %s`, field, typeName)
+	}
+}
+
+// loadTimeZone returns the location for the given time zone, which may either be a named time zone (such as
+// "America/New_York" or "UTC") or an offset (such as "+05:30").
+func loadTimeZone(tz string) (*time.Location, error) {
+	if strings.ToLower(tz) == "utc" {
+		return time.UTC, nil
+	}
+	if loc, err := time.LoadLocation(tz); err == nil {
+		return loc, nil
+	}
+	offset, err := convertTzToOffsetSecs(tz)
+	if err != nil {
+		return nil, fmt.Errorf(`time zone "%s" not recognized`, tz)
+	}
+	return time.FixedZone(tz, int(-offset)), nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDateRange registers the functions to the catalog.
+func initDateRange() {
+	framework.RegisterFunction(daterange_date_date)
+	framework.RegisterFunction(daterange_date_date_text)
+}
+
+// daterange_date_date represents the PostgreSQL function of the same name, taking the same parameters.
+var daterange_date_date = framework.Function2{
+	Name:       "daterange",
+	Return:     pgtypes.DateRange,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Date, pgtypes.Date},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return constructRange(pgtypes.DateRange, val1, val2, "[)")
+	},
+}
+
+// daterange_date_date_text represents the PostgreSQL function of the same name, taking the same parameters.
+var daterange_date_date_text = framework.Function3{
+	Name:       "daterange",
+	Return:     pgtypes.DateRange,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Date, pgtypes.Date, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		if val3 == nil {
+			return nil, fmt.Errorf("range constructor flags argument must not be null")
+		}
+		return constructRange(pgtypes.DateRange, val1, val2, val3.(string))
+	},
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initEnumFirst registers the functions to the catalog.
+func initEnumFirst() {
+	framework.RegisterFunction(enum_first_anyenum)
+}
+
+// enum_first_anyenum represents the PostgreSQL function of the same name, taking the same parameters.
+var enum_first_anyenum = framework.Function1{
+	Name:       "enum_first",
+	Return:     pgtypes.AnyEnum,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.AnyEnum},
+	Callable: func(ctx *sql.Context, t [2]pgtypes.DoltgresType, val any) (any, error) {
+		enumType, err := enumTypeFromParameter(t[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(enumType.Labels) == 0 {
+			return nil, nil
+		}
+		return enumType.Labels[0], nil
+	},
+}
+
+// enumTypeFromParameter returns the enum type that the given parameter type was resolved to.
+func enumTypeFromParameter(t pgtypes.DoltgresType) (pgtypes.EnumType, error) {
+	enumType, ok := t.(pgtypes.EnumType)
+	if !ok {
+		return pgtypes.EnumType{}, fmt.Errorf("could not determine actual enum type")
+	}
+	return enumType, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initEnumRange registers the functions to the catalog.
+func initEnumRange() {
+	framework.RegisterFunction(enum_range_anyenum)
+	framework.RegisterFunction(enum_range_anyenum_anyenum)
+}
+
+// enum_range_anyenum represents the PostgreSQL function of the same name, taking the same parameters.
+var enum_range_anyenum = framework.Function1{
+	Name:       "enum_range",
+	Return:     pgtypes.AnyArray,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.AnyEnum},
+	Callable: func(ctx *sql.Context, t [2]pgtypes.DoltgresType, val any) (any, error) {
+		enumType, err := enumTypeFromParameter(t[0])
+		if err != nil {
+			return nil, err
+		}
+		labels := make([]any, len(enumType.Labels))
+		for i, label := range enumType.Labels {
+			labels[i] = label
+		}
+		return labels, nil
+	},
+}
+
+// enum_range_anyenum_anyenum represents the PostgreSQL function of the same name, taking the same parameters.
+var enum_range_anyenum_anyenum = framework.Function2{
+	Name:       "enum_range",
+	Return:     pgtypes.AnyArray,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyEnum, pgtypes.AnyEnum},
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		enumType, err := enumTypeFromParameter(t[0])
+		if err != nil {
+			return nil, err
+		}
+		// A NULL bound refers to the first or last label respectively
+		start, end := 0, len(enumType.Labels)-1
+		if val1 != nil {
+			if start = enumType.LabelIndex(val1.(string)); start == -1 {
+				return nil, pgtypes.ErrInvalidEnumInput.New(enumType.Name, val1.(string))
+			}
+		}
+		if val2 != nil {
+			if end = enumType.LabelIndex(val2.(string)); end == -1 {
+				return nil, pgtypes.ErrInvalidEnumInput.New(enumType.Name, val2.(string))
+			}
+		}
+		labels := make([]any, 0, len(enumType.Labels))
+		for i := start; i <= end; i++ {
+			labels = append(labels, enumType.Labels[i])
+		}
+		return labels, nil
+	},
+}
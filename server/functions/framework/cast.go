@@ -259,10 +259,11 @@ func getCast(mutex *sync.RWMutex,
 	if fromArrayType, ok := fromType.IsBaseIDArrayType(); ok && fromType != pgtypes.DoltgresTypeBaseID_Unknown {
 		if toArrayType, ok := toType.IsBaseIDArrayType(); ok {
 			if baseCast := outerFunc(fromArrayType.BaseType().BaseID(), toArrayType.BaseType().BaseID()); baseCast != nil {
-				// We use a closure that can unwrap the slice, since conversion functions expect a singular non-nil value
-				return func(ctx *sql.Context, vals any, targetType pgtypes.DoltgresType) (any, error) {
+				// We use a closure that can unwrap the slice, since conversion functions expect a singular non-nil value.
+				// Sub-arrays of multidimensional arrays are unwrapped recursively.
+				var castArray func(ctx *sql.Context, oldVals []any, targetType pgtypes.DoltgresType) ([]any, error)
+				castArray = func(ctx *sql.Context, oldVals []any, targetType pgtypes.DoltgresType) ([]any, error) {
 					var err error
-					oldVals := vals.([]any)
 					newVals := make([]any, len(oldVals))
 					for i, oldVal := range oldVals {
 						if oldVal == nil {
@@ -271,13 +272,20 @@ func getCast(mutex *sync.RWMutex,
 						// Some errors are optional depending on the context, so we'll still process all values even
 						// after an error is received.
 						var nErr error
-						newVals[i], nErr = baseCast(ctx, oldVal, targetType.(pgtypes.DoltgresArrayType).BaseType())
+						if subArray, ok := oldVal.([]any); ok {
+							newVals[i], nErr = castArray(ctx, subArray, targetType)
+						} else {
+							newVals[i], nErr = baseCast(ctx, oldVal, targetType.(pgtypes.DoltgresArrayType).BaseType())
+						}
 						if nErr != nil && err == nil {
 							err = nErr
 						}
 					}
 					return newVals, err
 				}
+				return func(ctx *sql.Context, vals any, targetType pgtypes.DoltgresType) (any, error) {
+					return castArray(ctx, vals.([]any), targetType)
+				}
 			}
 		}
 	}
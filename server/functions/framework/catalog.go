@@ -50,6 +50,15 @@ func RegisterFunction(f FunctionInterface) {
 	case Function4:
 		name := strings.ToLower(f.Name)
 		Catalog[name] = append(Catalog[name], f)
+	case Function5:
+		name := strings.ToLower(f.Name)
+		Catalog[name] = append(Catalog[name], f)
+	case Function6:
+		name := strings.ToLower(f.Name)
+		Catalog[name] = append(Catalog[name], f)
+	case Function7:
+		name := strings.ToLower(f.Name)
+		Catalog[name] = append(Catalog[name], f)
 	default:
 		panic("unhandled function type")
 	}
@@ -85,6 +85,21 @@ func newCompiledFunctionInternal(
 	// Then we'll handle the polymorphic types
 	// https://www.postgresql.org/docs/15/extend-type-system.html#EXTEND-TYPES-POLYMORPHIC
 	functionParameterTypes := fn.GetParameters()
+	// A range type cannot be determined from its subtype, so at least one range must be given
+	hasRangeParam := false
+	hasRangeArg := false
+	for i, param := range functionParameterTypes {
+		if param.BaseID() == pgtypes.DoltgresTypeBaseID_AnyRange {
+			hasRangeParam = true
+			if i < len(originalTypes) && originalTypes[i].BaseID() != pgtypes.DoltgresTypeBaseID_Unknown {
+				hasRangeArg = true
+			}
+		}
+	}
+	if hasRangeParam && !hasRangeArg {
+		c.stashedErr = fmt.Errorf("could not determine polymorphic type because input has type unknown")
+		return c
+	}
 	c.callResolved = make([]pgtypes.DoltgresType, len(functionParameterTypes)+1)
 	hasPolymorphicParam := false
 	for i, param := range functionParameterTypes {
@@ -96,6 +111,26 @@ func newCompiledFunctionInternal(
 			c.callResolved[i] = param
 		}
 	}
+	// Unknown literals that could not be used directly by a polymorphic parameter take on the type that the other
+	// polymorphic parameters resolved to, so that they're converted to that type rather than to the pseudo-type. Range
+	// elements must always match the range's subtype, so unknown literals are converted for those as well. Similarly,
+	// when another polymorphic parameter has a known type, then the unknown literal must be of that type.
+	if hasPolymorphicParam {
+		hasKnownPolymorphicArg := false
+		for i, param := range functionParameterTypes {
+			if _, ok := param.(pgtypes.DoltgresPolymorphicType); ok && i < len(originalTypes) &&
+				originalTypes[i].BaseID() != pgtypes.DoltgresTypeBaseID_Unknown {
+				hasKnownPolymorphicArg = true
+			}
+		}
+		for i, param := range functionParameterTypes {
+			if polymorphicType, ok := param.(pgtypes.DoltgresPolymorphicType); ok && i < len(originalTypes) &&
+				originalTypes[i].BaseID() == pgtypes.DoltgresTypeBaseID_Unknown &&
+				(hasRangeParam || hasKnownPolymorphicArg || !polymorphicType.IsValid(originalTypes[i])) {
+				c.callResolved[i] = c.resolvePolymorphicReturnType(functionParameterTypes, originalTypes, param)
+			}
+		}
+	}
 	returnType := fn.GetReturn()
 	c.callResolved[len(c.callResolved)-1] = returnType
 	if _, ok := returnType.(pgtypes.DoltgresPolymorphicType); ok {
@@ -143,6 +178,21 @@ func (c *CompiledFunction) StashedError() error {
 	return c.stashedErr
 }
 
+// Schema returns the schema that the function belongs to. All functions are currently built-in, and therefore reside
+// in pg_catalog.
+func (c *CompiledFunction) Schema() string {
+	return "pg_catalog"
+}
+
+// Signature returns the parameter types of the resolved overload, formatted as they'd appear in the function's
+// signature. Returns an empty string if no overload could be resolved.
+func (c *CompiledFunction) Signature() string {
+	if !c.overload.Valid() {
+		return ""
+	}
+	return FormatParameters(c.overload.Function().GetParameters())
+}
+
 // String implements the interface sql.Expression.
 func (c *CompiledFunction) String() string {
 	sb := strings.Builder{}
@@ -199,6 +249,12 @@ func (c *CompiledFunction) IsNonDeterministic() bool {
 	return true
 }
 
+// IsSRF returns whether the function is a set-returning function. Set-returning functions must be evaluated using
+// EvalSet, as they return multiple values.
+func (c *CompiledFunction) IsSRF() bool {
+	return c.stashedErr == nil && c.overload.Valid() && c.overload.Function().IsSRF()
+}
+
 // Eval implements the interface sql.Expression.
 func (c *CompiledFunction) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	// If we have a stashed error, then we should return that now. Errors are stashed when they're supposed to be
@@ -206,6 +262,39 @@ func (c *CompiledFunction) Eval(ctx *sql.Context, row sql.Row) (interface{}, err
 	if c.stashedErr != nil {
 		return nil, c.stashedErr
 	}
+	// Set-returning functions are evaluated by the node that contains them, so reaching this point means that the
+	// function was used somewhere that a set is not allowed.
+	if c.overload.Function().IsSRF() {
+		return nil, fmt.Errorf("set-valued function called in context that cannot accept a set")
+	}
+	return c.call(ctx, row)
+}
+
+// EvalSet evaluates a set-returning function, returning an iterator over the values of the set. Each row of the
+// iterator contains a single value. A strict function that is given a NULL argument returns an empty set.
+func (c *CompiledFunction) EvalSet(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if c.stashedErr != nil {
+		return nil, c.stashedErr
+	}
+	if !c.overload.Function().IsSRF() {
+		return nil, fmt.Errorf("function %s does not return a set", c.Name)
+	}
+	result, err := c.call(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return sql.RowsToRowIter(), nil
+	}
+	iter, ok := result.(sql.RowIter)
+	if !ok {
+		return nil, fmt.Errorf("set-returning function %s returned `%T` instead of a set", c.Name, result)
+	}
+	return iter, nil
+}
+
+// call evaluates the arguments and calls the resolved overload.
+func (c *CompiledFunction) call(ctx *sql.Context, row sql.Row) (any, error) {
 
 	// Evaluate all of the arguments.
 	args, err := c.evalArgs(ctx, row)
@@ -237,7 +326,7 @@ func (c *CompiledFunction) Eval(ctx *sql.Context, row sql.Row) (interface{}, err
 				}
 				targetType = targetArrayType.BaseType()
 			} else {
-				targetType = targetParamTypes[i]
+				targetType = c.callResolved[i]
 			}
 
 			if c.overload.casts[i] != nil {
@@ -265,6 +354,12 @@ func (c *CompiledFunction) Eval(ctx *sql.Context, row sql.Row) (interface{}, err
 		return f.Callable(ctx, ([4]pgtypes.DoltgresType)(c.callResolved), args[0], args[1], args[2])
 	case Function4:
 		return f.Callable(ctx, ([5]pgtypes.DoltgresType)(c.callResolved), args[0], args[1], args[2], args[3])
+	case Function5:
+		return f.Callable(ctx, ([6]pgtypes.DoltgresType)(c.callResolved), args[0], args[1], args[2], args[3], args[4])
+	case Function6:
+		return f.Callable(ctx, ([7]pgtypes.DoltgresType)(c.callResolved), args[0], args[1], args[2], args[3], args[4], args[5])
+	case Function7:
+		return f.Callable(ctx, ([8]pgtypes.DoltgresType)(c.callResolved), args[0], args[1], args[2], args[3], args[4], args[5], args[6])
 	default:
 		return nil, fmt.Errorf("unknown function type in CompiledFunction::Eval")
 	}
@@ -414,7 +509,12 @@ func (c *CompiledFunction) typeCompatibleOverloads(fnOverloads []Overload, argTy
 			paramType := overload.argTypes[i]
 
 			if polymorphicType, ok := paramType.GetRepresentativeType().(pgtypes.DoltgresPolymorphicType); ok && polymorphicType.IsValid(argTypes[i]) {
-				overloadCasts[i] = identityCast
+				// Unknown literals are converted to whichever type the polymorphic parameter resolves to
+				if argTypes[i].BaseID() == pgtypes.DoltgresTypeBaseID_Unknown {
+					overloadCasts[i] = UnknownLiteralCast
+				} else {
+					overloadCasts[i] = identityCast
+				}
 				polymorphicParameters = append(polymorphicParameters, polymorphicType)
 				polymorphicTargets = append(polymorphicTargets, argTypes[i])
 			} else {
@@ -561,13 +661,17 @@ func (*CompiledFunction) polymorphicTypesCompatible(paramTypes []pgtypes.Doltgre
 			baseExprType := exprTypes[i]
 			if arrayBaseExprType, ok := baseExprType.(pgtypes.DoltgresArrayType); ok {
 				baseExprType = arrayBaseExprType.BaseType()
+			} else if rangeBaseExprType, ok := baseExprType.(pgtypes.RangeType); ok && polymorphicParamType.BaseID() == pgtypes.DoltgresTypeBaseID_AnyRange {
+				baseExprType = rangeBaseExprType.SubType
 			}
-			// TODO: handle range types
 			// Check that the base expression type matches the previously-found base type
 			if baseType == nil {
 				baseType = baseExprType
 			} else if baseType.BaseID() != baseExprType.BaseID() {
 				return false
+			} else if baseType.BaseID() == pgtypes.DoltgresTypeBaseID_Enum && baseType.OID() != baseExprType.OID() {
+				// Each enum is its own type, so two different enums are not compatible
+				return false
 			}
 		}
 	}
@@ -586,10 +690,20 @@ func (c *CompiledFunction) resolvePolymorphicReturnType(functionInterfaceTypes [
 	// We can use the first polymorphic non-unknown type that we find, since we can morph it into any type that we need.
 	// We've verified that all polymorphic types are compatible in a previous step, so this is safe to do.
 	var firstPolymorphicType pgtypes.DoltgresType
+	var firstRangeType pgtypes.DoltgresType
 	for i, functionInterfaceType := range functionInterfaceTypes {
 		if _, ok = functionInterfaceType.(pgtypes.DoltgresPolymorphicType); ok && originalTypes[i].BaseID() != pgtypes.DoltgresTypeBaseID_Unknown {
-			firstPolymorphicType = originalTypes[i]
-			break
+			if rangeType, ok := originalTypes[i].(pgtypes.RangeType); ok && functionInterfaceType.BaseID() == pgtypes.DoltgresTypeBaseID_AnyRange {
+				// The element type of a range is its subtype
+				firstRangeType = rangeType
+				if firstPolymorphicType == nil {
+					firstPolymorphicType = rangeType.SubType
+				}
+				continue
+			}
+			if firstPolymorphicType == nil {
+				firstPolymorphicType = originalTypes[i]
+			}
 		}
 	}
 
@@ -599,7 +713,7 @@ func (c *CompiledFunction) resolvePolymorphicReturnType(functionInterfaceTypes [
 	}
 
 	switch polymorphicReturnType.BaseID() {
-	case pgtypes.DoltgresTypeBaseID_AnyElement, pgtypes.DoltgresTypeBaseID_AnyNonArray:
+	case pgtypes.DoltgresTypeBaseID_AnyElement, pgtypes.DoltgresTypeBaseID_AnyNonArray, pgtypes.DoltgresTypeBaseID_AnyEnum, pgtypes.DoltgresTypeBaseID_Record:
 		// For return types, anyelement behaves the same as anynonarray.
 		// This isn't explicitly in the documentation, however it does note that:
 		// "...anynonarray and anyenum do not represent separate type variables; they are the same type as anyelement..."
@@ -613,6 +727,12 @@ func (c *CompiledFunction) resolvePolymorphicReturnType(functionInterfaceTypes [
 	case pgtypes.DoltgresTypeBaseID_AnyArray:
 		// Array types will return themselves, so this is safe
 		return firstPolymorphicType.ToArrayType()
+	case pgtypes.DoltgresTypeBaseID_AnyRange:
+		// A range cannot be deduced from its subtype, so a range must have been given
+		if firstRangeType == nil {
+			panic(fmt.Errorf("`%s` requires at least one input of type anyrange", polymorphicReturnType.String()))
+		}
+		return firstRangeType
 	default:
 		panic(fmt.Errorf("`%s` is not yet handled during function compilation", polymorphicReturnType.String()))
 	}
@@ -670,6 +790,10 @@ func (c *CompiledFunction) analyzeParameters() (originalTypes []pgtypes.Doltgres
 	for i, param := range c.Arguments {
 		returnType := param.Type()
 		if extendedType, ok := returnType.(pgtypes.DoltgresType); ok {
+			if resolvableType, ok := extendedType.(pgtypes.ResolvableType); ok {
+				// The analyzer will resolve the type, at which point the function will be compiled again
+				return nil, fmt.Errorf("type %s has not been resolved", resolvableType.String())
+			}
 			if domainType, ok := extendedType.(pgtypes.DomainType); ok {
 				extendedType = domainType.UnderlyingBaseType()
 			}
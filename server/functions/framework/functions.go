@@ -15,6 +15,8 @@
 package framework
 
 import (
+	"strings"
+
 	"github.com/dolthub/go-mysql-server/sql"
 
 	pgtypes "github.com/dolthub/doltgresql/server/types"
@@ -37,16 +39,30 @@ type FunctionInterface interface {
 	// IsStrict returns whether the function is STRICT, which means if any parameter is NULL, then it returns NULL.
 	// Otherwise, if it's not, the NULL input must be handled by user.
 	IsStrict() bool
+	// IsSRF returns whether the function is a set-returning function (SETOF), in which case the Callable returns a
+	// sql.RowIter that produces a single value for each row of the set, rather than returning a single value.
+	IsSRF() bool
 	// enforceInterfaceInheritance is a special function that ensures only the expected types inherit this interface.
 	enforceInterfaceInheritance(error)
 }
 
+// FormatParameters returns the given parameter types formatted as they'd appear in a function's signature, such as
+// "integer, text". This identifies a specific overload of a function.
+func FormatParameters(parameters []pgtypes.DoltgresType) string {
+	names := make([]string, len(parameters))
+	for i, parameter := range parameters {
+		names[i] = parameter.String()
+	}
+	return strings.Join(names, ", ")
+}
+
 // Function0 is a function that does not take any parameters.
 type Function0 struct {
 	Name               string
 	Return             pgtypes.DoltgresType
 	IsNonDeterministic bool
 	Strict             bool
+	SRF                bool
 	Callable           func(ctx *sql.Context) (any, error)
 }
 
@@ -59,6 +75,7 @@ type Function1 struct {
 	Variadic           bool
 	IsNonDeterministic bool
 	Strict             bool
+	SRF                bool
 	Callable           func(ctx *sql.Context, paramsAndReturn [2]pgtypes.DoltgresType, val1 any) (any, error)
 }
 
@@ -72,6 +89,7 @@ type Function2 struct {
 	Variadic           bool
 	IsNonDeterministic bool
 	Strict             bool
+	SRF                bool
 	Callable           func(ctx *sql.Context, paramsAndReturn [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error)
 }
 
@@ -85,6 +103,7 @@ type Function3 struct {
 	Variadic           bool
 	IsNonDeterministic bool
 	Strict             bool
+	SRF                bool
 	Callable           func(ctx *sql.Context, paramsAndReturn [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error)
 }
 
@@ -98,14 +117,60 @@ type Function4 struct {
 	Variadic           bool
 	IsNonDeterministic bool
 	Strict             bool
+	SRF                bool
 	Callable           func(ctx *sql.Context, paramsAndReturn [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error)
 }
 
+// Function5 is a function that takes five parameters. The parameter and return types are passed into the Callable
+// function when the parameters (and possibly return type) have at least one polymorphic type. The return type is the
+// last type in the array.
+type Function5 struct {
+	Name               string
+	Return             pgtypes.DoltgresType
+	Parameters         [5]pgtypes.DoltgresType
+	Variadic           bool
+	IsNonDeterministic bool
+	Strict             bool
+	SRF                bool
+	Callable           func(ctx *sql.Context, paramsAndReturn [6]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any, val5 any) (any, error)
+}
+
+// Function6 is a function that takes six parameters. The parameter and return types are passed into the Callable
+// function when the parameters (and possibly return type) have at least one polymorphic type. The return type is the
+// last type in the array.
+type Function6 struct {
+	Name               string
+	Return             pgtypes.DoltgresType
+	Parameters         [6]pgtypes.DoltgresType
+	Variadic           bool
+	IsNonDeterministic bool
+	Strict             bool
+	SRF                bool
+	Callable           func(ctx *sql.Context, paramsAndReturn [7]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any, val5 any, val6 any) (any, error)
+}
+
+// Function7 is a function that takes seven parameters. The parameter and return types are passed into the Callable
+// function when the parameters (and possibly return type) have at least one polymorphic type. The return type is the
+// last type in the array.
+type Function7 struct {
+	Name               string
+	Return             pgtypes.DoltgresType
+	Parameters         [7]pgtypes.DoltgresType
+	Variadic           bool
+	IsNonDeterministic bool
+	Strict             bool
+	SRF                bool
+	Callable           func(ctx *sql.Context, paramsAndReturn [8]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any, val5 any, val6 any, val7 any) (any, error)
+}
+
 var _ FunctionInterface = Function0{}
 var _ FunctionInterface = Function1{}
 var _ FunctionInterface = Function2{}
 var _ FunctionInterface = Function3{}
 var _ FunctionInterface = Function4{}
+var _ FunctionInterface = Function5{}
+var _ FunctionInterface = Function6{}
+var _ FunctionInterface = Function7{}
 
 // GetName implements the FunctionInterface interface.
 func (f Function0) GetName() string { return f.Name }
@@ -129,6 +194,9 @@ func (f Function0) NonDeterministic() bool { return f.IsNonDeterministic }
 // IsStrict implements the FunctionInterface interface.
 func (f Function0) IsStrict() bool { return f.Strict }
 
+// IsSRF implements the FunctionInterface interface.
+func (f Function0) IsSRF() bool { return f.SRF }
+
 // enforceInterfaceInheritance implements the FunctionInterface interface.
 func (f Function0) enforceInterfaceInheritance(error) {}
 
@@ -159,6 +227,9 @@ func (f Function1) NonDeterministic() bool { return f.IsNonDeterministic }
 // IsStrict implements the FunctionInterface interface.
 func (f Function1) IsStrict() bool { return f.Strict }
 
+// IsSRF implements the FunctionInterface interface.
+func (f Function1) IsSRF() bool { return f.SRF }
+
 // enforceInterfaceInheritance implements the FunctionInterface interface.
 func (f Function1) enforceInterfaceInheritance(error) {}
 
@@ -189,6 +260,9 @@ func (f Function2) NonDeterministic() bool { return f.IsNonDeterministic }
 // IsStrict implements the FunctionInterface interface.
 func (f Function2) IsStrict() bool { return f.Strict }
 
+// IsSRF implements the FunctionInterface interface.
+func (f Function2) IsSRF() bool { return f.SRF }
+
 // enforceInterfaceInheritance implements the FunctionInterface interface.
 func (f Function2) enforceInterfaceInheritance(error) {}
 
@@ -219,6 +293,9 @@ func (f Function3) NonDeterministic() bool { return f.IsNonDeterministic }
 // IsStrict implements the FunctionInterface interface.
 func (f Function3) IsStrict() bool { return f.Strict }
 
+// IsSRF implements the FunctionInterface interface.
+func (f Function3) IsSRF() bool { return f.SRF }
+
 // enforceInterfaceInheritance implements the FunctionInterface interface.
 func (f Function3) enforceInterfaceInheritance(error) {}
 
@@ -249,5 +326,107 @@ func (f Function4) NonDeterministic() bool { return f.IsNonDeterministic }
 // IsStrict implements the FunctionInterface interface.
 func (f Function4) IsStrict() bool { return f.Strict }
 
+// IsSRF implements the FunctionInterface interface.
+func (f Function4) IsSRF() bool { return f.SRF }
+
 // enforceInterfaceInheritance implements the FunctionInterface interface.
 func (f Function4) enforceInterfaceInheritance(error) {}
+
+// GetName implements the FunctionInterface interface.
+func (f Function5) GetName() string { return f.Name }
+
+// GetReturn implements the FunctionInterface interface.
+func (f Function5) GetReturn() pgtypes.DoltgresType { return f.Return }
+
+// GetParameters implements the FunctionInterface interface.
+func (f Function5) GetParameters() []pgtypes.DoltgresType { return f.Parameters[:] }
+
+// VariadicIndex implements the FunctionInterface interface.
+func (f Function5) VariadicIndex() int {
+	if f.Variadic {
+		return 4
+	} else {
+		return -1
+	}
+}
+
+// GetExpectedParameterCount implements the FunctionInterface interface.
+func (f Function5) GetExpectedParameterCount() int { return 5 }
+
+// NonDeterministic implements the FunctionInterface interface.
+func (f Function5) NonDeterministic() bool { return f.IsNonDeterministic }
+
+// IsStrict implements the FunctionInterface interface.
+func (f Function5) IsStrict() bool { return f.Strict }
+
+// IsSRF implements the FunctionInterface interface.
+func (f Function5) IsSRF() bool { return f.SRF }
+
+// enforceInterfaceInheritance implements the FunctionInterface interface.
+func (f Function5) enforceInterfaceInheritance(error) {}
+
+// GetName implements the FunctionInterface interface.
+func (f Function6) GetName() string { return f.Name }
+
+// GetReturn implements the FunctionInterface interface.
+func (f Function6) GetReturn() pgtypes.DoltgresType { return f.Return }
+
+// GetParameters implements the FunctionInterface interface.
+func (f Function6) GetParameters() []pgtypes.DoltgresType { return f.Parameters[:] }
+
+// VariadicIndex implements the FunctionInterface interface.
+func (f Function6) VariadicIndex() int {
+	if f.Variadic {
+		return 5
+	} else {
+		return -1
+	}
+}
+
+// GetExpectedParameterCount implements the FunctionInterface interface.
+func (f Function6) GetExpectedParameterCount() int { return 6 }
+
+// NonDeterministic implements the FunctionInterface interface.
+func (f Function6) NonDeterministic() bool { return f.IsNonDeterministic }
+
+// IsStrict implements the FunctionInterface interface.
+func (f Function6) IsStrict() bool { return f.Strict }
+
+// IsSRF implements the FunctionInterface interface.
+func (f Function6) IsSRF() bool { return f.SRF }
+
+// enforceInterfaceInheritance implements the FunctionInterface interface.
+func (f Function6) enforceInterfaceInheritance(error) {}
+
+// GetName implements the FunctionInterface interface.
+func (f Function7) GetName() string { return f.Name }
+
+// GetReturn implements the FunctionInterface interface.
+func (f Function7) GetReturn() pgtypes.DoltgresType { return f.Return }
+
+// GetParameters implements the FunctionInterface interface.
+func (f Function7) GetParameters() []pgtypes.DoltgresType { return f.Parameters[:] }
+
+// VariadicIndex implements the FunctionInterface interface.
+func (f Function7) VariadicIndex() int {
+	if f.Variadic {
+		return 6
+	} else {
+		return -1
+	}
+}
+
+// GetExpectedParameterCount implements the FunctionInterface interface.
+func (f Function7) GetExpectedParameterCount() int { return 7 }
+
+// NonDeterministic implements the FunctionInterface interface.
+func (f Function7) NonDeterministic() bool { return f.IsNonDeterministic }
+
+// IsStrict implements the FunctionInterface interface.
+func (f Function7) IsStrict() bool { return f.Strict }
+
+// IsSRF implements the FunctionInterface interface.
+func (f Function7) IsSRF() bool { return f.SRF }
+
+// enforceInterfaceInheritance implements the FunctionInterface interface.
+func (f Function7) enforceInterfaceInheritance(error) {}
@@ -50,6 +50,15 @@ const (
 	Operator_BinaryJSONTopLevel                        // ?
 	Operator_BinaryJSONTopLevelAny                     // ?|
 	Operator_BinaryJSONTopLevelAll                     // ?&
+	Operator_BinaryJSONPathExists                      // @?
+	Operator_BinaryJSONPathMatch                       // @@
+	Operator_BinaryOverlaps                            // &&
+	Operator_BinaryRegexMatch                          // ~
+	Operator_BinaryRegexIMatch                         // ~*
+	Operator_BinaryNotRegexMatch                       // !~
+	Operator_BinaryNotRegexIMatch                      // !~*
+	Operator_BinaryILike                               // ~~*
+	Operator_BinaryNotILike                            // !~~*
 	Operator_UnaryPlus                                 // +
 	Operator_UnaryMinus                                // -
 )
@@ -168,6 +177,20 @@ func (o Operator) String() string {
 		return "#"
 	case Operator_BinaryConcatenate:
 		return "||"
+	case Operator_BinaryOverlaps:
+		return "&&"
+	case Operator_BinaryRegexMatch:
+		return "~"
+	case Operator_BinaryRegexIMatch:
+		return "~*"
+	case Operator_BinaryNotRegexMatch:
+		return "!~"
+	case Operator_BinaryNotRegexIMatch:
+		return "!~*"
+	case Operator_BinaryILike:
+		return "~~*"
+	case Operator_BinaryNotILike:
+		return "!~~*"
 	case Operator_BinaryEqual:
 		return "="
 	case Operator_BinaryNotEqual:
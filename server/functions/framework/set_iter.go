@@ -0,0 +1,53 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SetIter is the sql.RowIter that is returned by the Callable of set-returning functions. Each call returns the next
+// value of the set, or io.EOF once the set has been exhausted. Rows produced by the iterator contain a single value.
+type SetIter func(ctx *sql.Context) (any, error)
+
+var _ sql.RowIter = SetIter(nil)
+
+// NewSetIterFromValues returns a SetIter that produces each of the given values in order.
+func NewSetIterFromValues(values []any) SetIter {
+	idx := 0
+	return func(ctx *sql.Context) (any, error) {
+		if idx >= len(values) {
+			return nil, io.EOF
+		}
+		idx++
+		return values[idx-1], nil
+	}
+}
+
+// Next implements the interface sql.RowIter.
+func (iter SetIter) Next(ctx *sql.Context) (sql.Row, error) {
+	val, err := iter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sql.Row{val}, nil
+}
+
+// Close implements the interface sql.RowIter.
+func (iter SetIter) Close(ctx *sql.Context) error {
+	return nil
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initGenerateSeries registers the functions to the catalog.
+func initGenerateSeries() {
+	framework.RegisterFunction(generate_series_int32_int32)
+	framework.RegisterFunction(generate_series_int32_int32_int32)
+	framework.RegisterFunction(generate_series_int64_int64)
+	framework.RegisterFunction(generate_series_int64_int64_int64)
+	framework.RegisterFunction(generate_series_numeric_numeric)
+	framework.RegisterFunction(generate_series_numeric_numeric_numeric)
+	framework.RegisterFunction(generate_series_timestamp_timestamp_interval)
+	framework.RegisterFunction(generate_series_timestamptz_timestamptz_interval)
+}
+
+// generate_series_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_int32_int32 = framework.Function2{
+	Name:       "generate_series",
+	Return:     pgtypes.Int32,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return generateIntegerSeries(int64(val1.(int32)), int64(val2.(int32)), 1, func(val int64) any {
+			return int32(val)
+		})
+	},
+}
+
+// generate_series_int32_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_int32_int32_int32 = framework.Function3{
+	Name:       "generate_series",
+	Return:     pgtypes.Int32,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return generateIntegerSeries(int64(val1.(int32)), int64(val2.(int32)), int64(val3.(int32)), func(val int64) any {
+			return int32(val)
+		})
+	},
+}
+
+// generate_series_int64_int64 represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_int64_int64 = framework.Function2{
+	Name:       "generate_series",
+	Return:     pgtypes.Int64,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Int64, pgtypes.Int64},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return generateIntegerSeries(val1.(int64), val2.(int64), 1, func(val int64) any {
+			return val
+		})
+	},
+}
+
+// generate_series_int64_int64_int64 represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_int64_int64_int64 = framework.Function3{
+	Name:       "generate_series",
+	Return:     pgtypes.Int64,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Int64, pgtypes.Int64, pgtypes.Int64},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return generateIntegerSeries(val1.(int64), val2.(int64), val3.(int64), func(val int64) any {
+			return val
+		})
+	},
+}
+
+// generate_series_numeric_numeric represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_numeric_numeric = framework.Function2{
+	Name:       "generate_series",
+	Return:     pgtypes.Numeric,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Numeric, pgtypes.Numeric},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return generateNumericSeries(val1.(decimal.Decimal), val2.(decimal.Decimal), decimal.NewFromInt(1))
+	},
+}
+
+// generate_series_numeric_numeric_numeric represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_numeric_numeric_numeric = framework.Function3{
+	Name:       "generate_series",
+	Return:     pgtypes.Numeric,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Numeric, pgtypes.Numeric, pgtypes.Numeric},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return generateNumericSeries(val1.(decimal.Decimal), val2.(decimal.Decimal), val3.(decimal.Decimal))
+	},
+}
+
+// generate_series_timestamp_timestamp_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_timestamp_timestamp_interval = framework.Function3{
+	Name:       "generate_series",
+	Return:     pgtypes.Timestamp,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Timestamp, pgtypes.Timestamp, pgtypes.Interval},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return generateTimestampSeries(val1.(time.Time), val2.(time.Time), val3.(duration.Duration))
+	},
+}
+
+// generate_series_timestamptz_timestamptz_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_timestamptz_timestamptz_interval = framework.Function3{
+	Name:       "generate_series",
+	Return:     pgtypes.TimestampTZ,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.TimestampTZ, pgtypes.TimestampTZ, pgtypes.Interval},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return generateTimestampSeries(val1.(time.Time), val2.(time.Time), val3.(duration.Duration))
+	},
+}
+
+// generateIntegerSeries returns a SetIter that produces the values from start to stop, incrementing by the given step.
+// The series ends early rather than overflowing, and each value is converted to the return type using toValue.
+func generateIntegerSeries(start int64, stop int64, step int64, toValue func(int64) any) (framework.SetIter, error) {
+	if step == 0 {
+		return nil, fmt.Errorf("step size cannot equal zero")
+	}
+	current := start
+	done := false
+	return func(ctx *sql.Context) (any, error) {
+		if done || (step > 0 && current > stop) || (step < 0 && current < stop) {
+			return nil, io.EOF
+		}
+		val := current
+		if (step > 0 && current > math.MaxInt64-step) || (step < 0 && current < math.MinInt64-step) {
+			done = true
+		} else {
+			current += step
+		}
+		return toValue(val), nil
+	}, nil
+}
+
+// generateNumericSeries returns a SetIter that produces the values from start to stop, incrementing by the given step.
+func generateNumericSeries(start decimal.Decimal, stop decimal.Decimal, step decimal.Decimal) (framework.SetIter, error) {
+	if step.IsZero() {
+		return nil, fmt.Errorf("step size cannot equal zero")
+	}
+	current := start
+	return func(ctx *sql.Context) (any, error) {
+		if (step.IsPositive() && current.GreaterThan(stop)) || (step.IsNegative() && current.LessThan(stop)) {
+			return nil, io.EOF
+		}
+		val := current
+		current = current.Add(step)
+		return val, nil
+	}, nil
+}
+
+// generateTimestampSeries returns a SetIter that produces the timestamps from start to stop, incrementing by the given
+// interval.
+func generateTimestampSeries(start time.Time, stop time.Time, step duration.Duration) (framework.SetIter, error) {
+	direction := step.Compare(duration.MakeDuration(0, 0, 0))
+	if direction == 0 {
+		return nil, fmt.Errorf("step size cannot equal zero")
+	}
+	current := start
+	return func(ctx *sql.Context) (any, error) {
+		if (direction > 0 && current.After(stop)) || (direction < 0 && current.Before(stop)) {
+			return nil, io.EOF
+		}
+		val := current
+		current = duration.Add(current, step)
+		return val, nil
+	}, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initGenerateSubscripts registers the functions to the catalog.
+func initGenerateSubscripts() {
+	framework.RegisterFunction(generate_subscripts_anyarray_int32)
+	framework.RegisterFunction(generate_subscripts_anyarray_int32_bool)
+}
+
+// generate_subscripts_anyarray_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_subscripts_anyarray_int32 = framework.Function2{
+	Name:       "generate_subscripts",
+	Return:     pgtypes.Int32,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.Int32},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return generateSubscripts(val1.([]any), val2.(int32), false), nil
+	},
+}
+
+// generate_subscripts_anyarray_int32_bool represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_subscripts_anyarray_int32_bool = framework.Function3{
+	Name:       "generate_subscripts",
+	Return:     pgtypes.Int32,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.Int32, pgtypes.Bool},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return generateSubscripts(val1.([]any), val2.(int32), val3.(bool)), nil
+	},
+}
+
+// generateSubscripts returns a SetIter that produces the subscripts of the given dimension of the array, in reverse
+// order if requested. Dimensions that the array does not have produce an empty set.
+func generateSubscripts(array []any, dimension int32, reverse bool) framework.SetIter {
+	dims, err := pgtypes.ArrayDimensions(array)
+	if err != nil || dimension < 1 || int(dimension) > len(dims) {
+		return framework.NewSetIterFromValues(nil)
+	}
+	length := dims[dimension-1]
+	idx := 0
+	return func(ctx *sql.Context) (any, error) {
+		if idx >= length {
+			return nil, io.EOF
+		}
+		idx++
+		if reverse {
+			return int32(length - idx + 1), nil
+		}
+		return int32(idx), nil
+	}
+}
@@ -15,30 +15,27 @@
 package functions
 
 import (
+	"net/netip"
+
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
-// initArrayAppend registers the functions to the catalog.
-func initArrayAppend() {
-	framework.RegisterFunction(array_append_anyarray_anyelement)
+// initInetContainedByOrEquals registers the functions to the catalog.
+func initInetContainedByOrEquals() {
+	framework.RegisterFunction(inet_contained_by_or_equals)
 }
 
-// array_append_anyarray_anyelement represents the PostgreSQL function of the same name, taking the same parameters.
-var array_append_anyarray_anyelement = framework.Function2{
-	Name:       "array_append",
-	Return:     pgtypes.AnyArray,
-	Parameters: [2]pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyElement},
+// inet_contained_by_or_equals represents the <<= operator, which the parser rewrites as a call to this function. This
+// is the same as the PostgreSQL function network_subeq.
+var inet_contained_by_or_equals = framework.Function2{
+	Name:       "inet_contained_by_or_equals",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Inet, pgtypes.Inet},
+	Strict:     true,
 	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
-		if val1 == nil {
-			return []any{val2}, nil
-		}
-		array := val1.([]any)
-		returnArray := make([]any, len(array)+1)
-		copy(returnArray, array)
-		returnArray[len(returnArray)-1] = val2
-		return returnArray, nil
+		return pgtypes.NetworkAddressContains(val2.(netip.Prefix), val1.(netip.Prefix), false), nil
 	},
 }
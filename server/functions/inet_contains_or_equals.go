@@ -0,0 +1,41 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"net/netip"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initInetContainsOrEquals registers the functions to the catalog.
+func initInetContainsOrEquals() {
+	framework.RegisterFunction(inet_contains_or_equals)
+}
+
+// inet_contains_or_equals represents the >>= operator, which the parser rewrites as a call to this function. This is
+// the same as the PostgreSQL function network_supeq.
+var inet_contains_or_equals = framework.Function2{
+	Name:       "inet_contains_or_equals",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Inet, pgtypes.Inet},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return pgtypes.NetworkAddressContains(val1.(netip.Prefix), val2.(netip.Prefix), false), nil
+	},
+}
@@ -16,13 +16,20 @@ package functions
 
 // Init initializes all functions in this package.
 func Init() {
+	initAbbrev()
 	initAbs()
 	initAcos()
 	initAcosd()
 	initAcosh()
 	initAge()
-	initArrayAppend()
+	initArrayLength()
+	initArrayLower()
+	initArrayPosition()
+	initArrayPositions()
+	initArrayRemove()
+	initArrayReplace()
 	initArrayToString()
+	initArrayUpper()
 	initAscii()
 	initAsin()
 	initAsind()
@@ -33,11 +40,14 @@ func Init() {
 	initAtand()
 	initAtanh()
 	initBitLength()
+	initBroadcast()
 	initBtrim()
+	initCardinality()
 	initCbrt()
 	initCeil()
 	initCharLength()
 	initChr()
+	initClockTimestamp()
 	initColDescription()
 	initCos()
 	initCosd()
@@ -45,34 +55,89 @@ func Init() {
 	initCot()
 	initCotd()
 	initCurrentDatabase()
+	initCurrentDate()
 	initCurrentSchema()
 	initCurrentSetting()
 	initCurrentSchemas()
+	initCurrentTime()
+	initCurrentTimestamp()
+	initDateBin()
+	initDatePart()
+	initDateRange()
+	initDateTrunc()
 	initDegrees()
 	initDiv()
 	initDoltProcedures()
+	initEnumFirst()
+	initEnumLast()
+	initEnumRange()
 	initExp()
 	initExtract()
 	initFactorial()
+	initFamily()
 	initFloor()
 	initFormatType()
 	initGcd()
+	initGenerateSeries()
+	initGenerateSubscripts()
+	initHost()
+	initHostmask()
+	initInetContainedByOrEquals()
+	initInetContainsOrEquals()
+	initInetSameFamily()
 	initInitcap()
+	initInt4Range()
+	initInt8Range()
+	initIsEmpty()
+	initIsFinite()
+	initJsonbArrayElements()
+	initJsonbDeletePath()
+	initJsonbEach()
+	initJsonbInsert()
+	initJsonbPathExists()
+	initJsonbPathMatch()
+	initJsonbPathQuery()
+	initJsonbPathQueryArray()
+	initJsonbPathQueryFirst()
+	initJsonbPretty()
+	initJsonbSet()
+	initJsonbStripNulls()
+	initJsonbTypeof()
+	initJsonTypeof()
+	initJustifyDays()
+	initJustifyHours()
+	initJustifyInterval()
 	initLcm()
 	initLeft()
 	initLength()
+	initLikeEscape()
 	initLn()
+	initLocalTime()
+	initLocalTimestamp()
 	initLog()
 	initLog10()
 	initLower()
+	initLowerInc()
+	initLowerInf()
 	initLpad()
 	initLtrim()
+	initMakeDate()
+	initMakeInterval()
+	initMakeTime()
+	initMakeTimestamp()
+	initMakeTimestampTZ()
+	initMasklen()
 	initMd5()
 	initMinScale()
 	initMod()
+	initNetmask()
+	initNetwork()
 	initNextVal()
+	initNow()
+	initNumRange()
 	initObjDescription()
 	initOctetLength()
+	initOverlaps()
 	initPgEncodingToChar()
 	initPgFunctionIsVisible()
 	initPgGetConstraintdef()
@@ -98,40 +163,62 @@ func Init() {
 	initQuoteIdent()
 	initRadians()
 	initRandom()
+	initRegexpCount()
+	initRegexpLike()
+	initRegexpMatch()
+	initRegexpMatches()
+	initRegexpReplace()
+	initRegexpSplitToArray()
+	initRegexpSplitToTable()
 	initRepeat()
 	initReplace()
 	initReverse()
 	initRight()
 	initRound()
+	initRowToJson()
 	initRpad()
 	initRtrim()
 	initScale()
 	initSetConfig()
+	initSetMasklen()
 	initSetVal()
 	initShobjDescription()
 	initSign()
+	initSimilarToEscape()
 	initSin()
 	initSind()
 	initSinh()
 	initSplitPart()
 	initSqrt()
+	initStatementTimestamp()
+	initStringToArray()
 	initStrpos()
 	initSubstr()
+	initSubstring()
 	initTan()
 	initTand()
 	initTanh()
 	initTimezone()
 	initToChar()
+	initToDate()
 	initToHex()
+	initToJson()
+	initToJsonb()
 	initToRegclass()
 	initToRegproc()
 	initToRegtype()
+	initToTimestamp()
+	initTransactionTimestamp()
 	initTranslate()
 	initTrimScale()
 	initTrunc()
+	initTsRange()
+	initTstzRange()
 	initTxidCurrent()
 	initUnnest()
 	initUpper()
+	initUpperInc()
+	initUpperInf()
 	initVersion()
 	initWidthBucket()
 }
@@ -0,0 +1,62 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initInt4Range registers the functions to the catalog.
+func initInt4Range() {
+	framework.RegisterFunction(int4range_int4_int4)
+	framework.RegisterFunction(int4range_int4_int4_text)
+}
+
+// int4range_int4_int4 represents the PostgreSQL function of the same name, taking the same parameters.
+var int4range_int4_int4 = framework.Function2{
+	Name:       "int4range",
+	Return:     pgtypes.Int4Range,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return constructRange(pgtypes.Int4Range, val1, val2, "[)")
+	},
+}
+
+// int4range_int4_int4_text represents the PostgreSQL function of the same name, taking the same parameters.
+var int4range_int4_int4_text = framework.Function3{
+	Name:       "int4range",
+	Return:     pgtypes.Int4Range,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		if val3 == nil {
+			return nil, fmt.Errorf("range constructor flags argument must not be null")
+		}
+		return constructRange(pgtypes.Int4Range, val1, val2, val3.(string))
+	},
+}
+
+// constructRange returns a new range of the given type using the given bounds. The flags determine whether each bound
+// is inclusive, and must be one of "[]", "[)", "(]", or "()". A NULL bound is infinite.
+func constructRange(rangeType pgtypes.RangeType, lower any, upper any, flags string) (any, error) {
+	if len(flags) != 2 || (flags[0] != '[' && flags[0] != '(') || (flags[1] != ']' && flags[1] != ')') {
+		return nil, fmt.Errorf(`invalid range bound flags`)
+	}
+	return rangeType.MakeRange(lower, upper, flags[0] == '[', flags[1] == ']')
+}
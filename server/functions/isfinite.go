@@ -0,0 +1,83 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgdate"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initIsFinite registers the functions to the catalog.
+func initIsFinite() {
+	framework.RegisterFunction(isfinite_date)
+	framework.RegisterFunction(isfinite_timestamp)
+	framework.RegisterFunction(isfinite_timestamptz)
+	framework.RegisterFunction(isfinite_interval)
+}
+
+// isfinite_date represents the PostgreSQL function of the same name, taking the same parameters.
+var isfinite_date = framework.Function1{
+	Name:       "isfinite",
+	Return:     pgtypes.Bool,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.Date},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		return !isInfiniteTime(val1.(time.Time)), nil
+	},
+}
+
+// isfinite_timestamp represents the PostgreSQL function of the same name, taking the same parameters.
+var isfinite_timestamp = framework.Function1{
+	Name:       "isfinite",
+	Return:     pgtypes.Bool,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.Timestamp},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		return !isInfiniteTime(val1.(time.Time)), nil
+	},
+}
+
+// isfinite_timestamptz represents the PostgreSQL function of the same name, taking the same parameters.
+var isfinite_timestamptz = framework.Function1{
+	Name:       "isfinite",
+	Return:     pgtypes.Bool,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.TimestampTZ},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		return !isInfiniteTime(val1.(time.Time)), nil
+	},
+}
+
+// isfinite_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var isfinite_interval = framework.Function1{
+	Name:       "isfinite",
+	Return:     pgtypes.Bool,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.Interval},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		// Intervals do not have infinite values
+		return true, nil
+	},
+}
+
+// isInfiniteTime returns whether the given time is one of the values that represent positive or negative infinity.
+func isInfiniteTime(t time.Time) bool {
+	return t.Equal(pgdate.TimeInfinity) || t.Equal(pgdate.TimeNegativeInfinity)
+}
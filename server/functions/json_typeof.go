@@ -0,0 +1,43 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonTypeof registers the functions to the catalog.
+func initJsonTypeof() {
+	framework.RegisterFunction(json_typeof)
+}
+
+// json_typeof represents the PostgreSQL function of the same name, taking the same parameters.
+var json_typeof = framework.Function1{
+	Name:       "json_typeof",
+	Return:     pgtypes.Text,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.Json},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		// TODO: make a bespoke implementation that does not need to parse the entire document
+		doc, err := pgtypes.JsonB.IoInput(ctx, val.(string))
+		if err != nil {
+			return nil, err
+		}
+		return pgtypes.JsonValueTypeName(doc.(pgtypes.JsonDocument).Value), nil
+	},
+}
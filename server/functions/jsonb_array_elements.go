@@ -0,0 +1,52 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbArrayElements registers the functions to the catalog.
+func initJsonbArrayElements() {
+	framework.RegisterFunction(jsonb_array_elements)
+}
+
+// jsonb_array_elements represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_array_elements = framework.Function1{
+	Name:       "jsonb_array_elements",
+	Return:     pgtypes.JsonB,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.JsonB},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		switch value := val.(pgtypes.JsonDocument).Value.(type) {
+		case pgtypes.JsonValueArray:
+			values := make([]any, len(value))
+			for i, element := range value {
+				values[i] = pgtypes.JsonDocument{Value: element}
+			}
+			return framework.NewSetIterFromValues(values), nil
+		case pgtypes.JsonValueObject:
+			return nil, fmt.Errorf("cannot extract elements from an object")
+		default:
+			return nil, fmt.Errorf("cannot extract elements from a scalar")
+		}
+	},
+}
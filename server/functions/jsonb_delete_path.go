@@ -0,0 +1,39 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbDeletePath registers the functions to the catalog.
+func initJsonbDeletePath() {
+	framework.RegisterFunction(jsonb_delete_path)
+}
+
+// jsonb_delete_path represents the PostgreSQL function of the same name, taking the same parameters. This is also
+// used for the #- operator.
+var jsonb_delete_path = framework.Function2{
+	Name:       "jsonb_delete_path",
+	Return:     pgtypes.JsonB,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.TextArray},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return jsonbModifyPath(val1.(pgtypes.JsonDocument), val2.([]any), nil, jsonbPathOp_Delete)
+	},
+}
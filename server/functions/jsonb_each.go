@@ -0,0 +1,61 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/lib/pq/oid"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbEach registers the functions to the catalog.
+func initJsonbEach() {
+	framework.RegisterFunction(jsonb_each)
+}
+
+// jsonbEachRecord is the record type returned by jsonb_each, containing the key and value of each item of the object.
+var jsonbEachRecord = pgtypes.CompositeType{
+	Oid:      uint32(oid.T_record),
+	ArrayOid: uint32(oid.T__record),
+	Name:     "record",
+	Attributes: []pgtypes.CompositeAttribute{
+		{Name: "key", Type: pgtypes.Text},
+		{Name: "value", Type: pgtypes.JsonB},
+	},
+}
+
+// jsonb_each represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_each = framework.Function1{
+	Name:       "jsonb_each",
+	Return:     jsonbEachRecord,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.JsonB},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		object, ok := val.(pgtypes.JsonDocument).Value.(pgtypes.JsonValueObject)
+		if !ok {
+			return nil, fmt.Errorf("cannot call jsonb_each on a non-object")
+		}
+		values := make([]any, len(object.Items))
+		for i, item := range object.Items {
+			values[i] = []any{item.Key, pgtypes.JsonDocument{Value: item.Value}}
+		}
+		return framework.NewSetIterFromValues(values), nil
+	},
+}
@@ -0,0 +1,54 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbInsert registers the functions to the catalog.
+func initJsonbInsert() {
+	framework.RegisterFunction(jsonb_insert_jsonb_text_array_jsonb)
+	framework.RegisterFunction(jsonb_insert_jsonb_text_array_jsonb_bool)
+}
+
+// jsonb_insert_jsonb_text_array_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_insert_jsonb_text_array_jsonb = framework.Function3{
+	Name:       "jsonb_insert",
+	Return:     pgtypes.JsonB,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.TextArray, pgtypes.JsonB},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return jsonb_insert_jsonb_text_array_jsonb_bool.Callable(ctx, [5]pgtypes.DoltgresType{}, val1, val2, val3, false)
+	},
+}
+
+// jsonb_insert_jsonb_text_array_jsonb_bool represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_insert_jsonb_text_array_jsonb_bool = framework.Function4{
+	Name:       "jsonb_insert",
+	Return:     pgtypes.JsonB,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.TextArray, pgtypes.JsonB, pgtypes.Bool},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error) {
+		op := jsonbPathOp_InsertBefore
+		if val4.(bool) {
+			op = jsonbPathOp_InsertAfter
+		}
+		return jsonbModifyPath(val1.(pgtypes.JsonDocument), val2.([]any), val3.(pgtypes.JsonDocument).Value, op)
+	},
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbPathMatch registers the functions to the catalog.
+func initJsonbPathMatch() {
+	framework.RegisterFunction(jsonb_path_match_jsonb_text)
+	framework.RegisterFunction(jsonb_path_match_jsonb_text_jsonb)
+	framework.RegisterFunction(jsonb_path_match_jsonb_text_jsonb_bool)
+}
+
+// jsonb_path_match_jsonb_text represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_path_match_jsonb_text = framework.Function2{
+	Name:       "jsonb_path_match",
+	Return:     pgtypes.Bool,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return jsonb_path_match_jsonb_text_jsonb_bool.Callable(ctx, [5]pgtypes.DoltgresType{}, val1, val2, nil, false)
+	},
+}
+
+// jsonb_path_match_jsonb_text_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_path_match_jsonb_text_jsonb = framework.Function3{
+	Name:       "jsonb_path_match",
+	Return:     pgtypes.Bool,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text, pgtypes.JsonB},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return jsonb_path_match_jsonb_text_jsonb_bool.Callable(ctx, [5]pgtypes.DoltgresType{}, val1, val2, val3, false)
+	},
+}
+
+// jsonb_path_match_jsonb_text_jsonb_bool represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_path_match_jsonb_text_jsonb_bool = framework.Function4{
+	Name:       "jsonb_path_match",
+	Return:     pgtypes.Bool,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text, pgtypes.JsonB, pgtypes.Bool},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error) {
+		silent := val4.(bool)
+		items, err := jsonbPathExecute(val1, val2, val3, false)
+		if err != nil {
+			if silent {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if len(items) == 1 {
+			switch item := items[0].(type) {
+			case pgtypes.JsonValueBoolean:
+				return bool(item), nil
+			case pgtypes.JsonValueNull:
+				return nil, nil
+			}
+		}
+		if silent {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("single boolean result is expected")
+	},
+}
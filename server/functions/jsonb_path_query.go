@@ -0,0 +1,96 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/functions/jsonpath"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbPathQuery registers the functions to the catalog.
+func initJsonbPathQuery() {
+	framework.RegisterFunction(jsonb_path_query_jsonb_text)
+	framework.RegisterFunction(jsonb_path_query_jsonb_text_jsonb)
+	framework.RegisterFunction(jsonb_path_query_jsonb_text_jsonb_bool)
+}
+
+// jsonb_path_query_jsonb_text represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_path_query_jsonb_text = framework.Function2{
+	Name:       "jsonb_path_query",
+	Return:     pgtypes.JsonB,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return jsonb_path_query_jsonb_text_jsonb_bool.Callable(ctx, [5]pgtypes.DoltgresType{}, val1, val2, nil, false)
+	},
+}
+
+// jsonb_path_query_jsonb_text_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_path_query_jsonb_text_jsonb = framework.Function3{
+	Name:       "jsonb_path_query",
+	Return:     pgtypes.JsonB,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text, pgtypes.JsonB},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return jsonb_path_query_jsonb_text_jsonb_bool.Callable(ctx, [5]pgtypes.DoltgresType{}, val1, val2, val3, false)
+	},
+}
+
+// jsonb_path_query_jsonb_text_jsonb_bool represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_path_query_jsonb_text_jsonb_bool = framework.Function4{
+	Name:       "jsonb_path_query",
+	Return:     pgtypes.JsonB,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text, pgtypes.JsonB, pgtypes.Bool},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error) {
+		items, err := jsonbPathExecute(val1, val2, val3, val4.(bool))
+		if err != nil {
+			return nil, err
+		}
+		values := make([]any, len(items))
+		for i, item := range items {
+			values[i] = pgtypes.JsonDocument{Value: item}
+		}
+		return framework.NewSetIterFromValues(values), nil
+	},
+}
+
+// jsonbPathExecute parses the path and executes it against the target document. The vars document may be nil. When
+// silent is true, errors that occur during execution are suppressed, and no items are returned. Errors in the syntax of
+// the path are always returned.
+func jsonbPathExecute(target any, path any, vars any, silent bool) ([]pgtypes.JsonValue, error) {
+	parsedPath, err := jsonpath.Parse(path.(string))
+	if err != nil {
+		return nil, err
+	}
+	var varsValue pgtypes.JsonValue
+	if vars != nil {
+		varsValue = vars.(pgtypes.JsonDocument).Value
+	}
+	items, err := parsedPath.Execute(target.(pgtypes.JsonDocument).Value, varsValue)
+	if err != nil {
+		if silent {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return items, nil
+}
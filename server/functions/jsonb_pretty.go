@@ -0,0 +1,94 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbPretty registers the functions to the catalog.
+func initJsonbPretty() {
+	framework.RegisterFunction(jsonb_pretty)
+}
+
+// jsonb_pretty represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_pretty = framework.Function1{
+	Name:       "jsonb_pretty",
+	Return:     pgtypes.Text,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.JsonB},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		sb := strings.Builder{}
+		if err := jsonbPrettyFormat(ctx, &sb, val.(pgtypes.JsonDocument).Value, 0); err != nil {
+			return nil, err
+		}
+		return sb.String(), nil
+	},
+}
+
+// jsonbPrettyFormat writes the value using PostgreSQL's indented format, where every object field and array element
+// is placed on its own line and indented by four spaces per level.
+func jsonbPrettyFormat(ctx *sql.Context, sb *strings.Builder, value pgtypes.JsonValue, level int) error {
+	indent := func(level int) {
+		sb.WriteRune('\n')
+		sb.WriteString(strings.Repeat("    ", level))
+	}
+	switch value := value.(type) {
+	case pgtypes.JsonValueObject:
+		sb.WriteRune('{')
+		for i, item := range value.Items {
+			if i > 0 {
+				sb.WriteRune(',')
+			}
+			indent(level + 1)
+			key, err := pgtypes.JsonB.IoOutput(ctx, pgtypes.JsonDocument{Value: pgtypes.JsonValueString(item.Key)})
+			if err != nil {
+				return err
+			}
+			sb.WriteString(key)
+			sb.WriteString(": ")
+			if err = jsonbPrettyFormat(ctx, sb, item.Value, level+1); err != nil {
+				return err
+			}
+		}
+		indent(level)
+		sb.WriteRune('}')
+	case pgtypes.JsonValueArray:
+		sb.WriteRune('[')
+		for i, element := range value {
+			if i > 0 {
+				sb.WriteRune(',')
+			}
+			indent(level + 1)
+			if err := jsonbPrettyFormat(ctx, sb, element, level+1); err != nil {
+				return err
+			}
+		}
+		indent(level)
+		sb.WriteRune(']')
+	default:
+		str, err := pgtypes.JsonB.IoOutput(ctx, pgtypes.JsonDocument{Value: value})
+		if err != nil {
+			return err
+		}
+		sb.WriteString(str)
+	}
+	return nil
+}
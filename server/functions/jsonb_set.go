@@ -0,0 +1,185 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbSet registers the functions to the catalog.
+func initJsonbSet() {
+	framework.RegisterFunction(jsonb_set_jsonb_text_array_jsonb)
+	framework.RegisterFunction(jsonb_set_jsonb_text_array_jsonb_bool)
+}
+
+// jsonb_set_jsonb_text_array_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_set_jsonb_text_array_jsonb = framework.Function3{
+	Name:       "jsonb_set",
+	Return:     pgtypes.JsonB,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.TextArray, pgtypes.JsonB},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return jsonb_set_jsonb_text_array_jsonb_bool.Callable(ctx, [5]pgtypes.DoltgresType{}, val1, val2, val3, true)
+	},
+}
+
+// jsonb_set_jsonb_text_array_jsonb_bool represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_set_jsonb_text_array_jsonb_bool = framework.Function4{
+	Name:       "jsonb_set",
+	Return:     pgtypes.JsonB,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.TextArray, pgtypes.JsonB, pgtypes.Bool},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error) {
+		op := jsonbPathOp_Replace
+		if val4.(bool) {
+			op |= jsonbPathOp_Create
+		}
+		return jsonbModifyPath(val1.(pgtypes.JsonDocument), val2.([]any), val3.(pgtypes.JsonDocument).Value, op)
+	},
+}
+
+// jsonbPathOp determines how jsonbSetPath modifies the value found at the end of a path.
+type jsonbPathOp uint8
+
+const (
+	jsonbPathOp_Delete       jsonbPathOp = 1 << iota // Removes the value
+	jsonbPathOp_Replace                              // Replaces the value if it exists
+	jsonbPathOp_Create                               // Adds the value if it does not exist
+	jsonbPathOp_InsertBefore                         // Inserts the value before an existing array element
+	jsonbPathOp_InsertAfter                          // Inserts the value after an existing array element
+)
+
+// jsonbPathOp_CreateOrInsert contains all operations that may add a new value.
+const jsonbPathOp_CreateOrInsert = jsonbPathOp_Create | jsonbPathOp_InsertBefore | jsonbPathOp_InsertAfter
+
+// jsonbModifyPath applies the given operation to the value found at the end of the path, returning the new document.
+// The original document is not modified. This is shared by jsonb_set, jsonb_insert, and jsonb_delete_path.
+func jsonbModifyPath(doc pgtypes.JsonDocument, path []any, newValue pgtypes.JsonValue, op jsonbPathOp) (any, error) {
+	switch doc.Value.(type) {
+	case pgtypes.JsonValueObject, pgtypes.JsonValueArray:
+	default:
+		if op&jsonbPathOp_Delete != 0 {
+			return nil, fmt.Errorf("cannot delete path in scalar")
+		}
+		return nil, fmt.Errorf("cannot set path in scalar")
+	}
+	for i, pathElement := range path {
+		if pathElement == nil {
+			return nil, fmt.Errorf("path element at position %d is null", i+1)
+		}
+	}
+	if len(path) == 0 {
+		return doc, nil
+	}
+	value, err := jsonbSetPath(doc.Value, path, 0, newValue, op)
+	if err != nil {
+		return nil, err
+	}
+	return pgtypes.JsonDocument{Value: value}, nil
+}
+
+// jsonbSetPath is the recursive implementation of jsonbModifyPath, handling the path element at the given level.
+func jsonbSetPath(value pgtypes.JsonValue, path []any, level int, newValue pgtypes.JsonValue, op jsonbPathOp) (pgtypes.JsonValue, error) {
+	key := path[level].(string)
+	isLast := level == len(path)-1
+	switch value := value.(type) {
+	case pgtypes.JsonValueObject:
+		idx, exists := value.Index[key]
+		if !exists {
+			if !isLast || op&jsonbPathOp_CreateOrInsert == 0 {
+				return value, nil
+			}
+			items := make([]pgtypes.JsonValueObjectItem, len(value.Items), len(value.Items)+1)
+			copy(items, value.Items)
+			items = append(items, pgtypes.JsonValueObjectItem{Key: key, Value: newValue})
+			return pgtypes.NewJsonValueObject(items), nil
+		}
+		items := make([]pgtypes.JsonValueObjectItem, 0, len(value.Items))
+		items = append(items, value.Items[:idx]...)
+		if isLast {
+			if op&(jsonbPathOp_InsertBefore|jsonbPathOp_InsertAfter) != 0 {
+				return nil, fmt.Errorf("cannot replace existing key")
+			}
+			if op&jsonbPathOp_Delete == 0 {
+				items = append(items, pgtypes.JsonValueObjectItem{Key: key, Value: newValue})
+			}
+		} else {
+			child, err := jsonbSetPath(value.Items[idx].Value, path, level+1, newValue, op)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, pgtypes.JsonValueObjectItem{Key: key, Value: child})
+		}
+		items = append(items, value.Items[idx+1:]...)
+		return pgtypes.NewJsonValueObject(items), nil
+	case pgtypes.JsonValueArray:
+		idx, err := strconv.Atoi(strings.TrimSpace(key))
+		if err != nil {
+			return nil, fmt.Errorf(`path element at position %d is not an integer: "%s"`, level+1, key)
+		}
+		// Negative indexes count from the end, and anything beyond the start of the array prepends
+		if idx < 0 {
+			if -idx > len(value) {
+				idx = math.MinInt
+			} else {
+				idx = len(value) + idx
+			}
+		}
+		if idx > len(value) {
+			idx = len(value)
+		}
+		newArray := make(pgtypes.JsonValueArray, 0, len(value)+1)
+		if (idx == math.MinInt || len(value) == 0) && isLast && op&jsonbPathOp_CreateOrInsert != 0 {
+			newArray = append(newArray, newValue)
+		}
+		for i, element := range value {
+			if i != idx {
+				newArray = append(newArray, element)
+				continue
+			}
+			if !isLast {
+				child, err := jsonbSetPath(element, path, level+1, newValue, op)
+				if err != nil {
+					return nil, err
+				}
+				newArray = append(newArray, child)
+				continue
+			}
+			switch {
+			case op&jsonbPathOp_Delete != 0:
+			case op&jsonbPathOp_InsertBefore != 0:
+				newArray = append(newArray, newValue, element)
+			case op&jsonbPathOp_InsertAfter != 0:
+				newArray = append(newArray, element, newValue)
+			default:
+				newArray = append(newArray, newValue)
+			}
+		}
+		if len(value) > 0 && idx == len(value) && isLast && op&jsonbPathOp_CreateOrInsert != 0 {
+			newArray = append(newArray, newValue)
+		}
+		return newArray, nil
+	default:
+		return value, nil
+	}
+}
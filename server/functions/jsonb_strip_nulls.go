@@ -0,0 +1,62 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbStripNulls registers the functions to the catalog.
+func initJsonbStripNulls() {
+	framework.RegisterFunction(jsonb_strip_nulls)
+}
+
+// jsonb_strip_nulls represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_strip_nulls = framework.Function1{
+	Name:       "jsonb_strip_nulls",
+	Return:     pgtypes.JsonB,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.JsonB},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		return pgtypes.JsonDocument{Value: jsonbStripNulls(val.(pgtypes.JsonDocument).Value)}, nil
+	},
+}
+
+// jsonbStripNulls returns a copy of the value with all object fields that have null values removed. Null values
+// within arrays are kept.
+func jsonbStripNulls(value pgtypes.JsonValue) pgtypes.JsonValue {
+	switch value := value.(type) {
+	case pgtypes.JsonValueObject:
+		items := make([]pgtypes.JsonValueObjectItem, 0, len(value.Items))
+		for _, item := range value.Items {
+			if _, ok := item.Value.(pgtypes.JsonValueNull); ok {
+				continue
+			}
+			items = append(items, pgtypes.JsonValueObjectItem{Key: item.Key, Value: jsonbStripNulls(item.Value)})
+		}
+		return pgtypes.NewJsonValueObject(items)
+	case pgtypes.JsonValueArray:
+		newArray := make(pgtypes.JsonValueArray, len(value))
+		for i, element := range value {
+			newArray[i] = jsonbStripNulls(element)
+		}
+		return newArray
+	default:
+		return value
+	}
+}
@@ -0,0 +1,604 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonpath
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// predicateResult is the result of a predicate, which uses three-valued logic.
+type predicateResult uint8
+
+const (
+	predicateResult_False predicateResult = iota
+	predicateResult_True
+	predicateResult_Unknown
+)
+
+// executor holds the state for a single execution of a path.
+type executor struct {
+	strict bool
+	root   pgtypes.JsonValue
+	vars   pgtypes.JsonValueObject
+	// ignoreStructural is set while evaluating the accessors that follow .**, as those accessors are applied to items
+	// of every type, so structural errors are ignored even in strict mode.
+	ignoreStructural bool
+}
+
+// Execute evaluates the path against the target, returning all items that the path produces. The vars object
+// supplies the values of named variables, and may be nil when there are no variables.
+func (p *Path) Execute(target pgtypes.JsonValue, vars pgtypes.JsonValue) ([]pgtypes.JsonValue, error) {
+	e := &executor{
+		strict: p.Strict,
+		root:   target,
+	}
+	switch vars := vars.(type) {
+	case nil:
+	case pgtypes.JsonValueObject:
+		e.vars = vars
+	default:
+		return nil, fmt.Errorf(`"vars" argument is not an object`)
+	}
+	return e.eval(p.root, target, -1)
+}
+
+// eval evaluates the node against the given current item. The last parameter is the last index of the array that is
+// being subscripted, which is only valid within array subscripts.
+func (e *executor) eval(n node, current pgtypes.JsonValue, last int) ([]pgtypes.JsonValue, error) {
+	switch n := n.(type) {
+	case literalNode:
+		return []pgtypes.JsonValue{n.value}, nil
+	case rootNode:
+		return []pgtypes.JsonValue{e.root}, nil
+	case currentNode:
+		return []pgtypes.JsonValue{current}, nil
+	case variableNode:
+		idx, ok := e.vars.Index[n.name]
+		if !ok {
+			return nil, fmt.Errorf(`could not find jsonpath variable "%s"`, n.name)
+		}
+		return []pgtypes.JsonValue{e.vars.Items[idx].Value}, nil
+	case lastNode:
+		if last < 0 {
+			return nil, fmt.Errorf("evaluating jsonpath LAST outside of array subscript")
+		}
+		return []pgtypes.JsonValue{pgtypes.JsonValueNumber(decimal.NewFromInt(int64(last)))}, nil
+	case accessorChainNode:
+		items, err := e.eval(n.base, current, last)
+		if err != nil {
+			return nil, err
+		}
+		savedIgnoreStructural := e.ignoreStructural
+		defer func() { e.ignoreStructural = savedIgnoreStructural }()
+		for _, acc := range n.accessors {
+			var newItems []pgtypes.JsonValue
+			for _, item := range items {
+				results, err := e.applyAccessor(acc, item, last)
+				if err != nil {
+					return nil, err
+				}
+				newItems = append(newItems, results...)
+			}
+			items = newItems
+			if _, ok := acc.(anyLevelAccessor); ok {
+				e.ignoreStructural = true
+			}
+		}
+		return items, nil
+	case arithmeticNode:
+		return e.evalArithmetic(n, current, last)
+	case unaryNode:
+		items, err := e.eval(n.operand, current, last)
+		if err != nil {
+			return nil, err
+		}
+		items = e.unwrap(items)
+		results := make([]pgtypes.JsonValue, len(items))
+		for i, item := range items {
+			number, ok := item.(pgtypes.JsonValueNumber)
+			if !ok {
+				return nil, fmt.Errorf("operand of unary jsonpath operator %s is not a numeric value", n.op)
+			}
+			if n.op == "-" {
+				number = pgtypes.JsonValueNumber(decimal.Decimal(number).Neg())
+			}
+			results[i] = number
+		}
+		return results, nil
+	default:
+		if !isPredicate(n) {
+			return nil, fmt.Errorf("unknown jsonpath node: %T", n)
+		}
+		switch e.evalPredicate(n, current, last) {
+		case predicateResult_True:
+			return []pgtypes.JsonValue{pgtypes.JsonValueBoolean(true)}, nil
+		case predicateResult_False:
+			return []pgtypes.JsonValue{pgtypes.JsonValueBoolean(false)}, nil
+		default:
+			return []pgtypes.JsonValue{pgtypes.JsonValueNull(0)}, nil
+		}
+	}
+}
+
+// unwrap returns the items with all arrays replaced by their elements when in lax mode. In strict mode, the items are
+// returned unchanged.
+func (e *executor) unwrap(items []pgtypes.JsonValue) []pgtypes.JsonValue {
+	if e.strict {
+		return items
+	}
+	var unwrapped []pgtypes.JsonValue
+	for _, item := range items {
+		if array, ok := item.(pgtypes.JsonValueArray); ok {
+			unwrapped = append(unwrapped, array...)
+		} else {
+			unwrapped = append(unwrapped, item)
+		}
+	}
+	return unwrapped
+}
+
+// applyAccessor applies the accessor to a single item.
+func (e *executor) applyAccessor(acc accessor, item pgtypes.JsonValue, last int) ([]pgtypes.JsonValue, error) {
+	switch acc := acc.(type) {
+	case memberAccessor:
+		switch item := item.(type) {
+		case pgtypes.JsonValueObject:
+			if idx, ok := item.Index[acc.key]; ok {
+				return []pgtypes.JsonValue{item.Items[idx].Value}, nil
+			}
+			if e.reportStructural() {
+				return nil, fmt.Errorf(`JSON object does not contain key "%s"`, acc.key)
+			}
+			return nil, nil
+		case pgtypes.JsonValueArray:
+			if !e.strict {
+				return e.applyToElements(acc, item, last)
+			}
+		}
+		if e.reportStructural() {
+			return nil, fmt.Errorf("jsonpath member accessor can only be applied to an object")
+		}
+		return nil, nil
+	case wildcardMemberAccessor:
+		switch item := item.(type) {
+		case pgtypes.JsonValueObject:
+			results := make([]pgtypes.JsonValue, len(item.Items))
+			for i := range item.Items {
+				results[i] = item.Items[i].Value
+			}
+			return results, nil
+		case pgtypes.JsonValueArray:
+			if !e.strict {
+				return e.applyToElements(acc, item, last)
+			}
+		}
+		if e.reportStructural() {
+			return nil, fmt.Errorf("jsonpath wildcard member accessor can only be applied to an object")
+		}
+		return nil, nil
+	case arrayAccessor:
+		array, ok := item.(pgtypes.JsonValueArray)
+		if !ok {
+			if e.reportStructural() {
+				return nil, fmt.Errorf("jsonpath array accessor can only be applied to an array")
+			} else if e.strict {
+				return nil, nil
+			}
+			array = pgtypes.JsonValueArray{item}
+		}
+		var results []pgtypes.JsonValue
+		for _, subscript := range acc.subscripts {
+			from, err := e.evalSubscript(subscript.from, item, len(array)-1)
+			if err != nil {
+				return nil, err
+			}
+			to := from
+			if subscript.to != nil {
+				if to, err = e.evalSubscript(subscript.to, item, len(array)-1); err != nil {
+					return nil, err
+				}
+			}
+			if from < 0 || from > to || to >= len(array) {
+				if e.reportStructural() {
+					return nil, fmt.Errorf("jsonpath array subscript is out of bounds")
+				}
+				from = max(from, 0)
+				to = min(to, len(array)-1)
+			}
+			for i := from; i <= to; i++ {
+				results = append(results, array[i])
+			}
+		}
+		return results, nil
+	case wildcardArrayAccessor:
+		if array, ok := item.(pgtypes.JsonValueArray); ok {
+			return array, nil
+		}
+		if e.reportStructural() {
+			return nil, fmt.Errorf("jsonpath wildcard array accessor can only be applied to an array")
+		} else if e.strict {
+			return nil, nil
+		}
+		return []pgtypes.JsonValue{item}, nil
+	case anyLevelAccessor:
+		var results []pgtypes.JsonValue
+		e.collectLevels(item, 0, acc.first, acc.last, &results)
+		return results, nil
+	case methodAccessor:
+		return e.applyMethod(acc.name, item, last)
+	case filterAccessor:
+		if array, ok := item.(pgtypes.JsonValueArray); ok && !e.strict {
+			return e.applyToElements(acc, array, last)
+		}
+		if e.evalPredicate(acc.predicate, item, last) == predicateResult_True {
+			return []pgtypes.JsonValue{item}, nil
+		}
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown jsonpath accessor: %T", acc)
+	}
+}
+
+// reportStructural returns whether structural errors, such as accessing a missing key, should be returned. Such errors
+// are only returned in strict mode, and are never returned for the accessors that follow .**.
+func (e *executor) reportStructural() bool {
+	return e.strict && !e.ignoreStructural
+}
+
+// applyToElements applies the accessor to each element of the array. This is used to automatically unwrap arrays in
+// lax mode.
+func (e *executor) applyToElements(acc accessor, array pgtypes.JsonValueArray, last int) ([]pgtypes.JsonValue, error) {
+	var results []pgtypes.JsonValue
+	for _, element := range array {
+		if _, ok := element.(pgtypes.JsonValueArray); ok {
+			// Only a single level is unwrapped, and structural errors are ignored in lax mode
+			if _, isFilter := acc.(filterAccessor); !isFilter {
+				continue
+			}
+		}
+		elementResults, err := e.applyAccessor(acc, element, last)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, elementResults...)
+	}
+	return results, nil
+}
+
+// collectLevels gathers the item and its descendants whose levels are between first and last (inclusive), in the
+// order that they appear. A last level of -1 means that there is no limit.
+func (e *executor) collectLevels(item pgtypes.JsonValue, level int, first int, last int, results *[]pgtypes.JsonValue) {
+	if level >= first && (last < 0 || level <= last) {
+		*results = append(*results, item)
+	}
+	if last >= 0 && level >= last {
+		return
+	}
+	switch item := item.(type) {
+	case pgtypes.JsonValueObject:
+		for _, objItem := range item.Items {
+			e.collectLevels(objItem.Value, level+1, first, last, results)
+		}
+	case pgtypes.JsonValueArray:
+		for _, element := range item {
+			e.collectLevels(element, level+1, first, last, results)
+		}
+	}
+}
+
+// evalSubscript evaluates an array subscript, which must be a single number. The number is truncated to an integer.
+func (e *executor) evalSubscript(n node, current pgtypes.JsonValue, last int) (int, error) {
+	items, err := e.eval(n, current, last)
+	if err != nil {
+		return 0, err
+	}
+	if len(items) != 1 {
+		return 0, fmt.Errorf("jsonpath array subscript is not a single numeric value")
+	}
+	number, ok := items[0].(pgtypes.JsonValueNumber)
+	if !ok {
+		return 0, fmt.Errorf("jsonpath array subscript is not a single numeric value")
+	}
+	index := decimal.Decimal(number).Truncate(0)
+	if index.GreaterThan(decimal.NewFromInt(math.MaxInt32)) || index.LessThan(decimal.NewFromInt(math.MinInt32)) {
+		return 0, fmt.Errorf("jsonpath array subscript is out of integer range")
+	}
+	return int(index.IntPart()), nil
+}
+
+// applyMethod calls the item method with the given name on the item.
+func (e *executor) applyMethod(name string, item pgtypes.JsonValue, last int) ([]pgtypes.JsonValue, error) {
+	switch name {
+	case "type":
+		return []pgtypes.JsonValue{pgtypes.JsonValueString(pgtypes.JsonValueTypeName(item))}, nil
+	case "size":
+		if array, ok := item.(pgtypes.JsonValueArray); ok {
+			return []pgtypes.JsonValue{pgtypes.JsonValueNumber(decimal.NewFromInt(int64(len(array))))}, nil
+		}
+		if e.strict {
+			return nil, fmt.Errorf("jsonpath item method .size() can only be applied to an array")
+		}
+		return []pgtypes.JsonValue{pgtypes.JsonValueNumber(decimal.NewFromInt(1))}, nil
+	}
+	// All remaining methods automatically unwrap arrays in lax mode
+	if array, ok := item.(pgtypes.JsonValueArray); ok && !e.strict {
+		var results []pgtypes.JsonValue
+		for _, element := range array {
+			elementResults, err := e.applyMethod(name, element, last)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, elementResults...)
+		}
+		return results, nil
+	}
+	switch name {
+	case "double":
+		var f float64
+		switch item := item.(type) {
+		case pgtypes.JsonValueNumber:
+			f = decimal.Decimal(item).InexactFloat64()
+		case pgtypes.JsonValueString:
+			var err error
+			f, err = strconv.ParseFloat(strings.TrimSpace(string(item)), 64)
+			if err != nil {
+				return nil, fmt.Errorf(`argument "%s" of jsonpath item method .double() is invalid for type double precision`, string(item))
+			}
+		default:
+			return nil, fmt.Errorf("jsonpath item method .double() can only be applied to a string or numeric value")
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("NaN or Infinity is not allowed for jsonpath item method .double()")
+		}
+		return []pgtypes.JsonValue{pgtypes.JsonValueNumber(decimal.NewFromFloat(f))}, nil
+	case "abs", "floor", "ceiling":
+		number, ok := item.(pgtypes.JsonValueNumber)
+		if !ok {
+			return nil, fmt.Errorf("jsonpath item method .%s() can only be applied to a numeric value", name)
+		}
+		d := decimal.Decimal(number)
+		switch name {
+		case "abs":
+			d = d.Abs()
+		case "floor":
+			d = d.Floor()
+		case "ceiling":
+			d = d.Ceil()
+		}
+		return []pgtypes.JsonValue{pgtypes.JsonValueNumber(d)}, nil
+	default:
+		return nil, fmt.Errorf("jsonpath item method .%s() is not yet supported", name)
+	}
+}
+
+// evalArithmetic evaluates a binary arithmetic operation, whose operands must each be a single number.
+func (e *executor) evalArithmetic(n arithmeticNode, current pgtypes.JsonValue, last int) ([]pgtypes.JsonValue, error) {
+	operand := func(side string, operandNode node) (decimal.Decimal, error) {
+		items, err := e.eval(operandNode, current, last)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		items = e.unwrap(items)
+		if len(items) == 1 {
+			if number, ok := items[0].(pgtypes.JsonValueNumber); ok {
+				return decimal.Decimal(number), nil
+			}
+		}
+		return decimal.Decimal{}, fmt.Errorf("%s operand of jsonpath operator %s is not a single numeric value", side, n.op)
+	}
+	left, err := operand("left", n.left)
+	if err != nil {
+		return nil, err
+	}
+	right, err := operand("right", n.right)
+	if err != nil {
+		return nil, err
+	}
+	var result decimal.Decimal
+	switch n.op {
+	case "+":
+		result = left.Add(right)
+	case "-":
+		result = left.Sub(right)
+	case "*":
+		result = left.Mul(right)
+	case "/":
+		if right.IsZero() {
+			return nil, fmt.Errorf("division by zero")
+		}
+		result = left.Div(right)
+	case "%":
+		if right.IsZero() {
+			return nil, fmt.Errorf("division by zero")
+		}
+		result = left.Mod(right)
+	default:
+		return nil, fmt.Errorf("unknown jsonpath operator: %s", n.op)
+	}
+	return []pgtypes.JsonValue{pgtypes.JsonValueNumber(result)}, nil
+}
+
+// evalPredicate evaluates a predicate node. Errors that occur while evaluating the operands of a predicate cause the
+// predicate to be unknown, rather than returning an error.
+func (e *executor) evalPredicate(n node, current pgtypes.JsonValue, last int) predicateResult {
+	switch n := n.(type) {
+	case andNode:
+		left := e.evalPredicate(n.left, current, last)
+		if left == predicateResult_False {
+			return predicateResult_False
+		}
+		right := e.evalPredicate(n.right, current, last)
+		if right == predicateResult_True {
+			return left
+		}
+		return right
+	case orNode:
+		left := e.evalPredicate(n.left, current, last)
+		if left == predicateResult_True {
+			return predicateResult_True
+		}
+		right := e.evalPredicate(n.right, current, last)
+		if right == predicateResult_False {
+			return left
+		}
+		return right
+	case notNode:
+		switch e.evalPredicate(n.operand, current, last) {
+		case predicateResult_True:
+			return predicateResult_False
+		case predicateResult_False:
+			return predicateResult_True
+		default:
+			return predicateResult_Unknown
+		}
+	case isUnknownNode:
+		if e.evalPredicate(n.operand, current, last) == predicateResult_Unknown {
+			return predicateResult_True
+		}
+		return predicateResult_False
+	case existsNode:
+		items, err := e.eval(n.operand, current, last)
+		if err != nil {
+			return predicateResult_Unknown
+		}
+		if len(items) > 0 {
+			return predicateResult_True
+		}
+		return predicateResult_False
+	case comparisonNode:
+		return e.evalAnyPair(n.left, n.right, current, last, func(left pgtypes.JsonValue, right pgtypes.JsonValue) predicateResult {
+			return compareItems(n.op, left, right)
+		})
+	case startsWithNode:
+		return e.evalAnyPair(n.operand, n.prefix, current, last, func(left pgtypes.JsonValue, right pgtypes.JsonValue) predicateResult {
+			str, ok := left.(pgtypes.JsonValueString)
+			prefix, ok2 := right.(pgtypes.JsonValueString)
+			if !ok || !ok2 {
+				return predicateResult_Unknown
+			}
+			if strings.HasPrefix(string(str), string(prefix)) {
+				return predicateResult_True
+			}
+			return predicateResult_False
+		})
+	case likeRegexNode:
+		return e.evalAnyPair(n.operand, nil, current, last, func(left pgtypes.JsonValue, _ pgtypes.JsonValue) predicateResult {
+			str, ok := left.(pgtypes.JsonValueString)
+			if !ok {
+				return predicateResult_Unknown
+			}
+			if n.regex.MatchString(string(str)) {
+				return predicateResult_True
+			}
+			return predicateResult_False
+		})
+	default:
+		return predicateResult_Unknown
+	}
+}
+
+// evalAnyPair evaluates both operands, and then tests every pair of items from the left and right sequences. The
+// result is true when any pair is true. In lax mode, true is returned as soon as it is found, while in strict mode, any
+// unknown pair causes the result to be unknown. The right operand may be nil, in which case only the left items are
+// tested.
+func (e *executor) evalAnyPair(leftNode node, rightNode node, current pgtypes.JsonValue, last int,
+	test func(left pgtypes.JsonValue, right pgtypes.JsonValue) predicateResult) predicateResult {
+	leftItems, err := e.eval(leftNode, current, last)
+	if err != nil {
+		return predicateResult_Unknown
+	}
+	leftItems = e.unwrap(leftItems)
+	rightItems := []pgtypes.JsonValue{nil}
+	if rightNode != nil {
+		if rightItems, err = e.eval(rightNode, current, last); err != nil {
+			return predicateResult_Unknown
+		}
+		rightItems = e.unwrap(rightItems)
+	}
+	found := false
+	hadError := false
+	for _, left := range leftItems {
+		for _, right := range rightItems {
+			switch test(left, right) {
+			case predicateResult_Unknown:
+				if e.strict {
+					return predicateResult_Unknown
+				}
+				hadError = true
+			case predicateResult_True:
+				if !e.strict {
+					return predicateResult_True
+				}
+				found = true
+			}
+		}
+	}
+	if found {
+		return predicateResult_True
+	}
+	if hadError {
+		return predicateResult_Unknown
+	}
+	return predicateResult_False
+}
+
+// compareItems compares two items using the given comparison operator. Items of different types may not be compared
+// (except against null), and neither may objects or arrays.
+func compareItems(op string, left pgtypes.JsonValue, right pgtypes.JsonValue) predicateResult {
+	_, leftIsNull := left.(pgtypes.JsonValueNull)
+	_, rightIsNull := right.(pgtypes.JsonValueNull)
+	if pgtypes.JsonValueTypeName(left) != pgtypes.JsonValueTypeName(right) {
+		if leftIsNull || rightIsNull {
+			if op == "!=" {
+				return predicateResult_True
+			}
+			return predicateResult_False
+		}
+		return predicateResult_Unknown
+	}
+	switch left.(type) {
+	case pgtypes.JsonValueObject, pgtypes.JsonValueArray:
+		return predicateResult_Unknown
+	}
+	cmp := pgtypes.JsonValueCompare(left, right)
+	var result bool
+	switch op {
+	case "==":
+		result = cmp == 0
+	case "!=":
+		result = cmp != 0
+	case "<":
+		result = cmp < 0
+	case "<=":
+		result = cmp <= 0
+	case ">":
+		result = cmp > 0
+	case ">=":
+		result = cmp >= 0
+	default:
+		return predicateResult_Unknown
+	}
+	if result {
+		return predicateResult_True
+	}
+	return predicateResult_False
+}
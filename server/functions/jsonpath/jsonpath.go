@@ -0,0 +1,207 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonpath
+
+import (
+	"regexp"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// Path is a parsed SQL/JSON path expression, as used by functions such as jsonb_path_query.
+// https://www.postgresql.org/docs/15/functions-json.html#FUNCTIONS-SQLJSON-PATH
+type Path struct {
+	// Strict is true when the path uses strict mode, and false when it uses lax mode (the default).
+	Strict bool
+	root   node
+}
+
+// IsPredicate returns whether the path is a predicate check expression, which returns a single boolean (or null when
+// the result is unknown) rather than a sequence of items.
+func (p *Path) IsPredicate() bool {
+	return isPredicate(p.root)
+}
+
+// node is an element of a parsed path expression.
+type node interface {
+	// enforceNodeInheritance is a special function that ensures only the expected types inherit this interface.
+	enforceNodeInheritance()
+}
+
+// literalNode is a string, numeric, boolean, or null literal.
+type literalNode struct {
+	value pgtypes.JsonValue
+}
+
+// rootNode is the context item, written as $.
+type rootNode struct{}
+
+// currentNode is the item being tested by a filter expression, written as @.
+type currentNode struct{}
+
+// variableNode is a named variable, such as $name, whose value is taken from the vars argument.
+type variableNode struct {
+	name string
+}
+
+// lastNode is the last index of the array being subscripted, written as last.
+type lastNode struct{}
+
+// accessorChainNode applies a series of accessors, such as .key or [0], to the items produced by its base node.
+type accessorChainNode struct {
+	base      node
+	accessors []accessor
+}
+
+// arithmeticNode is a binary arithmetic operation, such as $.a + 1.
+type arithmeticNode struct {
+	op    string
+	left  node
+	right node
+}
+
+// unaryNode is a unary plus or minus, which is applied to every item of its operand.
+type unaryNode struct {
+	op      string
+	operand node
+}
+
+// comparisonNode is a predicate comparing two expressions, such as $.a == 1.
+type comparisonNode struct {
+	op    string
+	left  node
+	right node
+}
+
+// andNode is the && predicate.
+type andNode struct {
+	left  node
+	right node
+}
+
+// orNode is the || predicate.
+type orNode struct {
+	left  node
+	right node
+}
+
+// notNode is the ! predicate.
+type notNode struct {
+	operand node
+}
+
+// isUnknownNode is the "is unknown" predicate.
+type isUnknownNode struct {
+	operand node
+}
+
+// existsNode is the exists() predicate.
+type existsNode struct {
+	operand node
+}
+
+// startsWithNode is the "starts with" predicate.
+type startsWithNode struct {
+	operand node
+	prefix  node
+}
+
+// likeRegexNode is the like_regex predicate.
+type likeRegexNode struct {
+	operand node
+	pattern string
+	regex   *regexp.Regexp
+}
+
+func (literalNode) enforceNodeInheritance()       {}
+func (rootNode) enforceNodeInheritance()          {}
+func (currentNode) enforceNodeInheritance()       {}
+func (variableNode) enforceNodeInheritance()      {}
+func (lastNode) enforceNodeInheritance()          {}
+func (accessorChainNode) enforceNodeInheritance() {}
+func (arithmeticNode) enforceNodeInheritance()    {}
+func (unaryNode) enforceNodeInheritance()         {}
+func (comparisonNode) enforceNodeInheritance()    {}
+func (andNode) enforceNodeInheritance()           {}
+func (orNode) enforceNodeInheritance()            {}
+func (notNode) enforceNodeInheritance()           {}
+func (isUnknownNode) enforceNodeInheritance()     {}
+func (existsNode) enforceNodeInheritance()        {}
+func (startsWithNode) enforceNodeInheritance()    {}
+func (likeRegexNode) enforceNodeInheritance()     {}
+
+// accessor is a step of an accessorChainNode.
+type accessor interface {
+	// enforceAccessorInheritance is a special function that ensures only the expected types inherit this interface.
+	enforceAccessorInheritance()
+}
+
+// memberAccessor returns the value of the object member with the given key, written as .key or ."key".
+type memberAccessor struct {
+	key string
+}
+
+// wildcardMemberAccessor returns the values of all object members, written as .*.
+type wildcardMemberAccessor struct{}
+
+// arrayAccessor returns the array elements at the given subscripts, written as [0], [1 to 3], or [0, last].
+type arrayAccessor struct {
+	subscripts []arraySubscript
+}
+
+// arraySubscript is a single index or an index range of an arrayAccessor. When to is nil, the subscript is a single
+// index.
+type arraySubscript struct {
+	from node
+	to   node
+}
+
+// wildcardArrayAccessor returns all array elements, written as [*].
+type wildcardArrayAccessor struct{}
+
+// anyLevelAccessor returns the item and all of its descendants within the given levels, written as .** or
+// .**{1 to 2}. A last level of -1 means that there is no limit.
+type anyLevelAccessor struct {
+	first int
+	last  int
+}
+
+// methodAccessor calls an item method, such as .type() or .size().
+type methodAccessor struct {
+	name string
+}
+
+// filterAccessor returns the items that satisfy the predicate, written as ? (predicate).
+type filterAccessor struct {
+	predicate node
+}
+
+func (memberAccessor) enforceAccessorInheritance()         {}
+func (wildcardMemberAccessor) enforceAccessorInheritance() {}
+func (arrayAccessor) enforceAccessorInheritance()          {}
+func (wildcardArrayAccessor) enforceAccessorInheritance()  {}
+func (anyLevelAccessor) enforceAccessorInheritance()       {}
+func (methodAccessor) enforceAccessorInheritance()         {}
+func (filterAccessor) enforceAccessorInheritance()         {}
+
+// isPredicate returns whether the node evaluates to a boolean predicate result.
+func isPredicate(n node) bool {
+	switch n.(type) {
+	case comparisonNode, andNode, orNode, notNode, isUnknownNode, existsNode, startsWithNode, likeRegexNode:
+		return true
+	default:
+		return false
+	}
+}
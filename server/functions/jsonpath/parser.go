@@ -0,0 +1,768 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonpath
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// tokenKind is the kind of a lexed token.
+type tokenKind uint8
+
+const (
+	tokenKind_EOF tokenKind = iota
+	tokenKind_Ident
+	tokenKind_Variable
+	tokenKind_String
+	tokenKind_Number
+	tokenKind_Punct
+)
+
+// token is a single lexed token of a path expression.
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// multiCharPunctuation contains all punctuation that spans multiple characters. These are checked before single
+// characters.
+var multiCharPunctuation = []string{"**", "==", "!=", "<>", "<=", ">=", "&&", "||"}
+
+// supportedMethods contains the item methods that may be called.
+var supportedMethods = map[string]struct{}{
+	"type":    {},
+	"size":    {},
+	"double":  {},
+	"ceiling": {},
+	"floor":   {},
+	"abs":     {},
+}
+
+// parser is a recursive descent parser for path expressions.
+type parser struct {
+	tokens      []token
+	pos         int
+	filterDepth int
+	subscripts  int
+}
+
+// Parse parses the given path expression.
+func Parse(input string) (*Path, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	path := &Path{}
+	if tok := p.peek(); tok.kind == tokenKind_Ident && (tok.text == "strict" || tok.text == "lax") {
+		path.Strict = tok.text == "strict"
+		p.pos++
+	}
+	if path.root, err = p.parseOr(); err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenKind_EOF {
+		return nil, p.syntaxError()
+	}
+	return path, nil
+}
+
+// peek returns the current token without consuming it.
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// peekN returns the token that is n tokens after the current token, without consuming anything.
+func (p *parser) peekN(n int) token {
+	if p.pos+n >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.pos+n]
+}
+
+// next consumes and returns the current token.
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenKind_EOF {
+		p.pos++
+	}
+	return tok
+}
+
+// isPunct returns whether the current token is the given punctuation.
+func (p *parser) isPunct(punct string) bool {
+	tok := p.peek()
+	return tok.kind == tokenKind_Punct && tok.text == punct
+}
+
+// isKeyword returns whether the current token is the given keyword.
+func (p *parser) isKeyword(keyword string) bool {
+	tok := p.peek()
+	return tok.kind == tokenKind_Ident && tok.text == keyword
+}
+
+// expectPunct consumes the given punctuation, returning a syntax error if it is not the current token.
+func (p *parser) expectPunct(punct string) error {
+	if !p.isPunct(punct) {
+		return p.syntaxError()
+	}
+	p.pos++
+	return nil
+}
+
+// syntaxError returns a syntax error for the current token.
+func (p *parser) syntaxError() error {
+	tok := p.peek()
+	if tok.kind == tokenKind_EOF {
+		return fmt.Errorf("syntax error at end of jsonpath input")
+	}
+	return fmt.Errorf(`syntax error at or near "%s" of jsonpath input`, tok.text)
+}
+
+// parseOr parses the lowest precedence level, which is the || predicate.
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("||") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		if !isPredicate(left) || !isPredicate(right) {
+			return nil, fmt.Errorf(`syntax error at or near "||" of jsonpath input`)
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseAnd parses the && predicate.
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("&&") {
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if !isPredicate(left) || !isPredicate(right) {
+			return nil, fmt.Errorf(`syntax error at or near "&&" of jsonpath input`)
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseNot parses the ! predicate.
+func (p *parser) parseNot() (node, error) {
+	if p.isPunct("!") {
+		p.pos++
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if !isPredicate(operand) {
+			return nil, fmt.Errorf(`syntax error at or near "!" of jsonpath input`)
+		}
+		return notNode{operand: operand}, nil
+	}
+	return p.parsePredicate()
+}
+
+// parsePredicate parses comparisons, along with the like_regex, starts with, and is unknown predicates.
+func (p *parser) parsePredicate() (node, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	tok := p.peek()
+	switch {
+	case tok.kind == tokenKind_Punct && (tok.text == "==" || tok.text == "!=" || tok.text == "<>" ||
+		tok.text == "<" || tok.text == "<=" || tok.text == ">" || tok.text == ">="):
+		p.pos++
+		right, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		if isPredicate(left) || isPredicate(right) {
+			return nil, fmt.Errorf(`syntax error at or near "%s" of jsonpath input`, tok.text)
+		}
+		op := tok.text
+		if op == "<>" {
+			op = "!="
+		}
+		left = comparisonNode{op: op, left: left, right: right}
+	case p.isKeyword("like_regex"):
+		p.pos++
+		patternTok := p.next()
+		if patternTok.kind != tokenKind_String {
+			p.pos--
+			return nil, p.syntaxError()
+		}
+		flags := ""
+		if p.isKeyword("flag") {
+			p.pos++
+			flagTok := p.next()
+			if flagTok.kind != tokenKind_String {
+				p.pos--
+				return nil, p.syntaxError()
+			}
+			flags = flagTok.text
+		}
+		regex, err := compileLikeRegex(patternTok.text, flags)
+		if err != nil {
+			return nil, err
+		}
+		left = likeRegexNode{operand: left, pattern: patternTok.text, regex: regex}
+	case p.isKeyword("starts") && p.peekN(1).kind == tokenKind_Ident && p.peekN(1).text == "with":
+		p.pos += 2
+		prefixTok := p.peek()
+		if prefixTok.kind != tokenKind_String && prefixTok.kind != tokenKind_Variable {
+			return nil, p.syntaxError()
+		}
+		prefix, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		left = startsWithNode{operand: left, prefix: prefix}
+	}
+	for p.isKeyword("is") {
+		if next := p.peekN(1); next.kind != tokenKind_Ident || next.text != "unknown" {
+			p.pos++
+			return nil, p.syntaxError()
+		}
+		if !isPredicate(left) {
+			return nil, p.syntaxError()
+		}
+		p.pos += 2
+		left = isUnknownNode{operand: left}
+	}
+	return left, nil
+}
+
+// parseAdditive parses the + and - arithmetic operators.
+func (p *parser) parseAdditive() (node, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("+") || p.isPunct("-") {
+		op := p.next().text
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		if isPredicate(left) || isPredicate(right) {
+			return nil, fmt.Errorf(`syntax error at or near "%s" of jsonpath input`, op)
+		}
+		left = arithmeticNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseMultiplicative parses the *, /, and % arithmetic operators.
+func (p *parser) parseMultiplicative() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("*") || p.isPunct("/") || p.isPunct("%") {
+		op := p.next().text
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if isPredicate(left) || isPredicate(right) {
+			return nil, fmt.Errorf(`syntax error at or near "%s" of jsonpath input`, op)
+		}
+		left = arithmeticNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseUnary parses the unary + and - operators.
+func (p *parser) parseUnary() (node, error) {
+	if p.isPunct("+") || p.isPunct("-") {
+		op := p.next().text
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if isPredicate(operand) {
+			return nil, fmt.Errorf(`syntax error at or near "%s" of jsonpath input`, op)
+		}
+		// Negative numeric literals are folded, so that they may be used wherever a literal is expected
+		if literal, ok := operand.(literalNode); ok {
+			if number, ok := literal.value.(pgtypes.JsonValueNumber); ok {
+				if op == "-" {
+					number = pgtypes.JsonValueNumber(decimal.Decimal(number).Neg())
+				}
+				return literalNode{value: number}, nil
+			}
+		}
+		return unaryNode{op: op, operand: operand}, nil
+	}
+	return p.parseAccessorChain()
+}
+
+// parseAccessorChain parses a primary expression followed by any number of accessors.
+func (p *parser) parseAccessorChain() (node, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	var accessors []accessor
+	for {
+		var acc accessor
+		switch {
+		case p.isPunct("."):
+			p.pos++
+			if acc, err = p.parseDotAccessor(); err != nil {
+				return nil, err
+			}
+		case p.isPunct("["):
+			p.pos++
+			if acc, err = p.parseArrayAccessor(); err != nil {
+				return nil, err
+			}
+		case p.isPunct("?"):
+			p.pos++
+			if err = p.expectPunct("("); err != nil {
+				return nil, err
+			}
+			p.filterDepth++
+			predicate, err := p.parseOr()
+			p.filterDepth--
+			if err != nil {
+				return nil, err
+			}
+			if !isPredicate(predicate) {
+				return nil, p.syntaxError()
+			}
+			if err = p.expectPunct(")"); err != nil {
+				return nil, err
+			}
+			acc = filterAccessor{predicate: predicate}
+		default:
+			if len(accessors) == 0 {
+				return base, nil
+			}
+			return accessorChainNode{base: base, accessors: accessors}, nil
+		}
+		accessors = append(accessors, acc)
+	}
+}
+
+// parseDotAccessor parses the accessor following a period, which may be a member accessor, a wildcard, or a method.
+func (p *parser) parseDotAccessor() (accessor, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokenKind_Punct:
+		switch tok.text {
+		case "*":
+			p.pos++
+			return wildcardMemberAccessor{}, nil
+		case "**":
+			p.pos++
+			return p.parseAnyLevelAccessor()
+		}
+	case tokenKind_String:
+		p.pos++
+		return memberAccessor{key: tok.text}, nil
+	case tokenKind_Ident:
+		p.pos++
+		if !p.isPunct("(") {
+			return memberAccessor{key: tok.text}, nil
+		}
+		if _, ok := supportedMethods[tok.text]; !ok {
+			switch tok.text {
+			case "datetime", "keyvalue", "bigint", "boolean", "date", "decimal", "integer", "number", "string",
+				"time", "time_tz", "timestamp", "timestamp_tz":
+				return nil, fmt.Errorf("jsonpath item method .%s() is not yet supported", tok.text)
+			default:
+				return nil, p.syntaxError()
+			}
+		}
+		p.pos++
+		if err := p.expectPunct(")"); err != nil {
+			return nil, err
+		}
+		return methodAccessor{name: tok.text}, nil
+	}
+	return nil, p.syntaxError()
+}
+
+// parseAnyLevelAccessor parses the optional level range that follows .**.
+func (p *parser) parseAnyLevelAccessor() (accessor, error) {
+	if !p.isPunct("{") {
+		return anyLevelAccessor{first: 0, last: -1}, nil
+	}
+	p.pos++
+	first, err := p.parseLevel()
+	if err != nil {
+		return nil, err
+	}
+	last := first
+	if p.isKeyword("to") {
+		p.pos++
+		if last, err = p.parseLevel(); err != nil {
+			return nil, err
+		}
+	}
+	if err = p.expectPunct("}"); err != nil {
+		return nil, err
+	}
+	return anyLevelAccessor{first: first, last: last}, nil
+}
+
+// parseLevel parses a single level of an any level accessor, where last represents an unlimited level.
+func (p *parser) parseLevel() (int, error) {
+	tok := p.peek()
+	if tok.kind == tokenKind_Ident && tok.text == "last" {
+		p.pos++
+		return -1, nil
+	}
+	if tok.kind != tokenKind_Number {
+		return 0, p.syntaxError()
+	}
+	level, err := strconv.Atoi(tok.text)
+	if err != nil || level < 0 {
+		return 0, p.syntaxError()
+	}
+	p.pos++
+	return level, nil
+}
+
+// parseArrayAccessor parses the subscripts of an array accessor, following the opening bracket.
+func (p *parser) parseArrayAccessor() (accessor, error) {
+	if p.isPunct("*") && p.peekN(1).kind == tokenKind_Punct && p.peekN(1).text == "]" {
+		p.pos += 2
+		return wildcardArrayAccessor{}, nil
+	}
+	p.subscripts++
+	defer func() { p.subscripts-- }()
+	var subscripts []arraySubscript
+	for {
+		from, err := p.parseSubscriptExpression()
+		if err != nil {
+			return nil, err
+		}
+		subscript := arraySubscript{from: from}
+		if p.isKeyword("to") {
+			p.pos++
+			if subscript.to, err = p.parseSubscriptExpression(); err != nil {
+				return nil, err
+			}
+		}
+		subscripts = append(subscripts, subscript)
+		if p.isPunct(",") {
+			p.pos++
+			continue
+		}
+		if err = p.expectPunct("]"); err != nil {
+			return nil, err
+		}
+		return arrayAccessor{subscripts: subscripts}, nil
+	}
+}
+
+// parseSubscriptExpression parses a single array index expression.
+func (p *parser) parseSubscriptExpression() (node, error) {
+	expr, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	if isPredicate(expr) {
+		return nil, p.syntaxError()
+	}
+	return expr, nil
+}
+
+// parsePrimary parses a primary expression, such as a literal, variable, or parenthesized expression.
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokenKind_String:
+		p.pos++
+		return literalNode{value: pgtypes.JsonValueString(tok.text)}, nil
+	case tokenKind_Number:
+		p.pos++
+		number, err := decimal.NewFromString(tok.text)
+		if err != nil {
+			return nil, fmt.Errorf(`syntax error at or near "%s" of jsonpath input`, tok.text)
+		}
+		return literalNode{value: pgtypes.JsonValueNumber(number)}, nil
+	case tokenKind_Variable:
+		p.pos++
+		return variableNode{name: tok.text}, nil
+	case tokenKind_Punct:
+		switch tok.text {
+		case "$":
+			p.pos++
+			return rootNode{}, nil
+		case "@":
+			if p.filterDepth == 0 {
+				return nil, fmt.Errorf("@ is not allowed in root expressions")
+			}
+			p.pos++
+			return currentNode{}, nil
+		case "(":
+			p.pos++
+			expr, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err = p.expectPunct(")"); err != nil {
+				return nil, err
+			}
+			return expr, nil
+		}
+	case tokenKind_Ident:
+		switch tok.text {
+		case "true", "false":
+			p.pos++
+			return literalNode{value: pgtypes.JsonValueBoolean(tok.text == "true")}, nil
+		case "null":
+			p.pos++
+			return literalNode{value: pgtypes.JsonValueNull(0)}, nil
+		case "last":
+			if p.subscripts == 0 {
+				return nil, fmt.Errorf("LAST is allowed only in array subscripts")
+			}
+			p.pos++
+			return lastNode{}, nil
+		case "exists":
+			p.pos++
+			if err := p.expectPunct("("); err != nil {
+				return nil, err
+			}
+			operand, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if isPredicate(operand) {
+				return nil, p.syntaxError()
+			}
+			if err = p.expectPunct(")"); err != nil {
+				return nil, err
+			}
+			return existsNode{operand: operand}, nil
+		}
+	}
+	return nil, p.syntaxError()
+}
+
+// compileLikeRegex compiles the pattern of a like_regex predicate, applying the given flags.
+func compileLikeRegex(pattern string, flags string) (*regexp.Regexp, error) {
+	prefix := ""
+	for _, flag := range flags {
+		switch flag {
+		case 'i':
+			prefix += "i"
+		case 's':
+			prefix += "s"
+		case 'm':
+			prefix += "m"
+		case 'q':
+			pattern = regexp.QuoteMeta(pattern)
+		case 'x':
+			return nil, fmt.Errorf(`XQuery "x" flag (expanded regular expressions) is not implemented`)
+		default:
+			return nil, fmt.Errorf(`invalid input syntax for type jsonpath`)
+		}
+	}
+	if len(prefix) > 0 {
+		pattern = "(?" + prefix + ")" + pattern
+	}
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression: %s", err.Error())
+	}
+	return regex, nil
+}
+
+// lex splits the input into tokens. The final token is always an EOF token.
+func lex(input string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(input); {
+		r, size := utf8.DecodeRuneInString(input[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r == '"':
+			str, length, err := lexString(input[i:])
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenKind_String, text: str})
+			i += length
+		case r == '$':
+			i++
+			if i < len(input) && input[i] == '"' {
+				str, length, err := lexString(input[i:])
+				if err != nil {
+					return nil, err
+				}
+				tokens = append(tokens, token{kind: tokenKind_Variable, text: str})
+				i += length
+			} else if ident := lexIdent(input[i:]); len(ident) > 0 {
+				tokens = append(tokens, token{kind: tokenKind_Variable, text: ident})
+				i += len(ident)
+			} else {
+				tokens = append(tokens, token{kind: tokenKind_Punct, text: "$"})
+			}
+		case r >= '0' && r <= '9':
+			number := lexNumber(input[i:])
+			if i+len(number) < len(input) && isIdentRune(rune(input[i+len(number)])) {
+				return nil, fmt.Errorf(`trailing junk after numeric literal at or near "%s" of jsonpath input`, input[i:i+len(number)+1])
+			}
+			tokens = append(tokens, token{kind: tokenKind_Number, text: number})
+			i += len(number)
+		case isIdentRune(r):
+			ident := lexIdent(input[i:])
+			tokens = append(tokens, token{kind: tokenKind_Ident, text: ident})
+			i += len(ident)
+		default:
+			punct := ""
+			for _, multiChar := range multiCharPunctuation {
+				if strings.HasPrefix(input[i:], multiChar) {
+					punct = multiChar
+					break
+				}
+			}
+			if len(punct) == 0 {
+				if !strings.ContainsRune("$@.[](),*?{}<>!+-/%", r) {
+					return nil, fmt.Errorf(`syntax error at or near "%s" of jsonpath input`, string(r))
+				}
+				punct = string(r)
+			}
+			tokens = append(tokens, token{kind: tokenKind_Punct, text: punct})
+			i += len(punct)
+		}
+	}
+	return append(tokens, token{kind: tokenKind_EOF}), nil
+}
+
+// isIdentRune returns whether the rune may be used within an unquoted key or keyword.
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// lexIdent returns the identifier at the start of the input, which may be empty if there is no identifier.
+func lexIdent(input string) string {
+	for i, r := range input {
+		if !isIdentRune(r) {
+			return input[:i]
+		}
+	}
+	return input
+}
+
+// lexNumber returns the numeric literal at the start of the input, which must begin with a digit.
+func lexNumber(input string) string {
+	i := 0
+	digits := func() {
+		for i < len(input) && input[i] >= '0' && input[i] <= '9' {
+			i++
+		}
+	}
+	digits()
+	if i+1 < len(input) && input[i] == '.' && input[i+1] >= '0' && input[i+1] <= '9' {
+		i++
+		digits()
+	}
+	if i < len(input) && (input[i] == 'e' || input[i] == 'E') {
+		j := i + 1
+		if j < len(input) && (input[j] == '+' || input[j] == '-') {
+			j++
+		}
+		if j < len(input) && input[j] >= '0' && input[j] <= '9' {
+			i = j
+			digits()
+		}
+	}
+	return input[:i]
+}
+
+// lexString reads the double-quoted string at the start of the input, returning the unescaped contents along with
+// the number of bytes that were read.
+func lexString(input string) (string, int, error) {
+	sb := strings.Builder{}
+	for i := 1; i < len(input); i++ {
+		switch input[i] {
+		case '"':
+			return sb.String(), i + 1, nil
+		case '\\':
+			i++
+			if i >= len(input) {
+				return "", 0, fmt.Errorf("unexpected end of quoted string")
+			}
+			switch input[i] {
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'v':
+				sb.WriteByte('\v')
+			case 'u':
+				if i+4 >= len(input) {
+					return "", 0, fmt.Errorf("invalid Unicode escape sequence")
+				}
+				code, err := strconv.ParseUint(input[i+1:i+5], 16, 32)
+				if err != nil {
+					return "", 0, fmt.Errorf("invalid Unicode escape sequence")
+				}
+				sb.WriteRune(rune(code))
+				i += 4
+			case 'x':
+				if i+2 >= len(input) {
+					return "", 0, fmt.Errorf("invalid hexadecimal character sequence")
+				}
+				code, err := strconv.ParseUint(input[i+1:i+3], 16, 8)
+				if err != nil {
+					return "", 0, fmt.Errorf("invalid hexadecimal character sequence")
+				}
+				sb.WriteRune(rune(code))
+				i += 2
+			default:
+				sb.WriteByte(input[i])
+			}
+		default:
+			sb.WriteByte(input[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unexpected end of quoted string")
+}
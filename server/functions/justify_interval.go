@@ -0,0 +1,60 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJustifyInterval registers the functions to the catalog.
+func initJustifyInterval() {
+	framework.RegisterFunction(justify_interval_interval)
+}
+
+// justify_interval_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var justify_interval_interval = framework.Function1{
+	Name:       "justify_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.Interval},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		dur := val1.(duration.Duration)
+		const nanosPerDay = duration.SecsPerDay * NanosPerSec
+		days := dur.Days + dur.Nanos()/nanosPerDay
+		nanos := dur.Nanos() % nanosPerDay
+		months := dur.Months + days/duration.DaysPerMonth
+		days = days % duration.DaysPerMonth
+		// The signs of the fields are made to agree, starting from the months
+		if months > 0 && (days < 0 || (days == 0 && nanos < 0)) {
+			days += duration.DaysPerMonth
+			months--
+		} else if months < 0 && (days > 0 || (days == 0 && nanos > 0)) {
+			days -= duration.DaysPerMonth
+			months++
+		}
+		if days > 0 && nanos < 0 {
+			nanos += nanosPerDay
+			days--
+		} else if days < 0 && nanos > 0 {
+			nanos -= nanosPerDay
+			days++
+		}
+		return duration.MakeDuration(nanos, days, months), nil
+	},
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initLikeEscape registers the functions to the catalog.
+func initLikeEscape() {
+	framework.RegisterFunction(like_escape_text_text)
+}
+
+// like_escape_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var like_escape_text_text = framework.Function2{
+	Name:       "like_escape",
+	Return:     pgtypes.Text,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		escapeRune, err := patternEscapeRune(val2.(string))
+		if err != nil {
+			return nil, err
+		}
+		// The pattern is rewritten to use a backslash as the escape character, which is the default for LIKE
+		sb := strings.Builder{}
+		afterEscape := false
+		for _, r := range val1.(string) {
+			switch {
+			case afterEscape:
+				sb.WriteRune('\\')
+				sb.WriteRune(r)
+				afterEscape = false
+			case escapeRune != 0 && r == escapeRune:
+				afterEscape = true
+			case r == '\\':
+				sb.WriteString(`\\`)
+			default:
+				sb.WriteRune(r)
+			}
+		}
+		if afterEscape {
+			sb.WriteRune('\\')
+		}
+		return sb.String(), nil
+	},
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/timeofday"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initLocalTime registers the functions to the catalog.
+func initLocalTime() {
+	framework.RegisterFunction(localtime)
+	framework.RegisterFunction(localtime_int32)
+}
+
+// localtime represents the PostgreSQL function of the same name, taking the same parameters.
+var localtime = framework.Function0{
+	Name:               "localtime",
+	Return:             pgtypes.Time,
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context) (any, error) {
+		t, err := localTransactionTimestamp(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return timeofday.FromTime(t).ToTime(), nil
+	},
+}
+
+// localtime_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var localtime_int32 = framework.Function1{
+	Name:               "localtime",
+	Return:             pgtypes.Time,
+	Parameters:         [1]pgtypes.DoltgresType{pgtypes.Int32},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		t, err := localTransactionTimestamp(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if t, err = roundTimeToPrecision(t, val1.(int32)); err != nil {
+			return nil, err
+		}
+		return timeofday.FromTime(t).ToTime(), nil
+	},
+}
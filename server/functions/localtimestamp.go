@@ -0,0 +1,73 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initLocalTimestamp registers the functions to the catalog.
+func initLocalTimestamp() {
+	framework.RegisterFunction(localtimestamp)
+	framework.RegisterFunction(localtimestamp_int32)
+}
+
+// localtimestamp represents the PostgreSQL function of the same name, taking the same parameters.
+var localtimestamp = framework.Function0{
+	Name:               "localtimestamp",
+	Return:             pgtypes.Timestamp,
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context) (any, error) {
+		return localTransactionTimestamp(ctx)
+	},
+}
+
+// localtimestamp_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var localtimestamp_int32 = framework.Function1{
+	Name:               "localtimestamp",
+	Return:             pgtypes.Timestamp,
+	Parameters:         [1]pgtypes.DoltgresType{pgtypes.Int32},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		t, err := localTransactionTimestamp(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return roundTimeToPrecision(t, val1.(int32))
+	},
+}
+
+// localTransactionTimestamp returns the start time of the current transaction as a timestamp without time zone, using
+// the wall time from the server's time zone.
+func localTransactionTimestamp(ctx *sql.Context) (time.Time, error) {
+	loc, err := pgtypes.GetServerLocation(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return toLocalTimestamp(TransactionTimestamp(ctx), loc), nil
+}
+
+// toLocalTimestamp returns the wall time of the given time within the given location as a timestamp without time zone.
+func toLocalTimestamp(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
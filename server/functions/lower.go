@@ -25,6 +25,7 @@ import (
 
 // initLower registers the functions to the catalog.
 func initLower() {
+	framework.RegisterFunction(lower_anyrange)
 	framework.RegisterFunction(lower_text)
 }
 
@@ -39,3 +40,15 @@ var lower_text = framework.Function1{
 		return strings.ToLower(val1.(string)), nil
 	},
 }
+
+// lower_anyrange represents the PostgreSQL function of the same name, taking the same parameters.
+var lower_anyrange = framework.Function1{
+	Name:       "lower",
+	Return:     pgtypes.AnyElement,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		// Empty ranges and infinite bounds both return NULL
+		return val.(pgtypes.RangeValue).Lower, nil
+	},
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initMakeDate registers the functions to the catalog.
+func initMakeDate() {
+	framework.RegisterFunction(make_date_int32_int32_int32)
+}
+
+// make_date_int32_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_date_int32_int32_int32 = framework.Function3{
+	Name:       "make_date",
+	Return:     pgtypes.Date,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+		return makeDate(val1.(int32), val2.(int32), val3.(int32))
+	},
+}
+
+// makeDate returns the date with the given fields. Negative years are interpreted as years BC, so there is no year 0.
+func makeDate(year int32, month int32, day int32) (time.Time, error) {
+	astronomicalYear := int(year)
+	if year < 0 {
+		// 1 BC is year 0 in Go's proleptic Gregorian calendar
+		astronomicalYear++
+	}
+	t := time.Date(astronomicalYear, time.Month(month), int(day), 0, 0, 0, 0, time.UTC)
+	if year == 0 || t.Year() != astronomicalYear || t.Month() != time.Month(month) || t.Day() != int(day) {
+		return time.Time{}, fmt.Errorf("date field value out of range: %d-%02d-%02d", year, month, day)
+	}
+	return t, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initMakeInterval registers the functions to the catalog.
+func initMakeInterval() {
+	framework.RegisterFunction(make_interval)
+	framework.RegisterFunction(make_interval_int32)
+	framework.RegisterFunction(make_interval_int32_int32)
+	framework.RegisterFunction(make_interval_int32_int32_int32)
+	framework.RegisterFunction(make_interval_int32_int32_int32_int32)
+	framework.RegisterFunction(make_interval_int32_int32_int32_int32_int32)
+	framework.RegisterFunction(make_interval_int32_int32_int32_int32_int32_int32)
+	framework.RegisterFunction(make_interval_int32_int32_int32_int32_int32_int32_float64)
+}
+
+// The PostgreSQL function declares a default of zero for each of its parameters, which is represented here by an
+// overload for each number of leading parameters.
+
+// make_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval = framework.Function0{
+	Name:   "make_interval",
+	Return: pgtypes.Interval,
+	Strict: true,
+	Callable: func(ctx *sql.Context) (any, error) {
+		return makeInterval(0, 0, 0, 0, 0, 0, 0)
+	},
+}
+
+// make_interval_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32 = framework.Function1{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		return makeInterval(val1.(int32), 0, 0, 0, 0, 0, 0)
+	},
+}
+
+// make_interval_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32_int32 = framework.Function2{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		return makeInterval(val1.(int32), val2.(int32), 0, 0, 0, 0, 0)
+	},
+}
+
+// make_interval_int32_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32_int32_int32 = framework.Function3{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+		return makeInterval(val1.(int32), val2.(int32), val3.(int32), 0, 0, 0, 0)
+	},
+}
+
+// make_interval_int32_int32_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32_int32_int32_int32 = framework.Function4{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1, val2, val3, val4 any) (any, error) {
+		return makeInterval(val1.(int32), val2.(int32), val3.(int32), val4.(int32), 0, 0, 0)
+	},
+}
+
+// make_interval_int32_int32_int32_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32_int32_int32_int32_int32 = framework.Function5{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [5]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [6]pgtypes.DoltgresType, val1, val2, val3, val4, val5 any) (any, error) {
+		return makeInterval(val1.(int32), val2.(int32), val3.(int32), val4.(int32), val5.(int32), 0, 0)
+	},
+}
+
+// make_interval_int32_int32_int32_int32_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32_int32_int32_int32_int32_int32 = framework.Function6{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [6]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [7]pgtypes.DoltgresType, val1, val2, val3, val4, val5, val6 any) (any, error) {
+		return makeInterval(val1.(int32), val2.(int32), val3.(int32), val4.(int32), val5.(int32), val6.(int32), 0)
+	},
+}
+
+// make_interval_int32_int32_int32_int32_int32_int32_float64 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_interval_int32_int32_int32_int32_int32_int32_float64 = framework.Function7{
+	Name:       "make_interval",
+	Return:     pgtypes.Interval,
+	Parameters: [7]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Float64},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [8]pgtypes.DoltgresType, val1, val2, val3, val4, val5, val6, val7 any) (any, error) {
+		return makeInterval(val1.(int32), val2.(int32), val3.(int32), val4.(int32), val5.(int32), val6.(int32), val7.(float64))
+	},
+}
+
+// makeInterval returns the interval with the given fields. Seconds are rounded to the nearest microsecond.
+func makeInterval(years, months, weeks, days, hours, mins int32, secs float64) (duration.Duration, error) {
+	if math.IsNaN(secs) || math.IsInf(secs, 0) {
+		return duration.Duration{}, fmt.Errorf("interval out of range")
+	}
+	totalMonths := int64(years)*duration.MonthsPerYear + int64(months)
+	totalDays := int64(weeks)*7 + int64(days)
+	nanos := int64(hours)*duration.SecsPerHour*NanosPerSec + int64(mins)*duration.SecsPerMinute*NanosPerSec +
+		int64(math.Round(secs*1000000))*NanosPerMicro
+	return duration.MakeDuration(nanos, totalDays, totalMonths), nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/timeofday"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initMakeTime registers the functions to the catalog.
+func initMakeTime() {
+	framework.RegisterFunction(make_time_int32_int32_float64)
+}
+
+// make_time_int32_int32_float64 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_time_int32_int32_float64 = framework.Function3{
+	Name:       "make_time",
+	Return:     pgtypes.Time,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Float64},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1, val2, val3 any) (any, error) {
+		nanos, err := makeTimeNanos(val1.(int32), val2.(int32), val3.(float64))
+		if err != nil {
+			return nil, err
+		}
+		return timeofday.FromInt(nanos / NanosPerMicro).ToTime(), nil
+	},
+}
+
+// makeTimeNanos returns the number of nanoseconds since midnight for the given time fields, rounded to the nearest
+// microsecond. A time of exactly 24:00:00 is permitted.
+func makeTimeNanos(hour int32, min int32, sec float64) (int64, error) {
+	if hour < 0 || hour > 24 || min < 0 || min > 59 || math.IsNaN(sec) || sec < 0 || sec > 60 ||
+		(hour == 24 && (min > 0 || sec > 0)) {
+		return 0, fmt.Errorf("time field value out of range: %d:%02d:%02g", hour, min, sec)
+	}
+	micros := int64(math.Round(sec * float64(time.Second/time.Microsecond)))
+	return int64(hour)*int64(time.Hour) + int64(min)*int64(time.Minute) + micros*NanosPerMicro, nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initMakeTimestamp registers the functions to the catalog.
+func initMakeTimestamp() {
+	framework.RegisterFunction(make_timestamp_int32_int32_int32_int32_int32_float64)
+}
+
+// make_timestamp_int32_int32_int32_int32_int32_float64 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_timestamp_int32_int32_int32_int32_int32_float64 = framework.Function6{
+	Name:       "make_timestamp",
+	Return:     pgtypes.Timestamp,
+	Parameters: [6]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Float64},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [7]pgtypes.DoltgresType, val1, val2, val3, val4, val5, val6 any) (any, error) {
+		return makeTimestamp(val1.(int32), val2.(int32), val3.(int32), val4.(int32), val5.(int32), val6.(float64), time.UTC)
+	},
+}
+
+// makeTimestamp returns the timestamp with the given fields, with the wall time interpreted within the given location.
+func makeTimestamp(year, month, day, hour, min int32, sec float64, loc *time.Location) (time.Time, error) {
+	date, err := makeDate(year, month, day)
+	if err != nil {
+		return time.Time{}, err
+	}
+	nanos, err := makeTimeNanos(hour, min, sec)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, int(nanos), loc), nil
+}
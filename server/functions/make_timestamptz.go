@@ -0,0 +1,59 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initMakeTimestampTZ registers the functions to the catalog.
+func initMakeTimestampTZ() {
+	framework.RegisterFunction(make_timestamptz_int32_int32_int32_int32_int32_float64)
+	framework.RegisterFunction(make_timestamptz_int32_int32_int32_int32_int32_float64_text)
+}
+
+// make_timestamptz_int32_int32_int32_int32_int32_float64 represents the PostgreSQL function of the same name, taking the same parameters.
+var make_timestamptz_int32_int32_int32_int32_int32_float64 = framework.Function6{
+	Name:               "make_timestamptz",
+	Return:             pgtypes.TimestampTZ,
+	Parameters:         [6]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Float64},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, _ [7]pgtypes.DoltgresType, val1, val2, val3, val4, val5, val6 any) (any, error) {
+		loc, err := pgtypes.GetServerLocation(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return makeTimestamp(val1.(int32), val2.(int32), val3.(int32), val4.(int32), val5.(int32), val6.(float64), loc)
+	},
+}
+
+// make_timestamptz_int32_int32_int32_int32_int32_float64_text represents the PostgreSQL function of the same name, taking the same parameters.
+var make_timestamptz_int32_int32_int32_int32_int32_float64_text = framework.Function7{
+	Name:       "make_timestamptz",
+	Return:     pgtypes.TimestampTZ,
+	Parameters: [7]pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Int32, pgtypes.Float64, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [8]pgtypes.DoltgresType, val1, val2, val3, val4, val5, val6, val7 any) (any, error) {
+		loc, err := loadTimeZone(val7.(string))
+		if err != nil {
+			return nil, err
+		}
+		return makeTimestamp(val1.(int32), val2.(int32), val3.(int32), val4.(int32), val5.(int32), val6.(float64), loc)
+	},
+}
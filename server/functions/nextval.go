@@ -18,6 +18,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/auth"
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -46,6 +47,9 @@ var nextval_text = framework.Function1{
 			return nil, err
 		}
 
+		if err = checkSequencePrivilege(ctx, schema, sequence, auth.Privilege_USAGE, auth.Privilege_UPDATE); err != nil {
+			return nil, err
+		}
 		collection, err := core.GetSequencesCollectionFromContext(ctx)
 		if err != nil {
 			return nil, err
@@ -72,6 +76,9 @@ var nextval_regclass = framework.Function1{
 			return nil, err
 		}
 
+		if err = checkSequencePrivilege(ctx, schema, sequence, auth.Privilege_USAGE, auth.Privilege_UPDATE); err != nil {
+			return nil, err
+		}
 		collection, err := core.GetSequencesCollectionFromContext(ctx)
 		if err != nil {
 			return nil, err
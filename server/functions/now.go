@@ -0,0 +1,93 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initNow registers the functions to the catalog.
+func initNow() {
+	framework.RegisterFunction(now)
+}
+
+var (
+	transactionTimestamps      = make(map[uint32]time.Time)
+	transactionTimestampsMutex = &sync.Mutex{}
+)
+
+// now represents the PostgreSQL function of the same name, taking the same parameters.
+var now = framework.Function0{
+	Name:               "now",
+	Return:             pgtypes.TimestampTZ,
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context) (any, error) {
+		return TransactionTimestamp(ctx), nil
+	},
+}
+
+// SetTransactionTimestamp records the start time of the current transaction for the given session. This should be
+// called for every statement that is not executing within an explicit transaction block, since each of those statements
+// begins a new transaction (including BEGIN itself).
+func SetTransactionTimestamp(sessionID uint32, t time.Time) {
+	transactionTimestampsMutex.Lock()
+	defer transactionTimestampsMutex.Unlock()
+	transactionTimestamps[sessionID] = t
+}
+
+// ClearTransactionTimestamp removes the recorded transaction start time for the given session. This should be called
+// once the session has been closed.
+func ClearTransactionTimestamp(sessionID uint32) {
+	transactionTimestampsMutex.Lock()
+	defer transactionTimestampsMutex.Unlock()
+	delete(transactionTimestamps, sessionID)
+}
+
+// TransactionTimestamp returns the start time of the current transaction, which is the value returned by now() and
+// the other functions that return the current time without advancing during a transaction. If no start time has been
+// recorded for the session, then the statement's start time is used.
+func TransactionTimestamp(ctx *sql.Context) time.Time {
+	transactionTimestampsMutex.Lock()
+	t, ok := transactionTimestamps[ctx.Session.ID()]
+	transactionTimestampsMutex.Unlock()
+	if !ok {
+		t = ctx.QueryTime()
+	}
+	return t.Truncate(time.Microsecond)
+}
+
+// roundTimeToPrecision rounds the fractional seconds of the given time to the given number of digits, as is done for
+// the functions that accept a precision such as current_timestamp(p). Precisions above 6 are reduced to 6.
+func roundTimeToPrecision(t time.Time, precision int32) (time.Time, error) {
+	if precision < 0 {
+		return time.Time{}, fmt.Errorf("TIMESTAMP(%d) precision must not be negative", precision)
+	}
+	if precision > 6 {
+		precision = 6
+	}
+	unit := time.Duration(1)
+	for i := int32(0); i < 9-precision; i++ {
+		unit *= 10
+	}
+	return t.Round(unit), nil
+}
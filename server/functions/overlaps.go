@@ -0,0 +1,213 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initOverlaps registers the functions to the catalog.
+func initOverlaps() {
+	framework.RegisterFunction(overlaps_date_date_date_date)
+	framework.RegisterFunction(overlaps_time_time_time_time)
+	framework.RegisterFunction(overlaps_timestamp_timestamp_timestamp_timestamp)
+	framework.RegisterFunction(overlaps_timestamp_interval_timestamp_interval)
+	framework.RegisterFunction(overlaps_timestamp_interval_timestamp_timestamp)
+	framework.RegisterFunction(overlaps_timestamp_timestamp_timestamp_interval)
+	framework.RegisterFunction(overlaps_timestamptz_timestamptz_timestamptz_timestamptz)
+	framework.RegisterFunction(overlaps_timestamptz_interval_timestamptz_interval)
+	framework.RegisterFunction(overlaps_timestamptz_interval_timestamptz_timestamptz)
+	framework.RegisterFunction(overlaps_timestamptz_timestamptz_timestamptz_interval)
+}
+
+// overlaps_date_date_date_date represents the PostgreSQL function of the same name, taking the same parameters.
+var overlaps_date_date_date_date = framework.Function4{
+	Name:       "overlaps",
+	Return:     pgtypes.Bool,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.Date, pgtypes.Date, pgtypes.Date, pgtypes.Date},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1, val2, val3, val4 any) (any, error) {
+		return overlaps(val1, val2, val3, val4), nil
+	},
+}
+
+// overlaps_time_time_time_time represents the PostgreSQL function of the same name, taking the same parameters.
+var overlaps_time_time_time_time = framework.Function4{
+	Name:       "overlaps",
+	Return:     pgtypes.Bool,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.Time, pgtypes.Time, pgtypes.Time, pgtypes.Time},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1, val2, val3, val4 any) (any, error) {
+		return overlaps(val1, val2, val3, val4), nil
+	},
+}
+
+// overlaps_timestamp_timestamp_timestamp_timestamp represents the PostgreSQL function of the same name, taking the same parameters.
+var overlaps_timestamp_timestamp_timestamp_timestamp = framework.Function4{
+	Name:       "overlaps",
+	Return:     pgtypes.Bool,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.Timestamp, pgtypes.Timestamp, pgtypes.Timestamp, pgtypes.Timestamp},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1, val2, val3, val4 any) (any, error) {
+		return overlaps(val1, val2, val3, val4), nil
+	},
+}
+
+// overlaps_timestamp_interval_timestamp_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var overlaps_timestamp_interval_timestamp_interval = framework.Function4{
+	Name:       "overlaps",
+	Return:     pgtypes.Bool,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.Timestamp, pgtypes.Interval, pgtypes.Timestamp, pgtypes.Interval},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1, val2, val3, val4 any) (any, error) {
+		return overlaps(val1, addIntervalForOverlaps(val1, val2), val3, addIntervalForOverlaps(val3, val4)), nil
+	},
+}
+
+// overlaps_timestamp_interval_timestamp_timestamp represents the PostgreSQL function of the same name, taking the same parameters.
+var overlaps_timestamp_interval_timestamp_timestamp = framework.Function4{
+	Name:       "overlaps",
+	Return:     pgtypes.Bool,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.Timestamp, pgtypes.Interval, pgtypes.Timestamp, pgtypes.Timestamp},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1, val2, val3, val4 any) (any, error) {
+		return overlaps(val1, addIntervalForOverlaps(val1, val2), val3, val4), nil
+	},
+}
+
+// overlaps_timestamp_timestamp_timestamp_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var overlaps_timestamp_timestamp_timestamp_interval = framework.Function4{
+	Name:       "overlaps",
+	Return:     pgtypes.Bool,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.Timestamp, pgtypes.Timestamp, pgtypes.Timestamp, pgtypes.Interval},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1, val2, val3, val4 any) (any, error) {
+		return overlaps(val1, val2, val3, addIntervalForOverlaps(val3, val4)), nil
+	},
+}
+
+// overlaps_timestamptz_timestamptz_timestamptz_timestamptz represents the PostgreSQL function of the same name, taking the same parameters.
+var overlaps_timestamptz_timestamptz_timestamptz_timestamptz = framework.Function4{
+	Name:       "overlaps",
+	Return:     pgtypes.Bool,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.TimestampTZ, pgtypes.TimestampTZ, pgtypes.TimestampTZ, pgtypes.TimestampTZ},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1, val2, val3, val4 any) (any, error) {
+		return overlaps(val1, val2, val3, val4), nil
+	},
+}
+
+// overlaps_timestamptz_interval_timestamptz_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var overlaps_timestamptz_interval_timestamptz_interval = framework.Function4{
+	Name:       "overlaps",
+	Return:     pgtypes.Bool,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.TimestampTZ, pgtypes.Interval, pgtypes.TimestampTZ, pgtypes.Interval},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1, val2, val3, val4 any) (any, error) {
+		return overlaps(val1, addIntervalForOverlaps(val1, val2), val3, addIntervalForOverlaps(val3, val4)), nil
+	},
+}
+
+// overlaps_timestamptz_interval_timestamptz_timestamptz represents the PostgreSQL function of the same name, taking the same parameters.
+var overlaps_timestamptz_interval_timestamptz_timestamptz = framework.Function4{
+	Name:       "overlaps",
+	Return:     pgtypes.Bool,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.TimestampTZ, pgtypes.Interval, pgtypes.TimestampTZ, pgtypes.TimestampTZ},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1, val2, val3, val4 any) (any, error) {
+		return overlaps(val1, addIntervalForOverlaps(val1, val2), val3, val4), nil
+	},
+}
+
+// overlaps_timestamptz_timestamptz_timestamptz_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var overlaps_timestamptz_timestamptz_timestamptz_interval = framework.Function4{
+	Name:       "overlaps",
+	Return:     pgtypes.Bool,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.TimestampTZ, pgtypes.TimestampTZ, pgtypes.TimestampTZ, pgtypes.Interval},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1, val2, val3, val4 any) (any, error) {
+		return overlaps(val1, val2, val3, addIntervalForOverlaps(val3, val4)), nil
+	},
+}
+
+// addIntervalForOverlaps returns the end of a period that is given by its start and length, which is NULL if either
+// value is NULL.
+func addIntervalForOverlaps(start any, length any) any {
+	if start == nil || length == nil {
+		return nil
+	}
+	return duration.Add(start.(time.Time), length.(duration.Duration))
+}
+
+// overlaps returns whether the two periods overlap. Each period is given by its endpoints, which may be given in either
+// order. A period with a single NULL endpoint is treated as an instant, which may still result in NULL when the
+// answer depends on the missing endpoint. This matches the behavior of PostgreSQL rather than strictly following the
+// SQL standard.
+func overlaps(start1, end1, start2, end2 any) any {
+	ts1, te1, ok := overlapsPeriod(start1, end1)
+	if !ok {
+		return nil
+	}
+	ts2, te2, ok := overlapsPeriod(start2, end2)
+	if !ok {
+		return nil
+	}
+	switch {
+	case ts1.After(ts2):
+		if te2 == nil {
+			return nil
+		}
+		if ts1.Before(*te2) {
+			return true
+		}
+		if te1 == nil {
+			return nil
+		}
+		return false
+	case ts1.Before(ts2):
+		if te1 == nil {
+			return nil
+		}
+		if ts2.Before(*te1) {
+			return true
+		}
+		if te2 == nil {
+			return nil
+		}
+		return false
+	default:
+		// Periods that begin at the same instant overlap, even if one of them is empty
+		if te1 == nil || te2 == nil {
+			return nil
+		}
+		return true
+	}
+}
+
+// overlapsPeriod returns the endpoints of a period, ordered so that the start comes first. If only one endpoint is
+// NULL, then it is returned as the end. Returns false if both endpoints are NULL.
+func overlapsPeriod(start any, end any) (time.Time, *time.Time, bool) {
+	if start == nil {
+		if end == nil {
+			return time.Time{}, nil, false
+		}
+		return end.(time.Time), nil, true
+	}
+	ts := start.(time.Time)
+	if end == nil {
+		return ts, nil, true
+	}
+	te := end.(time.Time)
+	if ts.After(te) {
+		ts, te = te, ts
+	}
+	return ts, &te, true
+}
@@ -0,0 +1,325 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// regexCacheSize is the maximum number of compiled regular expressions that are cached. PostgreSQL caches the most
+// recently used expressions in the same way, since the same pattern is generally used for every row of a query.
+const regexCacheSize = 32
+
+var (
+	regexCache      = make(map[string]*regexp.Regexp)
+	regexCacheMutex = &sync.Mutex{}
+)
+
+// CompileRegex compiles the PostgreSQL advanced regular expression (ARE) using the given flags, returning whether the
+// global flag was given. The expression is translated into Go's regular expression syntax, so features that Go does not
+// support, such as back references and lookaround constraints, return an error.
+func CompileRegex(pattern string, flags string) (re *regexp.Regexp, global bool, err error) {
+	caseInsensitive := false
+	literal := false
+	expanded := false
+	// By default, "." matches newlines and the anchors only match at the beginning and end of the string
+	dotMatchesNewline := true
+	multiline := false
+	for _, flag := range flags {
+		switch flag {
+		case 'g':
+			global = true
+		case 'i':
+			caseInsensitive = true
+		case 'c':
+			caseInsensitive = false
+		case 'n', 'm':
+			dotMatchesNewline = false
+			multiline = true
+		case 'p':
+			dotMatchesNewline = false
+			multiline = false
+		case 'w':
+			dotMatchesNewline = true
+			multiline = true
+		case 's':
+			dotMatchesNewline = true
+			multiline = false
+		case 'q':
+			literal = true
+		case 'x':
+			expanded = true
+		case 't':
+			expanded = false
+		default:
+			return nil, false, fmt.Errorf(`invalid regular expression option: "%c"`, flag)
+		}
+	}
+	sb := strings.Builder{}
+	if caseInsensitive || dotMatchesNewline || multiline {
+		sb.WriteString("(?")
+		if caseInsensitive {
+			sb.WriteRune('i')
+		}
+		if dotMatchesNewline {
+			sb.WriteRune('s')
+		}
+		if multiline {
+			sb.WriteRune('m')
+		}
+		sb.WriteRune(')')
+	}
+	if literal {
+		sb.WriteString(regexp.QuoteMeta(pattern))
+	} else {
+		if expanded {
+			pattern = stripExpandedRegex(pattern)
+		}
+		sb.WriteString(translateRegex(pattern))
+	}
+	re, err = compileCachedRegex(sb.String())
+	if err != nil {
+		return nil, false, err
+	}
+	return re, global, nil
+}
+
+// compileCachedRegex compiles the Go regular expression, reusing a previously compiled expression when possible.
+func compileCachedRegex(pattern string) (*regexp.Regexp, error) {
+	regexCacheMutex.Lock()
+	defer regexCacheMutex.Unlock()
+	if re, ok := regexCache[pattern]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression: %s", err.Error())
+	}
+	if len(regexCache) >= regexCacheSize {
+		for existingKey := range regexCache {
+			delete(regexCache, existingKey)
+			break
+		}
+	}
+	regexCache[pattern] = re
+	return re, nil
+}
+
+// translateRegex translates the escapes that are specific to PostgreSQL's regular expressions into their Go
+// equivalents. Escapes that are shared by both syntaxes are left unchanged.
+func translateRegex(pattern string) string {
+	sb := strings.Builder{}
+	inBrackets := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			switch next := pattern[i]; {
+			case inBrackets:
+				sb.WriteByte(c)
+				sb.WriteByte(next)
+			case next == 'm' || next == 'M' || next == 'y':
+				// Go does not have separate constraints for the beginning and end of a word
+				sb.WriteString(`\b`)
+			case next == 'Y':
+				sb.WriteString(`\B`)
+			case next == 'Z':
+				sb.WriteString(`\z`)
+			default:
+				sb.WriteByte(c)
+				sb.WriteByte(next)
+			}
+		case inBrackets:
+			if c == ']' {
+				inBrackets = false
+			}
+			sb.WriteByte(c)
+		case strings.HasPrefix(pattern[i:], "[[:<:]]") || strings.HasPrefix(pattern[i:], "[[:>:]]"):
+			sb.WriteString(`\b`)
+			i += len("[[:<:]]") - 1
+		case c == '[':
+			inBrackets = true
+			sb.WriteByte(c)
+			i += copyBracketPrefix(&sb, pattern[i+1:])
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// stripExpandedRegex removes the whitespace and comments from a regular expression that uses the expanded syntax.
+// Escaped characters and the contents of bracket expressions are left unchanged.
+func stripExpandedRegex(pattern string) string {
+	sb := strings.Builder{}
+	inBrackets := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\' && i+1 < len(pattern):
+			sb.WriteByte(c)
+			sb.WriteByte(pattern[i+1])
+			i++
+		case inBrackets:
+			if c == ']' {
+				inBrackets = false
+			}
+			sb.WriteByte(c)
+		case c == '[':
+			inBrackets = true
+			sb.WriteByte(c)
+			i += copyBracketPrefix(&sb, pattern[i+1:])
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			continue
+		case c == '#':
+			for i+1 < len(pattern) && pattern[i+1] != '\n' {
+				i++
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// copyBracketPrefix copies the negation and leading closing bracket of a bracket expression, since a closing bracket at
+// the start of the expression is a literal character. The given string follows the opening bracket. Returns the number
+// of characters that were copied.
+func copyBracketPrefix(sb *strings.Builder, str string) int {
+	copied := 0
+	if copied < len(str) && str[copied] == '^' {
+		sb.WriteByte('^')
+		copied++
+	}
+	if copied < len(str) && str[copied] == ']' {
+		sb.WriteByte(']')
+		copied++
+	}
+	return copied
+}
+
+// LikeToRegex converts the given LIKE pattern into an anchored Go regular expression. The escape character defaults to
+// a backslash, and an empty escape disables escaping.
+func LikeToRegex(pattern string, escape string, caseInsensitive bool) (*regexp.Regexp, error) {
+	escapeRune, err := patternEscapeRune(escape)
+	if err != nil {
+		return nil, err
+	}
+	sb := strings.Builder{}
+	sb.WriteString("(?s")
+	if caseInsensitive {
+		sb.WriteRune('i')
+	}
+	sb.WriteString(")^")
+	afterEscape := false
+	for _, r := range pattern {
+		switch {
+		case afterEscape:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			afterEscape = false
+		case escapeRune != 0 && r == escapeRune:
+			afterEscape = true
+		case r == '%':
+			sb.WriteString(".*")
+		case r == '_':
+			sb.WriteRune('.')
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	if afterEscape {
+		return nil, fmt.Errorf("LIKE pattern must not end with escape character")
+	}
+	sb.WriteRune('$')
+	return compileCachedRegex(sb.String())
+}
+
+// SimilarToRegex converts the given SQL regular expression, as used by SIMILAR TO, into a PostgreSQL regular expression.
+// The escape character defaults to a backslash, and an empty escape disables escaping. This mirrors PostgreSQL's
+// similar_to_escape function, which includes handling escape-double-quote separators as used by substring.
+func SimilarToRegex(pattern string, escape string) (string, error) {
+	escapeRune, err := patternEscapeRune(escape)
+	if err != nil {
+		return "", err
+	}
+	sb := strings.Builder{}
+	sb.WriteString("^(?:")
+	afterEscape := false
+	inCharClass := false
+	quotes := 0
+	for _, r := range pattern {
+		switch {
+		case afterEscape:
+			if r == '"' && !inCharClass {
+				switch quotes {
+				case 0:
+					sb.WriteString("){1,1}?(")
+				case 1:
+					sb.WriteString("){1,1}(?:")
+				default:
+					return "", fmt.Errorf("SQL regular expression may not contain more than two escape-double-quote separators")
+				}
+				quotes++
+			} else {
+				sb.WriteRune('\\')
+				sb.WriteRune(r)
+			}
+			afterEscape = false
+		case escapeRune != 0 && r == escapeRune:
+			afterEscape = true
+		case inCharClass:
+			if r == '\\' {
+				sb.WriteRune('\\')
+			}
+			sb.WriteRune(r)
+			if r == ']' {
+				inCharClass = false
+			}
+		case r == '[':
+			sb.WriteRune(r)
+			inCharClass = true
+		case r == '%':
+			sb.WriteString(".*")
+		case r == '_':
+			sb.WriteRune('.')
+		case r == '(':
+			sb.WriteString("(?:")
+		case r == '\\' || r == '.' || r == '^' || r == '$':
+			sb.WriteRune('\\')
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteString(")$")
+	return sb.String(), nil
+}
+
+// patternEscapeRune returns the escape character for LIKE and SIMILAR TO patterns, or zero if escaping is disabled.
+func patternEscapeRune(escape string) (rune, error) {
+	runes := []rune(escape)
+	switch len(runes) {
+	case 0:
+		return 0, nil
+	case 1:
+		return runes[0], nil
+	default:
+		return 0, fmt.Errorf("invalid escape string")
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initRegexpCount registers the functions to the catalog.
+func initRegexpCount() {
+	framework.RegisterFunction(regexp_count_text_text)
+	framework.RegisterFunction(regexp_count_text_text_int32)
+	framework.RegisterFunction(regexp_count_text_text_int32_text)
+}
+
+// regexp_count_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var regexp_count_text_text = framework.Function2{
+	Name:       "regexp_count",
+	Return:     pgtypes.Int32,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return regexpCount(val1.(string), val2.(string), 1, "")
+	},
+}
+
+// regexp_count_text_text_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var regexp_count_text_text_int32 = framework.Function3{
+	Name:       "regexp_count",
+	Return:     pgtypes.Int32,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return regexpCount(val1.(string), val2.(string), val3.(int32), "")
+	},
+}
+
+// regexp_count_text_text_int32_text represents the PostgreSQL function of the same name, taking the same parameters.
+var regexp_count_text_text_int32_text = framework.Function4{
+	Name:       "regexp_count",
+	Return:     pgtypes.Int32,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text, pgtypes.Int32, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error) {
+		return regexpCount(val1.(string), val2.(string), val3.(int32), val4.(string))
+	},
+}
+
+// regexpCount returns the number of times that the pattern matches within the string, starting the search at the given
+// 1-indexed character position. The global flag is implied.
+func regexpCount(str string, pattern string, start int32, flags string) (int32, error) {
+	if start <= 0 {
+		return 0, fmt.Errorf(`invalid value for parameter "start": %d`, start)
+	}
+	re, _, err := CompileRegex(pattern, flags)
+	if err != nil {
+		return 0, err
+	}
+	runes := []rune(str)
+	if int(start) > len(runes) {
+		return 0, nil
+	}
+	return int32(len(re.FindAllStringIndex(string(runes[start-1:]), -1))), nil
+}
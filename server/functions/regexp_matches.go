@@ -0,0 +1,88 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initRegexpMatches registers the functions to the catalog.
+func initRegexpMatches() {
+	framework.RegisterFunction(regexp_matches_text_text)
+	framework.RegisterFunction(regexp_matches_text_text_text)
+}
+
+// regexp_matches_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var regexp_matches_text_text = framework.Function2{
+	Name:       "regexp_matches",
+	Return:     pgtypes.TextArray,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return regexpMatches(val1.(string), val2.(string), "")
+	},
+}
+
+// regexp_matches_text_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var regexp_matches_text_text_text = framework.Function3{
+	Name:       "regexp_matches",
+	Return:     pgtypes.TextArray,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return regexpMatches(val1.(string), val2.(string), val3.(string))
+	},
+}
+
+// regexpMatches returns a SetIter containing the captured substrings of each match of the pattern within the string.
+// Only the first match is returned unless the global flag is given.
+func regexpMatches(str string, pattern string, flags string) (framework.SetIter, error) {
+	re, global, err := CompileRegex(pattern, flags)
+	if err != nil {
+		return nil, err
+	}
+	limit := 1
+	if global {
+		limit = -1
+	}
+	matches := re.FindAllStringSubmatchIndex(str, limit)
+	values := make([]any, len(matches))
+	for i, match := range matches {
+		values[i] = regexpMatchGroups(str, match)
+	}
+	return framework.NewSetIterFromValues(values), nil
+}
+
+// regexpMatchGroups returns the text array for a single match, given the indexes returned by FindStringSubmatchIndex.
+// This contains the substring of each capture group, or the entire match if the pattern has no capture groups. Groups
+// that did not participate in the match are NULL.
+func regexpMatchGroups(str string, match []int) []any {
+	if len(match) == 2 {
+		return []any{str[match[0]:match[1]]}
+	}
+	groups := make([]any, len(match)/2-1)
+	for i := range groups {
+		start, end := match[(i+1)*2], match[(i+1)*2+1]
+		if start >= 0 {
+			groups[i] = str[start:end]
+		}
+	}
+	return groups
+}
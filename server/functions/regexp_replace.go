@@ -0,0 +1,100 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initRegexpReplace registers the functions to the catalog.
+func initRegexpReplace() {
+	framework.RegisterFunction(regexp_replace_text_text_text)
+	framework.RegisterFunction(regexp_replace_text_text_text_text)
+}
+
+// regexp_replace_text_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var regexp_replace_text_text_text = framework.Function3{
+	Name:       "regexp_replace",
+	Return:     pgtypes.Text,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return regexpReplace(val1.(string), val2.(string), val3.(string), "")
+	},
+}
+
+// regexp_replace_text_text_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var regexp_replace_text_text_text_text = framework.Function4{
+	Name:       "regexp_replace",
+	Return:     pgtypes.Text,
+	Parameters: [4]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text, pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error) {
+		return regexpReplace(val1.(string), val2.(string), val3.(string), val4.(string))
+	},
+}
+
+// regexpReplace replaces the first match of the pattern within the string, or every match if the global flag is given.
+// Within the replacement, \1 through \9 refer to the respective capture group, \& refers to the entire match, and \\
+// is a literal backslash.
+func regexpReplace(str string, pattern string, replacement string, flags string) (string, error) {
+	re, global, err := CompileRegex(pattern, flags)
+	if err != nil {
+		return "", err
+	}
+	limit := 1
+	if global {
+		limit = -1
+	}
+	matches := re.FindAllStringSubmatchIndex(str, limit)
+	if len(matches) == 0 {
+		return str, nil
+	}
+	sb := strings.Builder{}
+	lastEnd := 0
+	for _, match := range matches {
+		sb.WriteString(str[lastEnd:match[0]])
+		for i := 0; i < len(replacement); i++ {
+			c := replacement[i]
+			if c != '\\' || i+1 >= len(replacement) {
+				sb.WriteByte(c)
+				continue
+			}
+			i++
+			switch next := replacement[i]; {
+			case next >= '1' && next <= '9':
+				group := int(next - '0')
+				if group*2+1 < len(match) && match[group*2] >= 0 {
+					sb.WriteString(str[match[group*2]:match[group*2+1]])
+				}
+			case next == '&':
+				sb.WriteString(str[match[0]:match[1]])
+			case next == '\\':
+				sb.WriteByte('\\')
+			default:
+				sb.WriteByte(c)
+				sb.WriteByte(next)
+			}
+		}
+		lastEnd = match[1]
+	}
+	sb.WriteString(str[lastEnd:])
+	return sb.String(), nil
+}
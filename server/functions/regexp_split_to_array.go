@@ -0,0 +1,74 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initRegexpSplitToArray registers the functions to the catalog.
+func initRegexpSplitToArray() {
+	framework.RegisterFunction(regexp_split_to_array_text_text)
+	framework.RegisterFunction(regexp_split_to_array_text_text_text)
+}
+
+// regexp_split_to_array_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var regexp_split_to_array_text_text = framework.Function2{
+	Name:       "regexp_split_to_array",
+	Return:     pgtypes.TextArray,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return regexpSplit("regexp_split_to_array", val1.(string), val2.(string), "")
+	},
+}
+
+// regexp_split_to_array_text_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var regexp_split_to_array_text_text_text = framework.Function3{
+	Name:       "regexp_split_to_array",
+	Return:     pgtypes.TextArray,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return regexpSplit("regexp_split_to_array", val1.(string), val2.(string), val3.(string))
+	},
+}
+
+// regexpSplit splits the string using each match of the pattern as a delimiter. Zero-length matches at the start or end
+// of the string are ignored, as are those immediately following a previous match. The function name is used for errors.
+func regexpSplit(functionName string, str string, pattern string, flags string) ([]any, error) {
+	re, global, err := CompileRegex(pattern, flags)
+	if err != nil {
+		return nil, err
+	}
+	if global {
+		return nil, fmt.Errorf(`%s() does not support the "global" option`, functionName)
+	}
+	var values []any
+	lastEnd := 0
+	for _, match := range re.FindAllStringIndex(str, -1) {
+		if match[0] == match[1] && (match[0] == 0 || match[0] == len(str) || match[0] == lastEnd) {
+			continue
+		}
+		values = append(values, str[lastEnd:match[0]])
+		lastEnd = match[1]
+	}
+	return append(values, str[lastEnd:]), nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initRegexpSplitToTable registers the functions to the catalog.
+func initRegexpSplitToTable() {
+	framework.RegisterFunction(regexp_split_to_table_text_text)
+	framework.RegisterFunction(regexp_split_to_table_text_text_text)
+}
+
+// regexp_split_to_table_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var regexp_split_to_table_text_text = framework.Function2{
+	Name:       "regexp_split_to_table",
+	Return:     pgtypes.Text,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		values, err := regexpSplit("regexp_split_to_table", val1.(string), val2.(string), "")
+		if err != nil {
+			return nil, err
+		}
+		return framework.NewSetIterFromValues(values), nil
+	},
+}
+
+// regexp_split_to_table_text_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var regexp_split_to_table_text_text_text = framework.Function3{
+	Name:       "regexp_split_to_table",
+	Return:     pgtypes.Text,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	SRF:        true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		values, err := regexpSplit("regexp_split_to_table", val1.(string), val2.(string), val3.(string))
+		if err != nil {
+			return nil, err
+		}
+		return framework.NewSetIterFromValues(values), nil
+	},
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initRowToJson registers the functions to the catalog.
+func initRowToJson() {
+	framework.RegisterFunction(row_to_json_record)
+	framework.RegisterFunction(row_to_json_record_bool)
+}
+
+// row_to_json_record represents the PostgreSQL function of the same name, taking the same parameters.
+var row_to_json_record = framework.Function1{
+	Name:       "row_to_json",
+	Return:     pgtypes.Json,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.Record},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [2]pgtypes.DoltgresType, val any) (any, error) {
+		return rowToJson(ctx, t[0], val, false)
+	},
+}
+
+// row_to_json_record_bool represents the PostgreSQL function of the same name, taking the same parameters.
+var row_to_json_record_bool = framework.Function2{
+	Name:       "row_to_json",
+	Return:     pgtypes.Json,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Record, pgtypes.Bool},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, t [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return rowToJson(ctx, t[0], val1, val2.(bool))
+	},
+}
+
+// rowToJson returns the json object representing the given composite value. When pretty is true, line feeds are added
+// between the top-level attributes.
+func rowToJson(ctx *sql.Context, typ pgtypes.DoltgresType, val any, pretty bool) (string, error) {
+	compositeType, ok := typ.(pgtypes.CompositeType)
+	if !ok {
+		return "", fmt.Errorf("function row_to_json does not support type %s", typ.String())
+	}
+	values := val.([]any)
+	separator := ","
+	if pretty {
+		separator = ",\n "
+	}
+	sb := strings.Builder{}
+	sb.WriteRune('{')
+	for i, attribute := range compositeType.Attributes {
+		if i > 0 {
+			sb.WriteString(separator)
+		}
+		key, err := pgtypes.JsonTextFromValue(ctx, pgtypes.Text, attribute.Name)
+		if err != nil {
+			return "", err
+		}
+		attrVal, err := pgtypes.JsonTextFromValue(ctx, attribute.Type, values[i])
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(key)
+		sb.WriteRune(':')
+		sb.WriteString(attrVal)
+	}
+	sb.WriteRune('}')
+	return sb.String(), nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initSetMasklen registers the functions to the catalog.
+func initSetMasklen() {
+	framework.RegisterFunction(set_masklen_cidr_int32)
+	framework.RegisterFunction(set_masklen_inet_int32)
+}
+
+// set_masklen_cidr_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var set_masklen_cidr_int32 = framework.Function2{
+	Name:       "set_masklen",
+	Return:     pgtypes.Cidr,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Cidr, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		prefix, err := setMasklen(val1.(netip.Prefix), val2.(int32))
+		if err != nil {
+			return nil, err
+		}
+		// A cidr may not have any bits set outside of its netmask
+		return prefix.Masked(), nil
+	},
+}
+
+// set_masklen_inet_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var set_masklen_inet_int32 = framework.Function2{
+	Name:       "set_masklen",
+	Return:     pgtypes.Inet,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Inet, pgtypes.Int32},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return setMasklen(val1.(netip.Prefix), val2.(int32))
+	},
+}
+
+// setMasklen returns the given address with the new netmask length. A length of -1 uses the maximum length for the
+// address family.
+func setMasklen(prefix netip.Prefix, bits int32) (netip.Prefix, error) {
+	maxBits := int32(prefix.Addr().BitLen())
+	if bits == -1 {
+		bits = maxBits
+	}
+	if bits < 0 || bits > maxBits {
+		return netip.Prefix{}, fmt.Errorf("invalid mask length: %d", bits)
+	}
+	return netip.PrefixFrom(prefix.Addr(), int(bits)), nil
+}
@@ -18,9 +18,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	"github.com/dolthub/doltgresql/server/auth"
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -61,6 +65,9 @@ var setval_text_int64_boolean = framework.Function3{
 		if err != nil {
 			return nil, err
 		}
+		if err = checkSequencePrivilege(ctx, schema, relation, auth.Privilege_UPDATE); err != nil {
+			return nil, err
+		}
 		return val2.(int64), collection.SetVal(schema, relation, val2.(int64), val3.(bool))
 	},
 }
@@ -92,3 +99,34 @@ func parseRelationName(ctx *sql.Context, name string) (schema string, relation s
 
 	return schema, relation, nil
 }
+
+// checkSequencePrivilege returns an error if the current user does not have at least one of the given privileges on
+// the sequence.
+func checkSequencePrivilege(ctx *sql.Context, schema string, sequence string, privileges ...auth.Privilege) error {
+	var hasPrivilege bool
+	auth.LockRead(func() {
+		role := auth.GetRole(ctx.Client().User)
+		public := auth.GetRole("public")
+		if !role.IsValid() {
+			// Internal operations do not run as a role, so there is nothing to check
+			hasPrivilege = true
+			return
+		}
+		for _, privilege := range privileges {
+			if auth.HasSequencePrivilege(auth.SequencePrivilegeKey{
+				Role:     role.ID(),
+				Sequence: doltdb.TableName{Name: sequence, Schema: schema},
+			}, privilege) || auth.HasSequencePrivilege(auth.SequencePrivilegeKey{
+				Role:     public.ID(),
+				Sequence: doltdb.TableName{Name: sequence, Schema: schema},
+			}, privilege) {
+				hasPrivilege = true
+				return
+			}
+		}
+	})
+	if !hasPrivilege {
+		return pgerror.Newf(pgcode.InsufficientPrivilege, "permission denied for sequence %s", sequence)
+	}
+	return nil
+}
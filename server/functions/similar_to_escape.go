@@ -0,0 +1,50 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initSimilarToEscape registers the functions to the catalog.
+func initSimilarToEscape() {
+	framework.RegisterFunction(similar_to_escape_text)
+	framework.RegisterFunction(similar_to_escape_text_text)
+}
+
+// similar_to_escape_text represents the PostgreSQL function of the same name, taking the same parameters.
+var similar_to_escape_text = framework.Function1{
+	Name:       "similar_to_escape",
+	Return:     pgtypes.Text,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		return SimilarToRegex(val.(string), `\`)
+	},
+}
+
+// similar_to_escape_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var similar_to_escape_text_text = framework.Function2{
+	Name:       "similar_to_escape",
+	Return:     pgtypes.Text,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return SimilarToRegex(val1.(string), val2.(string))
+	},
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initSubstring registers the functions to the catalog.
+func initSubstring() {
+	framework.RegisterFunction(substring_text_int32)
+	framework.RegisterFunction(substring_text_int32_int32)
+	framework.RegisterFunction(substring_text_text)
+	framework.RegisterFunction(substring_text_text_text)
+}
+
+// substring_text_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var substring_text_int32 = framework.Function2{
+	Name:       "substring",
+	Return:     pgtypes.Text,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Int32},
+	Strict:     true,
+	Callable:   substr_text_int32.Callable,
+}
+
+// substring_text_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var substring_text_int32_int32 = framework.Function3{
+	Name:       "substring",
+	Return:     pgtypes.Text,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Int32, pgtypes.Int32},
+	Strict:     true,
+	Callable:   substr_text_int32_int32.Callable,
+}
+
+// substring_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var substring_text_text = framework.Function2{
+	Name:       "substring",
+	Return:     pgtypes.Text,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return substringRegex(val1.(string), val2.(string))
+	},
+}
+
+// substring_text_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var substring_text_text_text = framework.Function3{
+	Name:       "substring",
+	Return:     pgtypes.Text,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text, pgtypes.Text},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		pattern, err := SimilarToRegex(val2.(string), val3.(string))
+		if err != nil {
+			return nil, err
+		}
+		return substringRegex(val1.(string), pattern)
+	},
+}
+
+// substringRegex returns the portion of the string that matches the first capture group of the pattern, or the entire
+// match if the pattern has no capture groups. Returns NULL if there is no match.
+func substringRegex(str string, pattern string) (any, error) {
+	re, _, err := CompileRegex(pattern, "")
+	if err != nil {
+		return nil, err
+	}
+	match := re.FindStringSubmatchIndex(str)
+	if match == nil {
+		return nil, nil
+	}
+	if len(match) > 2 {
+		match = match[2:4]
+	}
+	if match[0] < 0 {
+		return nil, nil
+	}
+	return str[match[0]:match[1]], nil
+}
@@ -0,0 +1,392 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgdate"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initToTimestamp registers the functions to the catalog.
+func initToTimestamp() {
+	framework.RegisterFunction(to_timestamp_float64)
+	framework.RegisterFunction(to_timestamp_text_text)
+}
+
+// to_timestamp_float64 represents the PostgreSQL function of the same name, taking the same parameters.
+var to_timestamp_float64 = framework.Function1{
+	Name:       "to_timestamp",
+	Return:     pgtypes.TimestampTZ,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.Float64},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		epoch := val1.(float64)
+		switch {
+		case math.IsNaN(epoch):
+			return nil, fmt.Errorf("timestamp cannot be NaN")
+		case math.IsInf(epoch, 1):
+			return pgdate.TimeInfinity, nil
+		case math.IsInf(epoch, -1):
+			return pgdate.TimeNegativeInfinity, nil
+		}
+		if epoch < float64(pgdate.TimeNegativeInfinity.Unix()) || epoch >= float64(pgdate.TimeInfinity.Unix()) {
+			return nil, fmt.Errorf(`timestamp out of range: "%g"`, epoch)
+		}
+		return time.UnixMicro(int64(math.Round(epoch * 1000000))).UTC(), nil
+	},
+}
+
+// to_timestamp_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var to_timestamp_text_text = framework.Function2{
+	Name:               "to_timestamp",
+	Return:             pgtypes.TimestampTZ,
+	Parameters:         [2]pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	IsNonDeterministic: true,
+	Strict:             true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1, val2 any) (any, error) {
+		fields, err := parseDateTimeFormat(val1.(string), val2.(string))
+		if err != nil {
+			return nil, err
+		}
+		loc, err := pgtypes.GetServerLocation(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return fields.toTime(val1.(string), loc)
+	},
+}
+
+// dateTimeFormatFields contains the fields that were parsed from an input string using a date/time format pattern.
+type dateTimeFormatFields struct {
+	year        int
+	month       int
+	day         int
+	dayOfYear   int
+	hour        int
+	minute      int
+	second      int
+	nanos       int
+	hour12      bool
+	pm          bool
+	bc          bool
+	hasTimeZone bool
+	tzOffset    int
+}
+
+// toTime converts the parsed fields into a time. The given location is used when the input did not specify a time
+// zone offset.
+func (f *dateTimeFormatFields) toTime(input string, loc *time.Location) (time.Time, error) {
+	hour := f.hour
+	if f.hour12 {
+		if hour < 1 || hour > 12 {
+			return time.Time{}, fmt.Errorf(`hour "%d" is invalid for the 12-hour clock`, hour)
+		}
+		if f.pm && hour < 12 {
+			hour += 12
+		} else if !f.pm && hour == 12 {
+			hour = 0
+		}
+	}
+	if f.hasTimeZone {
+		loc = time.FixedZone("", f.tzOffset)
+	}
+	year := f.year
+	if f.bc {
+		year = 1 - year
+	}
+	month, day := f.month, f.day
+	if f.dayOfYear > 0 && month == 0 {
+		month, day = 1, f.dayOfYear
+	}
+	if month == 0 {
+		month = 1
+	}
+	if day == 0 {
+		day = 1
+	}
+	t := time.Date(year, time.Month(month), day, hour, f.minute, f.second, f.nanos, loc)
+	if month > 12 || (f.dayOfYear == 0 && t.Day() != day) || hour > 24 || f.minute > 59 || f.second > 60 {
+		return time.Time{}, fmt.Errorf(`date/time field value out of range: "%s"`, input)
+	}
+	return t, nil
+}
+
+// parseDateTimeFormat parses the input string using the template patterns of the given format, as used by
+// to_timestamp and to_date. Separators in the format match any single separator within the input, and whitespace is
+// skipped before numeric fields, unless the FX modifier has been given.
+func parseDateTimeFormat(input string, format string) (*dateTimeFormatFields, error) {
+	fields := &dateTimeFormatFields{}
+	fixedFormat := false
+	for len(format) > 0 {
+		if len(input) == 0 {
+			// Any remaining fields are left at their defaults
+			break
+		}
+		upper := strings.ToUpper(format)
+		switch {
+		case strings.HasPrefix(upper, "FX"):
+			fixedFormat = true
+			format = format[2:]
+			continue
+		case strings.HasPrefix(upper, "FM"):
+			format = format[2:]
+			continue
+		case format[0] == '"':
+			// Quoted text skips the same number of characters within the input
+			end := strings.IndexByte(format[1:], '"')
+			if end < 0 {
+				end = len(format) - 1
+			}
+			literal := []rune(format[1 : end+1])
+			inputRunes := []rune(input)
+			skip := len(literal)
+			if skip > len(inputRunes) {
+				skip = len(inputRunes)
+			}
+			input = string(inputRunes[skip:])
+			format = format[min(end+2, len(format)):]
+			continue
+		}
+
+		pattern := matchFormatPattern(upper)
+		if pattern == "" {
+			r := []rune(format)[0]
+			format = format[len(string(r)):]
+			if unicode.IsSpace(r) {
+				if !fixedFormat {
+					input = strings.TrimLeftFunc(input, unicode.IsSpace)
+				} else if ir := []rune(input)[0]; unicode.IsSpace(ir) {
+					input = input[len(string(ir)):]
+				}
+				continue
+			}
+			// Non-pattern characters match any single separator, or are skipped
+			if ir := []rune(input)[0]; fixedFormat || !(unicode.IsLetter(ir) || unicode.IsDigit(ir)) {
+				input = input[len(string(ir)):]
+			}
+			continue
+		}
+		formatPattern := format[:len(pattern)]
+		format = format[len(pattern):]
+		if !fixedFormat {
+			input = strings.TrimLeftFunc(input, unicode.IsSpace)
+			if len(input) == 0 {
+				break
+			}
+		}
+		// Numeric fields have a fixed width when they are immediately followed by another pattern
+		adjacent := len(format) > 0 && (unicode.IsLetter(rune(format[0])) || unicode.IsDigit(rune(format[0])))
+
+		var err error
+		switch pattern {
+		case "Y,YYY":
+			var thousands, rest int
+			if thousands, input, err = readFormatNumber(input, formatPattern, 0, false); err != nil {
+				return nil, err
+			}
+			if len(input) > 0 && input[0] == ',' {
+				input = input[1:]
+			}
+			if rest, input, err = readFormatNumber(input, formatPattern, 3, false); err != nil {
+				return nil, err
+			}
+			fields.year = thousands*1000 + rest
+		case "YYYY":
+			fields.year, input, err = readFormatNumber(input, formatPattern, 4, adjacent)
+		case "YYY":
+			fields.year, input, err = readFormatNumber(input, formatPattern, 3, adjacent)
+			if err == nil && fields.year < 100 {
+				fields.year += 2000
+			} else if err == nil && fields.year < 1000 {
+				if fields.year < 520 {
+					fields.year += 2000
+				} else {
+					fields.year += 1000
+				}
+			}
+		case "YY":
+			fields.year, input, err = readFormatNumber(input, formatPattern, 2, adjacent)
+			if err == nil && fields.year < 100 {
+				if fields.year < 70 {
+					fields.year += 2000
+				} else {
+					fields.year += 1900
+				}
+			}
+		case "Y":
+			fields.year, input, err = readFormatNumber(input, formatPattern, 1, adjacent)
+			if err == nil && fields.year < 10 {
+				fields.year += 2000
+			}
+		case "MM":
+			fields.month, input, err = readFormatNumber(input, formatPattern, 2, adjacent)
+		case "DDD":
+			fields.dayOfYear, input, err = readFormatNumber(input, formatPattern, 3, adjacent)
+		case "DD":
+			fields.day, input, err = readFormatNumber(input, formatPattern, 2, adjacent)
+		case "D", "ID":
+			// The day of the week is validated as a number but does not affect the result
+			_, input, err = readFormatNumber(input, formatPattern, 1, adjacent)
+		case "HH24":
+			fields.hour, input, err = readFormatNumber(input, formatPattern, 2, adjacent)
+		case "HH12", "HH":
+			fields.hour, input, err = readFormatNumber(input, formatPattern, 2, adjacent)
+			fields.hour12 = true
+		case "MI":
+			fields.minute, input, err = readFormatNumber(input, formatPattern, 2, adjacent)
+		case "SSSSS", "SSSS":
+			var secs int
+			secs, input, err = readFormatNumber(input, formatPattern, 5, adjacent)
+			fields.hour, fields.minute, fields.second = secs/3600, (secs%3600)/60, secs%60
+		case "SS":
+			fields.second, input, err = readFormatNumber(input, formatPattern, 2, adjacent)
+		case "MS", "US", "FF1", "FF2", "FF3", "FF4", "FF5", "FF6":
+			digits := 3
+			if pattern == "US" {
+				digits = 6
+			} else if strings.HasPrefix(pattern, "FF") {
+				digits = int(pattern[2] - '0')
+			}
+			start := len(input)
+			var fraction int
+			fraction, input, err = readFormatNumber(input, formatPattern, digits, true)
+			// The fraction is scaled by the number of digits that were actually read, so ".5" is half of a second
+			for read := start - len(input); read < 9; read++ {
+				fraction *= 10
+			}
+			fields.nanos = fraction
+		case "AM", "PM", "A.M.", "P.M.":
+			var value string
+			value, input, err = readFormatWord(input, formatPattern, []string{"a.m.", "p.m.", "am", "pm"})
+			fields.pm = value[0] == 'p'
+			fields.hour12 = true
+		case "BC", "AD", "B.C.", "A.D.":
+			var value string
+			value, input, err = readFormatWord(input, formatPattern, []string{"b.c.", "a.d.", "bc", "ad"})
+			fields.bc = value[0] == 'b'
+		case "MONTH", "MON":
+			var value string
+			value, input, err = readFormatWord(input, formatPattern, monthNamesForFormat)
+			for i, name := range monthNamesForFormat {
+				if name == value {
+					fields.month = i%12 + 1
+				}
+			}
+		case "DAY", "DY":
+			_, input, err = readFormatWord(input, formatPattern, dayNamesForFormat)
+		case "TZH":
+			negative := false
+			if len(input) > 0 && (input[0] == '-' || input[0] == '+') {
+				negative = input[0] == '-'
+				input = input[1:]
+			}
+			var hours int
+			hours, input, err = readFormatNumber(input, formatPattern, 2, adjacent)
+			fields.tzOffset = hours * 3600
+			if negative {
+				fields.tzOffset = -fields.tzOffset
+			}
+			fields.hasTimeZone = true
+		case "TZM":
+			var minutes int
+			minutes, input, err = readFormatNumber(input, formatPattern, 2, adjacent)
+			if fields.tzOffset < 0 {
+				fields.tzOffset -= minutes * 60
+			} else {
+				fields.tzOffset += minutes * 60
+			}
+			fields.hasTimeZone = true
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return fields, nil
+}
+
+// formatPatterns are the template patterns that may be parsed by parseDateTimeFormat, ordered so that longer patterns
+// are matched before their prefixes.
+var formatPatterns = []string{"Y,YYY", "YYYY", "YYY", "YY", "Y", "MONTH", "MON", "MM", "MS", "MI", "DDD", "DD", "DAY",
+	"DY", "D", "ID", "HH24", "HH12", "HH", "SSSSS", "SSSS", "SS", "US", "FF1", "FF2", "FF3", "FF4", "FF5", "FF6",
+	"A.M.", "P.M.", "AM", "PM", "B.C.", "A.D.", "BC", "AD", "TZH", "TZM"}
+
+// monthNamesForFormat contains the full month names followed by their abbreviations. The full names are first so that
+// they are matched before their abbreviations.
+var monthNamesForFormat = []string{"january", "february", "march", "april", "may", "june", "july", "august",
+	"september", "october", "november", "december", "jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep",
+	"oct", "nov", "dec"}
+
+// dayNamesForFormat contains the full day names followed by their abbreviations.
+var dayNamesForFormat = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sun",
+	"mon", "tue", "wed", "thu", "fri", "sat"}
+
+// matchFormatPattern returns the template pattern at the beginning of the uppercased format, or an empty string if the
+// format does not begin with a pattern.
+func matchFormatPattern(upperFormat string) string {
+	for _, pattern := range formatPatterns {
+		if strings.HasPrefix(upperFormat, pattern) {
+			return pattern
+		}
+	}
+	return ""
+}
+
+// readFormatNumber reads an unsigned integer from the beginning of the input. When fixedWidth is true, at most width
+// digits are read, otherwise all consecutive digits are read.
+func readFormatNumber(input string, pattern string, width int, fixedWidth bool) (int, string, error) {
+	n := 0
+	for n < len(input) && input[n] >= '0' && input[n] <= '9' && (!fixedWidth || n < width) {
+		n++
+	}
+	if n == 0 {
+		value := input
+		if len(value) > width && width > 0 {
+			value = value[:width]
+		}
+		return 0, input, fmt.Errorf(`invalid value "%s" for "%s"`, value, pattern)
+	}
+	result := 0
+	for _, c := range input[:n] {
+		result = result*10 + int(c-'0')
+		if result > math.MaxInt32 {
+			return 0, input, fmt.Errorf(`value for "%s" in source string is out of range`, pattern)
+		}
+	}
+	return result, input[n:], nil
+}
+
+// readFormatWord reads the first of the given lowercase words that matches the beginning of the input, ignoring case.
+func readFormatWord(input string, pattern string, words []string) (string, string, error) {
+	lowerInput := strings.ToLower(input)
+	for _, word := range words {
+		if strings.HasPrefix(lowerInput, word) {
+			return word, input[len(word):], nil
+		}
+	}
+	value := input
+	if len(value) > 10 {
+		value = value[:10]
+	}
+	return "", input, fmt.Errorf(`invalid value "%s" for "%s"`, value, pattern)
+}
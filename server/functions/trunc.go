@@ -16,6 +16,7 @@ package functions
 
 import (
 	"math"
+	"net"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/shopspring/decimal"
@@ -27,6 +28,8 @@ import (
 // initTrunc registers the functions to the catalog.
 func initTrunc() {
 	framework.RegisterFunction(trunc_float64)
+	framework.RegisterFunction(trunc_macaddr)
+	framework.RegisterFunction(trunc_macaddr8)
 	framework.RegisterFunction(trunc_numeric)
 	framework.RegisterFunction(trunc_numeric_int64)
 }
@@ -45,6 +48,31 @@ var trunc_float64 = framework.Function1{
 	},
 }
 
+// trunc_macaddr represents the PostgreSQL function of the same name, taking the same parameters.
+var trunc_macaddr = framework.Function1{
+	Name:       "trunc",
+	Return:     pgtypes.MacAddr,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.MacAddr},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		// Only the manufacturer's portion of the address is kept
+		addr := val1.(net.HardwareAddr)
+		return net.HardwareAddr{addr[0], addr[1], addr[2], 0, 0, 0}, nil
+	},
+}
+
+// trunc_macaddr8 represents the PostgreSQL function of the same name, taking the same parameters.
+var trunc_macaddr8 = framework.Function1{
+	Name:       "trunc",
+	Return:     pgtypes.MacAddr8,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.MacAddr8},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		addr := val1.(net.HardwareAddr)
+		return net.HardwareAddr{addr[0], addr[1], addr[2], 0, 0, 0, 0, 0}, nil
+	},
+}
+
 // trunc_numeric represents the PostgreSQL function of the same name, taking the same parameters.
 var trunc_numeric = framework.Function1{
 	Name:       "trunc",
@@ -0,0 +1,53 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initTsRange registers the functions to the catalog.
+func initTsRange() {
+	framework.RegisterFunction(tsrange_timestamp_timestamp)
+	framework.RegisterFunction(tsrange_timestamp_timestamp_text)
+}
+
+// tsrange_timestamp_timestamp represents the PostgreSQL function of the same name, taking the same parameters.
+var tsrange_timestamp_timestamp = framework.Function2{
+	Name:       "tsrange",
+	Return:     pgtypes.TsRange,
+	Parameters: [2]pgtypes.DoltgresType{pgtypes.Timestamp, pgtypes.Timestamp},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return constructRange(pgtypes.TsRange, val1, val2, "[)")
+	},
+}
+
+// tsrange_timestamp_timestamp_text represents the PostgreSQL function of the same name, taking the same parameters.
+var tsrange_timestamp_timestamp_text = framework.Function3{
+	Name:       "tsrange",
+	Return:     pgtypes.TsRange,
+	Parameters: [3]pgtypes.DoltgresType{pgtypes.Timestamp, pgtypes.Timestamp, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		if val3 == nil {
+			return nil, fmt.Errorf("range constructor flags argument must not be null")
+		}
+		return constructRange(pgtypes.TsRange, val1, val2, val3.(string))
+	},
+}
@@ -15,8 +15,6 @@
 package functions
 
 import (
-	"fmt"
-
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/server/functions/framework"
@@ -31,17 +29,11 @@ func initUnnest() {
 // unnest represents the PostgreSQL function of the same name, taking the same parameters.
 var unnest = framework.Function1{
 	Name:       "unnest",
-	Return:     pgtypes.AnyElement, // TODO: Should return setof AnyElement
+	Return:     pgtypes.AnyElement,
 	Parameters: [1]pgtypes.DoltgresType{pgtypes.AnyArray},
 	Strict:     true,
+	SRF:        true,
 	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
-		valArr := val1.([]interface{})
-		if len(valArr) == 0 {
-			return nil, nil
-		}
-		if len(valArr) == 1 {
-			return valArr[0], nil
-		}
-		return nil, fmt.Errorf("unnest() only supports single-element arrays")
+		return framework.NewSetIterFromValues(pgtypes.ArrayFlatten(val1.([]any))), nil
 	},
 }
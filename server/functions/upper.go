@@ -25,6 +25,7 @@ import (
 
 // initUpper registers the functions to the catalog.
 func initUpper() {
+	framework.RegisterFunction(upper_anyrange)
 	framework.RegisterFunction(upper_text)
 }
 
@@ -39,3 +40,15 @@ var upper_text = framework.Function1{
 		return strings.ToUpper(val1.(string)), nil
 	},
 }
+
+// upper_anyrange represents the PostgreSQL function of the same name, taking the same parameters.
+var upper_anyrange = framework.Function1{
+	Name:       "upper",
+	Return:     pgtypes.AnyElement,
+	Parameters: [1]pgtypes.DoltgresType{pgtypes.AnyRange},
+	Strict:     true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		// Empty ranges and infinite bounds both return NULL
+		return val.(pgtypes.RangeValue).Upper, nil
+	},
+}
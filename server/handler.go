@@ -24,10 +24,15 @@ import (
 )
 
 type Handler interface {
-	// ComBind is called when a connection receives a request to bind a prepared statement to a set of values.
-	ComBind(ctx context.Context, c *mysql.Conn, query string, parsedQuery mysql.ParsedQuery, bindVars map[string]sqlparser.Expr) (mysql.BoundQuery, []pgproto3.FieldDescription, error)
+	// ComBind is called when a connection receives a request to bind a prepared statement to a set of values. The
+	// |formatCodes| are the result format codes of the Bind message, which are reflected in the returned fields.
+	ComBind(ctx context.Context, c *mysql.Conn, query string, parsedQuery mysql.ParsedQuery, bindVars map[string]sqlparser.Expr, formatCodes []int16) (mysql.BoundQuery, []pgproto3.FieldDescription, error)
 	// ComExecuteBound is called when a connection receives a request to execute a prepared statement that has already bound to a set of values.
-	ComExecuteBound(ctx context.Context, conn *mysql.Conn, query string, boundQuery mysql.BoundQuery, callback func(*Result) error) error
+	// The |formatCodes| are the result format codes that the rows are returned in.
+	ComExecuteBound(ctx context.Context, conn *mysql.Conn, query string, boundQuery mysql.BoundQuery, formatCodes []int16, callback func(*Result) error) error
+	// ComOpenCursor is called when a connection receives a request to execute a bound statement whose rows may be
+	// returned over multiple Execute messages. The returned cursor must be closed once it's no longer needed.
+	ComOpenCursor(ctx context.Context, conn *mysql.Conn, query string, boundQuery mysql.BoundQuery, formatCodes []int16) (*Cursor, error)
 	// ComPrepareParsed is called when a connection receives a prepared statement query that has already been parsed.
 	ComPrepareParsed(ctx context.Context, c *mysql.Conn, query string, parsed sqlparser.Statement) (mysql.ParsedQuery, []pgproto3.FieldDescription, error)
 	// ComQuery is called when a connection receives a query. Note the contents of the query slice may change
@@ -16,11 +16,14 @@ package server
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"os"
+	"sync/atomic"
 
 	"github.com/dolthub/go-mysql-server/server"
+	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/netutil"
 )
@@ -29,12 +32,33 @@ var (
 	connectionIDCounter uint32
 	processID           = uint32(os.Getpid())
 	certificate         tls.Certificate //TODO: move this into the mysql.ListenerConfig
+	// configClientCAs are the certificate authorities from the server's config, which listeners use unless they are
+	// given their own.
+	configClientCAs *x509.CertPool
 )
 
+// NewInternalConnection returns a connection for a session that the server runs on its own behalf, such as the session
+// used by replication, as the given user. The connection is not associated with a client.
+func NewInternalConnection(user string) *mysql.Conn {
+	conn := &mysql.Conn{
+		PrepareData: make(map[uint32]*mysql.PrepareData),
+		User:        user,
+		UserData: sql.MysqlConnectionUser{
+			User: user,
+			Host: "localhost",
+		},
+	}
+	conn.ConnectionID = atomic.AddUint32(&connectionIDCounter, 1)
+	return conn
+}
+
 // Listener listens for connections to process PostgreSQL requests into Dolt requests.
 type Listener struct {
 	listener net.Listener
 	cfg      mysql.ListenerConfig
+	// clientCAs are the certificate authorities that are used to verify client certificates. Client certificates are
+	// not requested when this is nil.
+	clientCAs *x509.CertPool
 }
 
 var _ server.ProtocolListener = (*Listener)(nil)
@@ -47,6 +71,13 @@ func WithCertificate(cert tls.Certificate) ListenerOpt {
 	}
 }
 
+// WithClientCAs sets the certificate authorities that are used to verify client certificates.
+func WithClientCAs(pool *x509.CertPool) ListenerOpt {
+	return func(l *Listener) {
+		l.clientCAs = pool
+	}
+}
+
 // NewListener creates a new Listener.
 func NewListener(listenerCfg mysql.ListenerConfig) (server.ProtocolListener, error) {
 	return NewListenerWithOpts(listenerCfg)
@@ -54,8 +85,9 @@ func NewListener(listenerCfg mysql.ListenerConfig) (server.ProtocolListener, err
 
 func NewListenerWithOpts(listenerCfg mysql.ListenerConfig, opts ...ListenerOpt) (server.ProtocolListener, error) {
 	l := &Listener{
-		listener: listenerCfg.Listener,
-		cfg:      listenerCfg,
+		listener:  listenerCfg.Listener,
+		cfg:       listenerCfg,
+		clientCAs: configClientCAs,
 	}
 
 	for _, opt := range opts {
@@ -83,7 +115,7 @@ func (l *Listener) Accept() {
 			conn = netutil.NewConnWithTimeouts(conn, l.cfg.ConnReadTimeout, l.cfg.ConnWriteTimeout)
 		}
 
-		connectionHandler := NewConnectionHandler(conn, l.cfg.Handler)
+		connectionHandler := NewConnectionHandler(conn, l.cfg.Handler, l.clientCAs)
 		go connectionHandler.HandleConnection()
 	}
 }
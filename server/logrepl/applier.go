@@ -0,0 +1,481 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logrepl
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/jackc/pglogrepl"
+
+	"github.com/dolthub/doltgresql/core"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/index"
+	"github.com/dolthub/doltgresql/server/node"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+const (
+	// tupleDataNull identifies a tuple value as NULL.
+	tupleDataNull = 'n'
+	// tupleDataUnchangedToast identifies a tuple value as an unchanged TOASTed value, whose actual value is not sent.
+	tupleDataUnchangedToast = 'u'
+	// tupleDataText identifies a tuple value as being in the text format.
+	tupleDataText = 't'
+	// tupleDataBinary identifies a tuple value as being in the binary format.
+	tupleDataBinary = 'b'
+)
+
+const (
+	// replicaIdentityNothing is the replica identity of tables that do not record old rows, so they cannot have their
+	// updates and deletes replicated.
+	replicaIdentityNothing = 'n'
+	// replicaIdentityFull is the replica identity of tables that record the entire old row.
+	replicaIdentityFull = 'f'
+)
+
+// relationKeyFlag is set on the columns of a relation that are part of its replica identity.
+const relationKeyFlag = 1
+
+const (
+	// truncateOptionCascade is set on truncates that used CASCADE. The relations that were truncated due to the cascade
+	// are already included in the message.
+	truncateOptionCascade = 1
+	// truncateOptionRestartIdentity is set on truncates that used RESTART IDENTITY.
+	truncateOptionRestartIdentity = 2
+)
+
+// tupleValue is a decoded value of a tuple sent by the primary.
+type tupleValue struct {
+	// value is the decoded value, which is nil for NULL values.
+	value any
+	// unchanged is set when the value is a TOASTed value that was not changed, so the actual value was not sent.
+	unchanged bool
+}
+
+// replicaTable is a table on the replica that receives the changes made to a relation on the primary.
+type replicaTable struct {
+	rel   *pglogrepl.RelationMessageV2
+	table sql.Table
+	sch   sql.Schema
+	// types contains the type of each column in the schema.
+	types []pgtypes.DoltgresType
+	// columns contains the index within the schema of each column of the relation.
+	columns []int
+}
+
+// rowEditor is the portion of the row inserters, updaters, and deleters that manages their statements.
+type rowEditor interface {
+	sql.EditOpenerCloser
+	sql.Closer
+}
+
+// rowApplier applies the row changes sent by the primary to the replica. Values are decoded from their text or binary
+// format using the types of the replica's columns, and the resulting rows are written directly to the replica's tables.
+type rowApplier struct {
+	// types contains the custom types described by the primary, keyed by their OID.
+	types map[uint32]*pglogrepl.TypeMessageV2
+	// catalog is used to resolve the defaults of the replica's columns.
+	catalog sql.Catalog
+}
+
+// insert applies an INSERT of the given tuple.
+func (ra rowApplier) insert(ctx *sql.Context, rel *pglogrepl.RelationMessageV2, tuple *pglogrepl.TupleData) error {
+	rt, err := ra.resolveTable(ctx, rel)
+	if err != nil {
+		return err
+	}
+	values, err := ra.decodeTuple(ctx, rt, tuple)
+	if err != nil {
+		return err
+	}
+	row, err := rt.newRow(ctx, values)
+	if err != nil {
+		return err
+	}
+	insertable, ok := rt.table.(sql.InsertableTable)
+	if !ok {
+		return fmt.Errorf(`cannot replicate INSERT on relation %s, as the table on the replica is read-only`, relationName(rel))
+	}
+	inserter := insertable.Inserter(ctx)
+	inserter.StatementBegin(ctx)
+	return finishStatement(ctx, inserter, inserter.Insert(ctx, row))
+}
+
+// update applies an UPDATE to the row identified by the old tuple, or by the new tuple's key when the key did not change.
+func (ra rowApplier) update(ctx *sql.Context, rel *pglogrepl.RelationMessageV2, oldTupleType uint8, oldTuple *pglogrepl.TupleData, newTuple *pglogrepl.TupleData) error {
+	if rel.ReplicaIdentity == replicaIdentityNothing {
+		return fmt.Errorf(`cannot replicate UPDATE on relation "%s.%s", as it does not have a replica identity`, rel.Namespace, rel.RelationName)
+	}
+	rt, err := ra.resolveTable(ctx, rel)
+	if err != nil {
+		return err
+	}
+	newValues, err := ra.decodeTuple(ctx, rt, newTuple)
+	if err != nil {
+		return err
+	}
+	// The old tuple is only sent when the key changed, or when the relation's replica identity is the full row
+	oldValues := newValues
+	if oldTupleType == pglogrepl.UpdateMessageTupleTypeKey || oldTupleType == pglogrepl.UpdateMessageTupleTypeOld {
+		oldValues, err = ra.decodeTuple(ctx, rt, oldTuple)
+		if err != nil {
+			return err
+		}
+	}
+
+	// A row that is identified by its full contents may have identical duplicates on a table without a key, but only a
+	// single row was changed on the primary, so only the first matching row is updated.
+	oldRow, err := ra.findRow(ctx, rt, oldValues)
+	if err != nil {
+		return err
+	}
+	// Unchanged TOASTed values keep the value that the row already has
+	newRow := oldRow.Copy()
+	for i, value := range newValues {
+		if !value.unchanged {
+			newRow[rt.columns[i]] = value.value
+		}
+	}
+	updatable, ok := rt.table.(sql.UpdatableTable)
+	if !ok {
+		return fmt.Errorf(`cannot replicate UPDATE on relation %s, as the table on the replica is read-only`, relationName(rel))
+	}
+	updater := updatable.Updater(ctx)
+	updater.StatementBegin(ctx)
+	return finishStatement(ctx, updater, updater.Update(ctx, oldRow, newRow))
+}
+
+// delete applies a DELETE of the row identified by the old tuple.
+func (ra rowApplier) delete(ctx *sql.Context, rel *pglogrepl.RelationMessageV2, oldTuple *pglogrepl.TupleData) error {
+	if rel.ReplicaIdentity == replicaIdentityNothing || oldTuple == nil {
+		return fmt.Errorf(`cannot replicate DELETE on relation "%s.%s", as it does not have a replica identity`, rel.Namespace, rel.RelationName)
+	}
+	rt, err := ra.resolveTable(ctx, rel)
+	if err != nil {
+		return err
+	}
+	oldValues, err := ra.decodeTuple(ctx, rt, oldTuple)
+	if err != nil {
+		return err
+	}
+	// As with updates, only the first of any identical rows is deleted
+	row, err := ra.findRow(ctx, rt, oldValues)
+	if err != nil {
+		return err
+	}
+	deletable, ok := rt.table.(sql.DeletableTable)
+	if !ok {
+		return fmt.Errorf(`cannot replicate DELETE on relation %s, as the table on the replica is read-only`, relationName(rel))
+	}
+	deleter := deletable.Deleter(ctx)
+	deleter.StatementBegin(ctx)
+	return finishStatement(ctx, deleter, deleter.Delete(ctx, row))
+}
+
+// truncate applies a TRUNCATE of the given relations. When |restartIdentity| is set, the sequences owned by the
+// relations' columns are restarted.
+func (ra rowApplier) truncate(ctx *sql.Context, rels []*pglogrepl.RelationMessageV2, restartIdentity bool) error {
+	for _, rel := range rels {
+		rt, err := ra.resolveTable(ctx, rel)
+		if err != nil {
+			return err
+		}
+		truncateable, ok := rt.table.(sql.TruncateableTable)
+		if !ok {
+			return fmt.Errorf(`cannot replicate TRUNCATE on relation %s, as the table on the replica cannot be truncated`, relationName(rel))
+		}
+		if _, err = truncateable.Truncate(ctx); err != nil {
+			return err
+		}
+		if restartIdentity {
+			if err = ra.restartSequences(ctx, rel); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// restartSequences resets every sequence owned by the relation to its start value, so that the next value returned is
+// the start value.
+func (ra rowApplier) restartSequences(ctx *sql.Context, rel *pglogrepl.RelationMessageV2) error {
+	collection, err := core.GetSequencesCollectionFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	for _, seq := range collection.GetSequencesWithTable(doltdb.TableName{Name: rel.RelationName, Schema: rel.Namespace}) {
+		if err = collection.SetVal(rel.Namespace, seq.Name, seq.Start, false); err != nil {
+			return err
+		}
+	}
+	// The sequences are only written to the root once the statement is finalized
+	return core.CloseContextRootFinalizer(ctx)
+}
+
+// resolveTable returns the table on the replica that receives the changes made to the given relation.
+func (ra rowApplier) resolveTable(ctx *sql.Context, rel *pglogrepl.RelationMessageV2) (*replicaTable, error) {
+	tableName := doltdb.TableName{Name: rel.RelationName, Schema: rel.Namespace}
+	table, err := core.GetSqlTableFromContext(ctx, "", tableName)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, fmt.Errorf(`relation %s does not exist on the replica`, relationName(rel))
+	}
+	// The defaults of tables read from storage can't be evaluated until they're resolved
+	sch, err := node.ResolveColumnDefaults(ctx, ra.catalog, ctx.GetCurrentDatabase(), tableName, table.Schema(), func(int) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+	types := make([]pgtypes.DoltgresType, len(sch))
+	for i, col := range sch {
+		var ok bool
+		types[i], ok = col.Type.(pgtypes.DoltgresType)
+		if !ok {
+			return nil, fmt.Errorf(`column "%s" of relation %s on the replica has the unsupported type %s`, col.Name, relationName(rel), col.Type.String())
+		}
+	}
+	columns := make([]int, len(rel.Columns))
+	for i, relCol := range rel.Columns {
+		columns[i] = sch.IndexOfColName(relCol.Name)
+		if columns[i] < 0 {
+			return nil, fmt.Errorf(`column "%s" of relation %s does not exist on the replica`, relCol.Name, relationName(rel))
+		}
+		if err = ra.checkCustomType(rel, relCol, types[columns[i]]); err != nil {
+			return nil, err
+		}
+	}
+	return &replicaTable{
+		rel:     rel,
+		table:   table,
+		sch:     sch,
+		types:   types,
+		columns: columns,
+	}, nil
+}
+
+// checkCustomType verifies that a column using a custom type on the primary uses the same type on the replica, when
+// the replica's column also has a custom type. Columns with a custom type on the primary may still use a built-in type
+// on the replica, such as text for an enum, as the values are decoded from their text format.
+func (ra rowApplier) checkCustomType(rel *pglogrepl.RelationMessageV2, relCol *pglogrepl.RelationMessageColumn, typ pgtypes.DoltgresType) error {
+	primaryType, ok := ra.types[relCol.DataType]
+	if !ok {
+		return nil
+	}
+	var schema, name string
+	switch typ := typ.(type) {
+	case pgtypes.CompositeType:
+		schema, name = typ.Schema, typ.Name
+	case pgtypes.DomainType:
+		schema, name = typ.Schema, typ.Name
+	case pgtypes.EnumType:
+		schema, name = typ.Schema, typ.Name
+	case pgtypes.RangeType:
+		schema, name = typ.Schema, typ.Name
+	default:
+		return nil
+	}
+	if schema != primaryType.Namespace || name != primaryType.Name {
+		return fmt.Errorf(`column "%s" of relation %s has type %s.%s on the primary, but type %s.%s on the replica`,
+			relCol.Name, relationName(rel), primaryType.Namespace, primaryType.Name, schema, name)
+	}
+	return nil
+}
+
+// decodeTuple decodes each value in the tuple using the type of its column on the replica.
+func (ra rowApplier) decodeTuple(ctx *sql.Context, rt *replicaTable, tuple *pglogrepl.TupleData) ([]tupleValue, error) {
+	if tuple == nil {
+		return nil, fmt.Errorf("missing tuple data for relation %s", relationName(rt.rel))
+	}
+	if len(tuple.Columns) != len(rt.columns) {
+		return nil, fmt.Errorf("tuple for relation %s has %d columns, expected %d", relationName(rt.rel), len(tuple.Columns), len(rt.columns))
+	}
+	values := make([]tupleValue, len(tuple.Columns))
+	for i, col := range tuple.Columns {
+		typ := rt.types[rt.columns[i]]
+		var err error
+		switch col.DataType {
+		case tupleDataNull:
+			// The value is already nil
+		case tupleDataUnchangedToast:
+			values[i].unchanged = true
+		case tupleDataText:
+			values[i].value, err = typ.IoInput(ctx, string(col.Data))
+		case tupleDataBinary:
+			values[i].value, err = typ.IoReceive(ctx, col.Data)
+		default:
+			err = fmt.Errorf("unknown column data type: %c", col.DataType)
+		}
+		if err != nil {
+			return nil, fmt.Errorf(`error decoding column "%s" of relation %s: %w`, rt.rel.Columns[i].Name, relationName(rt.rel), err)
+		}
+	}
+	return values, nil
+}
+
+// newRow returns a row containing the given values. Columns that only exist on the replica, as well as unchanged
+// TOASTed values, take the column's default.
+func (rt *replicaTable) newRow(ctx *sql.Context, values []tupleValue) (sql.Row, error) {
+	row := make(sql.Row, len(rt.sch))
+	loaded := make([]bool, len(rt.sch))
+	for i, value := range values {
+		if !value.unchanged {
+			row[rt.columns[i]] = value.value
+			loaded[rt.columns[i]] = true
+		}
+	}
+	for i, col := range rt.sch {
+		if loaded[i] || col.Default == nil {
+			continue
+		}
+		var err error
+		row[i], err = col.Default.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+// findRow returns the first row of the table that matches the given values of the relation's replica identity. Returns
+// an error if no rows match, as the replica would otherwise silently diverge from the primary.
+func (ra rowApplier) findRow(ctx *sql.Context, rt *replicaTable, values []tupleValue) (sql.Row, error) {
+	var filters []sql.Expression
+	var nullColumns []int
+	for i, relCol := range rt.rel.Columns {
+		if relCol.Flags&relationKeyFlag == 0 && rt.rel.ReplicaIdentity != replicaIdentityFull {
+			continue
+		}
+		if values[i].unchanged {
+			// TOASTed values can't be used to find the row, but the remaining values are still enough in practice
+			continue
+		}
+		colIdx := rt.columns[i]
+		if values[i].value == nil {
+			nullColumns = append(nullColumns, colIdx)
+			continue
+		}
+		col := rt.sch[colIdx]
+		getField := expression.NewGetFieldWithTable(colIdx, 0, col.Type, col.DatabaseSource, col.Source, col.Name, col.Nullable)
+		filter, err := pgexprs.NewBinaryOperator(framework.Operator_BinaryEqual).WithResolvedChildren(
+			[]any{getField, pgexprs.NewUnsafeLiteral(values[i].value, rt.types[colIdx])})
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter.(sql.Expression))
+	}
+	if len(filters) == 0 && len(nullColumns) == 0 {
+		return nil, fmt.Errorf(`cannot find rows of relation "%s.%s", as its replica identity has no columns`, rt.rel.Namespace, rt.rel.RelationName)
+	}
+
+	iter, err := rt.rowIter(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := iter.Close(ctx); closeErr != nil {
+			log.Printf("error closing row iterator: %v", closeErr)
+		}
+	}()
+RowLoop:
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		for _, colIdx := range nullColumns {
+			if row[colIdx] != nil {
+				continue RowLoop
+			}
+		}
+		for _, filter := range filters {
+			matches, err := sql.EvaluateCondition(ctx, filter, row)
+			if err != nil {
+				return nil, err
+			}
+			if !sql.IsTrue(matches) {
+				continue RowLoop
+			}
+		}
+		return row, nil
+	}
+	return nil, fmt.Errorf(`the row to be changed in relation %s does not exist on the replica`, relationName(rt.rel))
+}
+
+// rowIter returns an iterator over the rows of the table, which uses an index to narrow down the rows when the filters
+// allow it. The returned rows must still be checked against the filters.
+func (rt *replicaTable) rowIter(ctx *sql.Context, filters []sql.Expression) (sql.RowIter, error) {
+	var searchable sql.IndexSearchableTable
+	switch table := rt.table.(type) {
+	case *sqle.AlterableDoltTable:
+		searchable = &index.WritableDoltgresTable{WritableDoltTable: &table.WritableDoltTable}
+	case *sqle.WritableDoltTable:
+		searchable = &index.WritableDoltgresTable{WritableDoltTable: table}
+	}
+	if searchable != nil && len(filters) > 0 {
+		lookup, _, _, _, err := searchable.LookupForExpressions(ctx, filters...)
+		if err != nil {
+			return nil, err
+		}
+		if lookup.Index != nil {
+			indexedTable := searchable.IndexedAccess(lookup)
+			partitions, err := indexedTable.LookupPartitions(ctx, lookup)
+			if err != nil {
+				return nil, err
+			}
+			return sql.NewTableRowIter(ctx, indexedTable, partitions), nil
+		}
+	}
+	partitions, err := rt.table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sql.NewTableRowIter(ctx, rt.table, partitions), nil
+}
+
+// finishStatement completes the statement of the given editor and closes it. If |err| is not nil, or the statement
+// could not be completed, then the statement's changes are discarded.
+func finishStatement(ctx *sql.Context, editor rowEditor, err error) error {
+	defer func() {
+		if closeErr := editor.Close(ctx); closeErr != nil {
+			log.Printf("error closing row editor: %v", closeErr)
+		}
+	}()
+
+	if err == nil {
+		err = editor.StatementComplete(ctx)
+	}
+	if err != nil {
+		if discardErr := editor.DiscardChanges(ctx, err); discardErr != nil {
+			log.Printf("error discarding changes: %v", discardErr)
+		}
+	}
+	return err
+}
+
+// relationName returns the quoted, schema-qualified name of the relation.
+func relationName(rel *pglogrepl.RelationMessageV2) string {
+	return fmt.Sprintf(`"%s"."%s"`, strings.ReplaceAll(rel.Namespace, `"`, `""`), strings.ReplaceAll(rel.RelationName, `"`, `""`))
+}
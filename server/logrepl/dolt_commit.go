@@ -0,0 +1,64 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logrepl
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pglogrepl"
+)
+
+// DefaultDoltCommitMessage is the message used for Dolt commits of replicated transactions when one is not configured.
+const DefaultDoltCommitMessage = "Replicated transaction from Postgres"
+
+// DoltCommitOptions configures the Dolt commits that are created for each transaction replicated from the primary.
+type DoltCommitOptions struct {
+	// Author is the author of each commit, in the standard "A U Thor <author@example.com>" format. When empty, the user
+	// of the replica connection is the author.
+	Author string
+	// Message is the message of each commit, which is followed by the LSN and ID of the replicated transaction. When
+	// empty, DefaultDoltCommitMessage is used.
+	Message string
+}
+
+// SetDoltCommitOptions enables the creation of a Dolt commit for every transaction that is replicated from the primary,
+// using the given options. Passing nil disables Dolt commits. This must be called before StartReplication.
+func (r *LogicalReplicator) SetDoltCommitOptions(options *DoltCommitOptions) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.doltCommitOptions = options
+}
+
+// doltCommitQuery returns the query that creates a Dolt commit for the replicated transaction with the given ID, whose
+// commit record is at |lsn|. The commit uses the time that the transaction was committed on the primary. Transactions
+// that did not change any tables on the replica do not create a commit.
+func (o *DoltCommitOptions) doltCommitQuery(xid uint32, lsn pglogrepl.LSN, commitTime time.Time) string {
+	message := o.Message
+	if len(message) == 0 {
+		message = DefaultDoltCommitMessage
+	}
+	message = fmt.Sprintf("%s\n\nLSN: %s\nXID: %d", message, lsn, xid)
+
+	args := []string{"-A", "--skip-empty", "-m", message, "--date", commitTime.UTC().Format(time.RFC3339)}
+	if len(o.Author) > 0 {
+		args = append(args, "--author", o.Author)
+	}
+	for i, arg := range args {
+		args[i] = "'" + strings.ReplaceAll(arg, "'", "''") + "'"
+	}
+	return fmt.Sprintf("SELECT DOLT_COMMIT(%s)", strings.Join(args, ", "))
+}
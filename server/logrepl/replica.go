@@ -0,0 +1,81 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logrepl
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqlserver"
+	gms "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/doltgresql/server/auth"
+)
+
+// replicaSession is the session on the running server that replicated changes are applied through.
+type replicaSession struct {
+	engine         *gms.Engine
+	sessionManager *server.SessionManager
+	conn           *mysql.Conn
+}
+
+// newReplicaSession creates a session on the running server for the given connection, using the database given.
+func newReplicaSession(conn *mysql.Conn, database string) (*replicaSession, error) {
+	runningServer := sqlserver.GetRunningServer()
+	if runningServer == nil {
+		return nil, fmt.Errorf("replication requires a running server")
+	}
+	rs := &replicaSession{
+		engine:         runningServer.Engine,
+		sessionManager: runningServer.SessionManager(),
+		conn:           conn,
+	}
+	if err := rs.sessionManager.SetDB(conn, database); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// newContext returns a new context for the session. Replication runs on the server's own behalf, so the context is
+// marked as internal.
+func (rs *replicaSession) newContext(query string) (*sql.Context, error) {
+	return rs.sessionManager.NewContext(auth.NewInternalContext(context.Background()), rs.conn, query)
+}
+
+// query executes the query provided on the session. This is only used for statements that control the session's
+// transactions, as the replicated changes themselves are written directly to the tables.
+func (rs *replicaSession) query(query string) error {
+	log.Printf("replicating query: %s", query)
+	ctx, err := rs.newContext(query)
+	if err != nil {
+		return err
+	}
+	_, iter, _, err := rs.engine.Query(ctx, query)
+	if err != nil {
+		return err
+	}
+	_, err = sql.RowIterToRows(ctx, iter)
+	return err
+}
+
+// close closes the session.
+func (rs *replicaSession) close() {
+	rs.engine.CloseSession(rs.conn.ConnectionID)
+	rs.sessionManager.RemoveConn(rs.conn)
+}
@@ -25,13 +25,12 @@ import (
 	"sync"
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/jackc/pglogrepl"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/jackc/pgx/v5/pgtype"
-
-	"github.com/dolthub/doltgresql/postgres/parser/uuid"
 )
 
 const outputPlugin = "pgoutput"
@@ -42,25 +41,35 @@ type rcvMsg struct {
 }
 
 type LogicalReplicator struct {
-	primaryDns     string
-	replicationDns string
+	primaryDns string
+
+	// replicaConn identifies the session on the running server that replicated changes are applied through, which uses
+	// the replicaDatabase.
+	replicaConn     *mysql.Conn
+	replicaDatabase string
 
 	walFilePath     string
 	running         bool
 	messageReceived bool
 	stop            chan struct{}
 	mu              *sync.Mutex
+
+	// doltCommitOptions configures the Dolt commit created for each replicated transaction, which is nil when Dolt
+	// commits are not created.
+	doltCommitOptions *DoltCommitOptions
 }
 
-// NewLogicalReplicator creates a new logical replicator instance which connects to the primary and replication
-// databases using the connection strings provided. The connection to the replica is established immediately, and the
-// connection to the primary is established when StartReplication is called.
-func NewLogicalReplicator(walFilePath string, primaryDns string, replicationDns string) (*LogicalReplicator, error) {
+// NewLogicalReplicator creates a new logical replicator instance which connects to the primary using the connection
+// string provided. Replicated changes are written directly to the given database of the running server, using a
+// session for the given connection. The session and the connection to the primary are established when
+// StartReplication is called.
+func NewLogicalReplicator(walFilePath string, primaryDns string, replicaConn *mysql.Conn, replicaDatabase string) (*LogicalReplicator, error) {
 	return &LogicalReplicator{
-		primaryDns:     primaryDns,
-		replicationDns: replicationDns,
-		walFilePath:    walFilePath,
-		mu:             &sync.Mutex{},
+		primaryDns:      primaryDns,
+		replicaConn:     replicaConn,
+		replicaDatabase: replicaDatabase,
+		walFilePath:     walFilePath,
+		mu:              &sync.Mutex{},
 	}, nil
 }
 
@@ -139,8 +148,8 @@ const maxConsecutiveFailures = 10
 var errShutdownRequested = errors.New("shutdown requested")
 
 type replicationState struct {
-	// replicaConn is the current connection to the replica database, which can be re-established if it fails
-	replicaConn *pgx.Conn
+	// replica is the session that replicated changes are applied through
+	replica *replicaSession
 
 	// lastWrittenLSN is the LSN of the commit record of the last transaction that was successfully replicated to the
 	// database.
@@ -154,10 +163,22 @@ type replicationState struct {
 	// when we get a CommitMessage
 	currentTransactionLSN pglogrepl.LSN
 
+	// currentTransactionXid is the ID of the current transaction we are processing on the primary.
+	currentTransactionXid uint32
+
 	// inStream tracks the state of the replication stream. When we receive a StreamStartMessage, we set inStream to
 	// true, and then back to false when we receive a StreamStopMessage.
 	inStream bool
 
+	// streamXid is the ID of the transaction whose changes are currently being streamed, which is set by each
+	// StreamStartMessage.
+	streamXid uint32
+
+	// streamedChanges holds the changes of in-progress transactions that are streamed by the primary, keyed by the ID of
+	// the top-level transaction. A large transaction is streamed in chunks before it is committed, so its changes are
+	// buffered on disk until we receive a StreamCommitMessage, or discarded when we receive a StreamAbortMessage.
+	streamedChanges map[uint32]*streamedTransaction
+
 	// We selectively ignore messages that are from before our last flush, which can be resent by postgres in certain
 	// crash scenarios. Postgres sends messages in batches based on changes in a transaction, beginning with a Begin
 	// message that records the last WAL position of the transaction. The individual INSERT, UPDATE, DELETE messages are
@@ -167,7 +188,18 @@ type replicationState struct {
 	// process or ignore all messages until a corresponding Commit message.
 	processMessages bool
 	relations       map[uint32]*pglogrepl.RelationMessageV2
-	typeMap         *pgtype.Map
+	// types contains the custom types described by the primary, keyed by their OID. The columns of a relation that use
+	// a custom type refer to it by this OID.
+	types map[uint32]*pglogrepl.TypeMessageV2
+}
+
+// relation returns the relation with the given ID, which must have been described by a previous RelationMessage.
+func (s *replicationState) relation(relationID uint32) (*pglogrepl.RelationMessageV2, error) {
+	rel, ok := s.relations[relationID]
+	if !ok {
+		return nil, fmt.Errorf("unknown relation ID %d", relationID)
+	}
+	return rel, nil
 }
 
 // StartReplication starts the replication process for the given slot name. This function blocks until replication is
@@ -181,17 +213,17 @@ func (r *LogicalReplicator) StartReplication(slotName string) error {
 		return err
 	}
 
-	// TODO: we need to be able to re-establish this connection if it goes bad
-	replicationConn, err := pgx.Connect(context.Background(), r.replicationDns)
+	replica, err := newReplicaSession(r.replicaConn, r.replicaDatabase)
 	if err != nil {
 		return err
 	}
 
 	state := &replicationState{
-		lastWrittenLSN: lastWrittenLsn,
-		replicaConn:    replicationConn,
-		relations:      map[uint32]*pglogrepl.RelationMessageV2{},
-		typeMap:        pgtype.NewMap(),
+		lastWrittenLSN:  lastWrittenLsn,
+		replica:         replica,
+		relations:       map[uint32]*pglogrepl.RelationMessageV2{},
+		types:           map[uint32]*pglogrepl.TypeMessageV2{},
+		streamedChanges: map[uint32]*streamedTransaction{},
 	}
 
 	var primaryConn *pgconn.PgConn
@@ -199,8 +231,9 @@ func (r *LogicalReplicator) StartReplication(slotName string) error {
 		if primaryConn != nil {
 			_ = primaryConn.Close(context.Background())
 		}
-		if state.replicaConn != nil {
-			_ = state.replicaConn.Close(context.Background())
+		state.replica.close()
+		for _, streamed := range state.streamedChanges {
+			streamed.discard()
 		}
 		// We always shut down here and only here, so we do the cleanup on thread exit in exactly one place
 		r.shutdown()
@@ -329,7 +362,7 @@ func (r *LogicalReplicator) StartReplication(slotName string) error {
 			case pglogrepl.PrimaryKeepaliveMessageByteID:
 				pkm, err := pglogrepl.ParsePrimaryKeepaliveMessage(msg.Data[1:])
 				if err != nil {
-					log.Fatalln("ParsePrimaryKeepaliveMessage failed:", err)
+					return err
 				}
 
 				log.Println("Primary Keepalive Message =>", "ServerWALEnd:", pkm.ServerWALEnd, "ServerTime:", pkm.ServerTime, "ReplyRequested:", pkm.ReplyRequested)
@@ -412,13 +445,6 @@ func (r *LogicalReplicator) Stop() {
 	<-r.stop
 }
 
-// replicateQuery executes the query provided on the replica connection
-func (r *LogicalReplicator) replicateQuery(replicationConn *pgx.Conn, query string) error {
-	log.Printf("replicating query: %s", query)
-	_, err := replicationConn.Exec(context.Background(), query)
-	return err
-}
-
 // beginReplication starts a new replication connection to the primary server and returns it. The LSN provided is the
 // last one we have confirmed that we flushed to disk.
 func (r *LogicalReplicator) beginReplication(slotName string, lastFlushLsn pglogrepl.LSN) (*pgconn.PgConn, error) {
@@ -541,9 +567,11 @@ func (r *LogicalReplicator) CreateReplicationSlotIfNecessary(slotName string) er
 }
 
 // processMessage processes a logical replication message as appropriate. A couple important aspects:
-//  1. Relation messages describe tables being replicated and are used to build a type map for decoding tuples
+//  1. Relation messages describe tables being replicated and are used to find the replica's table for decoding tuples
 //  2. INSERT/UPDATE/DELETE messages describe changes to rows that must be applied to the replica.
-//     These describe a row in the form of a tuple, and are used to construct a query to apply the change to the replica.
+//     These describe a row in the form of a tuple, which is decoded and written directly to the replica's table.
+//  3. Large transactions may be streamed before they are committed. Their changes are buffered until the transaction is
+//     committed, or discarded if it is aborted.
 //
 // Returns a boolean true if the message was a commit that should be acknowledged, and an error if one occurred.
 func (r *LogicalReplicator) processMessage(
@@ -574,191 +602,105 @@ func (r *LogicalReplicator) processMessage(
 
 		state.processMessages = true
 		state.currentTransactionLSN = logicalMsg.FinalLSN
+		state.currentTransactionXid = logicalMsg.Xid
 
 		log.Printf("BeginMessage: %v", logicalMsg)
-		err = r.replicateQuery(state.replicaConn, "START TRANSACTION")
+		err = state.replica.query("START TRANSACTION")
 		if err != nil {
 			return false, err
 		}
 	case *pglogrepl.CommitMessage:
 		log.Printf("CommitMessage: %v", logicalMsg)
-		err = r.replicateQuery(state.replicaConn, "COMMIT")
+		err = r.commitTransaction(state, state.currentTransactionXid, logicalMsg.CommitLSN, logicalMsg.CommitTime)
 		if err != nil {
 			return false, err
 		}
 		state.processMessages = false
 
 		return true, nil
-	case *pglogrepl.InsertMessageV2:
-		if !state.processMessages {
-			log.Printf("Received stale message, ignoring. Last written LSN: %s Message LSN: %s", state.lastWrittenLSN, xld.ServerWALEnd)
-			return false, nil
-		}
-
-		rel, ok := state.relations[logicalMsg.RelationID]
-		if !ok {
-			log.Fatalf("unknown relation ID %d", logicalMsg.RelationID)
-		}
-
-		columnStr := strings.Builder{}
-		valuesStr := strings.Builder{}
-		for idx, col := range logicalMsg.Tuple.Columns {
-			if idx > 0 {
-				columnStr.WriteString(", ")
-				valuesStr.WriteString(", ")
-			}
-
-			colName := rel.Columns[idx].Name
-			columnStr.WriteString(colName)
-
-			switch col.DataType {
-			case 'n': // null
-				valuesStr.WriteString("NULL")
-			case 't': // text
-
-				// We have to round-trip the data through the encodings to get an accurate text rep back
-				val, err := decodeTextColumnData(state.typeMap, col.Data, rel.Columns[idx].DataType)
-				if err != nil {
-					log.Fatalln("error decoding column data:", err)
-				}
-				colData, err := encodeColumnData(state.typeMap, val, rel.Columns[idx].DataType)
-				if err != nil {
-					return false, err
-				}
-				valuesStr.WriteString(colData)
-			default:
-				log.Printf("unknown column data type: %c", col.DataType)
-			}
-		}
-
-		err = r.replicateQuery(state.replicaConn, fmt.Sprintf("INSERT INTO %s.%s (%s) VALUES (%s)", rel.Namespace, rel.RelationName, columnStr.String(), valuesStr.String()))
-		if err != nil {
-			return false, err
-		}
-	case *pglogrepl.UpdateMessageV2:
-		if !state.processMessages {
-			log.Printf("Received stale message, ignoring. Last written LSN: %s Message LSN: %s", state.lastWrittenLSN, xld.ServerWALEnd)
-			return false, nil
-		}
-
-		// TODO: this won't handle primary key changes correctly
-		// TODO: this probably doesn't work for unkeyed tables
-		rel, ok := state.relations[logicalMsg.RelationID]
-		if !ok {
-			log.Fatalf("unknown relation ID %d", logicalMsg.RelationID)
-		}
-
-		updateStr := strings.Builder{}
-		whereStr := strings.Builder{}
-		for idx, col := range logicalMsg.NewTuple.Columns {
-			colName := rel.Columns[idx].Name
-			colFlags := rel.Columns[idx].Flags
-
-			var stringVal string
-			switch col.DataType {
-			case 'n': // null
-				stringVal = "NULL"
-			case 'u': // unchanged toast
-			case 't': // text
-				val, err := decodeTextColumnData(state.typeMap, col.Data, rel.Columns[idx].DataType)
-				if err != nil {
-					log.Fatalln("error decoding column data:", err)
-				}
-
-				stringVal, err = encodeColumnData(state.typeMap, val, rel.Columns[idx].DataType)
+	case *pglogrepl.InsertMessageV2, *pglogrepl.UpdateMessageV2, *pglogrepl.DeleteMessageV2, *pglogrepl.TruncateMessageV2:
+		if state.inStream {
+			// Changes of streamed transactions are only applied once we know that the transaction was committed
+			streamed, ok := state.streamedChanges[state.streamXid]
+			if !ok {
+				streamed, err = newStreamedTransaction()
 				if err != nil {
 					return false, err
 				}
-			default:
-				log.Printf("unknown column data type: %c", col.DataType)
-			}
-
-			// TODO: quote column names?
-			if colFlags == 0 {
-				if updateStr.Len() > 0 {
-					updateStr.WriteString(", ")
-				}
-				updateStr.WriteString(fmt.Sprintf("%s = %v", colName, stringVal))
-			} else {
-				if whereStr.Len() > 0 {
-					updateStr.WriteString(", ")
-				}
-				whereStr.WriteString(fmt.Sprintf("%s = %v", colName, stringVal))
+				state.streamedChanges[state.streamXid] = streamed
 			}
+			return false, streamed.append(changeXid(logicalMsg), walData)
 		}
 
-		err = r.replicateQuery(state.replicaConn, fmt.Sprintf("UPDATE %s.%s SET %s%s", rel.Namespace, rel.RelationName, updateStr.String(), whereClause(whereStr)))
-		if err != nil {
-			return false, err
-		}
-	case *pglogrepl.DeleteMessageV2:
 		if !state.processMessages {
 			log.Printf("Received stale message, ignoring. Last written LSN: %s Message LSN: %s", state.lastWrittenLSN, xld.ServerWALEnd)
 			return false, nil
 		}
 
-		// TODO: this probably doesn't work for unkeyed tables
-		rel, ok := state.relations[logicalMsg.RelationID]
-		if !ok {
-			log.Fatalf("unknown relation ID %d", logicalMsg.RelationID)
-		}
-
-		whereStr := strings.Builder{}
-		for idx, col := range logicalMsg.OldTuple.Columns {
-			colName := rel.Columns[idx].Name
-			colFlags := rel.Columns[idx].Flags
-
-			var stringVal string
-			switch col.DataType {
-			case 'n': // null
-				stringVal = "NULL"
-			case 'u': // unchanged toast
-			case 't': // text
-				val, err := decodeTextColumnData(state.typeMap, col.Data, rel.Columns[idx].DataType)
-				if err != nil {
-					log.Fatalln("error decoding column data:", err)
-				}
-
-				stringVal, err = encodeColumnData(state.typeMap, val, rel.Columns[idx].DataType)
-				if err != nil {
-					return false, err
-				}
-			default:
-				log.Printf("unknown column data type: %c", col.DataType)
-			}
-
-			if colFlags == 0 {
-				// nothing to do
-			} else {
-				if whereStr.Len() > 0 {
-					whereStr.WriteString(", ")
-				}
-				whereStr.WriteString(fmt.Sprintf("%s = %v", colName, stringVal))
-			}
-		}
-
-		err = r.replicateQuery(state.replicaConn, fmt.Sprintf("DELETE FROM %s.%s WHERE %s", rel.Namespace, rel.RelationName, whereStr.String()))
+		err = r.applyChange(state, logicalMsg)
 		if err != nil {
-			return false, err
+			return false, r.rollbackTransaction(state, err)
 		}
-	case *pglogrepl.TruncateMessageV2:
-		log.Printf("truncate for xid %d\n", logicalMsg.Xid)
 	case *pglogrepl.TypeMessageV2:
-		log.Printf("typeMessage for xid %d\n", logicalMsg.Xid)
+		// Type messages are sent before the relation message of any relation with a column using a custom type, such as
+		// an enum or a domain. Values are still decoded using the types of the replica's columns, but the type's name is
+		// used to ensure that the replica's column uses the same type, when the replica's column has a custom type.
+		log.Printf("typeMessage: %s.%s (%d)", logicalMsg.Namespace, logicalMsg.Name, logicalMsg.DataType)
+		state.types[logicalMsg.DataType] = logicalMsg
 	case *pglogrepl.OriginMessage:
 		log.Printf("originMessage for xid %s\n", logicalMsg.Name)
 	case *pglogrepl.LogicalDecodingMessageV2:
 		log.Printf("Logical decoding message: %q, %q, %d", logicalMsg.Prefix, logicalMsg.Content, logicalMsg.Xid)
 	case *pglogrepl.StreamStartMessageV2:
 		state.inStream = true
+		state.streamXid = logicalMsg.Xid
 		log.Printf("Stream start message: xid %d, first segment? %d", logicalMsg.Xid, logicalMsg.FirstSegment)
 	case *pglogrepl.StreamStopMessageV2:
 		state.inStream = false
 		log.Printf("Stream stop message")
 	case *pglogrepl.StreamCommitMessageV2:
 		log.Printf("Stream commit message: xid %d", logicalMsg.Xid)
+		streamed, ok := state.streamedChanges[logicalMsg.Xid]
+		if ok {
+			delete(state.streamedChanges, logicalMsg.Xid)
+			defer streamed.discard()
+		}
+
+		if state.lastWrittenLSN > logicalMsg.CommitLSN {
+			log.Printf("Received stale message, ignoring. Last written LSN: %s Message LSN: %s", state.lastWrittenLSN, logicalMsg.CommitLSN)
+			return false, nil
+		}
+
+		state.currentTransactionLSN = logicalMsg.CommitLSN
+		err = state.replica.query("START TRANSACTION")
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			err = streamed.forEach(func(msg pglogrepl.Message) error {
+				return r.applyChange(state, msg)
+			})
+			if err != nil {
+				return false, r.rollbackTransaction(state, err)
+			}
+		}
+		err = r.commitTransaction(state, logicalMsg.Xid, logicalMsg.CommitLSN, logicalMsg.CommitTime)
+		if err != nil {
+			return false, err
+		}
+
+		return true, nil
 	case *pglogrepl.StreamAbortMessageV2:
-		log.Printf("Stream abort message: xid %d", logicalMsg.Xid)
+		log.Printf("Stream abort message: xid %d, subxid %d", logicalMsg.Xid, logicalMsg.SubXid)
+		if streamed, ok := state.streamedChanges[logicalMsg.Xid]; ok {
+			if logicalMsg.SubXid == logicalMsg.Xid {
+				delete(state.streamedChanges, logicalMsg.Xid)
+				streamed.discard()
+			} else {
+				// Only the changes made by the aborted subtransaction are discarded
+				streamed.abortSubtransaction(logicalMsg.SubXid)
+			}
+		}
 	default:
 		log.Printf("Unknown message type in pgoutput stream: %T", logicalMsg)
 	}
@@ -766,6 +708,90 @@ func (r *LogicalReplicator) processMessage(
 	return false, nil
 }
 
+// commitTransaction commits the replicated transaction on the replica. When Dolt commits are enabled, a Dolt commit
+// recording the transaction's ID and the LSN of its commit record is created as part of the same transaction.
+func (r *LogicalReplicator) commitTransaction(state *replicationState, xid uint32, lsn pglogrepl.LSN, commitTime time.Time) error {
+	r.mu.Lock()
+	doltCommitOptions := r.doltCommitOptions
+	r.mu.Unlock()
+
+	if doltCommitOptions != nil {
+		err := state.replica.query(doltCommitOptions.doltCommitQuery(xid, lsn, commitTime))
+		if err != nil {
+			return err
+		}
+	}
+	return state.replica.query("COMMIT")
+}
+
+// rollbackTransaction rolls back the replicated transaction on the replica after a change could not be applied, so that
+// none of its changes are kept. Returns the error that caused the rollback.
+func (r *LogicalReplicator) rollbackTransaction(state *replicationState, cause error) error {
+	if err := state.replica.query("ROLLBACK"); err != nil {
+		log.Printf("error rolling back replicated transaction: %v", err)
+	}
+	return cause
+}
+
+// applyChange applies a change to the rows of one or more relations to the replica.
+func (r *LogicalReplicator) applyChange(state *replicationState, msg pglogrepl.Message) error {
+	ctx, err := state.replica.newContext("")
+	if err != nil {
+		return err
+	}
+	applier := rowApplier{types: state.types, catalog: state.replica.engine.Analyzer.Catalog}
+	switch msg := msg.(type) {
+	case *pglogrepl.InsertMessageV2:
+		rel, err := state.relation(msg.RelationID)
+		if err != nil {
+			return err
+		}
+		return applier.insert(ctx, rel, msg.Tuple)
+	case *pglogrepl.UpdateMessageV2:
+		rel, err := state.relation(msg.RelationID)
+		if err != nil {
+			return err
+		}
+		return applier.update(ctx, rel, msg.OldTupleType, msg.OldTuple, msg.NewTuple)
+	case *pglogrepl.DeleteMessageV2:
+		rel, err := state.relation(msg.RelationID)
+		if err != nil {
+			return err
+		}
+		return applier.delete(ctx, rel, msg.OldTuple)
+	case *pglogrepl.TruncateMessageV2:
+		// Relations that were truncated due to CASCADE are already included in the message
+		rels := make([]*pglogrepl.RelationMessageV2, len(msg.RelationIDs))
+		for i, relationID := range msg.RelationIDs {
+			rel, err := state.relation(relationID)
+			if err != nil {
+				return err
+			}
+			rels[i] = rel
+		}
+		return applier.truncate(ctx, rels, msg.Option&truncateOptionRestartIdentity != 0)
+	default:
+		return fmt.Errorf("unexpected change message: %T", msg)
+	}
+}
+
+// changeXid returns the ID of the transaction or subtransaction that made the given change, which is only sent for
+// changes that are part of a streamed transaction.
+func changeXid(msg pglogrepl.Message) uint32 {
+	switch msg := msg.(type) {
+	case *pglogrepl.InsertMessageV2:
+		return msg.Xid
+	case *pglogrepl.UpdateMessageV2:
+		return msg.Xid
+	case *pglogrepl.DeleteMessageV2:
+		return msg.Xid
+	case *pglogrepl.TruncateMessageV2:
+		return msg.Xid
+	default:
+		return 0
+	}
+}
+
 // readWALPosition reads the recorded WAL position from the WAL position file
 func (r *LogicalReplicator) readWALPosition() (pglogrepl.LSN, error) {
 	walFileContents, err := os.ReadFile(r.walFilePath)
@@ -784,53 +810,3 @@ func (r *LogicalReplicator) readWALPosition() (pglogrepl.LSN, error) {
 func (r *LogicalReplicator) writeWALPosition(lsn pglogrepl.LSN) error {
 	return os.WriteFile(r.walFilePath, []byte(lsn.String()), 0644)
 }
-
-// whereClause returns a WHERE clause string with the contents of the builder if it's non-empty, or the empty
-// string otherwise
-func whereClause(str strings.Builder) string {
-	if str.Len() > 0 {
-		return " WHERE " + str.String()
-	}
-	return ""
-}
-
-// decodeTextColumnData decodes the given data using the given data type OID and returns the result as a golang value
-func decodeTextColumnData(mi *pgtype.Map, data []byte, dataType uint32) (interface{}, error) {
-	if dt, ok := mi.TypeForOID(dataType); ok {
-		return dt.Codec.DecodeValue(mi, dataType, pgtype.TextFormatCode, data)
-	}
-	return string(data), nil
-}
-
-// encodeColumnData encodes the given data using the given data type OID and returns the result as a string to be
-// used in an INSERT or other DML query.
-func encodeColumnData(mi *pgtype.Map, data interface{}, dataType uint32) (string, error) {
-	var value string
-	if dt, ok := mi.TypeForOID(dataType); ok {
-		e := dt.Codec.PlanEncode(mi, dataType, pgtype.TextFormatCode, data)
-		if e != nil {
-			encoded, err := e.Encode(data, nil)
-			if err != nil {
-				return "", err
-			}
-			value = string(encoded)
-		} else {
-			// no encoder for this type, use the string representation
-			value = fmt.Sprintf("%v", data)
-		}
-	} else {
-		value = fmt.Sprintf("%v", data)
-	}
-
-	// Some types need additional quoting after encoding
-	switch data := data.(type) {
-	case string, time.Time, pgtype.Time, bool:
-		return fmt.Sprintf("'%s'", value), nil
-	case [16]byte:
-		// TODO: should we actually register an encoder for this type?
-		uid := uuid.UUID(data)
-		return fmt.Sprintf("'%s'", uid.String()), nil
-	default:
-		return value, nil
-	}
-}
@@ -0,0 +1,112 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logrepl
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"log"
+	"os"
+
+	"github.com/jackc/pglogrepl"
+)
+
+// streamedTransaction buffers the changes of an in-progress transaction that is streamed by the primary. The primary
+// only streams transactions that are too large for it to hold in memory, so the changes are written to a temporary
+// file rather than held in memory, in the same way that Postgres spills them on its own replicas.
+type streamedTransaction struct {
+	file   *os.File
+	writer *bufio.Writer
+	// abortedSubXids contains the IDs of the subtransactions that were aborted, whose changes must not be applied.
+	abortedSubXids map[uint32]struct{}
+}
+
+// newStreamedTransaction returns a new streamedTransaction, backed by a new temporary file.
+func newStreamedTransaction() (*streamedTransaction, error) {
+	file, err := os.CreateTemp("", "doltgres_streamed_xact_*")
+	if err != nil {
+		return nil, err
+	}
+	return &streamedTransaction{
+		file:           file,
+		writer:         bufio.NewWriter(file),
+		abortedSubXids: make(map[uint32]struct{}),
+	}, nil
+}
+
+// append adds the change with the given WAL data, which was made by the transaction or subtransaction with the given ID.
+func (st *streamedTransaction) append(xid uint32, walData []byte) error {
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[:4], xid)
+	binary.BigEndian.PutUint32(header[4:], uint32(len(walData)))
+	if _, err := st.writer.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := st.writer.Write(walData)
+	return err
+}
+
+// abortSubtransaction discards the changes made by the subtransaction with the given ID.
+func (st *streamedTransaction) abortSubtransaction(subXid uint32) {
+	st.abortedSubXids[subXid] = struct{}{}
+}
+
+// forEach calls |f| with each buffered change, in the order that they were made, skipping the changes of aborted
+// subtransactions.
+func (st *streamedTransaction) forEach(f func(msg pglogrepl.Message) error) error {
+	if err := st.writer.Flush(); err != nil {
+		return err
+	}
+	if _, err := st.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	reader := bufio.NewReader(st.file)
+	var header [8]byte
+	for {
+		if _, err := io.ReadFull(reader, header[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		xid := binary.BigEndian.Uint32(header[:4])
+		walData := make([]byte, binary.BigEndian.Uint32(header[4:]))
+		if _, err := io.ReadFull(reader, walData); err != nil {
+			return err
+		}
+		if _, ok := st.abortedSubXids[xid]; ok {
+			continue
+		}
+		msg, err := pglogrepl.ParseV2(walData, true)
+		if err != nil {
+			return err
+		}
+		if err = f(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// discard closes and removes the temporary file that holds the changes.
+func (st *streamedTransaction) discard() {
+	if err := st.file.Close(); err != nil {
+		log.Printf("error closing streamed transaction file: %v", err)
+	}
+	if err := os.Remove(st.file.Name()); err != nil {
+		log.Printf("error removing streamed transaction file: %v", err)
+	}
+}
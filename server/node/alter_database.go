@@ -0,0 +1,115 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	"github.com/dolthub/doltgresql/server/auth"
+)
+
+// AlterDatabase handles the ALTER DATABASE statement when it changes the database's connection settings.
+type AlterDatabase struct {
+	Name             string
+	AllowConnections *bool  // ALLOW_CONNECTIONS allowconn
+	ConnectionLimit  *int32 // CONNECTION LIMIT connlimit
+}
+
+var _ sql.ExecSourceRel = (*AlterDatabase)(nil)
+var _ vitess.Injectable = (*AlterDatabase)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterDatabase) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterDatabase) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterDatabase) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterDatabase) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if !dsess.DSessFromSess(ctx.Session).Provider().HasDatabase(ctx, c.Name) {
+		return nil, pgerror.Newf(pgcode.InvalidCatalogName, `database "%s" does not exist`, c.Name)
+	}
+	var userRole auth.Role
+	var isOwner bool
+	auth.LockRead(func() {
+		userRole = auth.GetRole(ctx.Client().User)
+		isOwner = auth.IsOwner(auth.OwnershipKey{
+			PrivilegeObject: auth.PrivilegeObject_DATABASE,
+			Name:            c.Name,
+		}, userRole.ID())
+	})
+	if !userRole.IsValid() {
+		return nil, fmt.Errorf(`role "%s" does not exist`, ctx.Client().User)
+	}
+	if !userRole.IsSuperUser && !isOwner {
+		return nil, pgerror.Newf(pgcode.InsufficientPrivilege, `must be owner of database %s`, c.Name)
+	}
+
+	var err error
+	auth.LockWrite(func() {
+		setting := auth.GetDatabaseSetting(c.Name)
+		if c.AllowConnections != nil {
+			setting.AllowConnections = *c.AllowConnections
+		}
+		if c.ConnectionLimit != nil {
+			setting.ConnectionLimit = *c.ConnectionLimit
+		}
+		auth.SetDatabaseSetting(c.Name, setting)
+		err = auth.PersistChanges()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterDatabase) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterDatabase) String() string {
+	return "ALTER DATABASE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterDatabase) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterDatabase) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
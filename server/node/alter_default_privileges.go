@@ -0,0 +1,174 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	"github.com/dolthub/doltgresql/server/auth"
+)
+
+// AlterDefaultPrivileges handles the ALTER DEFAULT PRIVILEGES statement.
+type AlterDefaultPrivileges struct {
+	TargetRoles     []string // When empty, this applies to the current user
+	Schemas         []string // When empty, this applies to all schemas
+	PrivilegeObject auth.PrivilegeObject
+	Privileges      []auth.Privilege
+	Grantees        []string
+	Grant           bool // When false, represents REVOKE
+	GrantOption     bool // This is "WITH GRANT OPTION" for GRANT, and "GRANT OPTION FOR" for REVOKE
+	Cascade         bool // When false, represents RESTRICT
+}
+
+var _ sql.ExecSourceRel = (*AlterDefaultPrivileges)(nil)
+var _ vitess.Injectable = (*AlterDefaultPrivileges)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if a.Cascade {
+		return nil, errors.New("ALTER DEFAULT PRIVILEGES does not yet support CASCADE")
+	}
+	schemas := make([]string, len(a.Schemas))
+	if len(a.Schemas) > 0 {
+		db, err := core.GetSqlDatabaseFromContext(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		schemaDb, ok := db.(sql.SchemaDatabase)
+		if !ok {
+			return nil, errors.New("ALTER DEFAULT PRIVILEGES IN SCHEMA requires a database that supports schemas")
+		}
+		for i, schema := range a.Schemas {
+			schemaName, err := core.GetSchemaName(ctx, nil, schema)
+			if err != nil {
+				return nil, err
+			}
+			if _, exists, err := schemaDb.GetSchema(ctx, schemaName); err != nil {
+				return nil, err
+			} else if !exists {
+				return nil, fmt.Errorf(`schema "%s" does not exist`, schemaName)
+			}
+			schemas[i] = schemaName
+		}
+	}
+	if len(schemas) == 0 {
+		schemas = []string{""}
+	}
+
+	var err error
+	auth.LockWrite(func() {
+		userRole := auth.GetRole(ctx.Client().User)
+		if !userRole.IsValid() {
+			err = fmt.Errorf(`role "%s" does not exist`, ctx.Client().User)
+			return
+		}
+		targetRoles := []auth.Role{userRole}
+		if len(a.TargetRoles) > 0 {
+			targetRoles = make([]auth.Role, len(a.TargetRoles))
+			for i, roleName := range a.TargetRoles {
+				targetRoles[i] = auth.GetRole(roleName)
+				if !targetRoles[i].IsValid() {
+					err = fmt.Errorf(`role "%s" does not exist`, roleName)
+					return
+				}
+				// Default privileges may only be altered for roles that the user is a member of
+				if !userRole.IsSuperUser && userRole.ID() != targetRoles[i].ID() {
+					if groupID, _, _ := auth.IsRoleAMember(userRole.ID(), targetRoles[i].ID()); !groupID.IsValid() {
+						err = pgerror.Newf(pgcode.InsufficientPrivilege, `permission denied to change default privileges`)
+						return
+					}
+				}
+			}
+		}
+		grantees := make([]auth.Role, len(a.Grantees))
+		for i, roleName := range a.Grantees {
+			grantees[i] = auth.GetRole(roleName)
+			if !grantees[i].IsValid() {
+				err = fmt.Errorf(`role "%s" does not exist`, roleName)
+				return
+			}
+		}
+		for _, targetRole := range targetRoles {
+			for _, schema := range schemas {
+				for _, grantee := range grantees {
+					key := auth.DefaultPrivilegeKey{
+						Role:            targetRole.ID(),
+						Schema:          schema,
+						PrivilegeObject: a.PrivilegeObject,
+						Grantee:         grantee.ID(),
+					}
+					for _, privilege := range a.Privileges {
+						if a.Grant {
+							auth.AddDefaultPrivilege(key, privilege, a.GrantOption)
+						} else {
+							auth.RemoveDefaultPrivilege(key, privilege, a.GrantOption)
+						}
+					}
+				}
+			}
+		}
+		err = auth.PersistChanges()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) String() string {
+	return "ALTER DEFAULT PRIVILEGES"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(a, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (a *AlterDefaultPrivileges) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return a, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/policies"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+)
+
+// AlterPolicy handles the ALTER POLICY statement. Empty fields are left unchanged.
+type AlterPolicy struct {
+	Database   string
+	SchemaName string
+	Table      string
+	Name       string
+	NewName    string
+	Roles      []string
+	Using      string
+	WithCheck  string
+	Columns    []string // The columns that are referenced by the USING and WITH CHECK expressions
+}
+
+var _ sql.ExecSourceRel = (*AlterPolicy)(nil)
+var _ vitess.Injectable = (*AlterPolicy)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	tableName, err := verifyPolicyTable(ctx, c.Database, c.SchemaName, c.Table, c.Columns)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := core.GetPoliciesCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.NewName) > 0 {
+		if err = collection.RenamePolicy(tableName, c.Name, c.NewName); err != nil {
+			return nil, pgerror.New(pgcode.UndefinedObject, err.Error())
+		}
+		return sql.RowsToRowIter(), nil
+	}
+	policy := collection.GetPolicy(tableName, c.Name)
+	if policy == nil {
+		return nil, pgerror.Newf(pgcode.UndefinedObject, `policy "%s" for table "%s" does not exist`, c.Name, tableName.Name)
+	}
+	if (policy.Command == policies.Command_Select || policy.Command == policies.Command_Delete) && len(c.WithCheck) > 0 {
+		return nil, pgerror.New(pgcode.Syntax, "WITH CHECK cannot be applied to SELECT or DELETE")
+	}
+	if policy.Command == policies.Command_Insert && len(c.Using) > 0 {
+		return nil, pgerror.New(pgcode.Syntax, "only WITH CHECK expression allowed for INSERT")
+	}
+	if len(c.Roles) > 0 {
+		roles, err := resolvePolicyRoles(ctx, c.Roles)
+		if err != nil {
+			return nil, err
+		}
+		policy.Roles = roles
+	}
+	if len(c.Using) > 0 {
+		policy.Using = c.Using
+	}
+	if len(c.WithCheck) > 0 {
+		policy.WithCheck = c.WithCheck
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) String() string {
+	return "ALTER POLICY"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterPolicy) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterPolicy) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+)
+
+// AlterTableRowLevelSecurity handles the ALTER TABLE statement when it only changes the table's row-level security
+// settings. Nil fields are left unchanged.
+type AlterTableRowLevelSecurity struct {
+	Database   string
+	SchemaName string
+	Table      string
+	IfExists   bool
+	Enable     *bool // ENABLE | DISABLE ROW LEVEL SECURITY
+	Force      *bool // FORCE | NO FORCE ROW LEVEL SECURITY
+}
+
+var _ sql.ExecSourceRel = (*AlterTableRowLevelSecurity)(nil)
+var _ vitess.Injectable = (*AlterTableRowLevelSecurity)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowLevelSecurity) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowLevelSecurity) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowLevelSecurity) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowLevelSecurity) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if c.IfExists {
+		schemaName, err := core.GetSchemaName(ctx, nil, c.SchemaName)
+		if err != nil {
+			return nil, err
+		}
+		table, err := core.GetSqlTableFromContext(ctx, c.Database, doltdb.TableName{Name: c.Table, Schema: schemaName})
+		if err != nil {
+			return nil, err
+		}
+		if table == nil {
+			// TODO: issue a notice
+			return sql.RowsToRowIter(), nil
+		}
+	}
+	tableName, err := verifyPolicyTable(ctx, c.Database, c.SchemaName, c.Table, nil)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := core.GetPoliciesCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c.Enable != nil {
+		collection.SetRowLevelSecurity(tableName, *c.Enable)
+	}
+	if c.Force != nil {
+		collection.SetForceRowLevelSecurity(tableName, *c.Force)
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowLevelSecurity) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowLevelSecurity) String() string {
+	return "ALTER TABLE ROW LEVEL SECURITY"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterTableRowLevelSecurity) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterTableRowLevelSecurity) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
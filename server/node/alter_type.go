@@ -0,0 +1,536 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/typecollection"
+	"github.com/dolthub/doltgresql/server/types"
+)
+
+// AlterTypeAddValue handles the ALTER TYPE ... ADD VALUE statement.
+type AlterTypeAddValue struct {
+	SchemaName    string
+	Name          string
+	NewLabel      string
+	IfNotExists   bool
+	HasPlacement  bool
+	Before        bool
+	ExistingLabel string
+}
+
+// AlterTypeRenameValue handles the ALTER TYPE ... RENAME VALUE statement.
+type AlterTypeRenameValue struct {
+	SchemaName string
+	Name       string
+	OldLabel   string
+	NewLabel   string
+}
+
+var _ sql.ExecSourceRel = (*AlterTypeAddValue)(nil)
+var _ vitess.Injectable = (*AlterTypeAddValue)(nil)
+var _ sql.ExecSourceRel = (*AlterTypeRenameValue)(nil)
+var _ vitess.Injectable = (*AlterTypeRenameValue)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (a *AlterTypeAddValue) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (a *AlterTypeAddValue) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (a *AlterTypeAddValue) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (a *AlterTypeAddValue) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	collection, typ, err := loadEnumType(ctx, a.SchemaName, a.Name)
+	if err != nil {
+		return nil, err
+	}
+	if enumLabelIndex(typ.EnumLabels, a.NewLabel) != -1 {
+		if a.IfNotExists {
+			// TODO: issue a notice
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, fmt.Errorf(`enum label "%s" already exists`, a.NewLabel)
+	}
+	if err = validateEnumLabel(a.NewLabel); err != nil {
+		return nil, err
+	}
+
+	// Labels are kept in declaration order, so the new label is given a sort order between its neighbors
+	labels := sortedEnumLabels(typ.EnumLabels)
+	var sortOrder float32
+	if !a.HasPlacement {
+		sortOrder = 1
+		if len(labels) > 0 {
+			sortOrder = labels[len(labels)-1].SortOrder + 1
+		}
+	} else {
+		idx := enumLabelIndex(labels, a.ExistingLabel)
+		if idx == -1 {
+			return nil, fmt.Errorf(`"%s" is not an existing enum label`, a.ExistingLabel)
+		}
+		if a.Before {
+			if idx == 0 {
+				sortOrder = labels[idx].SortOrder - 1
+			} else {
+				sortOrder = (labels[idx-1].SortOrder + labels[idx].SortOrder) / 2
+			}
+		} else {
+			if idx == len(labels)-1 {
+				sortOrder = labels[idx].SortOrder + 1
+			} else {
+				sortOrder = (labels[idx].SortOrder + labels[idx+1].SortOrder) / 2
+			}
+		}
+	}
+	typ.EnumLabels = append(typ.EnumLabels, types.EnumLabel{
+		Oid:       collection.NextOid(),
+		SortOrder: sortOrder,
+		Label:     a.NewLabel,
+	})
+	if err = refreshEnumColumns(ctx, collection, typ, "", ""); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (a *AlterTypeAddValue) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (a *AlterTypeAddValue) String() string {
+	return "ALTER TYPE ADD VALUE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (a *AlterTypeAddValue) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(a, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (a *AlterTypeAddValue) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return a, nil
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (a *AlterTypeRenameValue) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (a *AlterTypeRenameValue) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (a *AlterTypeRenameValue) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (a *AlterTypeRenameValue) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	collection, typ, err := loadEnumType(ctx, a.SchemaName, a.Name)
+	if err != nil {
+		return nil, err
+	}
+	idx := enumLabelIndex(typ.EnumLabels, a.OldLabel)
+	if idx == -1 {
+		return nil, fmt.Errorf(`"%s" is not an existing enum label`, a.OldLabel)
+	}
+	if enumLabelIndex(typ.EnumLabels, a.NewLabel) != -1 {
+		return nil, fmt.Errorf(`enum label "%s" already exists`, a.NewLabel)
+	}
+	if err = validateEnumLabel(a.NewLabel); err != nil {
+		return nil, err
+	}
+	typ.EnumLabels[idx].Label = a.NewLabel
+	if err = refreshEnumColumns(ctx, collection, typ, a.OldLabel, a.NewLabel); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (a *AlterTypeRenameValue) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (a *AlterTypeRenameValue) String() string {
+	return "ALTER TYPE RENAME VALUE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (a *AlterTypeRenameValue) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(a, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (a *AlterTypeRenameValue) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return a, nil
+}
+
+// loadEnumType returns the enum type with the given name, along with the collection that it belongs to.
+func loadEnumType(ctx *sql.Context, schemaName string, name string) (*typecollection.TypeCollection, *types.Type, error) {
+	schema, err := core.GetSchemaName(ctx, nil, schemaName)
+	if err != nil {
+		return nil, nil, err
+	}
+	collection, err := core.GetTypesCollectionFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	typ, exists := collection.GetType(schema, name)
+	if !exists {
+		return nil, nil, types.ErrTypeDoesNotExist.New(name)
+	}
+	if typ.TypType != types.TypeType_Enum {
+		return nil, nil, fmt.Errorf(`%s is not an enum`, name)
+	}
+	return collection, typ, nil
+}
+
+// enumLabelIndex returns the index of the given label within the slice. Returns -1 if the label is not found.
+func enumLabelIndex(labels []types.EnumLabel, label string) int {
+	for i := range labels {
+		if labels[i].Label == label {
+			return i
+		}
+	}
+	return -1
+}
+
+// sortedEnumLabels returns a copy of the given labels, sorted by their sort order.
+func sortedEnumLabels(labels []types.EnumLabel) []types.EnumLabel {
+	sorted := make([]types.EnumLabel, len(labels))
+	copy(sorted, labels)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].SortOrder < sorted[j].SortOrder
+	})
+	return sorted
+}
+
+// refreshEnumColumns updates every type and column in the current database that uses the given enum type, including
+// those where the enum is nested within a domain, composite type, or range type, so that they reflect the enum's current
+// labels. If a label was renamed, then all rows containing the old label are rewritten to use the new label.
+func refreshEnumColumns(ctx *sql.Context, collection *typecollection.TypeCollection, typ *types.Type, oldLabel string, newLabel string) error {
+	enumType := types.EnumTypeFromType(typ)
+	// Composite and range types hold their own copy of the enum type. Domains are resolved from the collection, so they
+	// do not need to be updated.
+	err := collection.IterateTypes(func(_ string, otherType *types.Type) error {
+		for i, attr := range otherType.CompositeAttrs {
+			if newAttrType, ok := replaceEnumType(attr.Type, enumType); ok {
+				otherType.CompositeAttrs[i].Type = newAttrType
+			}
+		}
+		if otherType.RangeSubType != nil {
+			if newSubType, ok := replaceEnumType(otherType.RangeSubType, enumType); ok {
+				otherType.RangeSubType = newSubType
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	db, err := core.GetSqlDatabaseFromContext(ctx, "")
+	if err != nil {
+		return err
+	}
+	schemaDb, ok := db.(sql.SchemaDatabase)
+	if !ok {
+		return nil
+	}
+	schemas, err := schemaDb.AllSchemas(ctx)
+	if err != nil {
+		return err
+	}
+	for _, schema := range schemas {
+		tableNames, err := schema.GetTableNames(ctx)
+		if err != nil {
+			return err
+		}
+		for _, tableName := range tableNames {
+			table, ok, err := schema.GetTableInsensitive(ctx, tableName)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			var enumColumns []int
+			for i, col := range table.Schema() {
+				if _, ok := replaceEnumType(col.Type, enumType); ok {
+					enumColumns = append(enumColumns, i)
+				}
+			}
+			if len(enumColumns) == 0 {
+				continue
+			}
+			if len(oldLabel) > 0 {
+				// Existing rows (and index keys) still hold the old label, so the columns temporarily use a type that
+				// accepts both labels while the rows are rewritten
+				transitionalType := enumType
+				transitionalType.Labels = make([]string, 0, len(enumType.Labels)+1)
+				for _, label := range enumType.Labels {
+					if label == newLabel {
+						transitionalType.Labels = append(transitionalType.Labels, oldLabel)
+					}
+					transitionalType.Labels = append(transitionalType.Labels, label)
+				}
+				if err = modifyEnumColumns(ctx, table, enumColumns, transitionalType); err != nil {
+					return err
+				}
+				// The table must be reloaded to pick up the modified columns
+				table, _, err = schema.GetTableInsensitive(ctx, tableName)
+				if err != nil {
+					return err
+				}
+				if err = renameEnumLabelInRows(ctx, table, enumColumns, enumType.Oid, oldLabel, newLabel); err != nil {
+					return err
+				}
+				table, _, err = schema.GetTableInsensitive(ctx, tableName)
+				if err != nil {
+					return err
+				}
+			}
+			if err = modifyEnumColumns(ctx, table, enumColumns, enumType); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// modifyEnumColumns modifies the given columns of the table, so that they use the given enum type.
+func modifyEnumColumns(ctx *sql.Context, table sql.Table, columns []int, enumType types.EnumType) error {
+	alterable, ok := table.(sql.AlterableTable)
+	if !ok {
+		return fmt.Errorf(`cannot alter type "%s" as table "%s" cannot be altered`, enumType.Name, table.Name())
+	}
+	for _, colIdx := range columns {
+		newCol := table.Schema()[colIdx].Copy()
+		newCol.Type, _ = replaceEnumType(newCol.Type, enumType)
+		if err := alterable.ModifyColumn(ctx, newCol.Name, newCol, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replaceEnumType returns a copy of the given type where every occurrence of the given enum type (matched by OID) has
+// been replaced, including occurrences nested within other types. Returns false if the type does not use the enum.
+func replaceEnumType(typ sql.Type, enumType types.EnumType) (types.DoltgresType, bool) {
+	if baseType, ok := arrayBaseType(typ); ok {
+		if newBaseType, ok := replaceEnumType(baseType, enumType); ok {
+			return newBaseType.ToArrayType(), true
+		}
+		return nil, false
+	}
+	switch typ := typ.(type) {
+	case types.EnumType:
+		if typ.Oid == enumType.Oid {
+			return enumType, true
+		}
+	case types.DomainType:
+		if newAsType, ok := replaceEnumType(typ.AsType, enumType); ok {
+			typ.AsType = newAsType
+			return typ, true
+		}
+	case types.CompositeType:
+		var attributes []types.CompositeAttribute
+		for i, attr := range typ.Attributes {
+			if newAttrType, ok := replaceEnumType(attr.Type, enumType); ok {
+				if attributes == nil {
+					attributes = make([]types.CompositeAttribute, len(typ.Attributes))
+					copy(attributes, typ.Attributes)
+				}
+				attributes[i].Type = newAttrType
+			}
+		}
+		if attributes != nil {
+			typ.Attributes = attributes
+			return typ, true
+		}
+	case types.RangeType:
+		if newSubType, ok := replaceEnumType(typ.SubType, enumType); ok {
+			typ.SubType = newSubType
+			return typ, true
+		}
+	}
+	return nil, false
+}
+
+// arrayBaseType returns the base type of the given array type. Returns false if the type is not an array, or is a
+// pseudo-type (such as unknown) that reports itself as its own base type.
+func arrayBaseType(typ sql.Type) (types.DoltgresType, bool) {
+	arrayType, ok := typ.(types.DoltgresArrayType)
+	if !ok {
+		return nil, false
+	}
+	baseType := arrayType.BaseType()
+	if _, ok = baseType.(types.DoltgresArrayType); ok {
+		return nil, false
+	}
+	return baseType, true
+}
+
+// renameEnumLabelInRows rewrites all rows in the table that contain the old label in any of the given columns.
+func renameEnumLabelInRows(ctx *sql.Context, table sql.Table, columns []int, enumOid uint32, oldLabel string, newLabel string) error {
+	updatable, ok := table.(sql.UpdatableTable)
+	if !ok {
+		return fmt.Errorf(`cannot rename enum label as table "%s" cannot be updated`, table.Name())
+	}
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return err
+	}
+	sch := table.Schema()
+	rowIter := sql.NewTableRowIter(ctx, table, partitions)
+	var oldRows, newRows []sql.Row
+	for {
+		row, err := rowIter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			_ = rowIter.Close(ctx)
+			return err
+		}
+		var newRow sql.Row
+		for _, colIdx := range columns {
+			colType, ok := sch[colIdx].Type.(types.DoltgresType)
+			if !ok {
+				continue
+			}
+			if newVal, ok := renameEnumLabel(colType, row[colIdx], enumOid, oldLabel, newLabel); ok {
+				if newRow == nil {
+					newRow = row.Copy()
+				}
+				newRow[colIdx] = newVal
+			}
+		}
+		if newRow != nil {
+			oldRows = append(oldRows, row)
+			newRows = append(newRows, newRow)
+		}
+	}
+	if err = rowIter.Close(ctx); err != nil {
+		return err
+	}
+	if len(oldRows) == 0 {
+		return nil
+	}
+	updater := updatable.Updater(ctx)
+	updater.StatementBegin(ctx)
+	for i := range oldRows {
+		if err = updater.Update(ctx, oldRows[i], newRows[i]); err != nil {
+			_ = updater.DiscardChanges(ctx, err)
+			_ = updater.Close(ctx)
+			return err
+		}
+	}
+	if err = updater.StatementComplete(ctx); err != nil {
+		_ = updater.Close(ctx)
+		return err
+	}
+	return updater.Close(ctx)
+}
+
+// renameEnumLabel returns a copy of the given value of the given type, where every occurrence of the old label of the
+// enum (matched by OID) has been replaced with the new label. Returns false if the value does not contain the old label.
+func renameEnumLabel(typ types.DoltgresType, val any, enumOid uint32, oldLabel string, newLabel string) (any, bool) {
+	if val == nil {
+		return nil, false
+	}
+	if baseType, ok := arrayBaseType(typ); ok {
+		vals, ok := val.([]any)
+		if !ok {
+			return nil, false
+		}
+		return renameEnumLabelInSlice(vals, func(int) types.DoltgresType { return baseType }, enumOid, oldLabel, newLabel)
+	}
+	switch typ := typ.(type) {
+	case types.EnumType:
+		if label, ok := val.(string); ok && typ.Oid == enumOid && label == oldLabel {
+			return newLabel, true
+		}
+	case types.DomainType:
+		return renameEnumLabel(typ.AsType, val, enumOid, oldLabel, newLabel)
+	case types.CompositeType:
+		vals, ok := val.([]any)
+		if !ok || len(vals) != len(typ.Attributes) {
+			return nil, false
+		}
+		return renameEnumLabelInSlice(vals, func(i int) types.DoltgresType { return typ.Attributes[i].Type }, enumOid, oldLabel, newLabel)
+	case types.RangeType:
+		rangeVal, ok := val.(types.RangeValue)
+		if !ok {
+			return nil, false
+		}
+		newLower, lowerRenamed := renameEnumLabel(typ.SubType, rangeVal.Lower, enumOid, oldLabel, newLabel)
+		newUpper, upperRenamed := renameEnumLabel(typ.SubType, rangeVal.Upper, enumOid, oldLabel, newLabel)
+		if lowerRenamed {
+			rangeVal.Lower = newLower
+		}
+		if upperRenamed {
+			rangeVal.Upper = newUpper
+		}
+		return rangeVal, lowerRenamed || upperRenamed
+	}
+	return nil, false
+}
+
+// renameEnumLabelInSlice applies renameEnumLabel to each element of the slice, using the type returned by the given
+// function for each element. The slice is only copied if an element was renamed.
+func renameEnumLabelInSlice(vals []any, elementType func(int) types.DoltgresType, enumOid uint32, oldLabel string, newLabel string) (any, bool) {
+	var newVals []any
+	for i, val := range vals {
+		if newVal, ok := renameEnumLabel(elementType(i), val, enumOid, oldLabel, newLabel); ok {
+			if newVals == nil {
+				newVals = make([]any, len(vals))
+				copy(newVals, vals)
+			}
+			newVals[i] = newVal
+		}
+	}
+	if newVals == nil {
+		return nil, false
+	}
+	return newVals, true
+}
@@ -15,24 +15,27 @@
 package node
 
 import (
-	"bufio"
 	"fmt"
-	"os"
-	"strings"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/transform"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
-	"github.com/sirupsen/logrus"
 
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/core/dataloader"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
-// TODO: Privilege Checking: https://www.postgresql.org/docs/15/sql-copy.html
-
-// CopyFrom handles the COPY ... FROM ... statement.
+// CopyFrom handles the COPY ... FROM ... statement. The data is loaded by the connection handler, using the DataLoader
+// returned by NewDataLoader.
 type CopyFrom struct {
 	DatabaseName string
 	TableName    doltdb.TableName
@@ -40,18 +43,17 @@ type CopyFrom struct {
 	Stdin        bool
 	Columns      tree.NameList
 	CopyOptions  tree.CopyOptions
+	Where        vitess.Expr
 }
 
 var _ vitess.Injectable = (*CopyFrom)(nil)
 var _ sql.ExecSourceRel = (*CopyFrom)(nil)
 
 // NewCopyFrom returns a new *CopyFrom.
-func NewCopyFrom(databaseName string, tableName doltdb.TableName, options tree.CopyOptions, fileName string, stdin bool, columns tree.NameList) *CopyFrom {
+func NewCopyFrom(databaseName string, tableName doltdb.TableName, options tree.CopyOptions, fileName string, stdin bool, columns tree.NameList, where vitess.Expr) *CopyFrom {
 	switch options.CopyFormat {
-	case tree.CopyFormatCsv, tree.CopyFormatText:
+	case tree.CopyFormatCsv, tree.CopyFormatText, tree.CopyFormatBinary:
 		// no-op
-	case tree.CopyFormatBinary:
-		panic("BINARY format is not supported for COPY FROM")
 	default:
 		panic(fmt.Sprintf("unknown COPY FROM format: %d", options.CopyFormat))
 	}
@@ -63,6 +65,7 @@ func NewCopyFrom(databaseName string, tableName doltdb.TableName, options tree.C
 		Stdin:        stdin,
 		Columns:      columns,
 		CopyOptions:  options,
+		Where:        where,
 	}
 }
 
@@ -86,41 +89,183 @@ func (cf *CopyFrom) Resolved() bool {
 //
 // TODO: This validation logic should be hooked into the analyzer so that it can be run in a consistent way.
 func (cf *CopyFrom) Validate(ctx *sql.Context) error {
-	table, err := core.GetSqlTableFromContext(ctx, cf.DatabaseName, cf.TableName)
+	table, err := cf.getTable(ctx)
 	if err != nil {
 		return err
 	}
-	if table == nil {
-		return fmt.Errorf(`relation "%s" does not exist`, cf.TableName.String())
-	}
-	if _, ok := table.(sql.InsertableTable); !ok {
-		return fmt.Errorf(`table "%s" is read-only`, cf.TableName.String())
+	columns, err := cf.columnIndexes(table.Schema())
+	if err != nil {
+		return err
 	}
+	return cf.checkPrivileges(ctx, table, columns)
+}
 
-	// If a set of columns was explicitly specified, validate them
-	if len(cf.Columns) > 0 {
-		if len(table.Schema()) != len(cf.Columns) {
-			return fmt.Errorf("invalid column name list for table %s: %v", table.Name(), cf.Columns)
+// checkPrivileges verifies that the role has the INSERT privilege on the table, or on each of the columns that are
+// loaded. Operations that the server runs on its own behalf are not checked.
+func (cf *CopyFrom) checkPrivileges(ctx *sql.Context, table sql.InsertableTable, columns []int) error {
+	role, isInternal, err := auth.GetSessionRole(ctx)
+	if err != nil || isInternal {
+		return err
+	}
+	schemaName := cf.TableName.Schema
+	if schemaTable, ok := table.(sql.DatabaseSchemaTable); ok {
+		if schemaName, err = core.GetSchemaName(ctx, schemaTable.DatabaseSchema(), schemaName); err != nil {
+			return err
 		}
-
-		for i, col := range table.Schema() {
-			name := cf.Columns[i]
-			nameString := strings.Trim(name.String(), `"`)
-			if nameString != col.Name {
-				return fmt.Errorf("invalid column name list for table %s: %v", table.Name(), cf.Columns)
+	}
+	tableName := doltdb.TableName{Name: table.Name(), Schema: schemaName}
+	sch := table.Schema()
+	if columns == nil {
+		columns = make([]int, len(sch))
+		for i := range sch {
+			columns[i] = i
+		}
+	}
+	var hasPrivileges bool
+	auth.LockRead(func() {
+		if auth.IsSuperUser(role.ID()) {
+			hasPrivileges = true
+			return
+		}
+		public := auth.GetRole("public")
+		if auth.HasTablePrivilege(auth.TablePrivilegeKey{Role: role.ID(), Table: tableName}, auth.Privilege_INSERT) ||
+			auth.HasTablePrivilege(auth.TablePrivilegeKey{Role: public.ID(), Table: tableName}, auth.Privilege_INSERT) {
+			hasPrivileges = true
+			return
+		}
+		for _, columnIdx := range columns {
+			column := sch[columnIdx].Name
+			if !auth.HasColumnPrivilege(auth.ColumnPrivilegeKey{Role: role.ID(), Table: tableName, Column: column}, auth.Privilege_INSERT) &&
+				!auth.HasColumnPrivilege(auth.ColumnPrivilegeKey{Role: public.ID(), Table: tableName, Column: column}, auth.Privilege_INSERT) {
+				return
 			}
 		}
+		hasPrivileges = true
+	})
+	if !hasPrivileges {
+		return pgerror.Newf(pgcode.InsufficientPrivilege, "permission denied for table %s", table.Name())
 	}
+	return cf.checkRowLevelSecurity(ctx, role, tableName)
+}
 
+// checkRowLevelSecurity returns an error if the table's row-level security policies apply to the role, as the loaded
+// rows are not verified against the policies.
+func (cf *CopyFrom) checkRowLevelSecurity(ctx *sql.Context, role auth.Role, tableName doltdb.TableName) error {
+	collection, err := core.GetPoliciesFromDatabase(ctx, cf.DatabaseName)
+	if err != nil {
+		return err
+	}
+	table := collection.GetTable(tableName)
+	if table == nil || !table.RowLevelSecurity {
+		return nil
+	}
+	var applies bool
+	auth.LockRead(func() {
+		if role.IsSuperUser || role.CanBypassRowLevelSecurity {
+			return
+		}
+		// Table owners are not subject to row-level security unless it is forced
+		applies = table.ForceRowLevelSecurity || !auth.HasOwnerAccess(auth.OwnershipKey{
+			PrivilegeObject: auth.PrivilegeObject_TABLE,
+			Schema:          tableName.Schema,
+			Name:            tableName.Name,
+		}, role.ID()).IsValid()
+	})
+	if applies {
+		return pgerror.New(pgcode.FeatureNotSupported, "COPY FROM not supported with row-level security")
+	}
 	return nil
 }
 
-// RowIter implements the interface sql.ExecSourceRel.
-func (cf *CopyFrom) RowIter(ctx *sql.Context, _ sql.Row) (_ sql.RowIter, err error) {
-	if err := cf.Validate(ctx); err != nil {
+// NewDataLoader returns the DataLoader that loads the data into the table. The |catalog| is used to resolve the
+// defaults of the columns that are not loaded, along with the WHERE clause.
+func (cf *CopyFrom) NewDataLoader(ctx *sql.Context, catalog sql.Catalog) (dataloader.DataLoader, error) {
+	table, err := cf.getTable(ctx)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := cf.columnIndexes(table.Schema())
+	if err != nil {
+		return nil, err
+	}
+	target := dataloader.LoadTarget{
+		Table:   table,
+		Schema:  table.Schema(),
+		Columns: columns,
+	}
+	if columns != nil || cf.Where != nil {
+		target.Schema, target.Where, err = cf.resolveExpressions(ctx, catalog, table.Schema(), columns)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The column names are used by the options that apply to specific columns
+	columnNames := make([]string, len(table.Schema()))
+	for i, col := range table.Schema() {
+		columnNames[i] = col.Name
+	}
+	if columns != nil {
+		columnNames = make([]string, len(columns))
+		for i, columnIdx := range columns {
+			columnNames[i] = table.Schema()[columnIdx].Name
+		}
+	}
+	forceColumns := func(names tree.NameList, all bool) []string {
+		if all {
+			return columnNames
+		}
+		forced := make([]string, len(names))
+		for i, name := range names {
+			forced[i] = string(name)
+		}
+		return forced
+	}
+
+	var dataLoader dataloader.DataLoader
+	switch cf.CopyOptions.CopyFormat {
+	case tree.CopyFormatText:
+		dataLoader, err = dataloader.NewTabularDataLoader(ctx, target, cf.CopyOptions.Delimiter, cf.CopyOptions.Null, cf.CopyOptions.Header)
+	case tree.CopyFormatCsv:
+		options := dataloader.CsvOptions{
+			Delimiter:    cf.CopyOptions.Delimiter,
+			Header:       cf.CopyOptions.Header,
+			ForceNotNull: forceColumns(cf.CopyOptions.ForceNotNull, cf.CopyOptions.ForceNotNullAll),
+			ForceNull:    forceColumns(cf.CopyOptions.ForceNull, cf.CopyOptions.ForceNullAll),
+		}
+		if cf.CopyOptions.Null != nil {
+			options.Null = *cf.CopyOptions.Null
+		}
+		if cf.CopyOptions.Quote != nil {
+			options.Quote = *cf.CopyOptions.Quote
+		}
+		if cf.CopyOptions.Escape != nil {
+			options.Escape = *cf.CopyOptions.Escape
+		}
+		dataLoader, err = dataloader.NewCsvDataLoader(ctx, target, options)
+	case tree.CopyFormatBinary:
+		dataLoader, err = dataloader.NewBinaryDataLoader(ctx, target)
+	default:
+		err = fmt.Errorf("unknown format specified for COPY FROM: %v", cf.CopyOptions.CopyFormat)
+	}
+	if err != nil {
 		return nil, err
 	}
 
+	// The binary format has no text to convert, so the encoding only applies to the other formats
+	if cf.CopyOptions.Encoding != nil && cf.CopyOptions.CopyFormat != tree.CopyFormatBinary {
+		encodingLoader, err := dataloader.NewEncodingDataLoader(dataLoader, *cf.CopyOptions.Encoding)
+		if err != nil {
+			_ = dataLoader.Abort(ctx)
+			return nil, err
+		}
+		dataLoader = encodingLoader
+	}
+	return dataLoader, nil
+}
+
+// getTable returns the table that the data is loaded into.
+func (cf *CopyFrom) getTable(ctx *sql.Context) (sql.InsertableTable, error) {
 	table, err := core.GetSqlTableFromContext(ctx, cf.DatabaseName, cf.TableName)
 	if err != nil {
 		return nil, err
@@ -132,41 +277,141 @@ func (cf *CopyFrom) RowIter(ctx *sql.Context, _ sql.Row) (_ sql.RowIter, err err
 	if !ok {
 		return nil, fmt.Errorf(`table "%s" is read-only`, cf.TableName.String())
 	}
+	return insertable, nil
+}
 
-	// Open the file
-	openFile, err := os.Open(cf.File)
-	if openFile == nil || err != nil {
-		return nil, fmt.Errorf(`could not open file "%s" for reading: No such file or directory`, cf.File)
+// columnIndexes returns the index in the schema of each column that is loaded. Returns nil if all columns are loaded,
+// either because no columns were given or because the columns match the schema.
+func (cf *CopyFrom) columnIndexes(sch sql.Schema) ([]int, error) {
+	if len(cf.Columns) == 0 {
+		return nil, nil
 	}
-	defer func() {
-		nErr := openFile.Close()
-		if err == nil {
-			err = nErr
+	indexes := make([]int, len(cf.Columns))
+	seen := make(map[int]struct{}, len(cf.Columns))
+	matchesSchema := len(cf.Columns) == len(sch)
+	for i, column := range cf.Columns {
+		columnName := string(column)
+		indexes[i] = sch.IndexOfColName(columnName)
+		if indexes[i] == -1 {
+			return nil, fmt.Errorf(`column "%s" of relation "%s" does not exist`, columnName, cf.TableName.Name)
 		}
-	}()
-	reader := bufio.NewReader(openFile)
+		if _, ok := seen[indexes[i]]; ok {
+			return nil, fmt.Errorf(`column "%s" specified more than once`, columnName)
+		}
+		seen[indexes[i]] = struct{}{}
+		matchesSchema = matchesSchema && indexes[i] == i
+	}
+	if matchesSchema {
+		return nil, nil
+	}
+	return indexes, nil
+}
 
-	dataLoader, err := dataloader.NewTabularDataLoader(ctx, insertable, cf.CopyOptions.Delimiter, "", cf.CopyOptions.Header)
+// resolveExpressions returns a copy of the schema whose defaults have been resolved for the columns that are not
+// loaded, along with the resolved WHERE expression (which is nil if there is no WHERE clause).
+func (cf *CopyFrom) resolveExpressions(ctx *sql.Context, catalog sql.Catalog, sch sql.Schema, columns []int) (_ sql.Schema, _ sql.Expression, err error) {
+	loaded := make(map[int]struct{}, len(columns))
+	for _, columnIdx := range columns {
+		loaded[columnIdx] = struct{}{}
+	}
+	resolvedSch, err := ResolveColumnDefaults(ctx, catalog, cf.DatabaseName, cf.TableName, sch, func(colIdx int) bool {
+		_, ok := loaded[colIdx]
+		return !ok && columns != nil
+	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if cf.Where == nil {
+		return resolvedSch, nil, nil
 	}
 
-	// NOTE: when loading data from a specified file, there is only one chunk for the entire file
-	if err = dataLoader.LoadChunk(ctx, reader); err != nil {
-		if abortError := dataLoader.Abort(ctx); abortError != nil {
-			logrus.Warnf("unable to cleanly abort data loader: %s", abortError.Error())
+	// The builder panics on errors, so we convert them back into errors
+	defer func() {
+		if r := recover(); r != nil {
+			if recoveredErr, ok := r.(error); ok {
+				err = recoveredErr
+			} else {
+				err = fmt.Errorf("%v", r)
+			}
 		}
-		return nil, err
+	}()
+	builder := planbuilder.New(ctx, catalog, nil, sql.GlobalParser)
+	where := builder.BuildScalarWithTable(cf.Where, columnDefaultTableExpr(cf.DatabaseName, cf.TableName))
+	// The builder does not assign the final field indexes, so we assign them using the schema
+	where, _, err = transform.Expr(where, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		if getField, ok := expr.(*expression.GetField); ok {
+			return getField.WithIndex(resolvedSch.IndexOfColName(getField.Name())), transform.NewTree, nil
+		}
+		return expr, transform.SameTree, nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
+	return resolvedSch, where, nil
+}
 
-	if _, err = dataLoader.Finish(ctx); err != nil {
-		if abortError := dataLoader.Abort(ctx); err != nil {
-			logrus.Warnf("unable to cleanly abort data loader: %s", abortError.Error())
+// ResolveColumnDefaults returns a copy of the schema whose unresolved defaults have been resolved, for each column where
+// |resolve| returns true. Tables that are read from storage have unresolved defaults, which can't be evaluated. Defaults
+// are assigned to the column the same way as inserted values, so they're cast to the column's type when needed.
+func ResolveColumnDefaults(ctx *sql.Context, catalog sql.Catalog, database string, tableName doltdb.TableName, sch sql.Schema, resolve func(colIdx int) bool) (_ sql.Schema, err error) {
+	// The builder panics on errors, so we convert them back into errors
+	defer func() {
+		if r := recover(); r != nil {
+			if recoveredErr, ok := r.(error); ok {
+				err = recoveredErr
+			} else {
+				err = fmt.Errorf("%v", r)
+			}
+		}
+	}()
+
+	builder := planbuilder.New(ctx, catalog, nil, sql.GlobalParser)
+	tableExpr := columnDefaultTableExpr(database, tableName)
+	resolvedSch := sch.Copy()
+	for i, col := range resolvedSch {
+		if col.Default == nil || !resolve(i) {
+			continue
+		}
+		if _, ok := col.Default.Expr.(*sql.UnresolvedColumnDefault); !ok {
+			continue
+		}
+		parsed, err := sql.GlobalParser.ParseSimple(fmt.Sprintf("SELECT %s", col.Default.String()))
+		if err != nil {
+			return nil, err
+		}
+		selectStmt, ok := parsed.(*vitess.Select)
+		if !ok || len(selectStmt.SelectExprs) != 1 {
+			return nil, sql.ErrInvalidColumnDefaultValue.New(col.Default.String())
+		}
+		aliasedExpr, ok := selectStmt.SelectExprs[0].(*vitess.AliasedExpr)
+		if !ok {
+			return nil, sql.ErrInvalidColumnDefaultValue.New(col.Default.String())
+		}
+		col.Default = builder.BuildColumnDefaultValueWithTable(aliasedExpr.Expr, tableExpr, col.Type, col.Nullable)
+		fromType, fromOk := col.Default.Expr.Type().(pgtypes.DoltgresType)
+		toType, toOk := col.Type.(pgtypes.DoltgresType)
+		if fromOk && toOk && !fromType.Equals(toType) {
+			col.Default.Expr = pgexprs.NewAssignmentCast(col.Default.Expr, fromType, toType)
 		}
-		return nil, err
 	}
+	return resolvedSch, nil
+}
 
-	return sql.RowsToRowIter(), nil
+// columnDefaultTableExpr returns the table expression that column defaults and filters are built against.
+func columnDefaultTableExpr(database string, tableName doltdb.TableName) *vitess.AliasedTableExpr {
+	return &vitess.AliasedTableExpr{
+		Expr: vitess.TableName{
+			Name:            vitess.NewTableIdent(tableName.Name),
+			SchemaQualifier: vitess.NewTableIdent(tableName.Schema),
+			DbQualifier:     vitess.NewTableIdent(database),
+		},
+	}
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (cf *CopyFrom) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	// The data must be loaded by the connection handler, so the statement can't be run as a normal node
+	return nil, fmt.Errorf("COPY FROM must be handled by the connection handler")
 }
 
 // Schema implements the interface sql.ExecSourceRel.
@@ -0,0 +1,253 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+)
+
+// TODO: Privilege Checking: https://www.postgresql.org/docs/15/sql-copy.html
+
+// CopyTo handles the COPY ... TO ... statement. Copying a table is represented by a query that selects the table's
+// columns, so the rows to copy always come from the Query. The query is run by the connection handler, which encodes
+// each row using EncodeRow before sending it to the client or writing it to the File.
+type CopyTo struct {
+	Query       vitess.SelectStatement
+	QueryString string
+	File        string
+	Stdout      bool
+	CopyOptions tree.CopyOptions
+	delimiter   byte
+	null        string
+	quote       byte
+	escape      byte
+	forceQuote  map[string]struct{}
+}
+
+var _ vitess.Injectable = (*CopyTo)(nil)
+var _ sql.ExecSourceRel = (*CopyTo)(nil)
+
+// NewCopyTo returns a new *CopyTo. The options are expected to have already been validated.
+func NewCopyTo(query vitess.SelectStatement, queryString string, options tree.CopyOptions, fileName string, stdout bool) *CopyTo {
+	switch options.CopyFormat {
+	case tree.CopyFormatCsv, tree.CopyFormatText:
+		// no-op
+	case tree.CopyFormatBinary:
+		panic("BINARY format is not supported for COPY TO")
+	default:
+		panic(fmt.Sprintf("unknown COPY TO format: %d", options.CopyFormat))
+	}
+
+	ct := &CopyTo{
+		Query:       query,
+		QueryString: queryString,
+		File:        fileName,
+		Stdout:      stdout,
+		CopyOptions: options,
+		delimiter:   '\t',
+		null:        `\N`,
+		quote:       '"',
+		forceQuote:  make(map[string]struct{}),
+	}
+	if options.CopyFormat == tree.CopyFormatCsv {
+		ct.delimiter = ','
+		ct.null = ""
+	}
+	if len(options.Delimiter) > 0 {
+		ct.delimiter = options.Delimiter[0]
+	}
+	if options.Null != nil {
+		ct.null = *options.Null
+	}
+	if options.Quote != nil {
+		ct.quote = (*options.Quote)[0]
+	}
+	ct.escape = ct.quote
+	if options.Escape != nil {
+		ct.escape = (*options.Escape)[0]
+	}
+	for _, column := range options.ForceQuote {
+		ct.forceQuote[string(column)] = struct{}{}
+	}
+	return ct
+}
+
+// ValidateColumns returns an error if the FORCE_QUOTE option references a column that is not being copied.
+func (ct *CopyTo) ValidateColumns(columnNames []string) error {
+	for _, column := range ct.CopyOptions.ForceQuote {
+		found := false
+		for _, columnName := range columnNames {
+			if string(column) == columnName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf(`FORCE_QUOTE column "%s" not referenced by COPY`, string(column))
+		}
+	}
+	return nil
+}
+
+// EncodeHeader returns the header line containing the given column names. Returns nil if the HEADER option was not
+// given.
+func (ct *CopyTo) EncodeHeader(columnNames []string) []byte {
+	if !ct.CopyOptions.Header {
+		return nil
+	}
+	buf := bytes.Buffer{}
+	for i, columnName := range columnNames {
+		if i > 0 {
+			buf.WriteByte(ct.delimiter)
+		}
+		if ct.CopyOptions.CopyFormat == tree.CopyFormatCsv {
+			ct.writeCsvValue(&buf, []byte(columnName), false)
+		} else {
+			ct.writeTextValue(&buf, []byte(columnName))
+		}
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
+
+// EncodeRow returns the line for a row with the given values, which are in their text representation. A nil value
+// represents NULL. The column names must match the ones that the values belong to.
+func (ct *CopyTo) EncodeRow(columnNames []string, values [][]byte) []byte {
+	buf := bytes.Buffer{}
+	for i, value := range values {
+		if i > 0 {
+			buf.WriteByte(ct.delimiter)
+		}
+		if value == nil {
+			buf.WriteString(ct.null)
+		} else if ct.CopyOptions.CopyFormat == tree.CopyFormatCsv {
+			_, forceQuote := ct.forceQuote[columnNames[i]]
+			// A lone end-of-data marker must be quoted so that it's not mistaken for the end of the data
+			forceQuote = forceQuote || ct.CopyOptions.ForceQuoteAll || (len(values) == 1 && string(value) == `\.`)
+			ct.writeCsvValue(&buf, value, forceQuote)
+		} else {
+			ct.writeTextValue(&buf, value)
+		}
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
+
+// writeTextValue writes the value using the escaping rules of the TEXT format.
+func (ct *CopyTo) writeTextValue(buf *bytes.Buffer, value []byte) {
+	for _, c := range value {
+		switch c {
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		case '\v':
+			buf.WriteString(`\v`)
+		case '\\':
+			buf.WriteString(`\\`)
+		default:
+			if c == ct.delimiter {
+				buf.WriteByte('\\')
+			}
+			buf.WriteByte(c)
+		}
+	}
+}
+
+// writeCsvValue writes the value using the quoting rules of the CSV format. The value is quoted when |forceQuote| is
+// true, or when the value would otherwise be ambiguous.
+func (ct *CopyTo) writeCsvValue(buf *bytes.Buffer, value []byte, forceQuote bool) {
+	// A value matching the NULL string must be quoted to differentiate it from NULL
+	useQuote := forceQuote || string(value) == ct.null
+	if !useQuote {
+		for _, c := range value {
+			if c == ct.delimiter || c == ct.quote || c == '\n' || c == '\r' {
+				useQuote = true
+				break
+			}
+		}
+	}
+	if !useQuote {
+		buf.Write(value)
+		return
+	}
+	buf.WriteByte(ct.quote)
+	for _, c := range value {
+		if c == ct.quote || c == ct.escape {
+			buf.WriteByte(ct.escape)
+		}
+		buf.WriteByte(c)
+	}
+	buf.WriteByte(ct.quote)
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) IsReadOnly() bool {
+	return true
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	// The query's rows must be streamed by the connection handler, so the statement can't be run as a normal node
+	return nil, fmt.Errorf("COPY TO must be handled by the connection handler")
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) String() string {
+	return "COPY TO"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(ct, len(children), 0)
+	}
+	return ct, nil
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (ct *CopyTo) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return ct, nil
+}
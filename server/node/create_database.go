@@ -0,0 +1,100 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	"github.com/dolthub/doltgresql/server/auth"
+)
+
+// CreateDatabase handles the CREATE DATABASE statement, along with recording the database's connection settings.
+type CreateDatabase struct {
+	Name        string
+	IfNotExists bool
+	Setting     auth.DatabaseSetting // ALLOW_CONNECTIONS and CONNECTION LIMIT
+}
+
+var _ sql.ExecSourceRel = (*CreateDatabase)(nil)
+var _ vitess.Injectable = (*CreateDatabase)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	provider := dsess.DSessFromSess(ctx.Session).Provider()
+	if provider.HasDatabase(ctx, c.Name) {
+		if c.IfNotExists {
+			return sql.RowsToRowIter(sql.Row{types.NewOkResult(0)}), nil
+		}
+		return nil, pgerror.Newf(pgcode.DuplicateDatabase, `database "%s" already exists`, c.Name)
+	}
+	if err := provider.CreateDatabase(ctx, c.Name); err != nil {
+		return nil, err
+	}
+
+	var err error
+	auth.LockWrite(func() {
+		// Settings may remain from a dropped database with the same name, so we always overwrite them
+		auth.SetDatabaseSetting(c.Name, c.Setting)
+		err = auth.PersistChanges()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{types.NewOkResult(1)}), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) String() string {
+	return "CREATE DATABASE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateDatabase) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
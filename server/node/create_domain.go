@@ -104,6 +104,7 @@ func (c *CreateDomain) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error)
 			Schema:          schema,
 			Name:            c.Name,
 		}, userRole.ID())
+		auth.ApplyDefaultPrivileges(auth.PrivilegeObject_TYPE, schema, c.Name, userRole.ID())
 		err = auth.PersistChanges()
 	})
 	if err != nil {
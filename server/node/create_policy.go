@@ -0,0 +1,193 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/policies"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	"github.com/dolthub/doltgresql/server/auth"
+)
+
+// CreatePolicy handles the CREATE POLICY statement.
+type CreatePolicy struct {
+	Database    string
+	SchemaName  string
+	Table       string
+	Name        string
+	Command     policies.Command
+	Restrictive bool
+	Roles       []string
+	Using       string
+	WithCheck   string
+	Columns     []string // The columns that are referenced by the USING and WITH CHECK expressions
+}
+
+var _ sql.ExecSourceRel = (*CreatePolicy)(nil)
+var _ vitess.Injectable = (*CreatePolicy)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if (c.Command == policies.Command_Select || c.Command == policies.Command_Delete) && len(c.WithCheck) > 0 {
+		return nil, pgerror.New(pgcode.Syntax, "WITH CHECK cannot be applied to SELECT or DELETE")
+	}
+	if c.Command == policies.Command_Insert && len(c.Using) > 0 {
+		return nil, pgerror.New(pgcode.Syntax, "only WITH CHECK expression allowed for INSERT")
+	}
+	tableName, err := verifyPolicyTable(ctx, c.Database, c.SchemaName, c.Table, c.Columns)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := resolvePolicyRoles(ctx, c.Roles)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := core.GetPoliciesCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = collection.CreatePolicy(tableName, &policies.Policy{
+		Name:        c.Name,
+		Command:     c.Command,
+		Restrictive: c.Restrictive,
+		Roles:       roles,
+		Using:       c.Using,
+		WithCheck:   c.WithCheck,
+	}); err != nil {
+		return nil, pgerror.New(pgcode.DuplicateObject, err.Error())
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) String() string {
+	return "CREATE POLICY"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreatePolicy) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreatePolicy) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
+
+// verifyPolicyTable verifies that the given table exists within the current database, that the current user owns the
+// table, and that every given column exists within the table. Returns the fully-qualified name of the table.
+func verifyPolicyTable(ctx *sql.Context, database string, schema string, table string, columns []string) (doltdb.TableName, error) {
+	if len(database) > 0 && database != ctx.GetCurrentDatabase() {
+		return doltdb.TableName{}, fmt.Errorf("policies are currently only supported for the current database")
+	}
+	schemaName, err := core.GetSchemaName(ctx, nil, schema)
+	if err != nil {
+		return doltdb.TableName{}, err
+	}
+	tableName := doltdb.TableName{Name: table, Schema: schemaName}
+	sqlTable, err := core.GetSqlTableFromContext(ctx, "", tableName)
+	if err != nil {
+		return doltdb.TableName{}, err
+	}
+	if sqlTable == nil {
+		return doltdb.TableName{}, pgerror.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, table)
+	}
+	tableName.Name = sqlTable.Name()
+	sch := sqlTable.Schema()
+	for _, column := range columns {
+		if sch.IndexOfColName(column) < 0 {
+			return doltdb.TableName{}, pgerror.Newf(pgcode.UndefinedColumn, `column "%s" does not exist`, column)
+		}
+	}
+
+	var userRole auth.Role
+	var owner auth.RoleID
+	auth.LockRead(func() {
+		userRole = auth.GetRole(ctx.Client().User)
+		if userRole.IsValid() {
+			owner = auth.HasOwnerAccess(auth.OwnershipKey{
+				PrivilegeObject: auth.PrivilegeObject_TABLE,
+				Schema:          schemaName,
+				Name:            tableName.Name,
+			}, userRole.ID())
+		}
+	})
+	if !userRole.IsValid() {
+		return doltdb.TableName{}, fmt.Errorf(`role "%s" does not exist`, ctx.Client().User)
+	}
+	if !owner.IsValid() {
+		return doltdb.TableName{}, pgerror.Newf(pgcode.InsufficientPrivilege, `must be owner of table %s`, tableName.Name)
+	}
+	return tableName, nil
+}
+
+// resolvePolicyRoles verifies that each role exists, replacing the special role names with the roles that they
+// represent. A policy without any roles applies to the public role.
+func resolvePolicyRoles(ctx *sql.Context, roleNames []string) ([]string, error) {
+	if len(roleNames) == 0 {
+		return []string{"public"}, nil
+	}
+	roles := make([]string, len(roleNames))
+	for i, roleName := range roleNames {
+		switch strings.ToLower(roleName) {
+		case "current_user", "current_role", "session_user":
+			roleName = ctx.Client().User
+		case "public":
+			roles[i] = "public"
+			continue
+		}
+		var role auth.Role
+		auth.LockRead(func() {
+			role = auth.GetRole(roleName)
+		})
+		if !role.IsValid() {
+			return nil, pgerror.Newf(pgcode.UndefinedObject, `role "%s" does not exist`, roleName)
+		}
+		roles[i] = role.Name
+	}
+	return roles, nil
+}
@@ -135,11 +135,12 @@ func (c *CreateSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, erro
 		return nil, err
 	}
 	auth.LockWrite(func() {
-		auth.RemoveOwner(auth.OwnershipKey{
+		auth.AddOwner(auth.OwnershipKey{
 			PrivilegeObject: auth.PrivilegeObject_SEQUENCE,
 			Schema:          schema,
 			Name:            c.sequence.Name,
 		}, userRole.ID())
+		auth.ApplyDefaultPrivileges(auth.PrivilegeObject_SEQUENCE, schema, c.sequence.Name, userRole.ID())
 		err = auth.PersistChanges()
 	})
 	if err != nil {
@@ -22,7 +22,10 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/rowexec"
 
 	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
 	"github.com/dolthub/doltgresql/server/auth"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
 // CreateTable is a node that implements functionality specifically relevant to Doltgres' table creation needs.
@@ -65,6 +68,9 @@ func (c *CreateTable) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error)
 	if !userRole.IsValid() {
 		return nil, fmt.Errorf(`role "%s" does not exist`, ctx.Client().User)
 	}
+	if err := c.checkTypePrivileges(userRole); err != nil {
+		return nil, err
+	}
 
 	createTableIter, err := rowexec.DefaultBuilder.Build(ctx, c.gmsCreateTable, r)
 	if err != nil {
@@ -90,6 +96,7 @@ func (c *CreateTable) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error)
 			Schema:          schemaName,
 			Name:            c.gmsCreateTable.Name(),
 		}, userRole.ID())
+		auth.ApplyDefaultPrivileges(auth.PrivilegeObject_TABLE, schemaName, c.gmsCreateTable.Name(), userRole.ID())
 		err = auth.PersistChanges()
 	})
 	if err != nil {
@@ -98,6 +105,40 @@ func (c *CreateTable) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error)
 	return createTableIter, err
 }
 
+// checkTypePrivileges verifies that the role has the USAGE privilege on the type of each column. All roles may use types
+// by default, so this only applies once the default has been revoked from some type.
+func (c *CreateTable) checkTypePrivileges(userRole auth.Role) error {
+	var deniedType string
+	auth.LockRead(func() {
+		if !auth.TypeDefaultsRevoked() {
+			return
+		}
+		public := auth.GetRole("public")
+		for _, column := range c.gmsCreateTable.PkSchema().Schema {
+			var key auth.TypePrivilegeKey
+			switch typ := column.Type.(type) {
+			case pgtypes.DomainType:
+				key = auth.TypePrivilegeKey{Schema: typ.Schema, Name: typ.Name}
+			case pgtypes.DoltgresType:
+				key = auth.TypePrivilegeKey{Schema: "pg_catalog", Name: typ.BaseName()}
+			default:
+				continue
+			}
+			roleKey, publicKey := key, key
+			roleKey.Role = userRole.ID()
+			publicKey.Role = public.ID()
+			if !auth.HasTypePrivilege(roleKey, auth.Privilege_USAGE) && !auth.HasTypePrivilege(publicKey, auth.Privilege_USAGE) {
+				deniedType = key.Name
+				return
+			}
+		}
+	})
+	if len(deniedType) > 0 {
+		return pgerror.Newf(pgcode.InsufficientPrivilege, "permission denied for type %s", deniedType)
+	}
+	return nil
+}
+
 // Schema implements the interface sql.ExecSourceRel.
 func (c *CreateTable) Schema() sql.Schema {
 	return c.gmsCreateTable.Schema()
@@ -0,0 +1,241 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/types"
+)
+
+// maxEnumLabelLength is the maximum length of an enum label in bytes, which matches NAMEDATALEN-1 in Postgres.
+const maxEnumLabelLength = 63
+
+// CreateType handles the CREATE TYPE statement.
+type CreateType struct {
+	SchemaName string
+	Name       string
+	TypType    types.TypeType
+	EnumLabels []string
+	// CompositeAttrs may contain unresolved types, which are resolved by the analyzer before execution.
+	CompositeAttrs []types.CompositeAttribute
+	// RangeSubType may be an unresolved type, which is resolved by the analyzer before execution.
+	RangeSubType types.DoltgresType
+}
+
+var _ sql.ExecSourceRel = (*CreateType)(nil)
+var _ vitess.Injectable = (*CreateType)(nil)
+
+// NewCreateEnumType returns a new *CreateType for an enum type.
+func NewCreateEnumType(schema string, name string, labels []string) *CreateType {
+	return &CreateType{
+		SchemaName: schema,
+		Name:       name,
+		TypType:    types.TypeType_Enum,
+		EnumLabels: labels,
+	}
+}
+
+// NewCreateCompositeType returns a new *CreateType for a composite type.
+func NewCreateCompositeType(schema string, name string, attrs []types.CompositeAttribute) *CreateType {
+	return &CreateType{
+		SchemaName:     schema,
+		Name:           name,
+		TypType:        types.TypeType_Composite,
+		CompositeAttrs: attrs,
+	}
+}
+
+// NewCreateRangeType returns a new *CreateType for a range type.
+func NewCreateRangeType(schema string, name string, subType types.DoltgresType) *CreateType {
+	return &CreateType{
+		SchemaName:   schema,
+		Name:         name,
+		TypType:      types.TypeType_Range,
+		RangeSubType: subType,
+	}
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateType) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateType) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateType) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateType) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	var userRole auth.Role
+	auth.LockRead(func() {
+		userRole = auth.GetRole(ctx.Client().User)
+	})
+	if !userRole.IsValid() {
+		return nil, fmt.Errorf(`role "%s" does not exist`, ctx.Client().User)
+	}
+
+	schema, err := core.GetSchemaName(ctx, nil, c.SchemaName)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := core.GetTypesCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, exists := collection.GetType(schema, c.Name); exists {
+		return nil, types.ErrTypeAlreadyExists.New(c.Name)
+	}
+
+	var newType *types.Type
+	switch c.TypType {
+	case types.TypeType_Enum:
+		if err = validateEnumLabels(c.EnumLabels); err != nil {
+			return nil, err
+		}
+		// The type and its array type take the first two OIDs, followed by each label
+		typeOid := collection.NextOid()
+		labels := make([]types.EnumLabel, len(c.EnumLabels))
+		for i, label := range c.EnumLabels {
+			labels[i] = types.EnumLabel{
+				Oid:       typeOid + 2 + uint32(i),
+				SortOrder: float32(i + 1),
+				Label:     label,
+			}
+		}
+		newType = types.NewEnumType(schema, c.Name, typeOid, typeOid+1, labels, "")
+	case types.TypeType_Composite:
+		if err = validateCompositeAttrs(c.CompositeAttrs); err != nil {
+			return nil, err
+		}
+		// The type, its array type, and its relation take the next three OIDs
+		typeOid := collection.NextOid()
+		newType = types.NewCompositeType(schema, c.Name, typeOid, typeOid+1, typeOid+2, c.CompositeAttrs, "")
+	case types.TypeType_Range:
+		if err = validateRangeSubType(c.RangeSubType); err != nil {
+			return nil, err
+		}
+		// The type and its array type take the next two OIDs
+		typeOid := collection.NextOid()
+		newType = types.NewRangeType(schema, c.Name, typeOid, typeOid+1, c.RangeSubType, "")
+	default:
+		return nil, fmt.Errorf("CREATE TYPE is not yet supported for this kind of type")
+	}
+	if err = collection.CreateType(schema, newType); err != nil {
+		return nil, err
+	}
+
+	auth.LockWrite(func() {
+		auth.AddOwner(auth.OwnershipKey{
+			PrivilegeObject: auth.PrivilegeObject_TYPE,
+			Schema:          schema,
+			Name:            c.Name,
+		}, userRole.ID())
+		auth.ApplyDefaultPrivileges(auth.PrivilegeObject_TYPE, schema, c.Name, userRole.ID())
+		err = auth.PersistChanges()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateType) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateType) String() string {
+	return "CREATE TYPE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateType) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateType) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
+
+// validateEnumLabels returns an error if any of the given labels are invalid, or if a label is used more than once.
+func validateEnumLabels(labels []string) error {
+	seen := make(map[string]struct{}, len(labels))
+	for _, label := range labels {
+		if err := validateEnumLabel(label); err != nil {
+			return err
+		}
+		if _, ok := seen[label]; ok {
+			return fmt.Errorf(`enum label "%s" used more than once`, label)
+		}
+		seen[label] = struct{}{}
+	}
+	return nil
+}
+
+// validateEnumLabel returns an error if the given label cannot be used as an enum label.
+func validateEnumLabel(label string) error {
+	if len(label) > maxEnumLabelLength {
+		return fmt.Errorf(`invalid enum label "%s"`, label)
+	}
+	return nil
+}
+
+// validateCompositeAttrs returns an error if any of the given attributes are invalid, or if an attribute name is used
+// more than once.
+func validateCompositeAttrs(attrs []types.CompositeAttribute) error {
+	seen := make(map[string]struct{}, len(attrs))
+	for _, attr := range attrs {
+		if _, ok := attr.Type.(types.ResolvableType); ok {
+			return fmt.Errorf(`type of attribute "%s" has not been resolved`, attr.Name)
+		}
+		if _, ok := seen[attr.Name]; ok {
+			return fmt.Errorf(`column "%s" specified more than once`, attr.Name)
+		}
+		seen[attr.Name] = struct{}{}
+	}
+	return nil
+}
+
+// validateRangeSubType returns an error if the given type cannot be used as the subtype of a range type.
+func validateRangeSubType(subType types.DoltgresType) error {
+	switch subType.(type) {
+	case types.ResolvableType:
+		return fmt.Errorf("range subtype has not been resolved")
+	case types.DoltgresPolymorphicType:
+		return fmt.Errorf("range subtype cannot be %s", subType.String())
+	}
+	if subType.BaseID() == types.DoltgresTypeBaseID_Unknown {
+		return fmt.Errorf("range subtype cannot be %s", subType.String())
+	}
+	return nil
+}
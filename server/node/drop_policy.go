@@ -0,0 +1,98 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+)
+
+// DropPolicy handles the DROP POLICY statement.
+type DropPolicy struct {
+	Database   string
+	SchemaName string
+	Table      string
+	Name       string
+	IfExists   bool
+}
+
+var _ sql.ExecSourceRel = (*DropPolicy)(nil)
+var _ vitess.Injectable = (*DropPolicy)(nil)
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	tableName, err := verifyPolicyTable(ctx, c.Database, c.SchemaName, c.Table, nil)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := core.GetPoliciesCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if collection.GetPolicy(tableName, c.Name) == nil {
+		if c.IfExists {
+			// TODO: issue a notice
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, pgerror.Newf(pgcode.UndefinedObject, `policy "%s" for table "%s" does not exist`, c.Name, tableName.Name)
+	}
+	if err = collection.DropPolicy(tableName, c.Name); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) String() string {
+	return "DROP POLICY"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropPolicy) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropPolicy) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/types"
+)
+
+// DropType handles the DROP TYPE statement.
+type DropType struct {
+	database string
+	schema   string
+	typeName string
+	ifExists bool
+	cascade  bool
+}
+
+var _ sql.ExecSourceRel = (*DropType)(nil)
+var _ vitess.Injectable = (*DropType)(nil)
+
+// NewDropType returns a new *DropType.
+func NewDropType(ifExists bool, db string, schema string, typeName string, cascade bool) *DropType {
+	return &DropType{
+		database: db,
+		schema:   schema,
+		typeName: typeName,
+		ifExists: ifExists,
+		cascade:  cascade,
+	}
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropType) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropType) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropType) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropType) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	var userRole auth.Role
+	auth.LockRead(func() {
+		userRole = auth.GetRole(ctx.Client().User)
+	})
+	if !userRole.IsValid() {
+		return nil, fmt.Errorf(`role "%s" does not exist`, ctx.Client().User)
+	}
+
+	currentDb := ctx.GetCurrentDatabase()
+	if len(c.database) > 0 && c.database != currentDb {
+		return nil, fmt.Errorf("DROP TYPE is currently only supported for the current database")
+	}
+	schema, err := core.GetSchemaName(ctx, nil, c.schema)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := core.GetTypesCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typ, exists := collection.GetType(schema, c.typeName)
+	if !exists {
+		if c.ifExists {
+			// TODO: issue a notice
+			return sql.RowsToRowIter(), nil
+		} else {
+			return nil, types.ErrTypeDoesNotExist.New(c.typeName)
+		}
+	}
+	if c.cascade {
+		// TODO: handle cascade
+		return nil, fmt.Errorf(`cascading type drops are not yet supported`)
+	}
+
+	// iterate on all table columns to check if this type is currently used.
+	db, err := core.GetSqlDatabaseFromContext(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	tableNames, err := db.GetTableNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, tableName := range tableNames {
+		t, ok, err := db.GetTableInsensitive(ctx, tableName)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			for _, col := range t.Schema() {
+				if typeDependsOn(col.Type, typ) {
+					// TODO: issue a detail (list of all columns and tables that uses this type)
+					//  and a hint (when we support CASCADE)
+					return nil, fmt.Errorf(`cannot drop type %s because other objects depend on it - column %s of table %s depends on type %s'`, c.typeName, col.Name, t.Name(), c.typeName)
+				}
+			}
+		}
+	}
+
+	// composite types may also use this type for their attributes, range types for their subtype, and domains for their
+	// base type
+	err = collection.IterateTypes(func(_ string, otherType *types.Type) error {
+		if otherType.TypType == types.TypeType_Domain && otherType.BaseTypeOID == typ.Oid && typ.TypType != types.TypeType_Domain {
+			return fmt.Errorf(`cannot drop type %s because other objects depend on it - type %s depends on type %s`, c.typeName, otherType.Name, c.typeName)
+		}
+		for _, attr := range otherType.CompositeAttrs {
+			if typeDependsOn(attr.Type, typ) {
+				return fmt.Errorf(`cannot drop type %s because other objects depend on it - column %s of composite type %s depends on type %s`, c.typeName, attr.Name, otherType.Name, c.typeName)
+			}
+		}
+		if otherType.RangeSubType != nil && typeDependsOn(otherType.RangeSubType, typ) {
+			return fmt.Errorf(`cannot drop type %s because other objects depend on it - type %s depends on type %s`, c.typeName, otherType.Name, c.typeName)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	privilegeObject := auth.PrivilegeObject_TYPE
+	if typ.TypType == types.TypeType_Domain {
+		privilegeObject = auth.PrivilegeObject_DOMAIN
+	}
+	if err = collection.DropType(schema, c.typeName); err != nil {
+		return nil, err
+	}
+	auth.LockWrite(func() {
+		auth.RemoveOwner(auth.OwnershipKey{
+			PrivilegeObject: privilegeObject,
+			Schema:          schema,
+			Name:            c.typeName,
+		}, userRole.ID())
+		err = auth.PersistChanges()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropType) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropType) String() string {
+	return "DROP TYPE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropType) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropType) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, ErrVitessChildCount.New(0, len(children))
+	}
+	return c, nil
+}
+
+// typeDependsOn returns whether the given column type is the given user-defined type, is an array of it, or is a
+// composite or range type that depends on it.
+func typeDependsOn(colType sql.Type, typ *types.Type) bool {
+	if arrayType, ok := colType.(types.DoltgresArrayType); ok {
+		colType = arrayType.BaseType()
+	}
+	switch colType := colType.(type) {
+	case types.DomainType:
+		return (colType.Schema == typ.Schema && colType.Name == typ.Name) || typeDependsOn(colType.AsType, typ)
+	case types.EnumType:
+		return colType.Oid == typ.Oid
+	case types.CompositeType:
+		if colType.Oid == typ.Oid {
+			return true
+		}
+		for _, attr := range colType.Attributes {
+			if typeDependsOn(attr.Type, typ) {
+				return true
+			}
+		}
+		return false
+	case types.RangeType:
+		return colType.Oid == typ.Oid || typeDependsOn(colType.SubType, typ)
+	default:
+		return false
+	}
+}
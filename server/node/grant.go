@@ -17,6 +17,7 @@ package node
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/go-mysql-server/sql"
@@ -25,13 +26,19 @@ import (
 
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
 // Grant handles all of the GRANT statements.
 type Grant struct {
 	GrantTable      *GrantTable
+	GrantColumn     *GrantColumn
 	GrantSchema     *GrantSchema
 	GrantDatabase   *GrantDatabase
+	GrantSequence   *GrantSequence
+	GrantFunction   *GrantFunction
+	GrantType       *GrantType
 	GrantRole       *GrantRole
 	ToRoles         []string
 	WithGrantOption bool // This is "WITH ADMIN OPTION" for GrantRole only
@@ -44,6 +51,18 @@ type GrantTable struct {
 	Tables     []doltdb.TableName
 }
 
+// GrantColumn specifically handles the GRANT <privilege> (<columns>) ON TABLE statement.
+type GrantColumn struct {
+	Privileges []ColumnPrivileges
+	Tables     []doltdb.TableName
+}
+
+// ColumnPrivileges is a privilege, along with the columns that the privilege applies to.
+type ColumnPrivileges struct {
+	Privilege auth.Privilege
+	Columns   []string
+}
+
 // GrantSchema specifically handles the GRANT ... ON SCHEMA statement.
 type GrantSchema struct {
 	Privileges []auth.Privilege
@@ -56,6 +75,33 @@ type GrantDatabase struct {
 	Databases  []string
 }
 
+// GrantSequence specifically handles the GRANT ... ON SEQUENCE statement. An empty sequence name refers to all
+// sequences in the schema.
+type GrantSequence struct {
+	Privileges []auth.Privilege
+	Sequences  []doltdb.TableName
+}
+
+// GrantFunction specifically handles the GRANT ... ON { FUNCTION | PROCEDURE | ROUTINE } statement. An empty function
+// name refers to all functions in the schema.
+type GrantFunction struct {
+	Privileges []auth.Privilege
+	Functions  []FunctionTarget
+}
+
+// FunctionTarget is a function that is given to GRANT or REVOKE. When the argument types are not given, the name must
+// refer to a single overload.
+type FunctionTarget struct {
+	Name      doltdb.TableName
+	Arguments []pgtypes.DoltgresType
+}
+
+// GrantType specifically handles the GRANT ... ON { TYPE | DOMAIN } statement.
+type GrantType struct {
+	Privileges []auth.Privilege
+	Types      []doltdb.TableName
+}
+
 // GrantRole specifically handles the GRANT <roles> TO <roles> statement.
 type GrantRole struct {
 	Groups []string
@@ -88,6 +134,10 @@ func (g *Grant) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
 			if err = g.grantTable(ctx); err != nil {
 				return
 			}
+		case g.GrantColumn != nil:
+			if err = g.grantColumn(ctx); err != nil {
+				return
+			}
 		case g.GrantSchema != nil:
 			if err = g.grantSchema(ctx); err != nil {
 				return
@@ -96,6 +146,18 @@ func (g *Grant) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
 			if err = g.grantDatabase(ctx); err != nil {
 				return
 			}
+		case g.GrantSequence != nil:
+			if err = g.grantSequence(ctx); err != nil {
+				return
+			}
+		case g.GrantFunction != nil:
+			if err = g.grantFunction(ctx); err != nil {
+				return
+			}
+		case g.GrantType != nil:
+			if err = g.grantType(ctx); err != nil {
+				return
+			}
 		case g.GrantRole != nil:
 			if err = g.grantRole(ctx); err != nil {
 				return
@@ -122,6 +184,14 @@ func (g *Grant) String() string {
 	switch {
 	case g.GrantTable != nil:
 		return "GRANT TABLE"
+	case g.GrantColumn != nil:
+		return "GRANT COLUMN"
+	case g.GrantSequence != nil:
+		return "GRANT SEQUENCE"
+	case g.GrantFunction != nil:
+		return "GRANT FUNCTION"
+	case g.GrantType != nil:
+		return "GRANT TYPE"
 	default:
 		return "GRANT"
 	}
@@ -204,6 +274,48 @@ func (g *Grant) grantTable(ctx *sql.Context) error {
 	return nil
 }
 
+// grantColumn handles *GrantColumn from within RowIter.
+func (g *Grant) grantColumn(ctx *sql.Context) error {
+	roles, userRole, err := g.common(ctx)
+	if err != nil {
+		return err
+	}
+	for _, table := range g.GrantColumn.Tables {
+		schemaName, err := core.GetSchemaName(ctx, nil, table.Schema)
+		if err != nil {
+			return err
+		}
+		tableName := doltdb.TableName{Name: table.Name, Schema: schemaName}
+		if err = verifyColumnsExist(ctx, tableName, g.GrantColumn.Privileges); err != nil {
+			return err
+		}
+		for _, role := range roles {
+			for _, columnPrivileges := range g.GrantColumn.Privileges {
+				for _, column := range columnPrivileges.Columns {
+					grantedBy := auth.HasColumnPrivilegeGrantOption(auth.ColumnPrivilegeKey{
+						Role:   userRole.ID(),
+						Table:  tableName,
+						Column: column,
+					}, columnPrivileges.Privilege)
+					if !grantedBy.IsValid() {
+						// TODO: grab the actual error message
+						return fmt.Errorf(`role "%s" does not have permission to grant this privilege`, userRole.Name)
+					}
+					auth.AddColumnPrivilege(auth.ColumnPrivilegeKey{
+						Role:   role.ID(),
+						Table:  tableName,
+						Column: column,
+					}, auth.GrantedPrivilege{
+						Privilege: columnPrivileges.Privilege,
+						GrantedBy: grantedBy,
+					}, g.WithGrantOption)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // grantSchema handles *GrantSchema from within RowIter.
 func (g *Grant) grantSchema(ctx *sql.Context) error {
 	roles, userRole, err := g.common(ctx)
@@ -266,6 +378,112 @@ func (g *Grant) grantDatabase(ctx *sql.Context) error {
 	return nil
 }
 
+// grantSequence handles *GrantSequence from within RowIter.
+func (g *Grant) grantSequence(ctx *sql.Context) error {
+	roles, userRole, err := g.common(ctx)
+	if err != nil {
+		return err
+	}
+	for _, sequence := range g.GrantSequence.Sequences {
+		sequenceName, err := resolveSequenceName(ctx, sequence)
+		if err != nil {
+			return err
+		}
+		key := auth.SequencePrivilegeKey{
+			Role:     userRole.ID(),
+			Sequence: sequenceName,
+		}
+		for _, role := range roles {
+			for _, privilege := range g.GrantSequence.Privileges {
+				grantedBy := auth.HasSequencePrivilegeGrantOption(key, privilege)
+				if !grantedBy.IsValid() {
+					// TODO: grab the actual error message
+					return fmt.Errorf(`role "%s" does not have permission to grant this privilege`, userRole.Name)
+				}
+				auth.AddSequencePrivilege(auth.SequencePrivilegeKey{
+					Role:     role.ID(),
+					Sequence: sequenceName,
+				}, auth.GrantedPrivilege{
+					Privilege: privilege,
+					GrantedBy: grantedBy,
+				}, g.WithGrantOption)
+			}
+		}
+	}
+	return nil
+}
+
+// grantFunction handles *GrantFunction from within RowIter.
+func (g *Grant) grantFunction(ctx *sql.Context) error {
+	roles, userRole, err := g.common(ctx)
+	if err != nil {
+		return err
+	}
+	for _, function := range g.GrantFunction.Functions {
+		key, err := resolveFunction(ctx, function)
+		if err != nil {
+			return err
+		}
+		key.Role = userRole.ID()
+		for _, role := range roles {
+			for _, privilege := range g.GrantFunction.Privileges {
+				grantedBy := auth.HasFunctionPrivilegeGrantOption(key, privilege)
+				if !grantedBy.IsValid() {
+					// TODO: grab the actual error message
+					return fmt.Errorf(`role "%s" does not have permission to grant this privilege`, userRole.Name)
+				}
+				auth.AddFunctionPrivilege(auth.FunctionPrivilegeKey{
+					Role:      role.ID(),
+					Schema:    key.Schema,
+					Name:      key.Name,
+					Arguments: key.Arguments,
+				}, auth.GrantedPrivilege{
+					Privilege: privilege,
+					GrantedBy: grantedBy,
+				}, g.WithGrantOption)
+			}
+		}
+	}
+	return nil
+}
+
+// grantType handles *GrantType from within RowIter.
+func (g *Grant) grantType(ctx *sql.Context) error {
+	roles, userRole, err := g.common(ctx)
+	if err != nil {
+		return err
+	}
+	for _, typ := range g.GrantType.Types {
+		typeName, err := resolveTypeName(ctx, typ)
+		if err != nil {
+			return err
+		}
+		key := auth.TypePrivilegeKey{
+			Role:   userRole.ID(),
+			Schema: typeName.Schema,
+			Name:   typeName.Name,
+		}
+		for _, role := range roles {
+			for _, privilege := range g.GrantType.Privileges {
+				grantedBy := auth.HasTypePrivilegeGrantOption(key, privilege)
+				if !grantedBy.IsValid() {
+					// TODO: grab the actual error message
+					return fmt.Errorf(`role "%s" does not have permission to grant this privilege`, userRole.Name)
+				}
+				auth.AddTypePrivilege(auth.TypePrivilegeKey{
+					Role:   role.ID(),
+					Schema: typeName.Schema,
+					Name:   typeName.Name,
+				}, auth.GrantedPrivilege{
+					Privilege: privilege,
+					GrantedBy: grantedBy,
+				}, g.WithGrantOption)
+			}
+		}
+	}
+	return nil
+}
+
 // grantRole handles *GrantRole from within RowIter.
 func (g *Grant) grantRole(ctx *sql.Context) error {
 	members, userRole, err := g.common(ctx)
@@ -291,3 +509,142 @@ func (g *Grant) grantRole(ctx *sql.Context) error {
 	}
 	return nil
 }
+
+// verifyColumnsExist verifies that the given table exists, and that it contains every column in the given privileges.
+func verifyColumnsExist(ctx *sql.Context, tableName doltdb.TableName, privileges []ColumnPrivileges) error {
+	table, err := core.GetSqlTableFromContext(ctx, "", tableName)
+	if err != nil {
+		return err
+	}
+	if table == nil {
+		return fmt.Errorf(`relation "%s" does not exist`, tableName.Name)
+	}
+	schema := table.Schema()
+	for _, columnPrivileges := range privileges {
+		for _, column := range columnPrivileges.Columns {
+			if schema.IndexOfColName(column) < 0 {
+				return fmt.Errorf(`column "%s" of relation "%s" does not exist`, column, tableName.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveSequenceName returns the sequence name with its schema resolved. An empty sequence name refers to all
+// sequences in the schema, so only the schema is verified. Otherwise, this verifies that the sequence exists.
+func resolveSequenceName(ctx *sql.Context, sequence doltdb.TableName) (doltdb.TableName, error) {
+	schemaName, err := core.GetSchemaName(ctx, nil, sequence.Schema)
+	if err != nil {
+		return doltdb.TableName{}, err
+	}
+	sequenceName := doltdb.TableName{Name: sequence.Name, Schema: schemaName}
+	if len(sequenceName.Name) == 0 {
+		return sequenceName, nil
+	}
+	collection, err := core.GetSequencesCollectionFromContext(ctx)
+	if err != nil {
+		return doltdb.TableName{}, err
+	}
+	if collection.GetSequence(sequenceName) == nil {
+		return doltdb.TableName{}, fmt.Errorf(`relation "%s" does not exist`, sequence.Name)
+	}
+	return sequenceName, nil
+}
+
+// resolveFunction returns the key for the given function, with its schema and overload resolved. The role of the key
+// is left unset. All functions are currently built-in, and therefore reside in pg_catalog. An empty function name
+// refers to all functions in the schema.
+func resolveFunction(ctx *sql.Context, function FunctionTarget) (auth.FunctionPrivilegeKey, error) {
+	if len(function.Name.Name) == 0 {
+		schemaName, err := core.GetSchemaName(ctx, nil, function.Name.Schema)
+		if err != nil {
+			return auth.FunctionPrivilegeKey{}, err
+		}
+		return auth.FunctionPrivilegeKey{Schema: schemaName}, nil
+	}
+	functionName := strings.ToLower(function.Name.Name)
+	overloads, ok := framework.Catalog[functionName]
+	if !ok || (len(function.Name.Schema) > 0 && function.Name.Schema != "pg_catalog") {
+		return auth.FunctionPrivilegeKey{}, fmt.Errorf(`function %s does not exist`, function.Name.Name)
+	}
+	var matches []framework.FunctionInterface
+	for _, overload := range overloads {
+		if len(function.Arguments) == 0 || functionArgumentsMatch(function.Arguments, overload.GetParameters()) {
+			matches = append(matches, overload)
+		}
+	}
+	// A function that is given without its arguments may still match a function that has no parameters
+	if len(function.Arguments) == 0 && len(matches) > 1 {
+		for _, match := range matches {
+			if len(match.GetParameters()) == 0 {
+				matches = []framework.FunctionInterface{match}
+				break
+			}
+		}
+	}
+	switch len(matches) {
+	case 0:
+		argumentNames := make([]string, len(function.Arguments))
+		for i, argument := range function.Arguments {
+			if resolvable, ok := argument.(pgtypes.ResolvableType); ok {
+				argumentNames[i] = resolvable.Typ.SQLString()
+			} else {
+				argumentNames[i] = argument.String()
+			}
+		}
+		return auth.FunctionPrivilegeKey{}, fmt.Errorf(`function %s(%s) does not exist`,
+			function.Name.Name, strings.Join(argumentNames, ", "))
+	case 1:
+		return auth.FunctionPrivilegeKey{
+			Schema:    "pg_catalog",
+			Name:      functionName,
+			Arguments: framework.FormatParameters(matches[0].GetParameters()),
+		}, nil
+	default:
+		return auth.FunctionPrivilegeKey{}, fmt.Errorf(`function name "%s" is not unique`, function.Name.Name)
+	}
+}
+
+// functionArgumentsMatch returns whether the given argument types exactly match the parameters of a function. Types
+// that have not been resolved (such as the polymorphic types) are matched by their name.
+func functionArgumentsMatch(arguments []pgtypes.DoltgresType, parameters []pgtypes.DoltgresType) bool {
+	if len(arguments) != len(parameters) {
+		return false
+	}
+	for i, argument := range arguments {
+		if resolvable, ok := argument.(pgtypes.ResolvableType); ok {
+			if !strings.EqualFold(resolvable.Typ.SQLString(), parameters[i].BaseName()) {
+				return false
+			}
+		} else if argument.OID() != parameters[i].OID() {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveTypeName returns the type name with its schema resolved. User-defined types (including domains) are searched
+// for first, followed by the built-in types, which reside in pg_catalog.
+func resolveTypeName(ctx *sql.Context, typ doltdb.TableName) (doltdb.TableName, error) {
+	if typ.Schema != "pg_catalog" {
+		schemaName, err := core.GetSchemaName(ctx, nil, typ.Schema)
+		if err != nil {
+			return doltdb.TableName{}, err
+		}
+		collection, err := core.GetTypesCollectionFromContext(ctx)
+		if err != nil {
+			return doltdb.TableName{}, err
+		}
+		if _, ok := collection.GetType(schemaName, typ.Name); ok {
+			return doltdb.TableName{Name: typ.Name, Schema: schemaName}, nil
+		}
+	}
+	if len(typ.Schema) == 0 || typ.Schema == "pg_catalog" {
+		for _, builtIn := range pgtypes.GetAllTypes() {
+			if builtIn.BaseName() == typ.Name {
+				return doltdb.TableName{Name: typ.Name, Schema: "pg_catalog"}, nil
+			}
+		}
+	}
+	return doltdb.TableName{}, fmt.Errorf(`type "%s" does not exist`, typ.Name)
+}
@@ -0,0 +1,264 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+)
+
+// ProjectSet evaluates the set-returning functions of a projection. For each row of its child, every function is
+// evaluated, and rows are returned until all functions have been exhausted. Each returned row consists of the child's
+// row, followed by the current value of each function. Functions that run out of values before the others return NULL
+// for the remaining rows. If every function returns an empty set, then no rows are returned for the child's row.
+type ProjectSet struct {
+	Functions []sql.Expression
+	child     sql.Node
+}
+
+var _ sql.ExecSourceRel = (*ProjectSet)(nil)
+var _ sql.Expressioner = (*ProjectSet)(nil)
+
+// NewProjectSet returns a new *ProjectSet. Each function must either be a set-returning *framework.CompiledFunction,
+// or an *expression.Ordinality.
+func NewProjectSet(functions []sql.Expression, child sql.Node) *ProjectSet {
+	return &ProjectSet{
+		Functions: functions,
+		child:     child,
+	}
+}
+
+// Child returns the single child of this node
+func (ps *ProjectSet) Child() sql.Node {
+	return ps.child
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (ps *ProjectSet) Children() []sql.Node {
+	return []sql.Node{ps.child}
+}
+
+// Expressions implements the interface sql.Expressioner.
+func (ps *ProjectSet) Expressions() []sql.Expression {
+	return ps.Functions
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (ps *ProjectSet) IsReadOnly() bool {
+	return ps.child.IsReadOnly()
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (ps *ProjectSet) Resolved() bool {
+	for _, function := range ps.Functions {
+		if !function.Resolved() {
+			return false
+		}
+	}
+	return ps.child.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (ps *ProjectSet) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	childIter, err := rowexec.DefaultBuilder.Build(ctx, ps.child, r)
+	if err != nil {
+		return nil, err
+	}
+	if childIter == nil {
+		childIter = sql.RowsToRowIter()
+	}
+	return &projectSetIter{
+		functions: ps.Functions,
+		childIter: childIter,
+		setIters:  make([]sql.RowIter, len(ps.Functions)),
+	}, nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (ps *ProjectSet) Schema() sql.Schema {
+	childSchema := ps.child.Schema()
+	schema := make(sql.Schema, len(childSchema), len(childSchema)+len(ps.Functions))
+	copy(schema, childSchema)
+	for _, function := range ps.Functions {
+		schema = append(schema, &sql.Column{
+			Name:     function.String(),
+			Type:     function.Type(),
+			Nullable: true,
+		})
+	}
+	return schema
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (ps *ProjectSet) String() string {
+	tp := sql.NewTreePrinter()
+	functions := make([]string, len(ps.Functions))
+	for i, function := range ps.Functions {
+		functions[i] = function.String()
+	}
+	_ = tp.WriteNode("ProjectSet(%s)", strings.Join(functions, ", "))
+	_ = tp.WriteChildren(ps.child.String())
+	return tp.String()
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (ps *ProjectSet) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(ps, len(children), 1)
+	}
+	return NewProjectSet(ps.Functions, children[0]), nil
+}
+
+// WithExpressions implements the interface sql.Expressioner.
+func (ps *ProjectSet) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(ps.Functions) {
+		return nil, sql.ErrInvalidChildrenNumber.New(ps, len(exprs), len(ps.Functions))
+	}
+	return NewProjectSet(exprs, ps.child), nil
+}
+
+// projectSetIter is the iterator for *ProjectSet.
+type projectSetIter struct {
+	functions []sql.Expression
+	childIter sql.RowIter
+	childRow  sql.Row
+	active    bool
+	setIters  []sql.RowIter
+	ordinal   int64
+}
+
+var _ sql.RowIter = (*projectSetIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *projectSetIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		if !iter.active {
+			childRow, err := iter.childIter.Next(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if err = iter.startSets(ctx, childRow); err != nil {
+				return nil, err
+			}
+		}
+		row, err := iter.nextSetRow(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if row != nil {
+			return row, nil
+		}
+		if err = iter.closeSets(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *projectSetIter) Close(ctx *sql.Context) error {
+	err := iter.closeSets(ctx)
+	if childErr := iter.childIter.Close(ctx); err == nil {
+		err = childErr
+	}
+	return err
+}
+
+// startSets evaluates each set-returning function against the given child row.
+func (iter *projectSetIter) startSets(ctx *sql.Context, childRow sql.Row) error {
+	iter.childRow = childRow
+	iter.active = true
+	iter.ordinal = 0
+	for i, function := range iter.functions {
+		switch function := function.(type) {
+		case *framework.CompiledFunction:
+			setIter, err := function.EvalSet(ctx, childRow)
+			if err != nil {
+				return err
+			}
+			iter.setIters[i] = setIter
+		case *pgexprs.Ordinality:
+		default:
+			return fmt.Errorf("ProjectSet received an unexpected expression: %s", function.String())
+		}
+	}
+	return nil
+}
+
+// nextSetRow returns the next row for the current child row. Returns a nil row once all sets have been exhausted.
+func (iter *projectSetIter) nextSetRow(ctx *sql.Context) (sql.Row, error) {
+	values := make(sql.Row, len(iter.functions))
+	hasValues := false
+	for i, setIter := range iter.setIters {
+		if setIter == nil {
+			continue
+		}
+		row, err := setIter.Next(ctx)
+		if err == io.EOF {
+			if err = setIter.Close(ctx); err != nil {
+				return nil, err
+			}
+			iter.setIters[i] = nil
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		values[i] = row[0]
+		hasValues = true
+	}
+	// Without any set-returning functions (such as a scalar function using WITH ORDINALITY), a single row is returned
+	if !hasValues && (iter.ordinal > 0 || iter.hasFunctions()) {
+		return nil, nil
+	}
+	iter.ordinal++
+	for i, function := range iter.functions {
+		if _, ok := function.(*pgexprs.Ordinality); ok {
+			values[i] = iter.ordinal
+		}
+	}
+	return append(iter.childRow.Copy(), values...), nil
+}
+
+// hasFunctions returns whether any set-returning functions are being evaluated.
+func (iter *projectSetIter) hasFunctions() bool {
+	for _, function := range iter.functions {
+		if _, ok := function.(*framework.CompiledFunction); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// closeSets closes any remaining set iterators, and clears the current child row.
+func (iter *projectSetIter) closeSets(ctx *sql.Context) error {
+	iter.childRow = nil
+	iter.active = false
+	var err error
+	for i, setIter := range iter.setIters {
+		if setIter != nil {
+			if closeErr := setIter.Close(ctx); err == nil {
+				err = closeErr
+			}
+			iter.setIters[i] = nil
+		}
+	}
+	return err
+}
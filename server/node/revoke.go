@@ -30,8 +30,12 @@ import (
 // Revoke handles all of the REVOKE statements.
 type Revoke struct {
 	RevokeTable    *RevokeTable
+	RevokeColumn   *RevokeColumn
 	RevokeSchema   *RevokeSchema
 	RevokeDatabase *RevokeDatabase
+	RevokeSequence *RevokeSequence
+	RevokeFunction *RevokeFunction
+	RevokeType     *RevokeType
 	RevokeRole     *RevokeRole
 	FromRoles      []string
 	GrantedBy      string
@@ -45,6 +49,12 @@ type RevokeTable struct {
 	Tables     []doltdb.TableName
 }
 
+// RevokeColumn specifically handles the REVOKE <privilege> (<columns>) ON TABLE statement.
+type RevokeColumn struct {
+	Privileges []ColumnPrivileges
+	Tables     []doltdb.TableName
+}
+
 // RevokeSchema specifically handles the REVOKE ... ON SCHEMA statement.
 type RevokeSchema struct {
 	Privileges []auth.Privilege
@@ -57,6 +67,26 @@ type RevokeDatabase struct {
 	Databases  []string
 }
 
+// RevokeSequence specifically handles the REVOKE ... ON SEQUENCE statement. An empty sequence name refers to all
+// sequences in the schema.
+type RevokeSequence struct {
+	Privileges []auth.Privilege
+	Sequences  []doltdb.TableName
+}
+
+// RevokeFunction specifically handles the REVOKE ... ON { FUNCTION | PROCEDURE | ROUTINE } statement. An empty
+// function name refers to all functions in the schema.
+type RevokeFunction struct {
+	Privileges []auth.Privilege
+	Functions  []FunctionTarget
+}
+
+// RevokeType specifically handles the REVOKE ... ON { TYPE | DOMAIN } statement.
+type RevokeType struct {
+	Privileges []auth.Privilege
+	Types      []doltdb.TableName
+}
+
 // RevokeRole specifically handles the REVOKE <roles> FROM <roles> statement.
 type RevokeRole struct {
 	Groups []string
@@ -93,6 +123,10 @@ func (r *Revoke) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
 			if err = r.revokeTable(ctx); err != nil {
 				return
 			}
+		case r.RevokeColumn != nil:
+			if err = r.revokeColumn(ctx); err != nil {
+				return
+			}
 		case r.RevokeSchema != nil:
 			if err = r.revokeSchema(ctx); err != nil {
 				return
@@ -101,6 +135,18 @@ func (r *Revoke) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
 			if err = r.revokeDatabase(ctx); err != nil {
 				return
 			}
+		case r.RevokeSequence != nil:
+			if err = r.revokeSequence(ctx); err != nil {
+				return
+			}
+		case r.RevokeFunction != nil:
+			if err = r.revokeFunction(ctx); err != nil {
+				return
+			}
+		case r.RevokeType != nil:
+			if err = r.revokeType(ctx); err != nil {
+				return
+			}
 		case r.RevokeRole != nil:
 			if err = r.revokeRole(ctx); err != nil {
 				return
@@ -127,6 +173,14 @@ func (r *Revoke) String() string {
 	switch {
 	case r.RevokeTable != nil:
 		return "REVOKE TABLE"
+	case r.RevokeColumn != nil:
+		return "REVOKE COLUMN"
+	case r.RevokeSequence != nil:
+		return "REVOKE SEQUENCE"
+	case r.RevokeFunction != nil:
+		return "REVOKE FUNCTION"
+	case r.RevokeType != nil:
+		return "REVOKE TYPE"
 	default:
 		return "REVOKE"
 	}
@@ -204,6 +258,55 @@ func (r *Revoke) revokeTable(ctx *sql.Context) error {
 					Privilege: privilege,
 					GrantedBy: grantedByID,
 				}, r.GrantOptionFor)
+				// Revoking a privilege on a table also revokes it from each of the table's columns
+				auth.RemoveTableColumnPrivileges(auth.TablePrivilegeKey{
+					Role:  role.ID(),
+					Table: doltdb.TableName{Name: table.Name, Schema: schemaName},
+				}, auth.GrantedPrivilege{
+					Privilege: privilege,
+					GrantedBy: grantedByID,
+				}, r.GrantOptionFor)
+			}
+		}
+	}
+	return nil
+}
+
+// revokeColumn handles *RevokeColumn from within RowIter.
+func (r *Revoke) revokeColumn(ctx *sql.Context) error {
+	roles, userRole, grantedByID, err := r.common(ctx)
+	if err != nil {
+		return err
+	}
+	for _, table := range r.RevokeColumn.Tables {
+		schemaName, err := core.GetSchemaName(ctx, nil, table.Schema)
+		if err != nil {
+			return err
+		}
+		tableName := doltdb.TableName{Name: table.Name, Schema: schemaName}
+		if err = verifyColumnsExist(ctx, tableName, r.RevokeColumn.Privileges); err != nil {
+			return err
+		}
+		for _, role := range roles {
+			for _, columnPrivileges := range r.RevokeColumn.Privileges {
+				for _, column := range columnPrivileges.Columns {
+					if id := auth.HasColumnPrivilegeGrantOption(auth.ColumnPrivilegeKey{
+						Role:   userRole.ID(),
+						Table:  tableName,
+						Column: column,
+					}, columnPrivileges.Privilege); !id.IsValid() {
+						// TODO: grab the actual error message
+						return fmt.Errorf(`role "%s" does not have permission to revoke this privilege`, userRole.Name)
+					}
+					auth.RemoveColumnPrivilege(auth.ColumnPrivilegeKey{
+						Role:   role.ID(),
+						Table:  tableName,
+						Column: column,
+					}, auth.GrantedPrivilege{
+						Privilege: columnPrivileges.Privilege,
+						GrantedBy: grantedByID,
+					}, r.GrantOptionFor)
+				}
 			}
 		}
 	}
@@ -270,6 +373,109 @@ func (r *Revoke) revokeDatabase(ctx *sql.Context) error {
 	return nil
 }
 
+// revokeSequence handles *RevokeSequence from within RowIter.
+func (r *Revoke) revokeSequence(ctx *sql.Context) error {
+	roles, userRole, grantedByID, err := r.common(ctx)
+	if err != nil {
+		return err
+	}
+	for _, sequence := range r.RevokeSequence.Sequences {
+		sequenceName, err := resolveSequenceName(ctx, sequence)
+		if err != nil {
+			return err
+		}
+		key := auth.SequencePrivilegeKey{
+			Role:     userRole.ID(),
+			Sequence: sequenceName,
+		}
+		for _, role := range roles {
+			for _, privilege := range r.RevokeSequence.Privileges {
+				if id := auth.HasSequencePrivilegeGrantOption(key, privilege); !id.IsValid() {
+					// TODO: grab the actual error message
+					return fmt.Errorf(`role "%s" does not have permission to revoke this privilege`, userRole.Name)
+				}
+				auth.RemoveSequencePrivilege(auth.SequencePrivilegeKey{
+					Role:     role.ID(),
+					Sequence: sequenceName,
+				}, auth.GrantedPrivilege{
+					Privilege: privilege,
+					GrantedBy: grantedByID,
+				}, r.GrantOptionFor)
+			}
+		}
+	}
+	return nil
+}
+
+// revokeFunction handles *RevokeFunction from within RowIter.
+func (r *Revoke) revokeFunction(ctx *sql.Context) error {
+	roles, userRole, grantedByID, err := r.common(ctx)
+	if err != nil {
+		return err
+	}
+	for _, function := range r.RevokeFunction.Functions {
+		key, err := resolveFunction(ctx, function)
+		if err != nil {
+			return err
+		}
+		key.Role = userRole.ID()
+		for _, role := range roles {
+			for _, privilege := range r.RevokeFunction.Privileges {
+				if id := auth.HasFunctionPrivilegeGrantOption(key, privilege); !id.IsValid() {
+					// TODO: grab the actual error message
+					return fmt.Errorf(`role "%s" does not have permission to revoke this privilege`, userRole.Name)
+				}
+				auth.RemoveFunctionPrivilege(auth.FunctionPrivilegeKey{
+					Role:      role.ID(),
+					Schema:    key.Schema,
+					Name:      key.Name,
+					Arguments: key.Arguments,
+				}, auth.GrantedPrivilege{
+					Privilege: privilege,
+					GrantedBy: grantedByID,
+				}, r.GrantOptionFor)
+			}
+		}
+	}
+	return nil
+}
+
+// revokeType handles *RevokeType from within RowIter.
+func (r *Revoke) revokeType(ctx *sql.Context) error {
+	roles, userRole, grantedByID, err := r.common(ctx)
+	if err != nil {
+		return err
+	}
+	for _, typ := range r.RevokeType.Types {
+		typeName, err := resolveTypeName(ctx, typ)
+		if err != nil {
+			return err
+		}
+		key := auth.TypePrivilegeKey{
+			Role:   userRole.ID(),
+			Schema: typeName.Schema,
+			Name:   typeName.Name,
+		}
+		for _, role := range roles {
+			for _, privilege := range r.RevokeType.Privileges {
+				if id := auth.HasTypePrivilegeGrantOption(key, privilege); !id.IsValid() {
+					// TODO: grab the actual error message
+					return fmt.Errorf(`role "%s" does not have permission to revoke this privilege`, userRole.Name)
+				}
+				auth.RemoveTypePrivilege(auth.TypePrivilegeKey{
+					Role:   role.ID(),
+					Schema: typeName.Schema,
+					Name:   typeName.Name,
+				}, auth.GrantedPrivilege{
+					Privilege: privilege,
+					GrantedBy: grantedByID,
+				}, r.GrantOptionFor)
+			}
+		}
+	}
+	return nil
+}
+
 // revokeRole handles *RevokeRole from within RowIter.
 func (r *Revoke) revokeRole(ctx *sql.Context) error {
 	members, userRole, _, err := r.common(ctx)
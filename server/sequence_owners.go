@@ -0,0 +1,76 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqlserver"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/server/auth"
+)
+
+// OwnUnownedSequences gives an owner to every sequence that does not have one, using a session that runs as the given
+// user. Older versions did not record the owners of sequences, so this is run once when such a version's authorization
+// database is loaded.
+func OwnUnownedSequences(user string) error {
+	runningServer := sqlserver.GetRunningServer()
+	if runningServer == nil {
+		return fmt.Errorf("giving sequences an owner requires a running server")
+	}
+	conn := NewInternalConnection(user)
+	sessionManager := runningServer.SessionManager()
+	defer func() {
+		runningServer.Engine.CloseSession(conn.ConnectionID)
+		sessionManager.RemoveConn(conn)
+	}()
+	ctx, err := sessionManager.NewContext(context.Background(), conn, "")
+	if err != nil {
+		return err
+	}
+	type schemaSequence struct {
+		schema string
+		seq    *sequences.Sequence
+	}
+	var allSequences []schemaSequence
+	for _, db := range dsess.DSessFromSess(ctx.Session).Provider().AllDatabases(ctx) {
+		name := db.Name()
+		if name == "information_schema" || name == "pg_catalog" || name == "performance_schema" {
+			continue
+		}
+		collection, err := core.GetSequencesFromDatabase(ctx, name)
+		if err != nil {
+			return err
+		}
+		if err = collection.IterateSequences(func(schema string, seq *sequences.Sequence) error {
+			allSequences = append(allSequences, schemaSequence{schema: schema, seq: seq})
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	auth.LockWrite(func() {
+		for _, sequence := range allSequences {
+			auth.OwnUnownedSequence(sequence.schema, sequence.seq.Name, sequence.seq.OwnerTable)
+		}
+		auth.ClearUnownedSequences()
+		err = auth.PersistChanges()
+	})
+	return err
+}
@@ -16,8 +16,10 @@ package server
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	_ "net/http/pprof"
+	"os"
 	"path/filepath"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
@@ -37,6 +39,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/jackc/pgx/v5"
 
+	"github.com/dolthub/doltgresql/server/auth"
 	"github.com/dolthub/doltgresql/server/initialization"
 	"github.com/dolthub/doltgresql/server/logrepl"
 	"github.com/dolthub/doltgresql/servercfg"
@@ -115,6 +118,22 @@ func runServer(ctx context.Context, cfg *servercfg.DoltgresConfig, dEnv *env.Dol
 		certificate = tlsConfig.Certificates[0]
 	}
 
+	if caFile := cfg.TLSCA(); len(caFile) > 0 {
+		caPEM, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the client certificate authorities: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no client certificate authorities were found in `%s`", caFile)
+		}
+		configClientCAs = pool
+	}
+
+	if err = loadHostBasedAuthRules(cfg); err != nil {
+		return nil, err
+	}
+
 	// We need a username and password for many SQL commands, so set defaults if they don't exist
 	dEnv.Config.SetFailsafes(map[string]string{
 		config.UserNameKey:  DefUserName,
@@ -171,6 +190,16 @@ func runServer(ctx context.Context, cfg *servercfg.DoltgresConfig, dEnv *env.Dol
 		}
 	}
 
+	var hasUnownedSequences bool
+	auth.LockRead(func() {
+		hasUnownedSequences = auth.HasUnownedSequences()
+	})
+	if hasUnownedSequences {
+		if err = OwnUnownedSequences(ssCfg.User()); err != nil {
+			return nil, err
+		}
+	}
+
 	// TODO: shutdown replication cleanly when we stop the server
 	_, err = startReplication(cfg, ssCfg)
 	if err != nil {
@@ -180,6 +209,35 @@ func runServer(ctx context.Context, cfg *servercfg.DoltgresConfig, dEnv *env.Dol
 	return controller, nil
 }
 
+// loadHostBasedAuthRules validates and loads the host-based authentication rules from the config. The default rules
+// are loaded when the config does not contain any.
+func loadHostBasedAuthRules(cfg *servercfg.DoltgresConfig) error {
+	rules := make([]auth.HostBasedAuthRule, len(cfg.HostBasedAuth))
+	for i, ruleCfg := range cfg.HostBasedAuth {
+		var connType, address, netmask, method string
+		if ruleCfg.Type != nil {
+			connType = *ruleCfg.Type
+		}
+		if ruleCfg.Address != nil {
+			address = *ruleCfg.Address
+		}
+		if ruleCfg.Netmask != nil {
+			netmask = *ruleCfg.Netmask
+		}
+		if ruleCfg.Method != nil {
+			method = *ruleCfg.Method
+		}
+		rule, err := auth.NewHostBasedAuthRule(int32(i+1), connType, ruleCfg.Databases, ruleCfg.Users,
+			address, netmask, method, ruleCfg.Options)
+		if err != nil {
+			return err
+		}
+		rules[i] = rule
+	}
+	auth.SetHostBasedAuthRules(rules)
+	return nil
+}
+
 // createDatabase creates the database named on the local server using the configuration values to connect, returning
 // any error
 func createDatabase(cfg doltservercfg.ServerConfig, dbName string) error {
@@ -222,18 +280,21 @@ func startReplication(cfg *servercfg.DoltgresConfig, ssCfg doltservercfg.ServerC
 		*cfg.PostgresReplicationConfig.PostgresDatabase,
 	)
 
-	replicationDns := fmt.Sprintf(
-		"postgres://%s:%s@localhost:%d/%s",
-		ssCfg.User(),
-		ssCfg.Password(),
-		ssCfg.Port(),
-		"postgres", // TODO: this needs to come from config
-	)
-
-	replicator, err := logrepl.NewLogicalReplicator(walFilePath, primaryDns, replicationDns)
+	// TODO: the database needs to come from config
+	replicator, err := logrepl.NewLogicalReplicator(walFilePath, primaryDns, NewInternalConnection(ssCfg.User()), "postgres")
 	if err != nil {
 		return nil, err
 	}
+	if replicationCfg := cfg.PostgresReplicationConfig; replicationCfg.DoltCommit != nil && *replicationCfg.DoltCommit {
+		doltCommitOptions := &logrepl.DoltCommitOptions{}
+		if replicationCfg.DoltCommitAuthor != nil {
+			doltCommitOptions.Author = *replicationCfg.DoltCommitAuthor
+		}
+		if replicationCfg.DoltCommitMessage != nil {
+			doltCommitOptions.Message = *replicationCfg.DoltCommitMessage
+		}
+		replicator.SetDoltCommitOptions(doltCommitOptions)
+	}
 
 	cli.Println("Starting replication")
 	go replicator.StartReplication(*cfg.PostgresReplicationConfig.SlotName)
@@ -0,0 +1,59 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package information_schema
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/information_schema"
+
+	"github.com/dolthub/doltgresql/server/auth"
+)
+
+// WithColumnPrivilegesRows returns a copy of the given information_schema.COLUMN_PRIVILEGES table that returns the
+// column privileges that have been granted. GMS does not allow this table to be replaced when the information_schema
+// database is created, so the analyzer swaps the table's reader instead. The table keeps the GMS schema, as columns
+// have already been resolved against it by that point.
+func WithColumnPrivilegesRows(table *information_schema.InformationSchemaTable) *information_schema.InformationSchemaTable {
+	newTable := *table
+	newTable.Reader = columnPrivilegesRowIter
+	return &newTable
+}
+
+// columnPrivilegesRowIter implements the sql.RowIter for the information_schema.COLUMN_PRIVILEGES table.
+func columnPrivilegesRowIter(ctx *sql.Context, c sql.Catalog) (sql.RowIter, error) {
+	var grants []auth.ColumnPrivilegeGrant
+	auth.LockRead(func() {
+		role := auth.GetRole(ctx.Client().User)
+		grants = auth.GetColumnPrivilegeGrants(role.ID())
+	})
+	catalogName := ctx.GetCurrentDatabase()
+	rows := make([]sql.Row, len(grants))
+	for i, grant := range grants {
+		isGrantable := "NO"
+		if grant.WithGrantOption {
+			isGrantable = "YES"
+		}
+		rows[i] = sql.Row{
+			grant.Grantee,            // grantee
+			catalogName,              // table_catalog
+			grant.Table.Schema,       // table_schema
+			grant.Table.Name,         // table_name
+			grant.Column,             // column_name
+			grant.Privilege.String(), // privilege_type
+			isGrantable,              // is_grantable
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 	"github.com/dolthub/doltgresql/server/types/oid"
@@ -68,6 +69,27 @@ func (p PgAttributeHandler) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 		if err != nil {
 			return nil, err
 		}
+		// The attributes of composite types belong to the relation of each type
+		collection, err := core.GetTypesCollectionFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		err = collection.IterateTypes(func(_ string, typ *pgtypes.Type) error {
+			for i, attr := range typ.CompositeAttrs {
+				cols = append(cols, &sql.Column{
+					Name:     attr.Name,
+					Type:     attr.Type,
+					Nullable: true,
+					Source:   typ.Name,
+				})
+				colIdxs = append(colIdxs, i)
+				tableOIDs = append(tableOIDs, typ.RelID)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
 		pgCatalogCache.attributeCols = cols
 		pgCatalogCache.attributeColIdxs = colIdxs
 		pgCatalogCache.attributeTableOIDs = tableOIDs
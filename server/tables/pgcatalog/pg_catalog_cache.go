@@ -69,8 +69,9 @@ type pgCatalogCache struct {
 	viewSchemas []string
 
 	// pg_types
-	types        []pgtypes.DoltgresType
-	pgCatalogOid uint32
+	types          []pgtypes.DoltgresType
+	pgCatalogOid   uint32
+	typeSchemaOids map[string]uint32
 
 	// pg_tables
 	tables       []sql.Table
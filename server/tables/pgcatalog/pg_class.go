@@ -18,8 +18,11 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/auth"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 	"github.com/dolthub/doltgresql/server/types/oid"
@@ -53,9 +56,19 @@ func (p PgClassHandler) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 
 	if pgCatalogCache.pgClasses == nil {
 		var classes []pgClass
+		policyCollection, err := core.GetPoliciesFromDatabase(ctx, "")
+		if err != nil {
+			return nil, err
+		}
 		tableHasIndexes := make(map[uint32]struct{})
 
-		err := oid.IterateCurrentDatabase(ctx, oid.Callbacks{
+		schemaOids := make(map[string]uint32)
+
+		err = oid.IterateCurrentDatabase(ctx, oid.Callbacks{
+			Schema: func(ctx *sql.Context, schema oid.ItemSchema) (cont bool, err error) {
+				schemaOids[schema.Item.SchemaName()] = schema.OID
+				return true, nil
+			},
 			Index: func(ctx *sql.Context, schema oid.ItemSchema, table oid.ItemTable, index oid.ItemIndex) (cont bool, err error) {
 				tableHasIndexes[table.OID] = struct{}{}
 				classes = append(classes, pgClass{
@@ -69,13 +82,23 @@ func (p PgClassHandler) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 			},
 			Table: func(ctx *sql.Context, schema oid.ItemSchema, table oid.ItemTable) (cont bool, err error) {
 				_, hasIndexes := tableHasIndexes[table.OID]
-				classes = append(classes, pgClass{
+				class := pgClass{
 					oid:        table.OID,
 					name:       table.Item.Name(),
 					hasIndexes: hasIndexes,
 					kind:       "r",
 					schemaOid:  schema.OID,
+				}
+				tableName := doltdb.TableName{Name: table.Item.Name(), Schema: schema.Item.SchemaName()}
+				policyTable := policyCollection.GetTable(tableName)
+				if policyTable != nil {
+					class.rowSecurity = policyTable.RowLevelSecurity
+					class.forceRowSecurity = policyTable.ForceRowLevelSecurity
+				}
+				auth.LockRead(func() {
+					class.acl = auth.GetTableACL(tableName)
 				})
+				classes = append(classes, class)
 				return true, nil
 			},
 			View: func(ctx *sql.Context, schema oid.ItemSchema, view oid.ItemView) (cont bool, err error) {
@@ -89,19 +112,44 @@ func (p PgClassHandler) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 				return true, nil
 			},
 			Sequence: func(ctx *sql.Context, schema oid.ItemSchema, sequence oid.ItemSequence) (cont bool, err error) {
-				classes = append(classes, pgClass{
+				class := pgClass{
 					oid:        sequence.OID,
 					name:       sequence.Item.Name,
 					hasIndexes: false,
 					kind:       "S",
 					schemaOid:  schema.OID,
+				}
+				auth.LockRead(func() {
+					class.acl = auth.GetSequenceACL(doltdb.TableName{Name: sequence.Item.Name, Schema: schema.Item.SchemaName()})
 				})
+				classes = append(classes, class)
 				return true, nil
 			},
 		})
 		if err != nil {
 			return nil, err
 		}
+		// Composite types each have a relation describing their attributes
+		collection, err := core.GetTypesCollectionFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		err = collection.IterateTypes(func(schema string, typ *pgtypes.Type) error {
+			if typ.TypType == pgtypes.TypeType_Composite {
+				classes = append(classes, pgClass{
+					oid:       typ.RelID,
+					name:      typ.Name,
+					typeOid:   typ.Oid,
+					numAttrs:  int16(len(typ.CompositeAttrs)),
+					kind:      "c",
+					schemaOid: schemaOids[schema],
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
 		pgCatalogCache.pgClasses = classes
 	}
 
@@ -121,6 +169,15 @@ func getIndexName(idx sql.Index) string {
 	// return fmt.Sprintf("%s_%s_key", idx.Table(), idx.ID())
 }
 
+// aclValue returns the given ACL as a row value. A nil ACL represents the default privileges, which are displayed
+// as NULL.
+func aclValue(acl []any) any {
+	if acl == nil {
+		return nil
+	}
+	return acl
+}
+
 // Schema implements the interface tables.Handler.
 func (p PgClassHandler) Schema() sql.PrimaryKeySchema {
 	return sql.PrimaryKeySchema{
@@ -168,11 +225,16 @@ var pgClassSchema = sql.Schema{
 
 // pgClass represents a row in the pg_class table.
 type pgClass struct {
-	oid        uint32
-	name       string
-	schemaOid  uint32
-	hasIndexes bool
-	kind       string // r = ordinary table, i = index, S = sequence, t = TOAST table, v = view, m = materialized view, c = composite type, f = foreign table, p = partitioned table, I = partitioned index
+	oid              uint32
+	name             string
+	schemaOid        uint32
+	typeOid          uint32
+	numAttrs         int16
+	hasIndexes       bool
+	rowSecurity      bool
+	forceRowSecurity bool
+	acl              []any
+	kind             string // r = ordinary table, i = index, S = sequence, t = TOAST table, v = view, m = materialized view, c = composite type, f = foreign table, p = partitioned table, I = partitioned index
 }
 
 // pgClassRowIter is the sql.RowIter for the pg_class table.
@@ -201,39 +263,39 @@ func (iter *pgClassRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 
 	// TODO: Fill in the rest of the pg_class columns
 	return sql.Row{
-		class.oid,        // oid
-		class.name,       // relname
-		class.schemaOid,  // relnamespace
-		uint32(0),        // reltype
-		uint32(0),        // reloftype
-		uint32(0),        // relowner
-		relam,            // relam
-		uint32(0),        // relfilenode
-		uint32(0),        // reltablespace
-		int32(0),         // relpages
-		float32(0),       // reltuples
-		int32(0),         // relallvisible
-		uint32(0),        // reltoastrelid
-		class.hasIndexes, // relhasindex
-		false,            // relisshared
-		"p",              // relpersistence
-		class.kind,       // relkind
-		int16(0),         // relnatts
-		int16(0),         // relchecks
-		false,            // relhasrules
-		false,            // relhastriggers
-		false,            // relhassubclass
-		false,            // relrowsecurity
-		false,            // relforcerowsecurity
-		true,             // relispopulated
-		"d",              // relreplident
-		false,            // relispartition
-		uint32(0),        // relrewrite
-		uint32(0),        // relfrozenxid
-		uint32(0),        // relminmxid
-		nil,              // relacl
-		nil,              // reloptions
-		nil,              // relpartbound
+		class.oid,              // oid
+		class.name,             // relname
+		class.schemaOid,        // relnamespace
+		class.typeOid,          // reltype
+		uint32(0),              // reloftype
+		uint32(0),              // relowner
+		relam,                  // relam
+		uint32(0),              // relfilenode
+		uint32(0),              // reltablespace
+		int32(0),               // relpages
+		float32(0),             // reltuples
+		int32(0),               // relallvisible
+		uint32(0),              // reltoastrelid
+		class.hasIndexes,       // relhasindex
+		false,                  // relisshared
+		"p",                    // relpersistence
+		class.kind,             // relkind
+		class.numAttrs,         // relnatts
+		int16(0),               // relchecks
+		false,                  // relhasrules
+		false,                  // relhastriggers
+		false,                  // relhassubclass
+		class.rowSecurity,      // relrowsecurity
+		class.forceRowSecurity, // relforcerowsecurity
+		true,                   // relispopulated
+		"d",                    // relreplident
+		false,                  // relispartition
+		uint32(0),              // relrewrite
+		uint32(0),              // relfrozenxid
+		uint32(0),              // relminmxid
+		aclValue(class.acl),    // relacl
+		nil,                    // reloptions
+		nil,                    // relpartbound
 	}, nil
 }
 
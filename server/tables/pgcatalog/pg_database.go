@@ -22,6 +22,7 @@ import (
 	sqle "github.com/dolthub/go-mysql-server"
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/server/auth"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 	"github.com/dolthub/doltgresql/server/types/oid"
@@ -115,26 +116,30 @@ func (iter *pgDatabaseRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 	iter.idx++
 	db := iter.dbs[iter.idx-1]
 	dbOid := oid.CreateOID(oid.Section_Database, 0, iter.idx-1)
+	var setting auth.DatabaseSetting
+	auth.LockRead(func() {
+		setting = auth.GetDatabaseSetting(db.Name())
+	})
 
 	// TODO: Add the rest of the pg_database columns
 	return sql.Row{
-		dbOid,     // oid
-		db.Name(), // datname
-		uint32(0), // datdba
-		int32(6),  // encoding
-		"i",       // datlocprovider
-		false,     // datistemplate
-		true,      // datallowconn
-		int32(-1), // datconnlimit
-		uint32(0), // datfrozenxid
-		uint32(0), // datminmxid
-		uint32(0), // dattablespace
-		"",        // datcollate
-		"",        // datctype
-		nil,       // daticulocale
-		"",        // daticurules
-		nil,       // datcollversion
-		nil,       // datacl
+		dbOid,                    // oid
+		db.Name(),                // datname
+		uint32(0),                // datdba
+		int32(6),                 // encoding
+		"i",                      // datlocprovider
+		false,                    // datistemplate
+		setting.AllowConnections, // datallowconn
+		setting.ConnectionLimit,  // datconnlimit
+		uint32(0),                // datfrozenxid
+		uint32(0),                // datminmxid
+		uint32(0),                // dattablespace
+		"",                       // datcollate
+		"",                       // datctype
+		nil,                      // daticulocale
+		"",                       // daticurules
+		nil,                      // datcollversion
+		nil,                      // datacl
 	}, nil
 }
 
@@ -19,8 +19,10 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/server/auth"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/server/types/oid"
 )
 
 // PgDefaultAclName is a constant to the pg_default_acl name.
@@ -43,8 +45,49 @@ func (p PgDefaultAclHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgDefaultAclHandler) RowIter(ctx *sql.Context) (sql.RowIter, error) {
-	// TODO: Implement pg_default_acl row iter
-	return emptyRowIter()
+	schemaOids := make(map[string]uint32)
+	err := oid.IterateCurrentDatabase(ctx, oid.Callbacks{
+		Schema: func(ctx *sql.Context, schema oid.ItemSchema) (cont bool, err error) {
+			schemaOids[schema.Item.SchemaName()] = schema.OID
+			return true, nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var defaultAcls []pgDefaultAcl
+	auth.LockRead(func() {
+		for _, value := range auth.GetAllDefaultPrivileges() {
+			defaultAcls = append(defaultAcls, pgDefaultAcl{
+				role:      uint32(value.Key.Role),
+				schemaOid: schemaOids[value.Key.Schema], // Default privileges for all schemas use zero
+				objType:   defaultAclObjectType(value.Key.PrivilegeObject),
+				acl:       auth.GetDefaultPrivilegeACL(value),
+			})
+		}
+	})
+	return &pgDefaultAclRowIter{
+		defaultAcls: defaultAcls,
+		idx:         0,
+	}, nil
+}
+
+// defaultAclObjectType returns the object type character used by pg_default_acl.defaclobjtype.
+func defaultAclObjectType(object auth.PrivilegeObject) string {
+	switch object {
+	case auth.PrivilegeObject_TABLE:
+		return "r"
+	case auth.PrivilegeObject_SEQUENCE:
+		return "S"
+	case auth.PrivilegeObject_FUNCTION:
+		return "f"
+	case auth.PrivilegeObject_TYPE:
+		return "T"
+	case auth.PrivilegeObject_SCHEMA:
+		return "n"
+	default:
+		return ""
+	}
 }
 
 // Schema implements the interface tables.Handler.
@@ -64,15 +107,36 @@ var pgDefaultAclSchema = sql.Schema{
 	{Name: "defaclacl", Type: pgtypes.TextArray, Default: nil, Nullable: false, Source: PgDefaultAclName}, // TODO: aclitem[] type
 }
 
+// pgDefaultAcl represents a row in the pg_default_acl table.
+type pgDefaultAcl struct {
+	role      uint32
+	schemaOid uint32
+	objType   string
+	acl       []any
+}
+
 // pgDefaultAclRowIter is the sql.RowIter for the pg_default_acl table.
 type pgDefaultAclRowIter struct {
+	defaultAcls []pgDefaultAcl
+	idx         int
 }
 
 var _ sql.RowIter = (*pgDefaultAclRowIter)(nil)
 
 // Next implements the interface sql.RowIter.
 func (iter *pgDefaultAclRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
+	if iter.idx >= len(iter.defaultAcls) {
+		return nil, io.EOF
+	}
+	iter.idx++
+	defaultAcl := iter.defaultAcls[iter.idx-1]
+	return sql.Row{
+		uint32(0),            // oid TODO: default privileges do not have an OID section yet
+		defaultAcl.role,      // defaclrole
+		defaultAcl.schemaOid, // defaclnamespace
+		defaultAcl.objType,   // defaclobjtype
+		defaultAcl.acl,       // defaclacl
+	}, nil
 }
 
 // Close implements the interface sql.RowIter.
@@ -16,9 +16,11 @@ package pgcatalog
 
 import (
 	"io"
+	"sort"
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -43,8 +45,32 @@ func (p PgEnumHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgEnumHandler) RowIter(ctx *sql.Context) (sql.RowIter, error) {
-	// TODO: Implement pg_enum row iter
-	return emptyRowIter()
+	collection, err := core.GetTypesCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var labels []pgEnumLabel
+	typesMap, schemaNames, _ := collection.GetAllTypes()
+	for _, schemaName := range schemaNames {
+		for _, typ := range typesMap[schemaName] {
+			if typ.TypType != pgtypes.TypeType_Enum {
+				continue
+			}
+			for _, label := range typ.EnumLabels {
+				labels = append(labels, pgEnumLabel{
+					typeOid: typ.Oid,
+					label:   label,
+				})
+			}
+		}
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].label.Oid < labels[j].label.Oid
+	})
+	return &pgEnumRowIter{
+		labels: labels,
+		idx:    0,
+	}, nil
 }
 
 // Schema implements the interface tables.Handler.
@@ -63,15 +89,34 @@ var pgEnumSchema = sql.Schema{
 	{Name: "enumlabel", Type: pgtypes.Name, Default: nil, Nullable: false, Source: PgEnumName},
 }
 
+// pgEnumLabel is a single enum label, along with the OID of the enum type that it belongs to.
+type pgEnumLabel struct {
+	typeOid uint32
+	label   pgtypes.EnumLabel
+}
+
 // pgEnumRowIter is the sql.RowIter for the pg_enum table.
 type pgEnumRowIter struct {
+	labels []pgEnumLabel
+	idx    int
 }
 
 var _ sql.RowIter = (*pgEnumRowIter)(nil)
 
 // Next implements the interface sql.RowIter.
 func (iter *pgEnumRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
+	if iter.idx >= len(iter.labels) {
+		return nil, io.EOF
+	}
+	iter.idx++
+	label := iter.labels[iter.idx-1]
+
+	return sql.Row{
+		label.label.Oid,       //oid
+		label.typeOid,         //enumtypid
+		label.label.SortOrder, //enumsortorder
+		label.label.Label,     //enumlabel
+	}, nil
 }
 
 // Close implements the interface sql.RowIter.
@@ -16,9 +16,13 @@ package pgcatalog
 
 import (
 	"io"
+	"sort"
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	"github.com/dolthub/doltgresql/server/auth"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -43,8 +47,19 @@ func (p PgHbaFileRulesHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgHbaFileRulesHandler) RowIter(ctx *sql.Context) (sql.RowIter, error) {
-	// TODO: Implement pg_hba_file_rules row iter
-	return emptyRowIter()
+	// The rules are only visible to superusers, although operations that the server runs on its own behalf may always
+	// read them
+	role, isInternal, err := auth.GetSessionRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isInternal && !role.IsSuperUser {
+		return nil, pgerror.Newf(pgcode.InsufficientPrivilege, "permission denied for view %s", PgHbaFileRulesName)
+	}
+	return &pgHbaFileRulesRowIter{
+		rules: auth.GetHostBasedAuthRules(),
+		idx:   0,
+	}, nil
 }
 
 // Schema implements the interface tables.Handler.
@@ -70,13 +85,58 @@ var pgHbaFileRulesSchema = sql.Schema{
 
 // pgHbaFileRulesRowIter is the sql.RowIter for the pg_hba_file_rules table.
 type pgHbaFileRulesRowIter struct {
+	rules []auth.HostBasedAuthRule
+	idx   int
 }
 
 var _ sql.RowIter = (*pgHbaFileRulesRowIter)(nil)
 
 // Next implements the interface sql.RowIter.
 func (iter *pgHbaFileRulesRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
+	if iter.idx >= len(iter.rules) {
+		return nil, io.EOF
+	}
+	iter.idx++
+	rule := iter.rules[iter.idx-1]
+
+	var address any
+	if len(rule.Address) > 0 {
+		address = rule.Address
+	}
+	var netmask any
+	if len(rule.Netmask) > 0 {
+		netmask = rule.Netmask
+	}
+	var options any
+	if len(rule.Options) > 0 {
+		optionStrs := make([]string, 0, len(rule.Options))
+		for key, value := range rule.Options {
+			optionStrs = append(optionStrs, key+"="+value)
+		}
+		sort.Strings(optionStrs)
+		options = stringsToArray(optionStrs)
+	}
+
+	return sql.Row{
+		rule.LineNumber,                // line_number
+		string(rule.Type),              // type
+		stringsToArray(rule.Databases), // database
+		stringsToArray(rule.Users),     // user_name
+		address,                        // address
+		netmask,                        // netmask
+		string(rule.Method),            // auth_method
+		options,                        // options
+		nil,                            // error
+	}, nil
+}
+
+// stringsToArray converts the given strings to the representation of a text array.
+func stringsToArray(strs []string) []any {
+	arr := make([]any, len(strs))
+	for i, str := range strs {
+		arr[i] = str
+	}
+	return arr
 }
 
 // Close implements the interface sql.RowIter.
@@ -43,8 +43,14 @@ func (p PgPoliciesHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgPoliciesHandler) RowIter(ctx *sql.Context) (sql.RowIter, error) {
-	// TODO: Implement pg_policies row iter
-	return emptyRowIter()
+	policyRows, err := getPgPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pgPoliciesRowIter{
+		policies: policyRows,
+		idx:      0,
+	}, nil
 }
 
 // Schema implements the interface tables.Handler.
@@ -69,13 +75,46 @@ var pgPoliciesSchema = sql.Schema{
 
 // pgPoliciesRowIter is the sql.RowIter for the pg_policies table.
 type pgPoliciesRowIter struct {
+	policies []pgPolicy
+	idx      int
 }
 
 var _ sql.RowIter = (*pgPoliciesRowIter)(nil)
 
 // Next implements the interface sql.RowIter.
 func (iter *pgPoliciesRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
+	if iter.idx >= len(iter.policies) {
+		return nil, io.EOF
+	}
+	iter.idx++
+	policy := iter.policies[iter.idx-1]
+
+	permissive := "PERMISSIVE"
+	if policy.policy.Restrictive {
+		permissive = "RESTRICTIVE"
+	}
+	roles := make([]any, len(policy.policy.Roles))
+	for i, roleName := range policy.policy.Roles {
+		roles[i] = roleName
+	}
+	var qual any
+	if len(policy.policy.Using) > 0 {
+		qual = policy.policy.Using
+	}
+	var withCheck any
+	if len(policy.policy.WithCheck) > 0 {
+		withCheck = policy.policy.WithCheck
+	}
+	return sql.Row{
+		policy.schemaName,              // schemaname
+		policy.tableName,               // tablename
+		policy.policy.Name,             // policyname
+		permissive,                     // permissive
+		roles,                          // roles
+		policy.policy.Command.String(), // cmd
+		qual,                           // qual
+		withCheck,                      // with_check
+	}, nil
 }
 
 // Close implements the interface sql.RowIter.
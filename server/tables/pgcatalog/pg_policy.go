@@ -17,10 +17,15 @@ package pgcatalog
 import (
 	"io"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/policies"
+	"github.com/dolthub/doltgresql/server/auth"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/server/types/oid"
 )
 
 // PgPolicyName is a constant to the pg_policy name.
@@ -43,8 +48,14 @@ func (p PgPolicyHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgPolicyHandler) RowIter(ctx *sql.Context) (sql.RowIter, error) {
-	// TODO: Implement pg_policy row iter
-	return emptyRowIter()
+	policyRows, err := getPgPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pgPolicyRowIter{
+		policies: policyRows,
+		idx:      0,
+	}, nil
 }
 
 // Schema implements the interface tables.Handler.
@@ -67,15 +78,107 @@ var pgPolicySchema = sql.Schema{
 	{Name: "polwithcheck", Type: pgtypes.Text, Default: nil, Nullable: true, Source: PgPolicyName}, // TODO: pg_node_tree type, collation C
 }
 
+// pgPolicy represents a single policy, along with the table that it belongs to.
+type pgPolicy struct {
+	oid        uint32
+	tableOid   uint32
+	schemaName string
+	tableName  string
+	policy     *policies.Policy
+}
+
+// getPgPolicies returns the policies for every table in the current database.
+func getPgPolicies(ctx *sql.Context) ([]pgPolicy, error) {
+	collection, err := core.GetPoliciesFromDatabase(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	var policyRows []pgPolicy
+	policyCounts := make(map[int]int)
+	err = oid.IterateCurrentDatabase(ctx, oid.Callbacks{
+		Table: func(ctx *sql.Context, schema oid.ItemSchema, table oid.ItemTable) (cont bool, err error) {
+			policyTable := collection.GetTable(doltdb.TableName{Name: table.Item.Name(), Schema: schema.Item.SchemaName()})
+			if policyTable == nil {
+				return true, nil
+			}
+			for _, policy := range policyTable.SortedPolicies() {
+				policyRows = append(policyRows, pgPolicy{
+					oid:        oid.CreateOID(oid.Section_Policy, schema.Index, policyCounts[schema.Index]),
+					tableOid:   table.OID,
+					schemaName: schema.Item.SchemaName(),
+					tableName:  table.Item.Name(),
+					policy:     policy,
+				})
+				policyCounts[schema.Index]++
+			}
+			return true, nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policyRows, nil
+}
+
 // pgPolicyRowIter is the sql.RowIter for the pg_policy table.
 type pgPolicyRowIter struct {
+	policies []pgPolicy
+	idx      int
 }
 
 var _ sql.RowIter = (*pgPolicyRowIter)(nil)
 
 // Next implements the interface sql.RowIter.
 func (iter *pgPolicyRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
+	if iter.idx >= len(iter.policies) {
+		return nil, io.EOF
+	}
+	iter.idx++
+	policy := iter.policies[iter.idx-1]
+
+	var command string
+	switch policy.policy.Command {
+	case policies.Command_Select:
+		command = "r"
+	case policies.Command_Insert:
+		command = "a"
+	case policies.Command_Update:
+		command = "w"
+	case policies.Command_Delete:
+		command = "d"
+	default:
+		command = "*"
+	}
+	roles := make([]any, len(policy.policy.Roles))
+	auth.LockRead(func() {
+		for i, roleName := range policy.policy.Roles {
+			// The public role is represented by zero
+			if roleName == "public" {
+				roles[i] = uint32(0)
+			} else {
+				role := auth.GetRole(roleName)
+				roles[i] = uint32(role.ID())
+			}
+		}
+	})
+	var qual any
+	if len(policy.policy.Using) > 0 {
+		qual = policy.policy.Using
+	}
+	var withCheck any
+	if len(policy.policy.WithCheck) > 0 {
+		withCheck = policy.policy.WithCheck
+	}
+	return sql.Row{
+		policy.oid,                 // oid
+		policy.policy.Name,         // polname
+		policy.tableOid,            // polrelid
+		command,                    // polcmd
+		!policy.policy.Restrictive, // polpermissive
+		roles,                      // polroles
+		qual,                       // polqual
+		withCheck,                  // polwithcheck
+	}, nil
 }
 
 // Close implements the interface sql.RowIter.
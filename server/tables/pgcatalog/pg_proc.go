@@ -16,11 +16,15 @@ package pgcatalog
 
 import (
 	"io"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
+	"github.com/dolthub/doltgresql/server/types/oid"
 )
 
 // PgProcName is a constant to the pg_proc name.
@@ -43,8 +47,60 @@ func (p PgProcHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgProcHandler) RowIter(ctx *sql.Context) (sql.RowIter, error) {
-	// TODO: Implement pg_proc row iter
-	return emptyRowIter()
+	var pgCatalogOid uint32
+	var procs []pgProc
+	err := oid.IterateCurrentDatabase(ctx, oid.Callbacks{
+		Function: func(ctx *sql.Context, function oid.ItemFunction) (cont bool, err error) {
+			name := strings.ToLower(function.Item.FunctionName())
+			overloads := framework.Catalog[name]
+			if len(overloads) == 0 {
+				procs = append(procs, pgProc{
+					oid:  function.OID,
+					name: name,
+				})
+				return true, nil
+			}
+			// Each overload has its own row, which is distinguished from the function's OID using the schema index
+			for i, overload := range overloads {
+				procOid := function.OID
+				if i > 0 {
+					procOid = oid.CreateOID(oid.Section_Function, i, function.Index)
+				}
+				parameters := overload.GetParameters()
+				argTypes := make([]any, len(parameters))
+				for j, parameter := range parameters {
+					argTypes[j] = parameter.OID()
+				}
+				procs = append(procs, pgProc{
+					oid:       procOid,
+					name:      name,
+					arguments: framework.FormatParameters(parameters),
+					argTypes:  argTypes,
+				})
+			}
+			return true, nil
+		},
+		Schema: func(ctx *sql.Context, schema oid.ItemSchema) (cont bool, err error) {
+			if schema.Item.SchemaName() == PgCatalogName {
+				pgCatalogOid = schema.OID
+			}
+			return true, nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// All functions are currently built-in, so they all belong to pg_catalog
+	auth.LockRead(func() {
+		for i := range procs {
+			procs[i].acl = auth.GetFunctionACL(PgCatalogName, procs[i].name, procs[i].arguments)
+		}
+	})
+	return &pgProcRowIter{
+		pgCatalogOid: pgCatalogOid,
+		procs:        procs,
+		idx:          0,
+	}, nil
 }
 
 // Schema implements the interface tables.Handler.
@@ -89,15 +145,65 @@ var pgProcSchema = sql.Schema{
 	{Name: "proacl", Type: pgtypes.TextArray, Default: nil, Nullable: true, Source: PgProcName},    // TODO: type aclitem[]
 }
 
+// pgProc represents a row in the pg_proc table.
+type pgProc struct {
+	oid       uint32
+	name      string
+	arguments string
+	argTypes  []any
+	acl       []any
+}
+
 // pgProcRowIter is the sql.RowIter for the pg_proc table.
 type pgProcRowIter struct {
+	pgCatalogOid uint32
+	procs        []pgProc
+	idx          int
 }
 
 var _ sql.RowIter = (*pgProcRowIter)(nil)
 
 // Next implements the interface sql.RowIter.
 func (iter *pgProcRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
+	if iter.idx >= len(iter.procs) {
+		return nil, io.EOF
+	}
+	iter.idx++
+	proc := iter.procs[iter.idx-1]
+
+	// TODO: populate the remaining columns from the function's definition
+	return sql.Row{
+		proc.oid,                  // oid
+		proc.name,                 // proname
+		iter.pgCatalogOid,         // pronamespace
+		uint32(0),                 // proowner
+		uint32(0),                 // prolang
+		float32(1),                // procost
+		float32(0),                // prorows
+		uint32(0),                 // provariadic
+		"-",                       // prosupport
+		"f",                       // prokind
+		false,                     // prosecdef
+		false,                     // proleakproof
+		false,                     // proisstrict
+		false,                     // proretset
+		"v",                       // provolatile
+		"u",                       // proparallel
+		int16(len(proc.argTypes)), // pronargs
+		int16(0),                  // pronargdefaults
+		uint32(0),                 // prorettype
+		proc.argTypes,             // proargtypes
+		nil,                       // proallargtypes
+		nil,                       // proargmodes
+		nil,                       // proargnames
+		nil,                       // proargdefaults
+		nil,                       // protrftypes
+		proc.name,                 // prosrc
+		nil,                       // probin
+		nil,                       // prosqlbody
+		nil,                       // proconfig
+		aclValue(proc.acl),        // proacl
+	}, nil
 }
 
 // Close implements the interface sql.RowIter.
@@ -18,7 +18,9 @@ import (
 	"io"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/lib/pq/oid"
 
+	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -43,8 +45,23 @@ func (p PgRangeHandler) Name() string {
 
 // RowIter implements the interface tables.Handler.
 func (p PgRangeHandler) RowIter(ctx *sql.Context) (sql.RowIter, error) {
-	// TODO: Implement pg_range row iter
-	return emptyRowIter()
+	rangeTypes := pgtypes.GetBuiltInRangeTypes()
+	collection, err := core.GetTypesCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typesMap, schemaNames, _ := collection.GetAllTypes()
+	for _, schemaName := range schemaNames {
+		for _, typ := range typesMap[schemaName] {
+			if typ.TypType == pgtypes.TypeType_Range {
+				rangeTypes = append(rangeTypes, pgtypes.RangeTypeFromType(typ))
+			}
+		}
+	}
+	return &pgRangeRowIter{
+		rangeTypes: rangeTypes,
+		idx:        0,
+	}, nil
 }
 
 // Schema implements the interface tables.Handler.
@@ -66,15 +83,51 @@ var pgRangeSchema = sql.Schema{
 	{Name: "rngsubdiff", Type: pgtypes.Text, Default: nil, Nullable: false, Source: PgRangeName},   // TODO: regproc type
 }
 
+// pgBuiltInRangeInfo contains the multirange type, canonical function, and subtype difference function of each
+// built-in range type.
+var pgBuiltInRangeInfo = map[uint32]struct {
+	multirangeOid uint32
+	canonical     string
+	subDiff       string
+}{
+	uint32(oid.T_int4range): {4451, "int4range_canonical", "int4range_subdiff"},
+	uint32(oid.T_numrange):  {4532, "-", "numrange_subdiff"},
+	uint32(oid.T_tsrange):   {4533, "-", "tsrange_subdiff"},
+	uint32(oid.T_tstzrange): {4534, "-", "tstzrange_subdiff"},
+	uint32(oid.T_daterange): {4535, "daterange_canonical", "daterange_subdiff"},
+	uint32(oid.T_int8range): {4536, "int8range_canonical", "int8range_subdiff"},
+}
+
 // pgRangeRowIter is the sql.RowIter for the pg_range table.
 type pgRangeRowIter struct {
+	rangeTypes []pgtypes.RangeType
+	idx        int
 }
 
 var _ sql.RowIter = (*pgRangeRowIter)(nil)
 
 // Next implements the interface sql.RowIter.
 func (iter *pgRangeRowIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return nil, io.EOF
+	if iter.idx >= len(iter.rangeTypes) {
+		return nil, io.EOF
+	}
+	iter.idx++
+	rangeType := iter.rangeTypes[iter.idx-1]
+
+	multirangeOid, canonical, subDiff := uint32(0), "-", "-"
+	if info, ok := pgBuiltInRangeInfo[rangeType.Oid]; ok {
+		multirangeOid, canonical, subDiff = info.multirangeOid, info.canonical, info.subDiff
+	}
+	// TODO: not all columns are populated
+	return sql.Row{
+		rangeType.Oid,           //rngtypid
+		rangeType.SubType.OID(), //rngsubtype
+		multirangeOid,           //rngmultitypid
+		uint32(0),               //rngcollation
+		uint32(0),               //rngsubopc
+		canonical,               //rngcanonical
+		subDiff,                 //rngsubdiff
+	}, nil
 }
 
 // Close implements the interface sql.RowIter.
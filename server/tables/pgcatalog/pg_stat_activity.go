@@ -64,7 +64,7 @@ var pgStatActivitySchema = sql.Schema{
 	{Name: "usesysid", Type: pgtypes.Oid, Default: nil, Nullable: true, Source: PgStatActivityName},
 	{Name: "usename", Type: pgtypes.Name, Default: nil, Nullable: true, Source: PgStatActivityName},
 	{Name: "application_name", Type: pgtypes.Text, Default: nil, Nullable: true, Source: PgStatActivityName},
-	{Name: "client_addr", Type: pgtypes.Text, Default: nil, Nullable: true, Source: PgStatActivityName}, // TODO: inet type
+	{Name: "client_addr", Type: pgtypes.Inet, Default: nil, Nullable: true, Source: PgStatActivityName},
 	{Name: "client_hostname", Type: pgtypes.Text, Default: nil, Nullable: true, Source: PgStatActivityName},
 	{Name: "client_port", Type: pgtypes.Int32, Default: nil, Nullable: true, Source: PgStatActivityName},
 	{Name: "backend_start", Type: pgtypes.TimestampTZ, Default: nil, Nullable: true, Source: PgStatActivityName},
@@ -61,7 +61,7 @@ var pgStatReplicationSchema = sql.Schema{
 	{Name: "usesysid", Type: pgtypes.Oid, Default: nil, Nullable: true, Source: PgStatReplicationName},
 	{Name: "usename", Type: pgtypes.Name, Default: nil, Nullable: true, Source: PgStatReplicationName},
 	{Name: "application_name", Type: pgtypes.Text, Default: nil, Nullable: true, Source: PgStatReplicationName},
-	{Name: "client_addr", Type: pgtypes.Text, Default: nil, Nullable: true, Source: PgStatReplicationName}, // TODO: inet type
+	{Name: "client_addr", Type: pgtypes.Inet, Default: nil, Nullable: true, Source: PgStatReplicationName},
 	{Name: "client_hostname", Type: pgtypes.Text, Default: nil, Nullable: true, Source: PgStatReplicationName},
 	{Name: "client_port", Type: pgtypes.Int32, Default: nil, Nullable: true, Source: PgStatReplicationName},
 	{Name: "backend_start", Type: pgtypes.TimestampTZ, Default: nil, Nullable: true, Source: PgStatReplicationName},
@@ -21,6 +21,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/server/tables"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 	"github.com/dolthub/doltgresql/server/types/oid"
@@ -54,12 +55,13 @@ func (p PgTypeHandler) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 
 	if pgCatalogCache.types == nil {
 		var pgCatalogOid uint32
+		schemaOids := make(map[string]uint32)
 		err := oid.IterateCurrentDatabase(ctx, oid.Callbacks{
 			Schema: func(ctx *sql.Context, schema oid.ItemSchema) (cont bool, err error) {
 				if schema.Item.SchemaName() == PgCatalogName {
 					pgCatalogOid = schema.OID
-					return false, nil
 				}
+				schemaOids[schema.Item.SchemaName()] = schema.OID
 				return true, nil
 			},
 		})
@@ -77,12 +79,32 @@ func (p PgTypeHandler) RowIter(ctx *sql.Context) (sql.RowIter, error) {
 		if err != nil {
 			return nil, err
 		}
+		// User-defined enum, composite, and range types are stored in the types collection rather than being built-in
+		collection, err := core.GetTypesCollectionFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		typesMap, schemaNames, _ := collection.GetAllTypes()
+		for _, schemaName := range schemaNames {
+			for _, typ := range typesMap[schemaName] {
+				switch typ.TypType {
+				case pgtypes.TypeType_Enum:
+					types = append(types, pgtypes.EnumTypeFromType(typ))
+				case pgtypes.TypeType_Composite:
+					types = append(types, pgtypes.CompositeTypeFromType(typ))
+				case pgtypes.TypeType_Range:
+					types = append(types, pgtypes.RangeTypeFromType(typ))
+				}
+			}
+		}
 		pgCatalogCache.types = types
 		pgCatalogCache.pgCatalogOid = pgCatalogOid
+		pgCatalogCache.typeSchemaOids = schemaOids
 	}
 
 	return &pgTypeRowIter{
 		pgCatalogOid: pgCatalogCache.pgCatalogOid,
+		schemaOids:   pgCatalogCache.typeSchemaOids,
 		types:        pgCatalogCache.types,
 		idx:          0,
 	}, nil
@@ -135,6 +157,7 @@ var pgTypeSchema = sql.Schema{
 // pgTypeRowIter is the sql.RowIter for the pg_type table.
 type pgTypeRowIter struct {
 	pgCatalogOid uint32
+	schemaOids   map[string]uint32
 	types        []pgtypes.DoltgresType
 	idx          int
 }
@@ -163,6 +186,9 @@ func (iter *pgTypeRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 		typAnalyze      = "-"
 		typModIn        = "-"
 		typModOut       = "-"
+		typNamespace    = iter.pgCatalogOid
+		typArray        = uint32(0)
+		typRelID        = uint32(0)
 	)
 
 	if l := typ.MaxTextResponseByteLength(ctx); l == math.MaxUint32 {
@@ -185,6 +211,13 @@ func (iter *pgTypeRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 		typStorage = "x"
 	case pgtypes.UuidType:
 		typConvFnSep = "_"
+	case pgtypes.InetType, pgtypes.CidrType:
+		typConvFnSep = "_"
+		typStorage = "m"
+	case pgtypes.MacAddrType, pgtypes.MacAddr8Type:
+		typConvFnSep = "_"
+		typByVal = false
+		typStorage = "p"
 	case pgtypes.DoltgresArrayType:
 		typStorage = "x"
 		typConvFnSep = "_"
@@ -211,6 +244,33 @@ func (iter *pgTypeRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 		typType = "p"
 		typConvFnSep = "_"
 		typByVal = true
+	case pgtypes.EnumType:
+		typLen = 4
+		typByVal = true
+		typType = "e"
+		typConvFnPrefix = "enum"
+		typConvFnSep = "_"
+		typStorage = "p"
+		typNamespace = iter.schemaOids[t.Schema]
+		typArray = t.ArrayOid
+	case pgtypes.CompositeType:
+		typType = "c"
+		typConvFnPrefix = "record"
+		typConvFnSep = "_"
+		typStorage = "x"
+		typNamespace = iter.schemaOids[t.Schema]
+		typArray = t.ArrayOid
+		typRelID = t.RelID
+	case pgtypes.RangeType:
+		typType = "r"
+		typConvFnPrefix = "range"
+		typConvFnSep = "_"
+		typStorage = "x"
+		typAnalyze = "range_typanalyze"
+		if len(t.Schema) > 0 {
+			typNamespace = iter.schemaOids[t.Schema]
+		}
+		typArray = t.ArrayOid
 	}
 
 	typIn := fmt.Sprintf("%s%sin", typConvFnPrefix, typConvFnSep)
@@ -230,7 +290,7 @@ func (iter *pgTypeRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 	return sql.Row{
 		typ.OID(),             //oid
 		typName,               //typname
-		iter.pgCatalogOid,     //typnamespace
+		typNamespace,          //typnamespace
 		uint32(0),             //typowner
 		typLen,                //typlen
 		typByVal,              //typbyval
@@ -239,10 +299,10 @@ func (iter *pgTypeRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 		typ.IsPreferredType(), //typispreferred
 		true,                  //typisdefined
 		",",                   //typdelim
-		uint32(0),             //typrelid
+		typRelID,              //typrelid
 		typSubscript,          //typsubscript
 		uint32(0),             //typelem
-		uint32(0),             //typarray
+		typArray,              //typarray
 		typIn,                 //typinput
 		typOut,                //typoutput
 		typRec,                //typreceive
@@ -109,6 +109,16 @@ func (aa AnyArrayType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return "", fmt.Errorf("%s cannot produce I/O output", aa.String())
 }
 
+// IoReceive implements the DoltgresType interface.
+func (aa AnyArrayType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return nil, fmt.Errorf("%s cannot receive binary input", aa.String())
+}
+
+// IoSend implements the DoltgresType interface.
+func (aa AnyArrayType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot produce binary output", aa.String())
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (aa AnyArrayType) IsPreferredType() bool {
 	return false
@@ -96,6 +96,16 @@ func (ae AnyElementType) IoOutput(ctx *sql.Context, output any) (string, error)
 	return "", fmt.Errorf("%s cannot produce I/O output", ae.String())
 }
 
+// IoReceive implements the DoltgresType interface.
+func (ae AnyElementType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return nil, fmt.Errorf("%s cannot receive binary input", ae.String())
+}
+
+// IoSend implements the DoltgresType interface.
+func (ae AnyElementType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot produce binary output", ae.String())
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (ae AnyElementType) IsPreferredType() bool {
 	return false
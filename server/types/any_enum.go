@@ -0,0 +1,205 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+)
+
+// AnyEnum is a pseudo-type that can represent any enum type.
+var AnyEnum = AnyEnumType{}
+
+// AnyEnumType is the extended type implementation of the PostgreSQL anyenum.
+type AnyEnumType struct{}
+
+var _ DoltgresType = AnyEnumType{}
+var _ DoltgresPolymorphicType = AnyEnumType{}
+
+// Alignment implements the DoltgresType interface.
+func (ae AnyEnumType) Alignment() TypeAlignment {
+	return TypeAlignment_Int
+}
+
+// BaseID implements the DoltgresType interface.
+func (ae AnyEnumType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_AnyEnum
+}
+
+// BaseName implements the DoltgresType interface.
+func (ae AnyEnumType) BaseName() string {
+	return "anyenum"
+}
+
+// Category implements the DoltgresType interface.
+func (ae AnyEnumType) Category() TypeCategory {
+	return TypeCategory_PseudoTypes
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (ae AnyEnumType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (ae AnyEnumType) Compare(v1 any, v2 any) (int, error) {
+	return 0, fmt.Errorf("%s cannot compare values", ae.String())
+}
+
+// Convert implements the DoltgresType interface.
+func (ae AnyEnumType) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case []any:
+		return nil, sql.OutOfRange, fmt.Errorf("%s: unhandled type: %T", ae.String(), val)
+	default:
+		return val, sql.InRange, nil
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (ae AnyEnumType) Equals(otherType sql.Type) bool {
+	_, ok := otherType.(AnyEnumType)
+	return ok
+}
+
+// FormatValue implements the DoltgresType interface.
+func (ae AnyEnumType) FormatValue(val any) (string, error) {
+	return "", fmt.Errorf("%s cannot format values", ae.String())
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (ae AnyEnumType) GetSerializationID() SerializationID {
+	return SerializationID_Invalid
+}
+
+// IoInput implements the DoltgresType interface.
+func (ae AnyEnumType) IoInput(ctx *sql.Context, input string) (any, error) {
+	return "", fmt.Errorf("%s cannot receive I/O input", ae.String())
+}
+
+// IoOutput implements the DoltgresType interface.
+func (ae AnyEnumType) IoOutput(ctx *sql.Context, output any) (string, error) {
+	return "", fmt.Errorf("%s cannot produce I/O output", ae.String())
+}
+
+// IoReceive implements the DoltgresType interface.
+func (ae AnyEnumType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return nil, fmt.Errorf("%s cannot receive binary input", ae.String())
+}
+
+// IoSend implements the DoltgresType interface.
+func (ae AnyEnumType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot produce binary output", ae.String())
+}
+
+// IsPreferredType implements the DoltgresType interface.
+func (ae AnyEnumType) IsPreferredType() bool {
+	return false
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (ae AnyEnumType) IsUnbounded() bool {
+	return true
+}
+
+// IsValid implements the DoltgresPolymorphicType interface.
+func (ae AnyEnumType) IsValid(target DoltgresType) bool {
+	_, ok := target.(EnumType)
+	return ok
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (ae AnyEnumType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	return types.ExtendedTypeSerializedWidth_Unbounded
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (ae AnyEnumType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return math.MaxUint32
+}
+
+// OID implements the DoltgresType interface.
+func (ae AnyEnumType) OID() uint32 {
+	return uint32(oid.T_anyenum)
+}
+
+// Promote implements the DoltgresType interface.
+func (ae AnyEnumType) Promote() sql.Type {
+	return ae
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (ae AnyEnumType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	return 0, fmt.Errorf("%s cannot compare serialized values", ae.String())
+}
+
+// SQL implements the DoltgresType interface.
+func (ae AnyEnumType) SQL(ctx *sql.Context, dest []byte, v any) (sqltypes.Value, error) {
+	return sqltypes.Value{}, fmt.Errorf("%s cannot output values in the wire format", ae.String())
+}
+
+// String implements the DoltgresType interface.
+func (ae AnyEnumType) String() string {
+	return "anyenum"
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (ae AnyEnumType) ToArrayType() DoltgresArrayType {
+	return Unknown
+}
+
+// Type implements the DoltgresType interface.
+func (ae AnyEnumType) Type() query.Type {
+	return sqltypes.Text
+}
+
+// ValueType implements the DoltgresType interface.
+func (ae AnyEnumType) ValueType() reflect.Type {
+	var val any
+	return reflect.TypeOf(val)
+}
+
+// Zero implements the DoltgresType interface.
+func (ae AnyEnumType) Zero() any {
+	var val any
+	return val
+}
+
+// SerializeType implements the DoltgresType interface.
+func (ae AnyEnumType) SerializeType() ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot be serialized", ae.String())
+}
+
+// deserializeType implements the DoltgresType interface.
+func (ae AnyEnumType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	return nil, fmt.Errorf("%s cannot be deserialized", ae.String())
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (ae AnyEnumType) SerializeValue(val any) ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot serialize values", ae.String())
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (ae AnyEnumType) DeserializeValue(val []byte) (any, error) {
+	return nil, fmt.Errorf("%s cannot deserialize values", ae.String())
+}
@@ -101,6 +101,16 @@ func (ana AnyNonArrayType) IoOutput(ctx *sql.Context, output any) (string, error
 	return "", fmt.Errorf("%s cannot produce I/O output", ana.String())
 }
 
+// IoReceive implements the DoltgresType interface.
+func (ana AnyNonArrayType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return nil, fmt.Errorf("%s cannot receive binary input", ana.String())
+}
+
+// IoSend implements the DoltgresType interface.
+func (ana AnyNonArrayType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot produce binary output", ana.String())
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (ana AnyNonArrayType) IsPreferredType() bool {
 	return false
@@ -0,0 +1,205 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+)
+
+// AnyRange is a pseudo-type that can represent any range type.
+var AnyRange = AnyRangeType{}
+
+// AnyRangeType is the extended type implementation of the PostgreSQL anyrange.
+type AnyRangeType struct{}
+
+var _ DoltgresType = AnyRangeType{}
+var _ DoltgresPolymorphicType = AnyRangeType{}
+
+// Alignment implements the DoltgresType interface.
+func (ae AnyRangeType) Alignment() TypeAlignment {
+	return TypeAlignment_Double
+}
+
+// BaseID implements the DoltgresType interface.
+func (ae AnyRangeType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_AnyRange
+}
+
+// BaseName implements the DoltgresType interface.
+func (ae AnyRangeType) BaseName() string {
+	return "anyrange"
+}
+
+// Category implements the DoltgresType interface.
+func (ae AnyRangeType) Category() TypeCategory {
+	return TypeCategory_PseudoTypes
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (ae AnyRangeType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (ae AnyRangeType) Compare(v1 any, v2 any) (int, error) {
+	return 0, fmt.Errorf("%s cannot compare values", ae.String())
+}
+
+// Convert implements the DoltgresType interface.
+func (ae AnyRangeType) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case []any:
+		return nil, sql.OutOfRange, fmt.Errorf("%s: unhandled type: %T", ae.String(), val)
+	default:
+		return val, sql.InRange, nil
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (ae AnyRangeType) Equals(otherType sql.Type) bool {
+	_, ok := otherType.(AnyRangeType)
+	return ok
+}
+
+// FormatValue implements the DoltgresType interface.
+func (ae AnyRangeType) FormatValue(val any) (string, error) {
+	return "", fmt.Errorf("%s cannot format values", ae.String())
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (ae AnyRangeType) GetSerializationID() SerializationID {
+	return SerializationID_Invalid
+}
+
+// IoInput implements the DoltgresType interface.
+func (ae AnyRangeType) IoInput(ctx *sql.Context, input string) (any, error) {
+	return "", fmt.Errorf("%s cannot receive I/O input", ae.String())
+}
+
+// IoOutput implements the DoltgresType interface.
+func (ae AnyRangeType) IoOutput(ctx *sql.Context, output any) (string, error) {
+	return "", fmt.Errorf("%s cannot produce I/O output", ae.String())
+}
+
+// IoReceive implements the DoltgresType interface.
+func (ae AnyRangeType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return nil, fmt.Errorf("%s cannot receive binary input", ae.String())
+}
+
+// IoSend implements the DoltgresType interface.
+func (ae AnyRangeType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot produce binary output", ae.String())
+}
+
+// IsPreferredType implements the DoltgresType interface.
+func (ae AnyRangeType) IsPreferredType() bool {
+	return false
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (ae AnyRangeType) IsUnbounded() bool {
+	return true
+}
+
+// IsValid implements the DoltgresPolymorphicType interface.
+func (ae AnyRangeType) IsValid(target DoltgresType) bool {
+	_, ok := target.(RangeType)
+	return ok
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (ae AnyRangeType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	return types.ExtendedTypeSerializedWidth_Unbounded
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (ae AnyRangeType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return math.MaxUint32
+}
+
+// OID implements the DoltgresType interface.
+func (ae AnyRangeType) OID() uint32 {
+	return uint32(oid.T_anyrange)
+}
+
+// Promote implements the DoltgresType interface.
+func (ae AnyRangeType) Promote() sql.Type {
+	return ae
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (ae AnyRangeType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	return 0, fmt.Errorf("%s cannot compare serialized values", ae.String())
+}
+
+// SQL implements the DoltgresType interface.
+func (ae AnyRangeType) SQL(ctx *sql.Context, dest []byte, v any) (sqltypes.Value, error) {
+	return sqltypes.Value{}, fmt.Errorf("%s cannot output values in the wire format", ae.String())
+}
+
+// String implements the DoltgresType interface.
+func (ae AnyRangeType) String() string {
+	return "anyrange"
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (ae AnyRangeType) ToArrayType() DoltgresArrayType {
+	return Unknown
+}
+
+// Type implements the DoltgresType interface.
+func (ae AnyRangeType) Type() query.Type {
+	return sqltypes.Text
+}
+
+// ValueType implements the DoltgresType interface.
+func (ae AnyRangeType) ValueType() reflect.Type {
+	var val any
+	return reflect.TypeOf(val)
+}
+
+// Zero implements the DoltgresType interface.
+func (ae AnyRangeType) Zero() any {
+	var val any
+	return val
+}
+
+// SerializeType implements the DoltgresType interface.
+func (ae AnyRangeType) SerializeType() ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot be serialized", ae.String())
+}
+
+// deserializeType implements the DoltgresType interface.
+func (ae AnyRangeType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	return nil, fmt.Errorf("%s cannot be deserialized", ae.String())
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (ae AnyRangeType) SerializeValue(val any) ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot serialize values", ae.String())
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (ae AnyRangeType) DeserializeValue(val []byte) (any, error) {
+	return nil, fmt.Errorf("%s cannot deserialize values", ae.String())
+}
@@ -21,6 +21,7 @@ import (
 	"math"
 	"reflect"
 	"strings"
+	"unicode"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
@@ -126,7 +127,16 @@ func (ac arrayContainer) Compare(v1 any, v2 any) (int, error) {
 
 	minLength := utils.Min(len(ab), len(bb))
 	for i := 0; i < minLength; i++ {
-		res, err := ac.innerType.Compare(ab[i], bb[i])
+		var res int
+		var err error
+		// Multidimensional arrays contain their sub-arrays as elements, which are compared using the array itself
+		_, aIsArray := ab[i].([]any)
+		_, bIsArray := bb[i].([]any)
+		if aIsArray || bIsArray {
+			res, err = ac.Compare(ab[i], bb[i])
+		} else {
+			res, err = ac.innerType.Compare(ab[i], bb[i])
+		}
 		if err != nil {
 			return 0, err
 		}
@@ -178,97 +188,32 @@ func (ac arrayContainer) GetSerializationID() SerializationID {
 
 // IoInput implements the DoltgresType interface.
 func (ac arrayContainer) IoInput(ctx *sql.Context, input string) (any, error) {
-	if len(input) < 2 || input[0] != '{' || input[len(input)-1] != '}' {
+	parser := arrayLiteralParser{
+		ctx:   ctx,
+		ac:    ac,
+		input: []rune(input),
+	}
+	parser.skipWhitespace()
+	values, err := parser.parseArray()
+	if err != nil {
 		// This error is regarded as a critical error, and thus we immediately return the error alongside a nil
 		// value. Returning a nil value is a signal to not ignore the error.
+		return nil, err
+	}
+	parser.skipWhitespace()
+	if parser.pos < len(parser.input) {
 		return nil, fmt.Errorf(`malformed array literal: "%s"`, input)
 	}
-	// We'll remove the surrounding braces since we've already verified that they're there
-	input = input[1 : len(input)-1]
-	var values []any
-	var err error
-	sb := strings.Builder{}
-	quoteStartCount := 0
-	quoteEndCount := 0
-	escaped := false
-	// Iterate over each rune in the input to collect and process the rune elements
-	for _, r := range input {
-		if escaped {
-			sb.WriteRune(r)
-			escaped = false
-		} else if quoteStartCount > quoteEndCount {
-			switch r {
-			case '\\':
-				escaped = true
-			case '"':
-				quoteEndCount++
-			default:
-				sb.WriteRune(r)
-			}
-		} else {
-			switch r {
-			case ' ', '\t', '\n', '\r':
-				continue
-			case '\\':
-				escaped = true
-			case '"':
-				quoteStartCount++
-			case ',':
-				if quoteStartCount >= 2 {
-					// This is a malformed string, thus we treat it as a critical error.
-					return nil, fmt.Errorf(`malformed array literal: "%s"`, input)
-				}
-				str := sb.String()
-				var innerValue any
-				if quoteStartCount == 0 && strings.EqualFold(str, "null") {
-					// An unquoted case-insensitive NULL is treated as an actual null value
-					innerValue = nil
-				} else {
-					var nErr error
-					innerValue, nErr = ac.innerType.IoInput(ctx, str)
-					if nErr != nil && err == nil {
-						// This is a non-critical error, therefore the error may be ignored at a higher layer (such as
-						// an explicit cast) and the inner type will still return a valid result, so we must allow the
-						// values to propagate.
-						err = nErr
-					}
-				}
-				values = append(values, innerValue)
-				sb.Reset()
-				quoteStartCount = 0
-				quoteEndCount = 0
-			default:
-				sb.WriteRune(r)
-			}
-		}
+	if _, err = ArrayDimensions(values); err != nil {
+		return nil, fmt.Errorf(`malformed array literal: "%s"`, input)
 	}
-	// Use anything remaining in the buffer as the last element
-	if sb.Len() > 0 {
-		if escaped || quoteStartCount > quoteEndCount || quoteStartCount >= 2 {
-			// These errors are regarded as critical errors, and thus we immediately return the error alongside a nil
-			// value. Returning a nil value is a signal to not ignore the error.
-			return nil, fmt.Errorf(`malformed array literal: "%s"`, input)
-		} else {
-			str := sb.String()
-			var innerValue any
-			if quoteStartCount == 0 && strings.EqualFold(str, "NULL") {
-				// An unquoted case-insensitive NULL is treated as an actual null value
-				innerValue = nil
-			} else {
-				var nErr error
-				innerValue, nErr = ac.innerType.IoInput(ctx, str)
-				if nErr != nil && err == nil {
-					// This is a non-critical error, therefore the error may be ignored at a higher layer (such as
-					// an explicit cast) and the inner type will still return a valid result, so we must allow the
-					// values to propagate.
-					err = nErr
-				}
-			}
-			values = append(values, innerValue)
-		}
+	// An array containing only empty sub-arrays is simply an empty array
+	if len(ArrayFlatten(values)) == 0 {
+		values = []any{}
 	}
-
-	return values, err
+	// Errors from the inner type are non-critical, therefore the error may be ignored at a higher layer (such as an
+	// explicit cast) and the inner type will still return a valid result, so we must allow the values to propagate.
+	return values, parser.innerErr
 }
 
 // IoOutput implements the DoltgresType interface.
@@ -278,15 +223,28 @@ func (ac arrayContainer) IoOutput(ctx *sql.Context, output any) (string, error)
 		return "", err
 	}
 	sb := strings.Builder{}
+	if err = ac.writeArray(ctx, &sb, converted.([]any)); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// writeArray writes the text representation of the array to the builder. Sub-arrays of multidimensional arrays are
+// written recursively.
+func (ac arrayContainer) writeArray(ctx *sql.Context, sb *strings.Builder, vals []any) error {
 	sb.WriteRune('{')
-	for i, v := range converted.([]any) {
+	for i, v := range vals {
 		if i > 0 {
 			sb.WriteString(",")
 		}
-		if v != nil {
+		if subArray, ok := v.([]any); ok {
+			if err := ac.writeArray(ctx, sb, subArray); err != nil {
+				return err
+			}
+		} else if v != nil {
 			str, err := ac.innerType.IoOutput(ctx, v)
 			if err != nil {
-				return "", err
+				return err
 			}
 			shouldQuote := false
 			for _, r := range str {
@@ -295,9 +253,9 @@ func (ac arrayContainer) IoOutput(ctx *sql.Context, output any) (string, error)
 					shouldQuote = true
 				}
 			}
-			if shouldQuote || strings.EqualFold(str, "NULL") {
+			if shouldQuote || len(str) == 0 || strings.EqualFold(str, "NULL") {
 				sb.WriteRune('"')
-				sb.WriteString(strings.ReplaceAll(str, `"`, `\"`))
+				sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(str, `\`, `\\`), `"`, `\"`))
 				sb.WriteRune('"')
 			} else {
 				sb.WriteString(str)
@@ -307,7 +265,118 @@ func (ac arrayContainer) IoOutput(ctx *sql.Context, output any) (string, error)
 		}
 	}
 	sb.WriteRune('}')
-	return sb.String(), nil
+	return nil
+}
+
+// IoReceive implements the DoltgresType interface.
+func (ac arrayContainer) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	// The header contains the number of dimensions, a flag for whether there are NULLs, and the element type. Each
+	// dimension then has its length and lower bound, which is followed by the elements themselves. Each element is
+	// preceded by its length, with a length of -1 representing NULL.
+	if len(data) < 12 {
+		return nil, ErrInvalidBinaryRepresentation.New(ac.String())
+	}
+	dimensions := int32(binary.BigEndian.Uint32(data))
+	elementOid := binary.BigEndian.Uint32(data[8:])
+	if dimensions == 0 {
+		return []any{}, nil
+	} else if dimensions < 0 || dimensions > MaxArrayDimensions {
+		return nil, ErrInvalidBinaryRepresentation.New(ac.String())
+	}
+	if elementOid != ac.innerType.OID() {
+		return nil, fmt.Errorf("binary data has array element type %d instead of expected %d", elementOid, ac.innerType.OID())
+	}
+	if len(data) < 12+int(dimensions)*8 {
+		return nil, ErrInvalidBinaryRepresentation.New(ac.String())
+	}
+	dims := make([]int, dimensions)
+	elementCount := 1
+	for i := range dims {
+		length := int32(binary.BigEndian.Uint32(data[12+i*8:]))
+		if length < 0 {
+			return nil, ErrInvalidBinaryRepresentation.New(ac.String())
+		}
+		dims[i] = int(length)
+		elementCount *= dims[i]
+	}
+	data = data[12+int(dimensions)*8:]
+	if elementCount > len(data)/4 {
+		return nil, ErrInvalidBinaryRepresentation.New(ac.String())
+	}
+	values := make([]any, elementCount)
+	for i := range values {
+		if len(data) < 4 {
+			return nil, ErrInvalidBinaryRepresentation.New(ac.String())
+		}
+		elementLength := int32(binary.BigEndian.Uint32(data))
+		data = data[4:]
+		if elementLength == -1 {
+			continue
+		}
+		if elementLength < 0 || int(elementLength) > len(data) {
+			return nil, ErrInvalidBinaryRepresentation.New(ac.String())
+		}
+		value, err := ac.innerType.IoReceive(ctx, data[:elementLength])
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+		data = data[elementLength:]
+	}
+	if len(data) > 0 {
+		return nil, ErrInvalidBinaryRepresentation.New(ac.String())
+	}
+	if elementCount == 0 {
+		return []any{}, nil
+	}
+	return ArrayFromFlat(values, dims), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (ac arrayContainer) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := ac.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	dims, err := ArrayDimensions(converted.([]any))
+	if err != nil {
+		return nil, err
+	}
+	vals := ArrayFlatten(converted.([]any))
+	hasNull := uint32(0)
+	for _, v := range vals {
+		if v == nil {
+			hasNull = 1
+			break
+		}
+	}
+	// Empty arrays have zero dimensions, and therefore do not write any dimension information
+	if len(vals) == 0 {
+		dims = nil
+	}
+	data := binary.BigEndian.AppendUint32(nil, uint32(len(dims)))
+	data = binary.BigEndian.AppendUint32(data, hasNull)
+	data = binary.BigEndian.AppendUint32(data, ac.innerType.OID())
+	if len(dims) == 0 {
+		return data, nil
+	}
+	for _, dim := range dims {
+		data = binary.BigEndian.AppendUint32(data, uint32(dim))
+		data = binary.BigEndian.AppendUint32(data, 1)
+	}
+	for _, v := range vals {
+		if v == nil {
+			data = binary.BigEndian.AppendUint32(data, math.MaxUint32)
+			continue
+		}
+		element, err := ac.innerType.IoSend(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		data = binary.BigEndian.AppendUint32(data, uint32(len(element)))
+		data = append(data, element...)
+	}
+	return data, nil
 }
 
 // IsPreferredType implements the DoltgresType interface.
@@ -418,6 +487,8 @@ func (ac arrayContainer) SerializeValue(valInterface any) ([]byte, error) {
 	// The last offset contains the length of the slice.
 	// The last section is the data section, where all elements store their data.
 	// Each element comprises two values: a single byte stating if it's null, and the data itself.
+	// The byte may also state that the element is a sub-array of a multidimensional array, in which case the data is the
+	// sub-array serialized using this same format.
 	// You may determine the length of the data by using the following offset, as the data occupies all bytes up to the next offset.
 	// The last element is a special case, as its data simply occupies all bytes up to the end of the slice.
 	// The data may have a length of zero, which is distinct from null for some types.
@@ -451,8 +522,17 @@ func (ac arrayContainer) SerializeValue(valInterface any) ([]byte, error) {
 	for i := range vals {
 		// Write the current offset
 		binary.LittleEndian.PutUint32(offsets[i*4:], currentOffset)
-		// Handle serialization of the value
-		// TODO: ARRAYs may be multidimensional, such as ARRAY[[4,2],[6,3]], which isn't accounted for here
+		// Handle serialization of the value, where sub-arrays are serialized as arrays
+		if subArray, ok := vals[i].([]any); ok {
+			serializedVal, err := ac.SerializeValue(subArray)
+			if err != nil {
+				return nil, err
+			}
+			bb.WriteByte(2)
+			bb.Write(serializedVal)
+			currentOffset += 1 + uint32(len(serializedVal))
+			continue
+		}
 		serializedVal, err := ac.innerType.SerializeValue(vals[i])
 		if err != nil {
 			return nil, err
@@ -497,7 +577,11 @@ func (ac arrayContainer) DeserializeValue(serializedVals []byte) (_ any, err err
 		}
 		// The element data is everything from the offset to the next offset, excluding the null determinant
 		nextOffset := binary.LittleEndian.Uint32(serializedVals[(i+2)*4:])
-		output[i], err = ac.innerType.DeserializeValue(serializedVals[offset+1 : nextOffset])
+		if serializedVals[offset] == 2 {
+			output[i], err = ac.DeserializeValue(serializedVals[offset+1 : nextOffset])
+		} else {
+			output[i], err = ac.innerType.DeserializeValue(serializedVals[offset+1 : nextOffset])
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -517,3 +601,237 @@ func arrayContainerSQL(ctx *sql.Context, ac arrayContainer, dest []byte, value a
 	}
 	return sqltypes.MakeTrusted(sqltypes.Text, types.AppendAndSliceBytes(dest, []byte(str))), nil
 }
+
+// MaxArrayDimensions is the maximum number of dimensions that an array may have.
+const MaxArrayDimensions = 6
+
+// arrayLiteralParser parses the text representation of an array, such as {1,2,3} or {{1,2},{3,4}}.
+type arrayLiteralParser struct {
+	ctx      *sql.Context
+	ac       arrayContainer
+	input    []rune
+	pos      int
+	innerErr error
+}
+
+// skipWhitespace advances the parser past any whitespace.
+func (p *arrayLiteralParser) skipWhitespace() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// malformed returns the error for a malformed array literal.
+func (p *arrayLiteralParser) malformed() error {
+	return fmt.Errorf(`malformed array literal: "%s"`, string(p.input))
+}
+
+// parseArray parses an array beginning at the current position, including all of its sub-arrays.
+func (p *arrayLiteralParser) parseArray() ([]any, error) {
+	if p.pos >= len(p.input) || p.input[p.pos] != '{' {
+		return nil, p.malformed()
+	}
+	p.pos++
+	values := []any{}
+	p.skipWhitespace()
+	if p.pos < len(p.input) && p.input[p.pos] == '}' {
+		p.pos++
+		return values, nil
+	}
+	for {
+		p.skipWhitespace()
+		if p.pos >= len(p.input) {
+			return nil, p.malformed()
+		}
+		if p.input[p.pos] == '{' {
+			subArray, err := p.parseArray()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, subArray)
+		} else {
+			value, err := p.parseElement()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		p.skipWhitespace()
+		if p.pos >= len(p.input) {
+			return nil, p.malformed()
+		}
+		switch p.input[p.pos] {
+		case ',':
+			p.pos++
+		case '}':
+			p.pos++
+			return values, nil
+		default:
+			return nil, p.malformed()
+		}
+	}
+}
+
+// parseElement parses a single quoted or unquoted element, converting it using the inner type.
+func (p *arrayLiteralParser) parseElement() (any, error) {
+	sb := strings.Builder{}
+	// Length of the element once trailing whitespace has been removed, which is only applicable to unquoted text
+	trimmedLength := 0
+	for p.pos < len(p.input) {
+		r := p.input[p.pos]
+		if r == ',' || r == '}' {
+			break
+		}
+		switch r {
+		case '{':
+			return nil, p.malformed()
+		case '"':
+			// Quotes may only surround the entire element
+			if sb.Len() > 0 {
+				return nil, p.malformed()
+			}
+			p.pos++
+			p.parseQuoted(&sb)
+			if p.pos >= len(p.input) {
+				return nil, p.malformed()
+			}
+			p.pos++
+			p.skipWhitespace()
+			if p.pos >= len(p.input) || (p.input[p.pos] != ',' && p.input[p.pos] != '}') {
+				return nil, p.malformed()
+			}
+			return p.convert(sb.String())
+		case '\\':
+			p.pos++
+			if p.pos >= len(p.input) {
+				return nil, p.malformed()
+			}
+			sb.WriteRune(p.input[p.pos])
+			trimmedLength = sb.Len()
+		default:
+			sb.WriteRune(r)
+			if !unicode.IsSpace(r) {
+				trimmedLength = sb.Len()
+			}
+		}
+		p.pos++
+	}
+	if p.pos >= len(p.input) {
+		return nil, p.malformed()
+	}
+	str := sb.String()[:trimmedLength]
+	if len(str) == 0 {
+		return nil, p.malformed()
+	}
+	if strings.EqualFold(str, "NULL") {
+		return nil, nil
+	}
+	return p.convert(str)
+}
+
+// parseQuoted reads the contents of a quoted element into the builder, stopping on the closing quote.
+func (p *arrayLiteralParser) parseQuoted(sb *strings.Builder) {
+	for p.pos < len(p.input) {
+		switch r := p.input[p.pos]; r {
+		case '\\':
+			p.pos++
+			if p.pos < len(p.input) {
+				sb.WriteRune(p.input[p.pos])
+			}
+		case '"':
+			return
+		default:
+			sb.WriteRune(r)
+		}
+		p.pos++
+	}
+}
+
+// convert converts the element's text using the inner type. Errors from the inner type are recorded rather than
+// returned, as they're considered non-critical.
+func (p *arrayLiteralParser) convert(str string) (any, error) {
+	value, err := p.ac.innerType.IoInput(p.ctx, str)
+	if err != nil {
+		if value == nil {
+			return nil, err
+		}
+		if p.innerErr == nil {
+			p.innerErr = err
+		}
+	}
+	return value, nil
+}
+
+// ArrayDimensions returns the length of each dimension of the given array. Multidimensional arrays are represented as
+// nested slices, and must be rectangular (every sub-array at the same depth has the same length). An empty array has a
+// single dimension of length zero.
+func ArrayDimensions(vals []any) ([]int, error) {
+	dims := []int{len(vals)}
+	if len(vals) == 0 {
+		return dims, nil
+	}
+	first, isNested := vals[0].([]any)
+	if !isNested {
+		for _, val := range vals {
+			if _, ok := val.([]any); ok {
+				return nil, fmt.Errorf("multidimensional arrays must have array expressions with matching dimensions")
+			}
+		}
+		return dims, nil
+	}
+	subDims, err := ArrayDimensions(first)
+	if err != nil {
+		return nil, err
+	}
+	for _, val := range vals[1:] {
+		subArray, ok := val.([]any)
+		if !ok {
+			return nil, fmt.Errorf("multidimensional arrays must have array expressions with matching dimensions")
+		}
+		otherDims, err := ArrayDimensions(subArray)
+		if err != nil {
+			return nil, err
+		}
+		if len(otherDims) != len(subDims) {
+			return nil, fmt.Errorf("multidimensional arrays must have array expressions with matching dimensions")
+		}
+		for i := range subDims {
+			if subDims[i] != otherDims[i] {
+				return nil, fmt.Errorf("multidimensional arrays must have array expressions with matching dimensions")
+			}
+		}
+	}
+	dims = append(dims, subDims...)
+	if len(dims) > MaxArrayDimensions {
+		return nil, fmt.Errorf("number of array dimensions (%d) exceeds the maximum allowed (%d)", len(dims), MaxArrayDimensions)
+	}
+	return dims, nil
+}
+
+// ArrayFlatten returns the elements of the given array in storage order, descending into the sub-arrays of a
+// multidimensional array.
+func ArrayFlatten(vals []any) []any {
+	flattened := make([]any, 0, len(vals))
+	for _, val := range vals {
+		if subArray, ok := val.([]any); ok {
+			flattened = append(flattened, ArrayFlatten(subArray)...)
+		} else {
+			flattened = append(flattened, val)
+		}
+	}
+	return flattened
+}
+
+// ArrayFromFlat is the inverse of ArrayFlatten, constructing an array with the given dimensions from the flattened
+// elements. The number of elements must equal the product of the dimensions.
+func ArrayFromFlat(flat []any, dims []int) []any {
+	if len(dims) <= 1 {
+		return flat
+	}
+	stride := len(flat) / dims[0]
+	vals := make([]any, dims[0])
+	for i := range vals {
+		vals[i] = ArrayFromFlat(flat[i*stride:(i+1)*stride], dims[1:])
+	}
+	return vals
+}
@@ -149,6 +149,26 @@ func (b BoolType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	}
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b BoolType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 1); err != nil {
+		return nil, err
+	}
+	return data[0] != 0, nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b BoolType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	if converted.(bool) {
+		return []byte{1}, nil
+	}
+	return []byte{0}, nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b BoolType) IsPreferredType() bool {
 	return true
@@ -139,6 +139,20 @@ func (b ByteaType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return `\x` + hex.EncodeToString(converted.([]byte)), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b ByteaType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return bytes.Clone(data), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b ByteaType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return converted.([]byte), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b ByteaType) IsPreferredType() bool {
 	return false
@@ -164,6 +164,20 @@ func (b CharType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	}
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b CharType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return b.IoInput(ctx, string(data))
+}
+
+// IoSend implements the DoltgresType interface.
+func (b CharType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	str, err := b.IoOutput(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(str), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b CharType) IsPreferredType() bool {
 	return false
@@ -0,0 +1,278 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"net/netip"
+	"reflect"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+)
+
+// Cidr is the network type, which holds an IPv4 or IPv6 network specification.
+var Cidr = CidrType{}
+
+// CidrType is the extended type implementation of the PostgreSQL cidr. Values are stored as a netip.Prefix, which never
+// has any bits set outside of the netmask.
+type CidrType struct{}
+
+var _ DoltgresType = CidrType{}
+
+// Alignment implements the DoltgresType interface.
+func (b CidrType) Alignment() TypeAlignment {
+	return TypeAlignment_Int
+}
+
+// BaseID implements the DoltgresType interface.
+func (b CidrType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_Cidr
+}
+
+// BaseName implements the DoltgresType interface.
+func (b CidrType) BaseName() string {
+	return "cidr"
+}
+
+// Category implements the DoltgresType interface.
+func (b CidrType) Category() TypeCategory {
+	return TypeCategory_NetworkAddressTypes
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (b CidrType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (b CidrType) Compare(v1 any, v2 any) (int, error) {
+	if v1 == nil && v2 == nil {
+		return 0, nil
+	} else if v1 != nil && v2 == nil {
+		return 1, nil
+	} else if v1 == nil && v2 != nil {
+		return -1, nil
+	}
+
+	ac, _, err := b.Convert(v1)
+	if err != nil {
+		return 0, err
+	}
+	bc, _, err := b.Convert(v2)
+	if err != nil {
+		return 0, err
+	}
+	return compareNetworkAddresses(ac.(netip.Prefix), bc.(netip.Prefix)), nil
+}
+
+// Convert implements the DoltgresType interface.
+func (b CidrType) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case netip.Prefix:
+		return val, sql.InRange, nil
+	case nil:
+		return nil, sql.InRange, nil
+	default:
+		return nil, sql.OutOfRange, fmt.Errorf("%s: unhandled type: %T", b.String(), val)
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (b CidrType) Equals(otherType sql.Type) bool {
+	if otherExtendedType, ok := otherType.(types.ExtendedType); ok {
+		return bytes.Equal(MustSerializeType(b), MustSerializeType(otherExtendedType))
+	}
+	return false
+}
+
+// FormatValue implements the DoltgresType interface.
+func (b CidrType) FormatValue(val any) (string, error) {
+	if val == nil {
+		return "", nil
+	}
+	return b.IoOutput(sql.NewEmptyContext(), val)
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (b CidrType) GetSerializationID() SerializationID {
+	return SerializationID_Cidr
+}
+
+// IoInput implements the DoltgresType interface.
+func (b CidrType) IoInput(ctx *sql.Context, input string) (any, error) {
+	prefix, ok := parseNetworkAddress(input, true)
+	if !ok {
+		return nil, fmt.Errorf("invalid input syntax for type %s: %q", b.String(), input)
+	}
+	if prefix != prefix.Masked() {
+		return nil, fmt.Errorf("invalid cidr value: %q", input)
+	}
+	return prefix, nil
+}
+
+// IoOutput implements the DoltgresType interface.
+func (b CidrType) IoOutput(ctx *sql.Context, output any) (string, error) {
+	converted, _, err := b.Convert(output)
+	if err != nil {
+		return "", err
+	}
+	return converted.(netip.Prefix).String(), nil
+}
+
+// IoReceive implements the DoltgresType interface.
+func (b CidrType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	prefix, _, ok := receiveNetworkAddress(data)
+	if !ok || prefix != prefix.Masked() {
+		return nil, ErrInvalidBinaryRepresentation.New(b.String())
+	}
+	return prefix, nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b CidrType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return sendNetworkAddress(converted.(netip.Prefix), true), nil
+}
+
+// IsPreferredType implements the DoltgresType interface.
+func (b CidrType) IsPreferredType() bool {
+	return false
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (b CidrType) IsUnbounded() bool {
+	return false
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (b CidrType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	return types.ExtendedTypeSerializedWidth_64K
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (b CidrType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return math.MaxUint32
+}
+
+// OID implements the DoltgresType interface.
+func (b CidrType) OID() uint32 {
+	return uint32(oid.T_cidr)
+}
+
+// Promote implements the DoltgresType interface.
+func (b CidrType) Promote() sql.Type {
+	return b
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (b CidrType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	if len(v1) == 0 && len(v2) == 0 {
+		return 0, nil
+	} else if len(v1) > 0 && len(v2) == 0 {
+		return 1, nil
+	} else if len(v1) == 0 && len(v2) > 0 {
+		return -1, nil
+	}
+	ac, err := b.DeserializeValue(v1)
+	if err != nil {
+		return 0, err
+	}
+	bc, err := b.DeserializeValue(v2)
+	if err != nil {
+		return 0, err
+	}
+	return b.Compare(ac, bc)
+}
+
+// SQL implements the DoltgresType interface.
+func (b CidrType) SQL(ctx *sql.Context, dest []byte, v any) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+	value, err := b.IoOutput(ctx, v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return sqltypes.MakeTrusted(sqltypes.Text, types.AppendAndSliceBytes(dest, []byte(value))), nil
+}
+
+// String implements the DoltgresType interface.
+func (b CidrType) String() string {
+	return "cidr"
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (b CidrType) ToArrayType() DoltgresArrayType {
+	return CidrArray
+}
+
+// Type implements the DoltgresType interface.
+func (b CidrType) Type() query.Type {
+	return sqltypes.VarBinary
+}
+
+// ValueType implements the DoltgresType interface.
+func (b CidrType) ValueType() reflect.Type {
+	return reflect.TypeOf(netip.Prefix{})
+}
+
+// Zero implements the DoltgresType interface.
+func (b CidrType) Zero() any {
+	return netip.PrefixFrom(netip.IPv4Unspecified(), 0)
+}
+
+// SerializeType implements the DoltgresType interface.
+func (b CidrType) SerializeType() ([]byte, error) {
+	return SerializationID_Cidr.ToByteSlice(0), nil
+}
+
+// deserializeType implements the DoltgresType interface.
+func (b CidrType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	switch version {
+	case 0:
+		return Cidr, nil
+	default:
+		return nil, fmt.Errorf("version %d is not yet supported for %s", version, b.String())
+	}
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (b CidrType) SerializeValue(val any) ([]byte, error) {
+	if val == nil {
+		return nil, nil
+	}
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return serializeNetworkAddress(converted.(netip.Prefix)), nil
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (b CidrType) DeserializeValue(val []byte) (any, error) {
+	if len(val) == 0 {
+		return nil, nil
+	}
+	return deserializeNetworkAddress(val)
+}
@@ -0,0 +1,637 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+var ErrMalformedRecordLiteral = errors.NewKind(`malformed record literal: "%s"`)
+
+// CompositeAttribute represents a single attribute (field) of a composite type.
+type CompositeAttribute struct {
+	Name string
+	Type DoltgresType
+}
+
+// CompositeType is the extended type implementation of a PostgreSQL composite type. This covers both user-defined
+// types that were created using CREATE TYPE ... AS (...), as well as the anonymous records that are created by ROW(...).
+// Values are stored as a slice containing the value of each attribute.
+type CompositeType struct {
+	Oid        uint32
+	ArrayOid   uint32
+	RelID      uint32
+	Schema     string
+	Name       string
+	Attributes []CompositeAttribute
+}
+
+var _ DoltgresType = CompositeType{}
+
+// NewCompositeType creates a new instance of a composite Type.
+func NewCompositeType(schema string, name string, typeOid uint32, arrayOid uint32, relID uint32, attributes []CompositeAttribute, owner string) *Type {
+	return &Type{
+		Oid:            typeOid,
+		Name:           name,
+		Schema:         schema,
+		Owner:          owner,
+		Length:         -1,
+		PassedByVal:    false,
+		TypType:        TypeType_Composite,
+		TypCategory:    TypeCategory_CompositeTypes,
+		IsPreferred:    false,
+		IsDefined:      true,
+		Delimiter:      ",",
+		RelID:          relID,
+		SubscriptFunc:  "-",
+		Elem:           0,
+		Array:          arrayOid,
+		InputFunc:      "record_in",
+		OutputFunc:     "record_out",
+		ReceiveFunc:    "record_recv",
+		SendFunc:       "record_send",
+		ModInFunc:      "-",
+		ModOutFunc:     "-",
+		AnalyzeFunc:    "-",
+		Align:          TypeAlignment_Double,
+		Storage:        TypeStorage_Extended,
+		NotNull:        false,
+		BaseTypeOID:    0,
+		TypMod:         -1,
+		NDims:          0,
+		Collation:      0,
+		DefaulBin:      "",
+		Default:        "",
+		Acl:            "",
+		CompositeAttrs: attributes,
+	}
+}
+
+// NewAnonymousRecordType returns the anonymous record type whose attributes have the given types, such as the type
+// produced by ROW(...). Attributes are named f1, f2, etc.
+func NewAnonymousRecordType(attributeTypes []DoltgresType) CompositeType {
+	attributes := make([]CompositeAttribute, len(attributeTypes))
+	for i, attributeType := range attributeTypes {
+		attributes[i] = CompositeAttribute{
+			Name: fmt.Sprintf("f%d", i+1),
+			Type: attributeType,
+		}
+	}
+	return CompositeType{
+		Oid:        uint32(oid.T_record),
+		ArrayOid:   uint32(oid.T__record),
+		Name:       "record",
+		Attributes: attributes,
+	}
+}
+
+// CompositeTypeFromType returns the CompositeType that represents the given composite Type.
+func CompositeTypeFromType(typ *Type) CompositeType {
+	attributes := make([]CompositeAttribute, len(typ.CompositeAttrs))
+	copy(attributes, typ.CompositeAttrs)
+	return CompositeType{
+		Oid:        typ.Oid,
+		ArrayOid:   typ.Array,
+		RelID:      typ.RelID,
+		Schema:     typ.Schema,
+		Name:       typ.Name,
+		Attributes: attributes,
+	}
+}
+
+// Alignment implements the DoltgresType interface.
+func (c CompositeType) Alignment() TypeAlignment {
+	return TypeAlignment_Double
+}
+
+// AttributeIndex returns the position of the attribute with the given name. Returns -1 if the attribute does not
+// belong to the type.
+func (c CompositeType) AttributeIndex(name string) int {
+	for i, attribute := range c.Attributes {
+		if attribute.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// BaseID implements the DoltgresType interface.
+func (c CompositeType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_Composite
+}
+
+// BaseName implements the DoltgresType interface.
+func (c CompositeType) BaseName() string {
+	return c.Name
+}
+
+// Category implements the DoltgresType interface.
+func (c CompositeType) Category() TypeCategory {
+	return TypeCategory_CompositeTypes
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (c CompositeType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (c CompositeType) Compare(v1 any, v2 any) (int, error) {
+	if v1 == nil && v2 == nil {
+		return 0, nil
+	} else if v1 != nil && v2 == nil {
+		return 1, nil
+	} else if v1 == nil && v2 != nil {
+		return -1, nil
+	}
+
+	ac, _, err := c.Convert(v1)
+	if err != nil {
+		return 0, err
+	}
+	bc, _, err := c.Convert(v2)
+	if err != nil {
+		return 0, err
+	}
+	ab := ac.([]any)
+	bb := bc.([]any)
+	minLength := utils.Min(len(ab), len(bb))
+	for i := 0; i < minLength; i++ {
+		// Null attributes are considered greater than all non-null attributes, which matches btree ordering
+		if ab[i] == nil && bb[i] == nil {
+			continue
+		} else if ab[i] == nil {
+			return 1, nil
+		} else if bb[i] == nil {
+			return -1, nil
+		}
+		res, err := c.attributeType(i).Compare(ab[i], bb[i])
+		if err != nil {
+			return 0, err
+		}
+		if res != 0 {
+			return res, nil
+		}
+	}
+	if len(ab) == len(bb) {
+		return 0, nil
+	} else if len(ab) < len(bb) {
+		return -1, nil
+	} else {
+		return 1, nil
+	}
+}
+
+// Convert implements the DoltgresType interface.
+func (c CompositeType) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case []any:
+		return val, sql.InRange, nil
+	case nil:
+		return nil, sql.InRange, nil
+	default:
+		return nil, sql.OutOfRange, fmt.Errorf("%s: unhandled type: %T", c.String(), val)
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (c CompositeType) Equals(otherType sql.Type) bool {
+	otherComposite, ok := otherType.(CompositeType)
+	if !ok || c.Oid != otherComposite.Oid || c.ArrayOid != otherComposite.ArrayOid || c.RelID != otherComposite.RelID ||
+		c.Schema != otherComposite.Schema || c.Name != otherComposite.Name || len(c.Attributes) != len(otherComposite.Attributes) {
+		return false
+	}
+	for i := range c.Attributes {
+		if c.Attributes[i].Name != otherComposite.Attributes[i].Name || !c.Attributes[i].Type.Equals(otherComposite.Attributes[i].Type) {
+			return false
+		}
+	}
+	return true
+}
+
+// FormatValue implements the DoltgresType interface.
+func (c CompositeType) FormatValue(val any) (string, error) {
+	if val == nil {
+		return "", nil
+	}
+	return c.IoOutput(sql.NewEmptyContext(), val)
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (c CompositeType) GetSerializationID() SerializationID {
+	return SerializationID_Composite
+}
+
+// IoInput implements the DoltgresType interface.
+func (c CompositeType) IoInput(ctx *sql.Context, input string) (any, error) {
+	fields, err := parseRecordLiteral(input)
+	if err != nil {
+		return nil, err
+	}
+	// A type without attributes is written as "()", which otherwise reads as a single NULL field
+	if len(c.Attributes) == 0 && len(fields) == 1 && fields[0] == nil {
+		return []any{}, nil
+	}
+	if len(fields) != len(c.Attributes) {
+		return nil, ErrMalformedRecordLiteral.New(input)
+	}
+	vals := make([]any, len(fields))
+	for i, field := range fields {
+		if field == nil {
+			continue
+		}
+		vals[i], err = c.Attributes[i].Type.IoInput(ctx, *field)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return vals, nil
+}
+
+// IoOutput implements the DoltgresType interface.
+func (c CompositeType) IoOutput(ctx *sql.Context, output any) (string, error) {
+	converted, _, err := c.Convert(output)
+	if err != nil {
+		return "", err
+	}
+	vals := converted.([]any)
+	sb := strings.Builder{}
+	sb.WriteRune('(')
+	for i, val := range vals {
+		if i > 0 {
+			sb.WriteRune(',')
+		}
+		if val == nil {
+			continue
+		}
+		str, err := c.attributeType(i).IoOutput(ctx, val)
+		if err != nil {
+			return "", err
+		}
+		if recordFieldRequiresQuotes(str) {
+			sb.WriteRune('"')
+			for _, r := range str {
+				if r == '"' || r == '\\' {
+					sb.WriteRune(r)
+				}
+				sb.WriteRune(r)
+			}
+			sb.WriteRune('"')
+		} else {
+			sb.WriteString(str)
+		}
+	}
+	sb.WriteRune(')')
+	return sb.String(), nil
+}
+
+// IoReceive implements the DoltgresType interface.
+func (c CompositeType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("insufficient data left in message")
+	}
+	count := int(int32(binary.BigEndian.Uint32(data)))
+	data = data[4:]
+	if count != len(c.Attributes) {
+		return nil, fmt.Errorf("wrong number of columns: %d, expected %d", count, len(c.Attributes))
+	}
+	vals := make([]any, count)
+	for i := 0; i < count; i++ {
+		if len(data) < 8 {
+			return nil, fmt.Errorf("insufficient data left in message")
+		}
+		length := int32(binary.BigEndian.Uint32(data[4:]))
+		data = data[8:]
+		if length == -1 {
+			continue
+		}
+		if int(length) > len(data) {
+			return nil, fmt.Errorf("insufficient data left in message")
+		}
+		var err error
+		vals[i], err = c.Attributes[i].Type.IoReceive(ctx, data[:length])
+		if err != nil {
+			return nil, err
+		}
+		data = data[length:]
+	}
+	return vals, nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (c CompositeType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := c.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	vals := converted.([]any)
+	output := binary.BigEndian.AppendUint32(nil, uint32(len(vals)))
+	for i, val := range vals {
+		attributeType := c.attributeType(i)
+		output = binary.BigEndian.AppendUint32(output, attributeType.OID())
+		if val == nil {
+			output = binary.BigEndian.AppendUint32(output, math.MaxUint32)
+			continue
+		}
+		data, err := attributeType.IoSend(ctx, val)
+		if err != nil {
+			return nil, err
+		}
+		output = binary.BigEndian.AppendUint32(output, uint32(len(data)))
+		output = append(output, data...)
+	}
+	return output, nil
+}
+
+// IsPreferredType implements the DoltgresType interface.
+func (c CompositeType) IsPreferredType() bool {
+	return false
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (c CompositeType) IsUnbounded() bool {
+	return true
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (c CompositeType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	// Values are stored inline so that they may be used as index keys, which are compared using SerializedCompare
+	return types.ExtendedTypeSerializedWidth_64K
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (c CompositeType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return math.MaxUint32
+}
+
+// OID implements the DoltgresType interface.
+func (c CompositeType) OID() uint32 {
+	return c.Oid
+}
+
+// Promote implements the DoltgresType interface.
+func (c CompositeType) Promote() sql.Type {
+	return c
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (c CompositeType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	if len(v1) == 0 && len(v2) == 0 {
+		return 0, nil
+	} else if len(v1) > 0 && len(v2) == 0 {
+		return 1, nil
+	} else if len(v1) == 0 && len(v2) > 0 {
+		return -1, nil
+	}
+	ac, err := c.DeserializeValue(v1)
+	if err != nil {
+		return 0, err
+	}
+	bc, err := c.DeserializeValue(v2)
+	if err != nil {
+		return 0, err
+	}
+	return c.Compare(ac, bc)
+}
+
+// SQL implements the DoltgresType interface.
+func (c CompositeType) SQL(ctx *sql.Context, dest []byte, v any) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+	value, err := c.IoOutput(ctx, v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return sqltypes.MakeTrusted(sqltypes.Text, types.AppendAndSliceBytes(dest, []byte(value))), nil
+}
+
+// String implements the DoltgresType interface.
+func (c CompositeType) String() string {
+	return c.Name
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (c CompositeType) ToArrayType() DoltgresArrayType {
+	return createArrayType(c, SerializationID_CompositeArray, oid.Oid(c.ArrayOid))
+}
+
+// Type implements the DoltgresType interface.
+func (c CompositeType) Type() query.Type {
+	// Composites are not reported as text or binary, so that GMS does not require an index prefix length, and index keys
+	// use the serialized form that is compared using SerializedCompare
+	return query.Type_TUPLE
+}
+
+// ValueType implements the DoltgresType interface.
+func (c CompositeType) ValueType() reflect.Type {
+	return reflect.TypeOf([]any{})
+}
+
+// Zero implements the DoltgresType interface.
+func (c CompositeType) Zero() any {
+	return make([]any, len(c.Attributes))
+}
+
+// SerializeType implements the DoltgresType interface.
+func (c CompositeType) SerializeType() ([]byte, error) {
+	b := SerializationID_Composite.ToByteSlice(0)
+	writer := utils.NewWriter(256)
+	writer.Uint32(c.Oid)
+	writer.Uint32(c.ArrayOid)
+	writer.Uint32(c.RelID)
+	writer.String(c.Schema)
+	writer.String(c.Name)
+	writer.VariableUint(uint64(len(c.Attributes)))
+	for _, attribute := range c.Attributes {
+		serializedType, err := attribute.Type.SerializeType()
+		if err != nil {
+			return nil, err
+		}
+		writer.String(attribute.Name)
+		writer.ByteSlice(serializedType)
+	}
+	return append(b, writer.Data()...), nil
+}
+
+// deserializeType implements the DoltgresType interface.
+func (c CompositeType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	switch version {
+	case 0:
+		reader := utils.NewReader(metadata)
+		c.Oid = reader.Uint32()
+		c.ArrayOid = reader.Uint32()
+		c.RelID = reader.Uint32()
+		c.Schema = reader.String()
+		c.Name = reader.String()
+		numOfAttributes := reader.VariableUint()
+		c.Attributes = make([]CompositeAttribute, numOfAttributes)
+		for i := uint64(0); i < numOfAttributes; i++ {
+			c.Attributes[i].Name = reader.String()
+			attributeType, err := DeserializeType(reader.ByteSlice())
+			if err != nil {
+				return nil, err
+			}
+			c.Attributes[i].Type = attributeType.(DoltgresType)
+		}
+		return c, nil
+	default:
+		return nil, fmt.Errorf("version %d is not yet supported for %s", version, c.String())
+	}
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (c CompositeType) SerializeValue(val any) ([]byte, error) {
+	if val == nil {
+		return nil, nil
+	}
+	converted, _, err := c.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	vals := converted.([]any)
+	writer := utils.NewWriter(uint64(16 * len(vals)))
+	writer.VariableUint(uint64(len(vals)))
+	for i, val := range vals {
+		if val == nil {
+			writer.Bool(false)
+			continue
+		}
+		serializedVal, err := c.attributeType(i).SerializeValue(val)
+		if err != nil {
+			return nil, err
+		}
+		writer.Bool(true)
+		writer.ByteSlice(serializedVal)
+	}
+	return writer.Data(), nil
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (c CompositeType) DeserializeValue(val []byte) (any, error) {
+	if len(val) == 0 {
+		return nil, nil
+	}
+	reader := utils.NewReader(val)
+	vals := make([]any, reader.VariableUint())
+	for i := range vals {
+		if !reader.Bool() {
+			continue
+		}
+		var err error
+		vals[i], err = c.attributeType(i).DeserializeValue(reader.ByteSlice())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return vals, nil
+}
+
+// attributeType returns the type of the attribute at the given index. Values may contain more attributes than the type
+// declares (such as when an attribute has been dropped), in which case the extra attributes are treated as text.
+func (c CompositeType) attributeType(index int) DoltgresType {
+	if index < len(c.Attributes) {
+		return c.Attributes[index].Type
+	}
+	return Text
+}
+
+// parseRecordLiteral parses the given input using Postgres' record syntax, returning the contents of each field. A nil
+// field represents a NULL value.
+func parseRecordLiteral(input string) ([]*string, error) {
+	str := strings.TrimLeft(input, " \t\n\r\v\f")
+	if len(str) == 0 || str[0] != '(' {
+		return nil, ErrMalformedRecordLiteral.New(input)
+	}
+	str = str[1:]
+	var fields []*string
+	for {
+		// An empty field (one with no characters at all, including quotes) represents a NULL value
+		if len(str) > 0 && (str[0] == ',' || str[0] == ')') {
+			fields = append(fields, nil)
+		} else {
+			sb := strings.Builder{}
+			inQuotes := false
+		FieldLoop:
+			for len(str) > 0 {
+				ch := str[0]
+				switch {
+				case ch == '\\':
+					if len(str) < 2 {
+						return nil, ErrMalformedRecordLiteral.New(input)
+					}
+					sb.WriteByte(str[1])
+					str = str[2:]
+					continue
+				case ch == '"' && inQuotes && len(str) > 1 && str[1] == '"':
+					sb.WriteByte('"')
+					str = str[2:]
+					continue
+				case ch == '"':
+					inQuotes = !inQuotes
+				case !inQuotes && (ch == ',' || ch == ')'):
+					break FieldLoop
+				default:
+					sb.WriteByte(ch)
+				}
+				str = str[1:]
+			}
+			if inQuotes || len(str) == 0 {
+				return nil, ErrMalformedRecordLiteral.New(input)
+			}
+			field := sb.String()
+			fields = append(fields, &field)
+		}
+		if len(str) == 0 {
+			return nil, ErrMalformedRecordLiteral.New(input)
+		}
+		if str[0] == ')' {
+			str = str[1:]
+			break
+		}
+		str = str[1:]
+	}
+	if len(strings.TrimSpace(str)) > 0 {
+		return nil, ErrMalformedRecordLiteral.New(input)
+	}
+	return fields, nil
+}
+
+// recordFieldRequiresQuotes returns whether the given field output must be quoted within a record literal.
+func recordFieldRequiresQuotes(str string) bool {
+	if len(str) == 0 {
+		return true
+	}
+	for _, r := range str {
+		switch r {
+		case '"', '\\', '(', ')', ',', ' ', '\t', '\n', '\r', '\v', '\f':
+			return true
+		}
+	}
+	return false
+}
@@ -16,6 +16,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"reflect"
 	"time"
@@ -141,6 +142,25 @@ func (b DateType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return converted.(time.Time).Format("2006-01-02"), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b DateType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 4); err != nil {
+		return nil, err
+	}
+	return postgresEpoch.AddDate(0, 0, int(int32(binary.BigEndian.Uint32(data)))), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b DateType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	t := converted.(time.Time)
+	days := timeToPostgresMicros(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)) / microsecondsPerDay
+	return binary.BigEndian.AppendUint32(nil, uint32(days)), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b DateType) IsPreferredType() bool {
 	return false
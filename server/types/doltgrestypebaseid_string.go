@@ -50,9 +50,13 @@ func _() {
 	_ = x[DoltgresTypeBaseID_Bool-3]
 	_ = x[DoltgresTypeBaseID_Bytea-7]
 	_ = x[DoltgresTypeBaseID_Char-9]
+	_ = x[DoltgresTypeBaseID_Cidr-11]
+	_ = x[DoltgresTypeBaseID_Composite-99]
 	_ = x[DoltgresTypeBaseID_Date-15]
+	_ = x[DoltgresTypeBaseID_Enum-19]
 	_ = x[DoltgresTypeBaseID_Float32-21]
 	_ = x[DoltgresTypeBaseID_Float64-23]
+	_ = x[DoltgresTypeBaseID_Inet-25]
 	_ = x[DoltgresTypeBaseID_Int16-27]
 	_ = x[DoltgresTypeBaseID_Int32-29]
 	_ = x[DoltgresTypeBaseID_Int64-33]
@@ -60,10 +64,13 @@ func _() {
 	_ = x[DoltgresTypeBaseID_Interval-37]
 	_ = x[DoltgresTypeBaseID_Json-39]
 	_ = x[DoltgresTypeBaseID_JsonB-41]
+	_ = x[DoltgresTypeBaseID_MacAddr-47]
+	_ = x[DoltgresTypeBaseID_MacAddr8-48]
 	_ = x[DoltgresTypeBaseID_Name-90]
 	_ = x[DoltgresTypeBaseID_Null-53]
 	_ = x[DoltgresTypeBaseID_Numeric-54]
 	_ = x[DoltgresTypeBaseID_Oid-92]
+	_ = x[DoltgresTypeBaseID_Range-101]
 	_ = x[DoltgresTypeBaseID_Text-64]
 	_ = x[DoltgresTypeBaseID_Time-66]
 	_ = x[DoltgresTypeBaseID_Timestamp-70]
@@ -75,74 +82,81 @@ func _() {
 	_ = x[DoltgresTypeBaseId_Domain-98]
 }
 
-const _DoltgresTypeBaseID_name = "DoltgresTypeBaseID_BoolDoltgresTypeBaseID_ByteaDoltgresTypeBaseID_CharDoltgresTypeBaseID_DateDoltgresTypeBaseID_Float32DoltgresTypeBaseID_Float64DoltgresTypeBaseID_Int16DoltgresTypeBaseID_Int32DoltgresTypeBaseID_Int64DoltgresTypeBaseID_IntervalDoltgresTypeBaseID_JsonDoltgresTypeBaseID_JsonBDoltgresTypeBaseID_NullDoltgresTypeBaseID_NumericDoltgresTypeBaseID_TextDoltgresTypeBaseID_TimeDoltgresTypeBaseID_TimeTZDoltgresTypeBaseID_TimestampDoltgresTypeBaseID_TimestampTZDoltgresTypeBaseID_UuidDoltgresTypeBaseID_VarCharDoltgresTypeBaseID_NameDoltgresTypeBaseID_OidDoltgresTypeBaseID_XidDoltgresTypeBaseID_InternalCharDoltgresTypeBaseId_DomainDoltgresTypeBaseID_AnyDoltgresTypeBaseID_AnyElementDoltgresTypeBaseID_AnyArrayDoltgresTypeBaseID_AnyNonArrayDoltgresTypeBaseID_AnyEnumDoltgresTypeBaseID_AnyRangeDoltgresTypeBaseID_AnyMultirangeDoltgresTypeBaseID_AnyCompatibleDoltgresTypeBaseID_AnyCompatibleArrayDoltgresTypeBaseID_AnyCompatibleNonArrayDoltgresTypeBaseID_AnyCompatibleRangeDoltgresTypeBaseID_AnyCompatibleMultirangeDoltgresTypeBaseID_CStringDoltgresTypeBaseID_InternalDoltgresTypeBaseID_Language_HandlerDoltgresTypeBaseID_FDW_HandlerDoltgresTypeBaseID_Table_AM_HandlerDoltgresTypeBaseID_Index_AM_HandlerDoltgresTypeBaseID_TSM_HandlerDoltgresTypeBaseID_RecordDoltgresTypeBaseID_TriggerDoltgresTypeBaseID_Event_TriggerDoltgresTypeBaseID_PG_DDL_CommandDoltgresTypeBaseID_VoidDoltgresTypeBaseID_UnknownDoltgresTypeBaseID_Int16SerialDoltgresTypeBaseID_Int32SerialDoltgresTypeBaseID_Int64SerialDoltgresTypeBaseID_RegclassDoltgresTypeBaseID_RegcollationDoltgresTypeBaseID_RegconfigDoltgresTypeBaseID_RegdictionaryDoltgresTypeBaseID_RegnamespaceDoltgresTypeBaseID_RegoperDoltgresTypeBaseID_RegoperatorDoltgresTypeBaseID_RegprocDoltgresTypeBaseID_RegprocedureDoltgresTypeBaseID_RegroleDoltgresTypeBaseID_Regtype"
+const _DoltgresTypeBaseID_name = "DoltgresTypeBaseID_BoolDoltgresTypeBaseID_ByteaDoltgresTypeBaseID_CharDoltgresTypeBaseID_CidrDoltgresTypeBaseID_DateDoltgresTypeBaseID_EnumDoltgresTypeBaseID_Float32DoltgresTypeBaseID_Float64DoltgresTypeBaseID_InetDoltgresTypeBaseID_Int16DoltgresTypeBaseID_Int32DoltgresTypeBaseID_Int64DoltgresTypeBaseID_IntervalDoltgresTypeBaseID_JsonDoltgresTypeBaseID_JsonBDoltgresTypeBaseID_MacAddrDoltgresTypeBaseID_MacAddr8DoltgresTypeBaseID_NullDoltgresTypeBaseID_NumericDoltgresTypeBaseID_TextDoltgresTypeBaseID_TimeDoltgresTypeBaseID_TimeTZDoltgresTypeBaseID_TimestampDoltgresTypeBaseID_TimestampTZDoltgresTypeBaseID_UuidDoltgresTypeBaseID_VarCharDoltgresTypeBaseID_NameDoltgresTypeBaseID_OidDoltgresTypeBaseID_XidDoltgresTypeBaseID_InternalCharDoltgresTypeBaseId_DomainDoltgresTypeBaseID_CompositeDoltgresTypeBaseID_RangeDoltgresTypeBaseID_AnyDoltgresTypeBaseID_AnyElementDoltgresTypeBaseID_AnyArrayDoltgresTypeBaseID_AnyNonArrayDoltgresTypeBaseID_AnyEnumDoltgresTypeBaseID_AnyRangeDoltgresTypeBaseID_AnyMultirangeDoltgresTypeBaseID_AnyCompatibleDoltgresTypeBaseID_AnyCompatibleArrayDoltgresTypeBaseID_AnyCompatibleNonArrayDoltgresTypeBaseID_AnyCompatibleRangeDoltgresTypeBaseID_AnyCompatibleMultirangeDoltgresTypeBaseID_CStringDoltgresTypeBaseID_InternalDoltgresTypeBaseID_Language_HandlerDoltgresTypeBaseID_FDW_HandlerDoltgresTypeBaseID_Table_AM_HandlerDoltgresTypeBaseID_Index_AM_HandlerDoltgresTypeBaseID_TSM_HandlerDoltgresTypeBaseID_RecordDoltgresTypeBaseID_TriggerDoltgresTypeBaseID_Event_TriggerDoltgresTypeBaseID_PG_DDL_CommandDoltgresTypeBaseID_VoidDoltgresTypeBaseID_UnknownDoltgresTypeBaseID_Int16SerialDoltgresTypeBaseID_Int32SerialDoltgresTypeBaseID_Int64SerialDoltgresTypeBaseID_RegclassDoltgresTypeBaseID_RegcollationDoltgresTypeBaseID_RegconfigDoltgresTypeBaseID_RegdictionaryDoltgresTypeBaseID_RegnamespaceDoltgresTypeBaseID_RegoperDoltgresTypeBaseID_RegoperatorDoltgresTypeBaseID_RegprocDoltgresTypeBaseID_RegprocedureDoltgresTypeBaseID_RegroleDoltgresTypeBaseID_Regtype"
 
 var _DoltgresTypeBaseID_map = map[DoltgresTypeBaseID]string{
 	3:    _DoltgresTypeBaseID_name[0:23],
 	7:    _DoltgresTypeBaseID_name[23:47],
 	9:    _DoltgresTypeBaseID_name[47:70],
-	15:   _DoltgresTypeBaseID_name[70:93],
-	21:   _DoltgresTypeBaseID_name[93:119],
-	23:   _DoltgresTypeBaseID_name[119:145],
-	27:   _DoltgresTypeBaseID_name[145:169],
-	29:   _DoltgresTypeBaseID_name[169:193],
-	33:   _DoltgresTypeBaseID_name[193:217],
-	37:   _DoltgresTypeBaseID_name[217:244],
-	39:   _DoltgresTypeBaseID_name[244:267],
-	41:   _DoltgresTypeBaseID_name[267:291],
-	53:   _DoltgresTypeBaseID_name[291:314],
-	54:   _DoltgresTypeBaseID_name[314:340],
-	64:   _DoltgresTypeBaseID_name[340:363],
-	66:   _DoltgresTypeBaseID_name[363:386],
-	68:   _DoltgresTypeBaseID_name[386:411],
-	70:   _DoltgresTypeBaseID_name[411:439],
-	74:   _DoltgresTypeBaseID_name[439:469],
-	82:   _DoltgresTypeBaseID_name[469:492],
-	86:   _DoltgresTypeBaseID_name[492:518],
-	90:   _DoltgresTypeBaseID_name[518:541],
-	92:   _DoltgresTypeBaseID_name[541:563],
-	94:   _DoltgresTypeBaseID_name[563:585],
-	96:   _DoltgresTypeBaseID_name[585:616],
-	98:   _DoltgresTypeBaseID_name[616:641],
-	8192: _DoltgresTypeBaseID_name[641:663],
-	8193: _DoltgresTypeBaseID_name[663:692],
-	8194: _DoltgresTypeBaseID_name[692:719],
-	8195: _DoltgresTypeBaseID_name[719:749],
-	8196: _DoltgresTypeBaseID_name[749:775],
-	8197: _DoltgresTypeBaseID_name[775:802],
-	8198: _DoltgresTypeBaseID_name[802:834],
-	8199: _DoltgresTypeBaseID_name[834:866],
-	8200: _DoltgresTypeBaseID_name[866:903],
-	8201: _DoltgresTypeBaseID_name[903:943],
-	8202: _DoltgresTypeBaseID_name[943:980],
-	8203: _DoltgresTypeBaseID_name[980:1022],
-	8204: _DoltgresTypeBaseID_name[1022:1048],
-	8205: _DoltgresTypeBaseID_name[1048:1075],
-	8206: _DoltgresTypeBaseID_name[1075:1110],
-	8207: _DoltgresTypeBaseID_name[1110:1140],
-	8208: _DoltgresTypeBaseID_name[1140:1175],
-	8209: _DoltgresTypeBaseID_name[1175:1210],
-	8210: _DoltgresTypeBaseID_name[1210:1240],
-	8211: _DoltgresTypeBaseID_name[1240:1265],
-	8212: _DoltgresTypeBaseID_name[1265:1291],
-	8213: _DoltgresTypeBaseID_name[1291:1323],
-	8214: _DoltgresTypeBaseID_name[1323:1356],
-	8215: _DoltgresTypeBaseID_name[1356:1379],
-	8216: _DoltgresTypeBaseID_name[1379:1405],
-	8217: _DoltgresTypeBaseID_name[1405:1435],
-	8218: _DoltgresTypeBaseID_name[1435:1465],
-	8219: _DoltgresTypeBaseID_name[1465:1495],
-	8220: _DoltgresTypeBaseID_name[1495:1522],
-	8221: _DoltgresTypeBaseID_name[1522:1553],
-	8222: _DoltgresTypeBaseID_name[1553:1581],
-	8223: _DoltgresTypeBaseID_name[1581:1613],
-	8224: _DoltgresTypeBaseID_name[1613:1644],
-	8225: _DoltgresTypeBaseID_name[1644:1670],
-	8226: _DoltgresTypeBaseID_name[1670:1700],
-	8227: _DoltgresTypeBaseID_name[1700:1726],
-	8228: _DoltgresTypeBaseID_name[1726:1757],
-	8229: _DoltgresTypeBaseID_name[1757:1783],
-	8230: _DoltgresTypeBaseID_name[1783:1809],
+	11:   _DoltgresTypeBaseID_name[70:93],
+	15:   _DoltgresTypeBaseID_name[93:116],
+	19:   _DoltgresTypeBaseID_name[116:139],
+	21:   _DoltgresTypeBaseID_name[139:165],
+	23:   _DoltgresTypeBaseID_name[165:191],
+	25:   _DoltgresTypeBaseID_name[191:214],
+	27:   _DoltgresTypeBaseID_name[214:238],
+	29:   _DoltgresTypeBaseID_name[238:262],
+	33:   _DoltgresTypeBaseID_name[262:286],
+	37:   _DoltgresTypeBaseID_name[286:313],
+	39:   _DoltgresTypeBaseID_name[313:336],
+	41:   _DoltgresTypeBaseID_name[336:360],
+	47:   _DoltgresTypeBaseID_name[360:386],
+	48:   _DoltgresTypeBaseID_name[386:413],
+	53:   _DoltgresTypeBaseID_name[413:436],
+	54:   _DoltgresTypeBaseID_name[436:462],
+	64:   _DoltgresTypeBaseID_name[462:485],
+	66:   _DoltgresTypeBaseID_name[485:508],
+	68:   _DoltgresTypeBaseID_name[508:533],
+	70:   _DoltgresTypeBaseID_name[533:561],
+	74:   _DoltgresTypeBaseID_name[561:591],
+	82:   _DoltgresTypeBaseID_name[591:614],
+	86:   _DoltgresTypeBaseID_name[614:640],
+	90:   _DoltgresTypeBaseID_name[640:663],
+	92:   _DoltgresTypeBaseID_name[663:685],
+	94:   _DoltgresTypeBaseID_name[685:707],
+	96:   _DoltgresTypeBaseID_name[707:738],
+	98:   _DoltgresTypeBaseID_name[738:763],
+	99:   _DoltgresTypeBaseID_name[763:791],
+	101:  _DoltgresTypeBaseID_name[791:815],
+	8192: _DoltgresTypeBaseID_name[815:837],
+	8193: _DoltgresTypeBaseID_name[837:866],
+	8194: _DoltgresTypeBaseID_name[866:893],
+	8195: _DoltgresTypeBaseID_name[893:923],
+	8196: _DoltgresTypeBaseID_name[923:949],
+	8197: _DoltgresTypeBaseID_name[949:976],
+	8198: _DoltgresTypeBaseID_name[976:1008],
+	8199: _DoltgresTypeBaseID_name[1008:1040],
+	8200: _DoltgresTypeBaseID_name[1040:1077],
+	8201: _DoltgresTypeBaseID_name[1077:1117],
+	8202: _DoltgresTypeBaseID_name[1117:1154],
+	8203: _DoltgresTypeBaseID_name[1154:1196],
+	8204: _DoltgresTypeBaseID_name[1196:1222],
+	8205: _DoltgresTypeBaseID_name[1222:1249],
+	8206: _DoltgresTypeBaseID_name[1249:1284],
+	8207: _DoltgresTypeBaseID_name[1284:1314],
+	8208: _DoltgresTypeBaseID_name[1314:1349],
+	8209: _DoltgresTypeBaseID_name[1349:1384],
+	8210: _DoltgresTypeBaseID_name[1384:1414],
+	8211: _DoltgresTypeBaseID_name[1414:1439],
+	8212: _DoltgresTypeBaseID_name[1439:1465],
+	8213: _DoltgresTypeBaseID_name[1465:1497],
+	8214: _DoltgresTypeBaseID_name[1497:1530],
+	8215: _DoltgresTypeBaseID_name[1530:1553],
+	8216: _DoltgresTypeBaseID_name[1553:1579],
+	8217: _DoltgresTypeBaseID_name[1579:1609],
+	8218: _DoltgresTypeBaseID_name[1609:1639],
+	8219: _DoltgresTypeBaseID_name[1639:1669],
+	8220: _DoltgresTypeBaseID_name[1669:1696],
+	8221: _DoltgresTypeBaseID_name[1696:1727],
+	8222: _DoltgresTypeBaseID_name[1727:1755],
+	8223: _DoltgresTypeBaseID_name[1755:1787],
+	8224: _DoltgresTypeBaseID_name[1787:1818],
+	8225: _DoltgresTypeBaseID_name[1818:1844],
+	8226: _DoltgresTypeBaseID_name[1844:1874],
+	8227: _DoltgresTypeBaseID_name[1874:1900],
+	8228: _DoltgresTypeBaseID_name[1900:1931],
+	8229: _DoltgresTypeBaseID_name[1931:1957],
+	8230: _DoltgresTypeBaseID_name[1957:1983],
 }
 
 func (i DoltgresTypeBaseID) String() string {
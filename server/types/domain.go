@@ -150,6 +150,16 @@ func (d DomainType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return d.AsType.IoOutput(ctx, output)
 }
 
+// IoReceive implements the DoltgresType interface.
+func (d DomainType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return d.AsType.IoReceive(ctx, data)
+}
+
+// IoSend implements the DoltgresType interface.
+func (d DomainType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	return d.AsType.IoSend(ctx, val)
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (d DomainType) IsPreferredType() bool {
 	return d.AsType.IsPreferredType()
@@ -0,0 +1,393 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+var ErrInvalidEnumInput = errors.NewKind(`invalid input value for enum %s: "%s"`)
+
+// EnumLabel represents a single label of an enum type.
+type EnumLabel struct {
+	Oid       uint32
+	SortOrder float32
+	Label     string
+}
+
+// EnumType is the extended type implementation of a user-defined PostgreSQL enum. Values are stored as their labels,
+// while ordering follows the order in which the labels were declared.
+type EnumType struct {
+	Oid      uint32
+	ArrayOid uint32
+	Schema   string
+	Name     string
+	Labels   []string
+}
+
+var _ DoltgresType = EnumType{}
+
+// NewEnumType creates a new instance of an enum Type.
+func NewEnumType(schema string, name string, typeOid uint32, arrayOid uint32, labels []EnumLabel, owner string) *Type {
+	return &Type{
+		Oid:           typeOid,
+		Name:          name,
+		Schema:        schema,
+		Owner:         owner,
+		Length:        4,
+		PassedByVal:   true,
+		TypType:       TypeType_Enum,
+		TypCategory:   TypeCategory_EnumTypes,
+		IsPreferred:   false,
+		IsDefined:     true,
+		Delimiter:     ",",
+		RelID:         0,
+		SubscriptFunc: "-",
+		Elem:          0,
+		Array:         arrayOid,
+		InputFunc:     "enum_in",
+		OutputFunc:    "enum_out",
+		ReceiveFunc:   "enum_recv",
+		SendFunc:      "enum_send",
+		ModInFunc:     "-",
+		ModOutFunc:    "-",
+		AnalyzeFunc:   "-",
+		Align:         TypeAlignment_Int,
+		Storage:       TypeStorage_Plain,
+		NotNull:       false,
+		BaseTypeOID:   0,
+		TypMod:        -1,
+		NDims:         0,
+		Collation:     0,
+		DefaulBin:     "",
+		Default:       "",
+		Acl:           "",
+		EnumLabels:    labels,
+	}
+}
+
+// Alignment implements the DoltgresType interface.
+func (e EnumType) Alignment() TypeAlignment {
+	return TypeAlignment_Int
+}
+
+// BaseID implements the DoltgresType interface.
+func (e EnumType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_Enum
+}
+
+// BaseName implements the DoltgresType interface.
+func (e EnumType) BaseName() string {
+	return e.Name
+}
+
+// Category implements the DoltgresType interface.
+func (e EnumType) Category() TypeCategory {
+	return TypeCategory_EnumTypes
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (e EnumType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (e EnumType) Compare(v1 any, v2 any) (int, error) {
+	if v1 == nil && v2 == nil {
+		return 0, nil
+	} else if v1 != nil && v2 == nil {
+		return 1, nil
+	} else if v1 == nil && v2 != nil {
+		return -1, nil
+	}
+
+	ac, _, err := e.Convert(v1)
+	if err != nil {
+		return 0, err
+	}
+	bc, _, err := e.Convert(v2)
+	if err != nil {
+		return 0, err
+	}
+	return e.compareLabels(ac.(string), bc.(string))
+}
+
+// Convert implements the DoltgresType interface.
+func (e EnumType) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case string:
+		return val, sql.InRange, nil
+	case nil:
+		return nil, sql.InRange, nil
+	default:
+		return nil, sql.OutOfRange, fmt.Errorf("%s: unhandled type: %T", e.String(), val)
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (e EnumType) Equals(otherType sql.Type) bool {
+	otherEnum, ok := otherType.(EnumType)
+	if !ok || e.Oid != otherEnum.Oid || e.ArrayOid != otherEnum.ArrayOid || e.Schema != otherEnum.Schema || e.Name != otherEnum.Name ||
+		len(e.Labels) != len(otherEnum.Labels) {
+		return false
+	}
+	for i := range e.Labels {
+		if e.Labels[i] != otherEnum.Labels[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// FormatValue implements the DoltgresType interface.
+func (e EnumType) FormatValue(val any) (string, error) {
+	if val == nil {
+		return "", nil
+	}
+	return e.IoOutput(sql.NewEmptyContext(), val)
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (e EnumType) GetSerializationID() SerializationID {
+	return SerializationID_Enum
+}
+
+// IoInput implements the DoltgresType interface.
+func (e EnumType) IoInput(ctx *sql.Context, input string) (any, error) {
+	if e.LabelIndex(input) == -1 {
+		return nil, ErrInvalidEnumInput.New(e.Name, input)
+	}
+	return input, nil
+}
+
+// IoOutput implements the DoltgresType interface.
+func (e EnumType) IoOutput(ctx *sql.Context, output any) (string, error) {
+	converted, _, err := e.Convert(output)
+	if err != nil {
+		return "", err
+	}
+	return converted.(string), nil
+}
+
+// IoReceive implements the DoltgresType interface.
+func (e EnumType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return e.IoInput(ctx, string(data))
+}
+
+// IoSend implements the DoltgresType interface.
+func (e EnumType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	str, err := e.IoOutput(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(str), nil
+}
+
+// IsPreferredType implements the DoltgresType interface.
+func (e EnumType) IsPreferredType() bool {
+	return false
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (e EnumType) IsUnbounded() bool {
+	return false
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (e EnumType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	return types.ExtendedTypeSerializedWidth_64K
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (e EnumType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return math.MaxUint32
+}
+
+// OID implements the DoltgresType interface.
+func (e EnumType) OID() uint32 {
+	return e.Oid
+}
+
+// Promote implements the DoltgresType interface.
+func (e EnumType) Promote() sql.Type {
+	return e
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (e EnumType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	if len(v1) == 0 && len(v2) == 0 {
+		return 0, nil
+	} else if len(v1) > 0 && len(v2) == 0 {
+		return 1, nil
+	} else if len(v1) == 0 && len(v2) > 0 {
+		return -1, nil
+	}
+	return e.compareLabels(utils.NewReader(v1).String(), utils.NewReader(v2).String())
+}
+
+// SQL implements the DoltgresType interface.
+func (e EnumType) SQL(ctx *sql.Context, dest []byte, v any) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+	value, err := e.IoOutput(ctx, v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return sqltypes.MakeTrusted(sqltypes.Text, types.AppendAndSliceBytes(dest, []byte(value))), nil
+}
+
+// String implements the DoltgresType interface.
+func (e EnumType) String() string {
+	return e.Name
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (e EnumType) ToArrayType() DoltgresArrayType {
+	return createArrayType(e, SerializationID_EnumArray, oid.Oid(e.ArrayOid))
+}
+
+// Type implements the DoltgresType interface.
+func (e EnumType) Type() query.Type {
+	// Enums are not reported as text, so that index keys use the serialized form, which is ordered by SerializedCompare
+	return sqltypes.Enum
+}
+
+// ValueType implements the DoltgresType interface.
+func (e EnumType) ValueType() reflect.Type {
+	return reflect.TypeOf("")
+}
+
+// Zero implements the DoltgresType interface.
+func (e EnumType) Zero() any {
+	if len(e.Labels) > 0 {
+		return e.Labels[0]
+	}
+	return ""
+}
+
+// SerializeType implements the DoltgresType interface.
+func (e EnumType) SerializeType() ([]byte, error) {
+	b := SerializationID_Enum.ToByteSlice(0)
+	writer := utils.NewWriter(256)
+	writer.Uint32(e.Oid)
+	writer.Uint32(e.ArrayOid)
+	writer.String(e.Schema)
+	writer.String(e.Name)
+	writer.StringSlice(e.Labels)
+	return append(b, writer.Data()...), nil
+}
+
+// deserializeType implements the DoltgresType interface.
+func (e EnumType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	switch version {
+	case 0:
+		reader := utils.NewReader(metadata)
+		e.Oid = reader.Uint32()
+		e.ArrayOid = reader.Uint32()
+		e.Schema = reader.String()
+		e.Name = reader.String()
+		e.Labels = reader.StringSlice()
+		return e, nil
+	default:
+		return nil, fmt.Errorf("version %d is not yet supported for %s", version, e.String())
+	}
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (e EnumType) SerializeValue(val any) ([]byte, error) {
+	if val == nil {
+		return nil, nil
+	}
+	converted, _, err := e.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	str := converted.(string)
+	writer := utils.NewWriter(uint64(len(str) + 4))
+	writer.String(str)
+	return writer.Data(), nil
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (e EnumType) DeserializeValue(val []byte) (any, error) {
+	if len(val) == 0 {
+		return nil, nil
+	}
+	reader := utils.NewReader(val)
+	return reader.String(), nil
+}
+
+// LabelIndex returns the position of the given label within the enum's declaration order. Returns -1 if the label
+// does not belong to the enum.
+func (e EnumType) LabelIndex(label string) int {
+	for i, l := range e.Labels {
+		if l == label {
+			return i
+		}
+	}
+	return -1
+}
+
+// compareLabels compares the two labels using their declaration order.
+func (e EnumType) compareLabels(v1 string, v2 string) (int, error) {
+	idx1 := e.LabelIndex(v1)
+	if idx1 == -1 {
+		return 0, ErrInvalidEnumInput.New(e.Name, v1)
+	}
+	idx2 := e.LabelIndex(v2)
+	if idx2 == -1 {
+		return 0, ErrInvalidEnumInput.New(e.Name, v2)
+	}
+	if idx1 == idx2 {
+		return 0, nil
+	} else if idx1 < idx2 {
+		return -1, nil
+	} else {
+		return 1, nil
+	}
+}
+
+// EnumTypeFromType returns the EnumType that represents the given enum Type. Labels are ordered by their sort order.
+func EnumTypeFromType(typ *Type) EnumType {
+	labels := make([]EnumLabel, len(typ.EnumLabels))
+	copy(labels, typ.EnumLabels)
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].SortOrder < labels[j].SortOrder
+	})
+	enumLabels := make([]string, len(labels))
+	for i := range labels {
+		enumLabels[i] = labels[i].Label
+	}
+	return EnumType{
+		Oid:      typ.Oid,
+		ArrayOid: typ.Array,
+		Schema:   typ.Schema,
+		Name:     typ.Name,
+		Labels:   enumLabels,
+	}
+}
@@ -149,6 +149,23 @@ func (b Float32Type) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return strconv.FormatFloat(float64(converted.(float32)), 'f', -1, 32), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b Float32Type) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 4); err != nil {
+		return nil, err
+	}
+	return math.Float32frombits(binary.BigEndian.Uint32(data)), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b Float32Type) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.AppendUint32(nil, math.Float32bits(converted.(float32))), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b Float32Type) IsPreferredType() bool {
 	return false
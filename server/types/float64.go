@@ -148,6 +148,23 @@ func (b Float64Type) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return strconv.FormatFloat(converted.(float64), 'f', -1, 64), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b Float64Type) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 8); err != nil {
+		return nil, err
+	}
+	return math.Float64frombits(binary.BigEndian.Uint64(data)), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b Float64Type) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.AppendUint64(nil, math.Float64bits(converted.(float64))), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b Float64Type) IsPreferredType() bool {
 	return true
@@ -71,9 +71,13 @@ const (
 	DoltgresTypeBaseID_Bool         = DoltgresTypeBaseID(SerializationID_Bool)
 	DoltgresTypeBaseID_Bytea        = DoltgresTypeBaseID(SerializationID_Bytea)
 	DoltgresTypeBaseID_Char         = DoltgresTypeBaseID(SerializationID_Char)
+	DoltgresTypeBaseID_Cidr         = DoltgresTypeBaseID(SerializationID_Cidr)
+	DoltgresTypeBaseID_Composite    = DoltgresTypeBaseID(SerializationID_Composite)
 	DoltgresTypeBaseID_Date         = DoltgresTypeBaseID(SerializationID_Date)
+	DoltgresTypeBaseID_Enum         = DoltgresTypeBaseID(SerializationID_Enum)
 	DoltgresTypeBaseID_Float32      = DoltgresTypeBaseID(SerializationID_Float32)
 	DoltgresTypeBaseID_Float64      = DoltgresTypeBaseID(SerializationID_Float64)
+	DoltgresTypeBaseID_Inet         = DoltgresTypeBaseID(SerializationID_Inet)
 	DoltgresTypeBaseID_Int16        = DoltgresTypeBaseID(SerializationID_Int16)
 	DoltgresTypeBaseID_Int32        = DoltgresTypeBaseID(SerializationID_Int32)
 	DoltgresTypeBaseID_Int64        = DoltgresTypeBaseID(SerializationID_Int64)
@@ -81,10 +85,13 @@ const (
 	DoltgresTypeBaseID_Interval     = DoltgresTypeBaseID(SerializationID_Interval)
 	DoltgresTypeBaseID_Json         = DoltgresTypeBaseID(SerializationID_Json)
 	DoltgresTypeBaseID_JsonB        = DoltgresTypeBaseID(SerializationID_JsonB)
+	DoltgresTypeBaseID_MacAddr      = DoltgresTypeBaseID(SerializationID_MacAddress)
+	DoltgresTypeBaseID_MacAddr8     = DoltgresTypeBaseID(SerializationID_MacAddress8)
 	DoltgresTypeBaseID_Name         = DoltgresTypeBaseID(SerializationID_Name)
 	DoltgresTypeBaseID_Null         = DoltgresTypeBaseID(SerializationID_Null)
 	DoltgresTypeBaseID_Numeric      = DoltgresTypeBaseID(SerializationID_Numeric)
 	DoltgresTypeBaseID_Oid          = DoltgresTypeBaseID(SerializationID_Oid)
+	DoltgresTypeBaseID_Range        = DoltgresTypeBaseID(SerializationID_Range)
 	DoltgresTypeBaseID_Text         = DoltgresTypeBaseID(SerializationID_Text)
 	DoltgresTypeBaseID_Time         = DoltgresTypeBaseID(SerializationID_Time)
 	DoltgresTypeBaseID_Timestamp    = DoltgresTypeBaseID(SerializationID_Timestamp)
@@ -185,6 +192,11 @@ func Init() {
 			baseIDCategories[t.BaseID()] = t.Category()
 		}
 	}
+	// The built-in range types share a base ID, so they're added separately
+	for _, t := range builtInRangeTypes {
+		oidToType[t.OID()] = t
+		baseIDCategories[t.BaseID()] = t.Category()
+	}
 }
 
 // IsBaseIDArrayType returns whether the base ID is an array type. If it is, it also returns the type.
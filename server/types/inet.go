@@ -0,0 +1,467 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+)
+
+// Inet is the network address type, which holds an IPv4 or IPv6 host address along with its subnet.
+var Inet = InetType{}
+
+// InetType is the extended type implementation of the PostgreSQL inet. Values are stored as a netip.Prefix, which
+// retains any bits of the address that are outside of the netmask.
+type InetType struct{}
+
+var _ DoltgresType = InetType{}
+
+// These are the address families used by the binary representation of inet and cidr.
+const (
+	inetFamilyIPv4 = 2
+	inetFamilyIPv6 = 3
+)
+
+// Alignment implements the DoltgresType interface.
+func (b InetType) Alignment() TypeAlignment {
+	return TypeAlignment_Int
+}
+
+// BaseID implements the DoltgresType interface.
+func (b InetType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_Inet
+}
+
+// BaseName implements the DoltgresType interface.
+func (b InetType) BaseName() string {
+	return "inet"
+}
+
+// Category implements the DoltgresType interface.
+func (b InetType) Category() TypeCategory {
+	return TypeCategory_NetworkAddressTypes
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (b InetType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (b InetType) Compare(v1 any, v2 any) (int, error) {
+	if v1 == nil && v2 == nil {
+		return 0, nil
+	} else if v1 != nil && v2 == nil {
+		return 1, nil
+	} else if v1 == nil && v2 != nil {
+		return -1, nil
+	}
+
+	ac, _, err := b.Convert(v1)
+	if err != nil {
+		return 0, err
+	}
+	bc, _, err := b.Convert(v2)
+	if err != nil {
+		return 0, err
+	}
+	return compareNetworkAddresses(ac.(netip.Prefix), bc.(netip.Prefix)), nil
+}
+
+// Convert implements the DoltgresType interface.
+func (b InetType) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case netip.Prefix:
+		return val, sql.InRange, nil
+	case nil:
+		return nil, sql.InRange, nil
+	default:
+		return nil, sql.OutOfRange, fmt.Errorf("%s: unhandled type: %T", b.String(), val)
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (b InetType) Equals(otherType sql.Type) bool {
+	if otherExtendedType, ok := otherType.(types.ExtendedType); ok {
+		return bytes.Equal(MustSerializeType(b), MustSerializeType(otherExtendedType))
+	}
+	return false
+}
+
+// FormatValue implements the DoltgresType interface.
+func (b InetType) FormatValue(val any) (string, error) {
+	if val == nil {
+		return "", nil
+	}
+	return b.IoOutput(sql.NewEmptyContext(), val)
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (b InetType) GetSerializationID() SerializationID {
+	return SerializationID_Inet
+}
+
+// IoInput implements the DoltgresType interface.
+func (b InetType) IoInput(ctx *sql.Context, input string) (any, error) {
+	prefix, ok := parseNetworkAddress(input, false)
+	if !ok {
+		return nil, fmt.Errorf("invalid input syntax for type %s: %q", b.String(), input)
+	}
+	return prefix, nil
+}
+
+// IoOutput implements the DoltgresType interface.
+func (b InetType) IoOutput(ctx *sql.Context, output any) (string, error) {
+	converted, _, err := b.Convert(output)
+	if err != nil {
+		return "", err
+	}
+	prefix := converted.(netip.Prefix)
+	// The netmask is omitted when it covers the entire address
+	if prefix.Bits() == prefix.Addr().BitLen() {
+		return prefix.Addr().String(), nil
+	}
+	return prefix.String(), nil
+}
+
+// IoReceive implements the DoltgresType interface.
+func (b InetType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	prefix, _, ok := receiveNetworkAddress(data)
+	if !ok {
+		return nil, ErrInvalidBinaryRepresentation.New(b.String())
+	}
+	return prefix, nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b InetType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return sendNetworkAddress(converted.(netip.Prefix), false), nil
+}
+
+// IsPreferredType implements the DoltgresType interface.
+func (b InetType) IsPreferredType() bool {
+	return true
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (b InetType) IsUnbounded() bool {
+	return false
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (b InetType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	return types.ExtendedTypeSerializedWidth_64K
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (b InetType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return math.MaxUint32
+}
+
+// OID implements the DoltgresType interface.
+func (b InetType) OID() uint32 {
+	return uint32(oid.T_inet)
+}
+
+// Promote implements the DoltgresType interface.
+func (b InetType) Promote() sql.Type {
+	return b
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (b InetType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	if len(v1) == 0 && len(v2) == 0 {
+		return 0, nil
+	} else if len(v1) > 0 && len(v2) == 0 {
+		return 1, nil
+	} else if len(v1) == 0 && len(v2) > 0 {
+		return -1, nil
+	}
+	ac, err := b.DeserializeValue(v1)
+	if err != nil {
+		return 0, err
+	}
+	bc, err := b.DeserializeValue(v2)
+	if err != nil {
+		return 0, err
+	}
+	return b.Compare(ac, bc)
+}
+
+// SQL implements the DoltgresType interface.
+func (b InetType) SQL(ctx *sql.Context, dest []byte, v any) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+	value, err := b.IoOutput(ctx, v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return sqltypes.MakeTrusted(sqltypes.Text, types.AppendAndSliceBytes(dest, []byte(value))), nil
+}
+
+// String implements the DoltgresType interface.
+func (b InetType) String() string {
+	return "inet"
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (b InetType) ToArrayType() DoltgresArrayType {
+	return InetArray
+}
+
+// Type implements the DoltgresType interface.
+func (b InetType) Type() query.Type {
+	return sqltypes.VarBinary
+}
+
+// ValueType implements the DoltgresType interface.
+func (b InetType) ValueType() reflect.Type {
+	return reflect.TypeOf(netip.Prefix{})
+}
+
+// Zero implements the DoltgresType interface.
+func (b InetType) Zero() any {
+	return netip.PrefixFrom(netip.IPv4Unspecified(), 0)
+}
+
+// SerializeType implements the DoltgresType interface.
+func (b InetType) SerializeType() ([]byte, error) {
+	return SerializationID_Inet.ToByteSlice(0), nil
+}
+
+// deserializeType implements the DoltgresType interface.
+func (b InetType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	switch version {
+	case 0:
+		return Inet, nil
+	default:
+		return nil, fmt.Errorf("version %d is not yet supported for %s", version, b.String())
+	}
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (b InetType) SerializeValue(val any) ([]byte, error) {
+	if val == nil {
+		return nil, nil
+	}
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return serializeNetworkAddress(converted.(netip.Prefix)), nil
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (b InetType) DeserializeValue(val []byte) (any, error) {
+	if len(val) == 0 {
+		return nil, nil
+	}
+	return deserializeNetworkAddress(val)
+}
+
+// NetworkAddressFamily returns the IP family of the given address, which is either 4 or 6.
+func NetworkAddressFamily(prefix netip.Prefix) int32 {
+	if prefix.Addr().Is4() {
+		return 4
+	}
+	return 6
+}
+
+// NetworkAddressBroadcast returns the broadcast address of the given address's network, which has every bit outside of
+// the netmask set.
+func NetworkAddressBroadcast(prefix netip.Prefix) netip.Prefix {
+	addr := prefix.Addr().AsSlice()
+	for i := prefix.Bits(); i < len(addr)*8; i++ {
+		addr[i/8] |= 0x80 >> (i % 8)
+	}
+	newAddr, _ := netip.AddrFromSlice(addr)
+	return netip.PrefixFrom(newAddr, prefix.Bits())
+}
+
+// NetworkAddressMask returns the netmask of the given address as an address. If host is true, then the host mask is
+// returned instead.
+func NetworkAddressMask(prefix netip.Prefix, host bool) netip.Prefix {
+	addr := make([]byte, prefix.Addr().BitLen()/8)
+	for i := 0; i < len(addr)*8; i++ {
+		if (i < prefix.Bits()) != host {
+			addr[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	newAddr, _ := netip.AddrFromSlice(addr)
+	return netip.PrefixFrom(newAddr, newAddr.BitLen())
+}
+
+// NetworkAddressContains returns whether the network of the first address contains the second address. When strict is
+// true, the second address' network must also be smaller than the first address's network.
+func NetworkAddressContains(prefix1 netip.Prefix, prefix2 netip.Prefix, strict bool) bool {
+	if prefix1.Addr().Is4() != prefix2.Addr().Is4() {
+		return false
+	}
+	if prefix2.Bits() < prefix1.Bits() || (strict && prefix2.Bits() == prefix1.Bits()) {
+		return false
+	}
+	return prefix1.Masked().Contains(prefix2.Addr())
+}
+
+// NetworkAddressOverlaps returns whether either address's network contains the other.
+func NetworkAddressOverlaps(prefix1 netip.Prefix, prefix2 netip.Prefix) bool {
+	if prefix1.Addr().Is4() != prefix2.Addr().Is4() {
+		return false
+	}
+	return prefix1.Masked().Overlaps(prefix2.Masked())
+}
+
+// compareNetworkAddresses compares the two addresses in the same way as Postgres. IPv4 addresses sort before IPv6
+// addresses, followed by comparing the common network portion, then the netmask length, and then the entire address.
+func compareNetworkAddresses(prefix1 netip.Prefix, prefix2 netip.Prefix) int {
+	if prefix1.Addr().Is4() != prefix2.Addr().Is4() {
+		if prefix1.Addr().Is4() {
+			return -1
+		}
+		return 1
+	}
+	minBits := prefix1.Bits()
+	if prefix2.Bits() < minBits {
+		minBits = prefix2.Bits()
+	}
+	network1 := netip.PrefixFrom(prefix1.Addr(), minBits).Masked().Addr()
+	network2 := netip.PrefixFrom(prefix2.Addr(), minBits).Masked().Addr()
+	if res := network1.Compare(network2); res != 0 {
+		return res
+	}
+	if prefix1.Bits() != prefix2.Bits() {
+		if prefix1.Bits() < prefix2.Bits() {
+			return -1
+		}
+		return 1
+	}
+	return prefix1.Addr().Compare(prefix2.Addr())
+}
+
+// parseNetworkAddress parses the given address using the input rules for inet and cidr. For cidr, IPv4 addresses may
+// omit trailing octets, and a missing netmask is derived from the classful network rules.
+func parseNetworkAddress(input string, isCidr bool) (netip.Prefix, bool) {
+	input = strings.TrimSpace(input)
+	addrStr, bitsStr, hasBits := strings.Cut(input, "/")
+	if isCidr && !strings.Contains(addrStr, ":") {
+		octets := strings.Split(addrStr, ".")
+		if len(octets) > 4 {
+			return netip.Prefix{}, false
+		}
+		if !hasBits {
+			bitsStr = strconv.Itoa(classfulNetworkBits(octets))
+			hasBits = true
+		}
+		for len(octets) < 4 {
+			octets = append(octets, "0")
+		}
+		addrStr = strings.Join(octets, ".")
+	}
+	addr, err := netip.ParseAddr(addrStr)
+	if err != nil || addr.Zone() != "" {
+		return netip.Prefix{}, false
+	}
+	bits := addr.BitLen()
+	if hasBits {
+		bits, err = strconv.Atoi(bitsStr)
+		if err != nil || bits < 0 || bits > addr.BitLen() || strings.HasPrefix(bitsStr, "+") {
+			return netip.Prefix{}, false
+		}
+	}
+	return netip.PrefixFrom(addr, bits), true
+}
+
+// classfulNetworkBits returns the netmask length for an IPv4 cidr input that did not specify one. This follows the
+// older classful network rules, while always covering every octet that was given.
+func classfulNetworkBits(octets []string) int {
+	first, err := strconv.Atoi(octets[0])
+	if err != nil {
+		return 32
+	}
+	bits := 8
+	switch {
+	case first >= 240:
+		bits = 32
+	case first >= 224:
+		bits = 4
+	case first >= 192:
+		bits = 24
+	case first >= 128:
+		bits = 16
+	}
+	if len(octets)*8 > bits {
+		bits = len(octets) * 8
+	}
+	return bits
+}
+
+// sendNetworkAddress returns the binary representation of the given address.
+func sendNetworkAddress(prefix netip.Prefix, isCidr bool) []byte {
+	addr := prefix.Addr().AsSlice()
+	data := make([]byte, 4, 4+len(addr))
+	data[0] = inetFamilyIPv6
+	if prefix.Addr().Is4() {
+		data[0] = inetFamilyIPv4
+	}
+	data[1] = byte(prefix.Bits())
+	if isCidr {
+		data[2] = 1
+	}
+	data[3] = byte(len(addr))
+	return append(data, addr...)
+}
+
+// receiveNetworkAddress reads the binary representation of an address. Also returns whether the address is a cidr.
+func receiveNetworkAddress(data []byte) (netip.Prefix, bool, bool) {
+	if len(data) < 4 || len(data) != 4+int(data[3]) {
+		return netip.Prefix{}, false, false
+	}
+	addr, ok := netip.AddrFromSlice(data[4:])
+	if !ok || int(data[1]) > addr.BitLen() {
+		return netip.Prefix{}, false, false
+	}
+	return netip.PrefixFrom(addr, int(data[1])), data[2] != 0, true
+}
+
+// serializeNetworkAddress returns the storage representation of the given address.
+func serializeNetworkAddress(prefix netip.Prefix) []byte {
+	addr := prefix.Addr().AsSlice()
+	return append([]byte{byte(prefix.Bits())}, addr...)
+}
+
+// deserializeNetworkAddress reads the storage representation of an address.
+func deserializeNetworkAddress(val []byte) (netip.Prefix, error) {
+	addr, ok := netip.AddrFromSlice(val[1:])
+	if !ok {
+		return netip.Prefix{}, fmt.Errorf("invalid serialized network address")
+	}
+	return netip.PrefixFrom(addr, int(val[0])), nil
+}
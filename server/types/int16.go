@@ -146,6 +146,23 @@ func (b Int16Type) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return strconv.FormatInt(int64(converted.(int16)), 10), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b Int16Type) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 2); err != nil {
+		return nil, err
+	}
+	return int16(binary.BigEndian.Uint16(data)), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b Int16Type) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.AppendUint16(nil, uint16(converted.(int16))), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b Int16Type) IsPreferredType() bool {
 	return false
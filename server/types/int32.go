@@ -146,6 +146,23 @@ func (b Int32Type) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return strconv.FormatInt(int64(converted.(int32)), 10), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b Int32Type) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 4); err != nil {
+		return nil, err
+	}
+	return int32(binary.BigEndian.Uint32(data)), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b Int32Type) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.AppendUint32(nil, uint32(converted.(int32))), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b Int32Type) IsPreferredType() bool {
 	return false
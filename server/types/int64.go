@@ -143,6 +143,23 @@ func (b Int64Type) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return strconv.FormatInt(converted.(int64), 10), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b Int64Type) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 8); err != nil {
+		return nil, err
+	}
+	return int64(binary.BigEndian.Uint64(data)), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b Int64Type) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.AppendUint64(nil, uint64(converted.(int64))), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b Int64Type) IsPreferredType() bool {
 	return false
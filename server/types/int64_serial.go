@@ -94,6 +94,16 @@ func (b Int64TypeSerial) IoOutput(ctx *sql.Context, output any) (string, error)
 	return "", fmt.Errorf("SERIAL types cannot produce I/O output")
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b Int64TypeSerial) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return nil, fmt.Errorf("SERIAL types cannot receive binary input")
+}
+
+// IoSend implements the DoltgresType interface.
+func (b Int64TypeSerial) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	return nil, fmt.Errorf("SERIAL types cannot produce binary output")
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b Int64TypeSerial) IsPreferredType() bool {
 	return false
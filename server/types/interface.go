@@ -25,42 +25,46 @@ import (
 
 var ErrTypeAlreadyExists = errors.NewKind(`type "%s" already exists`)
 var ErrTypeDoesNotExist = errors.NewKind(`type "%s" does not exist`)
+var ErrInvalidBinaryRepresentation = errors.NewKind(`incorrect binary data format for type %s`)
 
 // Type represents a single type.
 type Type struct {
-	Oid           uint32
-	Name          string
-	Schema        string // TODO: should be `uint32`.
-	Owner         string // TODO: should be `uint32`.
-	Length        int16
-	PassedByVal   bool
-	TypType       TypeType
-	TypCategory   TypeCategory
-	IsPreferred   bool
-	IsDefined     bool
-	Delimiter     string
-	RelID         uint32 // for Composite types
-	SubscriptFunc string
-	Elem          uint32
-	Array         uint32
-	InputFunc     string
-	OutputFunc    string
-	ReceiveFunc   string
-	SendFunc      string
-	ModInFunc     string
-	ModOutFunc    string
-	AnalyzeFunc   string
-	Align         TypeAlignment
-	Storage       TypeStorage
-	NotNull       bool   // for Domain types
-	BaseTypeOID   uint32 // for Domain types
-	TypMod        int32  // for Domain types
-	NDims         int32  // for Domain types
-	Collation     uint32
-	DefaulBin     string // for Domain types
-	Default       string
-	Acl           string                 // TODO: list of privileges
-	Checks        []*sql.CheckDefinition // TODO: this is not part of `pg_type` instead `pg_constraint` for Domain types.
+	Oid            uint32
+	Name           string
+	Schema         string // TODO: should be `uint32`.
+	Owner          string // TODO: should be `uint32`.
+	Length         int16
+	PassedByVal    bool
+	TypType        TypeType
+	TypCategory    TypeCategory
+	IsPreferred    bool
+	IsDefined      bool
+	Delimiter      string
+	RelID          uint32 // for Composite types
+	SubscriptFunc  string
+	Elem           uint32
+	Array          uint32
+	InputFunc      string
+	OutputFunc     string
+	ReceiveFunc    string
+	SendFunc       string
+	ModInFunc      string
+	ModOutFunc     string
+	AnalyzeFunc    string
+	Align          TypeAlignment
+	Storage        TypeStorage
+	NotNull        bool   // for Domain types
+	BaseTypeOID    uint32 // for Domain types
+	TypMod         int32  // for Domain types
+	NDims          int32  // for Domain types
+	Collation      uint32
+	DefaulBin      string // for Domain types
+	Default        string
+	Acl            string                 // TODO: list of privileges
+	Checks         []*sql.CheckDefinition // TODO: this is not part of `pg_type` instead `pg_constraint` for Domain types.
+	EnumLabels     []EnumLabel            // TODO: this is not part of `pg_type` instead `pg_enum` for Enum types.
+	CompositeAttrs []CompositeAttribute   // TODO: this is not part of `pg_type` instead `pg_attribute` for Composite types.
+	RangeSubType   DoltgresType           // TODO: this is not part of `pg_type` instead `pg_range` for Range types.
 }
 
 // DoltgresType is a type that is distinct from the MySQL types in GMS.
@@ -84,6 +88,12 @@ type DoltgresType interface {
 	// strings are not intended for output, but are instead intended for serialization and cross-type conversion. Output
 	// values will always be non-NULL.
 	IoOutput(ctx *sql.Context, output any) (string, error)
+	// IoReceive returns a value from the given binary data. This function mirrors Postgres' binary receive function,
+	// and is used for values that clients send in the binary format. The data will never represent NULL.
+	IoReceive(ctx *sql.Context, data []byte) (any, error)
+	// IoSend returns the binary representation of the given value. This function mirrors Postgres' binary send
+	// function, and is used for values that clients request in the binary format. Values will always be non-NULL.
+	IoSend(ctx *sql.Context, val any) ([]byte, error)
 	// IsPreferredType returns true if the type is preferred type.
 	IsPreferredType() bool
 	// IsUnbounded returns whether the type is unbounded. Unbounded types do not enforce a length, precision, etc. on
@@ -129,19 +139,26 @@ type DoltgresPolymorphicType interface {
 var typesFromBaseID = map[DoltgresTypeBaseID]DoltgresType{
 	AnyArray.BaseID():          AnyArray,
 	AnyElement.BaseID():        AnyElement,
+	AnyEnum.BaseID():           AnyEnum,
 	AnyNonArray.BaseID():       AnyNonArray,
+	AnyRange.BaseID():          AnyRange,
+	Record.BaseID():            Record,
 	BpChar.BaseID():            BpChar,
 	BpCharArray.BaseID():       BpCharArray,
 	Bool.BaseID():              Bool,
 	BoolArray.BaseID():         BoolArray,
 	Bytea.BaseID():             Bytea,
 	ByteaArray.BaseID():        ByteaArray,
+	Cidr.BaseID():              Cidr,
+	CidrArray.BaseID():         CidrArray,
 	Date.BaseID():              Date,
 	DateArray.BaseID():         DateArray,
 	Float32.BaseID():           Float32,
 	Float32Array.BaseID():      Float32Array,
 	Float64.BaseID():           Float64,
 	Float64Array.BaseID():      Float64Array,
+	Inet.BaseID():              Inet,
+	InetArray.BaseID():         InetArray,
 	Int16.BaseID():             Int16,
 	Int16Array.BaseID():        Int16Array,
 	Int16Serial.BaseID():       Int16Serial,
@@ -159,6 +176,10 @@ var typesFromBaseID = map[DoltgresTypeBaseID]DoltgresType{
 	JsonArray.BaseID():         JsonArray,
 	JsonB.BaseID():             JsonB,
 	JsonBArray.BaseID():        JsonBArray,
+	MacAddr.BaseID():           MacAddr,
+	MacAddrArray.BaseID():      MacAddrArray,
+	MacAddr8.BaseID():          MacAddr8,
+	MacAddr8Array.BaseID():     MacAddr8Array,
 	Name.BaseID():              Name,
 	NameArray.BaseID():         NameArray,
 	Numeric.BaseID():           Numeric,
@@ -190,13 +211,20 @@ var typesFromBaseID = map[DoltgresTypeBaseID]DoltgresType{
 	XidArray.BaseID():          XidArray,
 }
 
-// GetAllTypes returns a slice containing all registered types. The slice is sorted by each type's base ID.
+// GetAllTypes returns a slice containing all registered types, including the built-in range types. The slice is sorted
+// by each type's base ID, and then by OID.
 func GetAllTypes() []DoltgresType {
-	pgTypes := make([]DoltgresType, 0, len(typesFromBaseID))
+	pgTypes := make([]DoltgresType, 0, len(typesFromBaseID)+len(builtInRangeTypes))
 	for _, typ := range typesFromBaseID {
 		pgTypes = append(pgTypes, typ)
 	}
+	for _, rangeType := range builtInRangeTypes {
+		pgTypes = append(pgTypes, rangeType)
+	}
 	sort.Slice(pgTypes, func(i, j int) bool {
+		if pgTypes[i].BaseID() == pgTypes[j].BaseID() {
+			return pgTypes[i].OID() < pgTypes[j].OID()
+		}
 		return pgTypes[i].BaseID() < pgTypes[j].BaseID()
 	})
 	return pgTypes
@@ -238,8 +266,8 @@ var OidToBuildInDoltgresType = map[uint32]DoltgresType{
 	uint32(oid.T_polygon):          Unknown,
 	uint32(oid.T_line):             Unknown,
 	uint32(oid.T__line):            Unknown,
-	uint32(oid.T_cidr):             Unknown,
-	uint32(oid.T__cidr):            Unknown,
+	uint32(oid.T_cidr):             Cidr,
+	uint32(oid.T__cidr):            CidrArray,
 	uint32(oid.T_float4):           Float32,
 	uint32(oid.T_float8):           Float64,
 	uint32(oid.T_abstime):          Unknown,
@@ -250,8 +278,8 @@ var OidToBuildInDoltgresType = map[uint32]DoltgresType{
 	uint32(oid.T__circle):          Unknown,
 	uint32(oid.T_money):            Unknown,
 	uint32(oid.T__money):           Unknown,
-	uint32(oid.T_macaddr):          Unknown,
-	uint32(oid.T_inet):             Unknown,
+	uint32(oid.T_macaddr):          MacAddr,
+	uint32(oid.T_inet):             Inet,
 	uint32(oid.T__bool):            BoolArray,
 	uint32(oid.T__bytea):           ByteaArray,
 	uint32(oid.T__char):            InternalCharArray,
@@ -281,8 +309,8 @@ var OidToBuildInDoltgresType = map[uint32]DoltgresType{
 	uint32(oid.T__oid):             OidArray,
 	uint32(oid.T_aclitem):          Unknown,
 	uint32(oid.T__aclitem):         Unknown,
-	uint32(oid.T__macaddr):         Unknown,
-	uint32(oid.T__inet):            Unknown,
+	uint32(oid.T__macaddr):         MacAddrArray,
+	uint32(oid.T__inet):            InetArray,
 	uint32(oid.T_bpchar):           BpChar,
 	uint32(oid.T_varchar):          VarChar,
 	uint32(oid.T_date):             Date,
@@ -352,23 +380,25 @@ var OidToBuildInDoltgresType = map[uint32]DoltgresType{
 	uint32(oid.T__regdictionary):   Unknown,
 	uint32(oid.T_jsonb):            JsonB,
 	uint32(oid.T__jsonb):           JsonBArray,
-	uint32(oid.T_anyrange):         Unknown,
+	uint32(oid.T_anyrange):         AnyRange,
 	uint32(oid.T_event_trigger):    Unknown,
-	uint32(oid.T_int4range):        Unknown,
+	uint32(oid.T_int4range):        Int4Range,
 	uint32(oid.T__int4range):       Unknown,
-	uint32(oid.T_numrange):         Unknown,
+	uint32(oid.T_numrange):         NumRange,
 	uint32(oid.T__numrange):        Unknown,
-	uint32(oid.T_tsrange):          Unknown,
+	uint32(oid.T_tsrange):          TsRange,
 	uint32(oid.T__tsrange):         Unknown,
-	uint32(oid.T_tstzrange):        Unknown,
+	uint32(oid.T_tstzrange):        TstzRange,
 	uint32(oid.T__tstzrange):       Unknown,
-	uint32(oid.T_daterange):        Unknown,
+	uint32(oid.T_daterange):        DateRange,
 	uint32(oid.T__daterange):       Unknown,
-	uint32(oid.T_int8range):        Unknown,
+	uint32(oid.T_int8range):        Int8Range,
 	uint32(oid.T__int8range):       Unknown,
 	uint32(oid.T_pg_shseclabel):    Unknown,
 	uint32(oid.T_regnamespace):     Unknown,
 	uint32(oid.T__regnamespace):    Unknown,
 	uint32(oid.T_regrole):          Unknown,
 	uint32(oid.T__regrole):         Unknown,
+	uint32(macAddr8Oid):            MacAddr8,
+	uint32(macAddr8ArrayOid):       MacAddr8Array,
 }
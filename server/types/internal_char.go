@@ -151,6 +151,30 @@ func (b InternalCharType) IoOutput(ctx *sql.Context, output any) (string, error)
 	return str, nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b InternalCharType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 1); err != nil {
+		return nil, err
+	}
+	if data[0] == 0 {
+		return "", nil
+	}
+	return string(data), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b InternalCharType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	str, err := b.IoOutput(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	// The empty string is stored as a null byte in Postgres, so that is what we send
+	if len(str) == 0 {
+		return []byte{0}, nil
+	}
+	return []byte(str), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b InternalCharType) IsPreferredType() bool {
 	return false
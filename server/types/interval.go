@@ -16,6 +16,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"reflect"
 
@@ -140,6 +141,30 @@ func (b IntervalType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return d.String(), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b IntervalType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	// The binary format is the microseconds, followed by the days and months
+	if err := checkBinaryLength(b, data, 16); err != nil {
+		return nil, err
+	}
+	micros := int64(binary.BigEndian.Uint64(data))
+	days := int32(binary.BigEndian.Uint32(data[8:]))
+	months := int32(binary.BigEndian.Uint32(data[12:]))
+	return duration.MakeDuration(micros*1000, int64(days), int64(months)), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b IntervalType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	d := converted.(duration.Duration)
+	data := binary.BigEndian.AppendUint64(nil, uint64(d.Nanos()/1000))
+	data = binary.BigEndian.AppendUint32(data, uint32(d.Days))
+	return binary.BigEndian.AppendUint32(data, uint32(d.Months)), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b IntervalType) IsPreferredType() bool {
 	return true
@@ -143,6 +143,20 @@ func (b JsonType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return converted.(string), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b JsonType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return b.IoInput(ctx, string(data))
+}
+
+// IoSend implements the DoltgresType interface.
+func (b JsonType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	str, err := b.IoOutput(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(str), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b JsonType) IsPreferredType() bool {
 	return false
@@ -0,0 +1,201 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/shopspring/decimal"
+)
+
+// JsonValueFromValue converts a value of the given type into a JsonValue, following the same rules as PostgreSQL's
+// to_jsonb. Arrays become JSON arrays, composite values become JSON objects, and JSON values are embedded directly.
+func JsonValueFromValue(ctx *sql.Context, typ DoltgresType, val any) (JsonValue, error) {
+	if val == nil {
+		return JsonValueNull(0), nil
+	}
+	switch typ := typ.(type) {
+	case DomainType:
+		return JsonValueFromValue(ctx, typ.AsType, val)
+	case DoltgresArrayType:
+		values, ok := val.([]any)
+		if !ok {
+			return nil, fmt.Errorf("expected array value for type %s, got %T", typ.String(), val)
+		}
+		baseType := typ.BaseType()
+		jsonArray := make(JsonValueArray, len(values))
+		for i, element := range values {
+			// Sub-arrays of multidimensional arrays become nested JSON arrays
+			elementType := baseType
+			if _, ok := element.([]any); ok {
+				elementType = typ
+			}
+			var err error
+			if jsonArray[i], err = JsonValueFromValue(ctx, elementType, element); err != nil {
+				return nil, err
+			}
+		}
+		return jsonArray, nil
+	case CompositeType:
+		values, ok := val.([]any)
+		if !ok || len(values) != len(typ.Attributes) {
+			return nil, fmt.Errorf("expected composite value for type %s, got %T", typ.String(), val)
+		}
+		items := make([]JsonValueObjectItem, len(values))
+		for i, attrVal := range values {
+			attrJson, err := JsonValueFromValue(ctx, typ.Attributes[i].Type, attrVal)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = JsonValueObjectItem{Key: typ.Attributes[i].Name, Value: attrJson}
+		}
+		return NewJsonValueObject(items), nil
+	}
+	switch typ.BaseID() {
+	case DoltgresTypeBaseID_JsonB:
+		return JsonValueCopy(val.(JsonDocument).Value), nil
+	case DoltgresTypeBaseID_Json:
+		doc, err := JsonB.IoInput(ctx, val.(string))
+		if err != nil {
+			return nil, err
+		}
+		return doc.(JsonDocument).Value, nil
+	default:
+		return jsonScalarFromValue(ctx, typ, val)
+	}
+}
+
+// JsonTextFromValue converts a value of the given type into the text of a json value, following the same rules as
+// PostgreSQL's to_json. Unlike JsonValueFromValue, the attributes of composite values retain their order, and json
+// values are embedded exactly as they were written.
+func JsonTextFromValue(ctx *sql.Context, typ DoltgresType, val any) (string, error) {
+	sb := strings.Builder{}
+	if err := jsonWriteValue(ctx, &sb, typ, val); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// jsonWriteValue is the recursive writer for JsonTextFromValue.
+func jsonWriteValue(ctx *sql.Context, sb *strings.Builder, typ DoltgresType, val any) error {
+	if val == nil {
+		sb.WriteString("null")
+		return nil
+	}
+	switch typ := typ.(type) {
+	case DomainType:
+		return jsonWriteValue(ctx, sb, typ.AsType, val)
+	case DoltgresArrayType:
+		values, ok := val.([]any)
+		if !ok {
+			return fmt.Errorf("expected array value for type %s, got %T", typ.String(), val)
+		}
+		baseType := typ.BaseType()
+		sb.WriteRune('[')
+		for i, element := range values {
+			if i > 0 {
+				sb.WriteRune(',')
+			}
+			elementType := baseType
+			if _, ok := element.([]any); ok {
+				elementType = typ
+			}
+			if err := jsonWriteValue(ctx, sb, elementType, element); err != nil {
+				return err
+			}
+		}
+		sb.WriteRune(']')
+		return nil
+	case CompositeType:
+		values, ok := val.([]any)
+		if !ok || len(values) != len(typ.Attributes) {
+			return fmt.Errorf("expected composite value for type %s, got %T", typ.String(), val)
+		}
+		sb.WriteRune('{')
+		for i, attrVal := range values {
+			if i > 0 {
+				sb.WriteRune(',')
+			}
+			jsonWriteString(sb, typ.Attributes[i].Name)
+			sb.WriteRune(':')
+			if err := jsonWriteValue(ctx, sb, typ.Attributes[i].Type, attrVal); err != nil {
+				return err
+			}
+		}
+		sb.WriteRune('}')
+		return nil
+	}
+	switch typ.BaseID() {
+	case DoltgresTypeBaseID_JsonB:
+		jsonValueFormatter(sb, val.(JsonDocument).Value)
+	case DoltgresTypeBaseID_Json:
+		sb.WriteString(val.(string))
+	default:
+		value, err := jsonScalarFromValue(ctx, typ, val)
+		if err != nil {
+			return err
+		}
+		jsonValueFormatter(sb, value)
+	}
+	return nil
+}
+
+// jsonScalarFromValue converts a non-container value into a JsonValue. Numbers and booleans keep their JSON types,
+// date and time types use the ISO 8601 format, and all other types use their text representation as a string.
+func jsonScalarFromValue(ctx *sql.Context, typ DoltgresType, val any) (JsonValue, error) {
+	switch typ.BaseID() {
+	case DoltgresTypeBaseID_Bool:
+		return JsonValueBoolean(val.(bool)), nil
+	case DoltgresTypeBaseID_Int16:
+		return JsonValueNumber(decimal.NewFromInt(int64(val.(int16)))), nil
+	case DoltgresTypeBaseID_Int32:
+		return JsonValueNumber(decimal.NewFromInt(int64(val.(int32)))), nil
+	case DoltgresTypeBaseID_Int64:
+		return JsonValueNumber(decimal.NewFromInt(val.(int64))), nil
+	case DoltgresTypeBaseID_Float32:
+		f := float64(val.(float32))
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			break
+		}
+		return JsonValueNumber(decimal.NewFromFloat32(val.(float32))), nil
+	case DoltgresTypeBaseID_Float64:
+		f := val.(float64)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			break
+		}
+		return JsonValueNumber(decimal.NewFromFloat(f)), nil
+	case DoltgresTypeBaseID_Numeric:
+		return JsonValueNumber(val.(decimal.Decimal)), nil
+	case DoltgresTypeBaseID_Date:
+		return JsonValueString(val.(time.Time).Format("2006-01-02")), nil
+	case DoltgresTypeBaseID_Timestamp:
+		return JsonValueString(val.(time.Time).Format("2006-01-02T15:04:05.999999")), nil
+	case DoltgresTypeBaseID_TimestampTZ:
+		serverLoc, err := GetServerLocation(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return JsonValueString(val.(time.Time).In(serverLoc).Format("2006-01-02T15:04:05.999999-07:00")), nil
+	}
+	str, err := typ.IoOutput(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	return JsonValueString(str), nil
+}
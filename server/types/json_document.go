@@ -16,6 +16,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/shopspring/decimal"
@@ -124,8 +125,8 @@ func JsonValueCopy(value JsonValue) JsonValue {
 	}
 }
 
-// jsonValueCompare compares two values.
-func jsonValueCompare(v1 JsonValue, v2 JsonValue) int {
+// JsonValueCompare compares two values, returning -1 if v1 sorts before v2, 1 if it sorts after, and 0 if they are equal.
+func JsonValueCompare(v1 JsonValue, v2 JsonValue) int {
 	// Some types sort before others, so we'll check those first
 	v1TypeSortOrder := jsonValueTypeSortOrder(v1)
 	v2TypeSortOrder := jsonValueTypeSortOrder(v2)
@@ -151,7 +152,7 @@ func jsonValueCompare(v1 JsonValue, v2 JsonValue) int {
 			} else if v1.Items[i].Key > v2.Items[i].Key {
 				return 1
 			} else {
-				innerCmp := jsonValueCompare(v1.Items[i].Value, v2.Items[i].Value)
+				innerCmp := JsonValueCompare(v1.Items[i].Value, v2.Items[i].Value)
 				if innerCmp != 0 {
 					return innerCmp
 				}
@@ -166,7 +167,7 @@ func jsonValueCompare(v1 JsonValue, v2 JsonValue) int {
 			return 1
 		}
 		for i := 0; i < len(v1); i++ {
-			innerCmp := jsonValueCompare(v1[i], v2[i])
+			innerCmp := JsonValueCompare(v1[i], v2[i])
 			if innerCmp != 0 {
 				return innerCmp
 			}
@@ -200,7 +201,7 @@ func jsonValueCompare(v1 JsonValue, v2 JsonValue) int {
 }
 
 // jsonValueTypeSortOrder returns the relative sorting order based on the JsonValueType of the JsonValue. This should
-// only be used from within jsonValueCompare. Lower values sort before larger values.
+// only be used from within JsonValueCompare. Lower values sort before larger values.
 func jsonValueTypeSortOrder(value JsonValue) int {
 	switch value.(type) {
 	case JsonValueObject:
@@ -303,9 +304,8 @@ func jsonValueFormatter(sb *strings.Builder, value JsonValue) {
 			if i > 0 {
 				sb.WriteString(", ")
 			}
-			sb.WriteRune('"')
-			sb.WriteString(strings.ReplaceAll(item.Key, `"`, `\"`))
-			sb.WriteString(`": `)
+			jsonWriteString(sb, item.Key)
+			sb.WriteString(`: `)
 			jsonValueFormatter(sb, item.Value)
 		}
 		sb.WriteRune('}')
@@ -319,9 +319,7 @@ func jsonValueFormatter(sb *strings.Builder, value JsonValue) {
 		}
 		sb.WriteRune(']')
 	case JsonValueString:
-		sb.WriteRune('"')
-		sb.WriteString(strings.ReplaceAll(string(value), `"`, `\"`))
-		sb.WriteRune('"')
+		jsonWriteString(sb, string(value))
 	case JsonValueNumber:
 		sb.WriteString(decimal.Decimal(value).String())
 	case JsonValueBoolean:
@@ -334,3 +332,81 @@ func jsonValueFormatter(sb *strings.Builder, value JsonValue) {
 		sb.WriteString(`null`)
 	}
 }
+
+// NewJsonValueObject returns a JsonValueObject containing the given items. Items are sorted in the same order that
+// PostgreSQL uses for jsonb (shorter keys sort first), and when a key appears multiple times, the last value is kept.
+func NewJsonValueObject(items []JsonValueObjectItem) JsonValueObject {
+	index := make(map[string]int)
+	uniqueItems := make([]JsonValueObjectItem, 0, len(items))
+	for _, item := range items {
+		if existingIdx, ok := index[item.Key]; ok {
+			uniqueItems[existingIdx].Value = item.Value
+			continue
+		}
+		index[item.Key] = len(uniqueItems)
+		uniqueItems = append(uniqueItems, item)
+	}
+	sort.Slice(uniqueItems, func(i, j int) bool {
+		if len(uniqueItems[i].Key) != len(uniqueItems[j].Key) {
+			return len(uniqueItems[i].Key) < len(uniqueItems[j].Key)
+		}
+		return uniqueItems[i].Key < uniqueItems[j].Key
+	})
+	for i, item := range uniqueItems {
+		index[item.Key] = i
+	}
+	return JsonValueObject{
+		Items: uniqueItems,
+		Index: index,
+	}
+}
+
+// JsonValueTypeName returns the name of the given value's type, as reported by functions such as jsonb_typeof.
+func JsonValueTypeName(value JsonValue) string {
+	switch value.(type) {
+	case JsonValueObject:
+		return "object"
+	case JsonValueArray:
+		return "array"
+	case JsonValueString:
+		return "string"
+	case JsonValueNumber:
+		return "number"
+	case JsonValueBoolean:
+		return "boolean"
+	case JsonValueNull:
+		return "null"
+	default:
+		return "unknown"
+	}
+}
+
+// jsonWriteString writes the given string as a quoted JSON string, escaping characters in the same way as PostgreSQL.
+func jsonWriteString(sb *strings.Builder, str string) {
+	sb.WriteRune('"')
+	for _, r := range str {
+		switch r {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				sb.WriteString(fmt.Sprintf(`\u%04x`, r))
+			} else {
+				sb.WriteRune(r)
+			}
+		}
+	}
+	sb.WriteRune('"')
+}
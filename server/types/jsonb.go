@@ -88,7 +88,7 @@ func (b JsonBType) Compare(v1 any, v2 any) (int, error) {
 	ab := ac.(JsonDocument)
 	bb := bc.(JsonDocument)
 
-	return jsonValueCompare(ab.Value, bb.Value), nil
+	return JsonValueCompare(ab.Value, bb.Value), nil
 }
 
 // Convert implements the DoltgresType interface.
@@ -146,6 +146,24 @@ func (b JsonBType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return sb.String(), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b JsonBType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	// The binary format is the text format, preceded by a version number. Only version 1 exists.
+	if len(data) == 0 || data[0] != 1 {
+		return nil, ErrInvalidBinaryRepresentation.New(b.String())
+	}
+	return b.IoInput(ctx, string(data[1:]))
+}
+
+// IoSend implements the DoltgresType interface.
+func (b JsonBType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	str, err := b.IoOutput(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{1}, str...), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b JsonBType) IsPreferredType() bool {
 	return false
@@ -0,0 +1,313 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+)
+
+// MacAddr is the MAC address type, which holds a 6 byte hardware address.
+var MacAddr = MacAddrType{}
+
+// MacAddrType is the extended type implementation of the PostgreSQL macaddr. Values are stored as a net.HardwareAddr.
+type MacAddrType struct{}
+
+var _ DoltgresType = MacAddrType{}
+
+// Alignment implements the DoltgresType interface.
+func (b MacAddrType) Alignment() TypeAlignment {
+	return TypeAlignment_Int
+}
+
+// BaseID implements the DoltgresType interface.
+func (b MacAddrType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_MacAddr
+}
+
+// BaseName implements the DoltgresType interface.
+func (b MacAddrType) BaseName() string {
+	return "macaddr"
+}
+
+// Category implements the DoltgresType interface.
+func (b MacAddrType) Category() TypeCategory {
+	return TypeCategory_UserDefinedTypes
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (b MacAddrType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (b MacAddrType) Compare(v1 any, v2 any) (int, error) {
+	if v1 == nil && v2 == nil {
+		return 0, nil
+	} else if v1 != nil && v2 == nil {
+		return 1, nil
+	} else if v1 == nil && v2 != nil {
+		return -1, nil
+	}
+
+	ac, _, err := b.Convert(v1)
+	if err != nil {
+		return 0, err
+	}
+	bc, _, err := b.Convert(v2)
+	if err != nil {
+		return 0, err
+	}
+	return bytes.Compare(ac.(net.HardwareAddr), bc.(net.HardwareAddr)), nil
+}
+
+// Convert implements the DoltgresType interface.
+func (b MacAddrType) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case net.HardwareAddr:
+		return val, sql.InRange, nil
+	case nil:
+		return nil, sql.InRange, nil
+	default:
+		return nil, sql.OutOfRange, fmt.Errorf("%s: unhandled type: %T", b.String(), val)
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (b MacAddrType) Equals(otherType sql.Type) bool {
+	if otherExtendedType, ok := otherType.(types.ExtendedType); ok {
+		return bytes.Equal(MustSerializeType(b), MustSerializeType(otherExtendedType))
+	}
+	return false
+}
+
+// FormatValue implements the DoltgresType interface.
+func (b MacAddrType) FormatValue(val any) (string, error) {
+	if val == nil {
+		return "", nil
+	}
+	return b.IoOutput(sql.NewEmptyContext(), val)
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (b MacAddrType) GetSerializationID() SerializationID {
+	return SerializationID_MacAddress
+}
+
+// IoInput implements the DoltgresType interface.
+func (b MacAddrType) IoInput(ctx *sql.Context, input string) (any, error) {
+	addr, ok := parseMacAddress(input)
+	if !ok || len(addr) != 6 {
+		return nil, fmt.Errorf("invalid input syntax for type %s: %q", b.String(), input)
+	}
+	return addr, nil
+}
+
+// IoOutput implements the DoltgresType interface.
+func (b MacAddrType) IoOutput(ctx *sql.Context, output any) (string, error) {
+	converted, _, err := b.Convert(output)
+	if err != nil {
+		return "", err
+	}
+	return converted.(net.HardwareAddr).String(), nil
+}
+
+// IoReceive implements the DoltgresType interface.
+func (b MacAddrType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 6); err != nil {
+		return nil, err
+	}
+	return net.HardwareAddr(bytes.Clone(data)), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b MacAddrType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Clone(converted.(net.HardwareAddr)), nil
+}
+
+// IsPreferredType implements the DoltgresType interface.
+func (b MacAddrType) IsPreferredType() bool {
+	return false
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (b MacAddrType) IsUnbounded() bool {
+	return false
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (b MacAddrType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	return types.ExtendedTypeSerializedWidth_64K
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (b MacAddrType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return 6
+}
+
+// OID implements the DoltgresType interface.
+func (b MacAddrType) OID() uint32 {
+	return uint32(oid.T_macaddr)
+}
+
+// Promote implements the DoltgresType interface.
+func (b MacAddrType) Promote() sql.Type {
+	return b
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (b MacAddrType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	if len(v1) == 0 && len(v2) == 0 {
+		return 0, nil
+	} else if len(v1) > 0 && len(v2) == 0 {
+		return 1, nil
+	} else if len(v1) == 0 && len(v2) > 0 {
+		return -1, nil
+	}
+	return bytes.Compare(v1, v2), nil
+}
+
+// SQL implements the DoltgresType interface.
+func (b MacAddrType) SQL(ctx *sql.Context, dest []byte, v any) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+	value, err := b.IoOutput(ctx, v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return sqltypes.MakeTrusted(sqltypes.Text, types.AppendAndSliceBytes(dest, []byte(value))), nil
+}
+
+// String implements the DoltgresType interface.
+func (b MacAddrType) String() string {
+	return "macaddr"
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (b MacAddrType) ToArrayType() DoltgresArrayType {
+	return MacAddrArray
+}
+
+// Type implements the DoltgresType interface.
+func (b MacAddrType) Type() query.Type {
+	return sqltypes.VarBinary
+}
+
+// ValueType implements the DoltgresType interface.
+func (b MacAddrType) ValueType() reflect.Type {
+	return reflect.TypeOf(net.HardwareAddr{})
+}
+
+// Zero implements the DoltgresType interface.
+func (b MacAddrType) Zero() any {
+	return make(net.HardwareAddr, 6)
+}
+
+// SerializeType implements the DoltgresType interface.
+func (b MacAddrType) SerializeType() ([]byte, error) {
+	return SerializationID_MacAddress.ToByteSlice(0), nil
+}
+
+// deserializeType implements the DoltgresType interface.
+func (b MacAddrType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	switch version {
+	case 0:
+		return MacAddr, nil
+	default:
+		return nil, fmt.Errorf("version %d is not yet supported for %s", version, b.String())
+	}
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (b MacAddrType) SerializeValue(val any) ([]byte, error) {
+	if val == nil {
+		return nil, nil
+	}
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Clone(converted.(net.HardwareAddr)), nil
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (b MacAddrType) DeserializeValue(val []byte) (any, error) {
+	if len(val) == 0 {
+		return nil, nil
+	}
+	return net.HardwareAddr(bytes.Clone(val)), nil
+}
+
+// parseMacAddress parses the given MAC address, returning either 6 or 8 bytes. Bytes are written as pairs of hex
+// digits, and may be separated by a colon, hyphen, or period, as long as the same separator is used throughout.
+func parseMacAddress(input string) (net.HardwareAddr, bool) {
+	input = strings.TrimSpace(input)
+	addr := make(net.HardwareAddr, 0, 8)
+	separator := byte(0)
+	for i := 0; i < len(input); {
+		if len(addr) > 0 && (input[i] == ':' || input[i] == '-' || input[i] == '.') {
+			if separator != 0 && separator != input[i] {
+				return nil, false
+			}
+			separator = input[i]
+			i++
+			if i >= len(input) {
+				return nil, false
+			}
+		}
+		if i+1 >= len(input) || len(addr) == 8 {
+			return nil, false
+		}
+		hi, ok1 := hexDigitValue(input[i])
+		lo, ok2 := hexDigitValue(input[i+1])
+		if !ok1 || !ok2 {
+			return nil, false
+		}
+		addr = append(addr, hi<<4|lo)
+		i += 2
+	}
+	if len(addr) != 6 && len(addr) != 8 {
+		return nil, false
+	}
+	return addr, true
+}
+
+// hexDigitValue returns the value of the given hexadecimal digit.
+func hexDigitValue(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	default:
+		return 0, false
+	}
+}
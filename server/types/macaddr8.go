@@ -0,0 +1,279 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"reflect"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+)
+
+// MacAddr8 is the MAC address type, which holds an 8 byte hardware address in EUI-64 format.
+var MacAddr8 = MacAddr8Type{}
+
+// MacAddr8Type is the extended type implementation of the PostgreSQL macaddr8. Values are stored as a net.HardwareAddr.
+type MacAddr8Type struct{}
+
+var _ DoltgresType = MacAddr8Type{}
+
+// These OIDs are not defined in the oid package, so they're defined here.
+const (
+	macAddr8Oid      = oid.Oid(774)
+	macAddr8ArrayOid = oid.Oid(775)
+)
+
+// Alignment implements the DoltgresType interface.
+func (b MacAddr8Type) Alignment() TypeAlignment {
+	return TypeAlignment_Int
+}
+
+// BaseID implements the DoltgresType interface.
+func (b MacAddr8Type) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_MacAddr8
+}
+
+// BaseName implements the DoltgresType interface.
+func (b MacAddr8Type) BaseName() string {
+	return "macaddr8"
+}
+
+// Category implements the DoltgresType interface.
+func (b MacAddr8Type) Category() TypeCategory {
+	return TypeCategory_UserDefinedTypes
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (b MacAddr8Type) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (b MacAddr8Type) Compare(v1 any, v2 any) (int, error) {
+	if v1 == nil && v2 == nil {
+		return 0, nil
+	} else if v1 != nil && v2 == nil {
+		return 1, nil
+	} else if v1 == nil && v2 != nil {
+		return -1, nil
+	}
+
+	ac, _, err := b.Convert(v1)
+	if err != nil {
+		return 0, err
+	}
+	bc, _, err := b.Convert(v2)
+	if err != nil {
+		return 0, err
+	}
+	return bytes.Compare(ac.(net.HardwareAddr), bc.(net.HardwareAddr)), nil
+}
+
+// Convert implements the DoltgresType interface.
+func (b MacAddr8Type) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case net.HardwareAddr:
+		return val, sql.InRange, nil
+	case nil:
+		return nil, sql.InRange, nil
+	default:
+		return nil, sql.OutOfRange, fmt.Errorf("%s: unhandled type: %T", b.String(), val)
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (b MacAddr8Type) Equals(otherType sql.Type) bool {
+	if otherExtendedType, ok := otherType.(types.ExtendedType); ok {
+		return bytes.Equal(MustSerializeType(b), MustSerializeType(otherExtendedType))
+	}
+	return false
+}
+
+// FormatValue implements the DoltgresType interface.
+func (b MacAddr8Type) FormatValue(val any) (string, error) {
+	if val == nil {
+		return "", nil
+	}
+	return b.IoOutput(sql.NewEmptyContext(), val)
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (b MacAddr8Type) GetSerializationID() SerializationID {
+	return SerializationID_MacAddress8
+}
+
+// IoInput implements the DoltgresType interface.
+func (b MacAddr8Type) IoInput(ctx *sql.Context, input string) (any, error) {
+	addr, ok := parseMacAddress(input)
+	if !ok {
+		return nil, fmt.Errorf("invalid input syntax for type %s: %q", b.String(), input)
+	}
+	return MacAddrToMacAddr8(addr), nil
+}
+
+// IoOutput implements the DoltgresType interface.
+func (b MacAddr8Type) IoOutput(ctx *sql.Context, output any) (string, error) {
+	converted, _, err := b.Convert(output)
+	if err != nil {
+		return "", err
+	}
+	return converted.(net.HardwareAddr).String(), nil
+}
+
+// IoReceive implements the DoltgresType interface.
+func (b MacAddr8Type) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 8); err != nil {
+		return nil, err
+	}
+	return net.HardwareAddr(bytes.Clone(data)), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b MacAddr8Type) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Clone(converted.(net.HardwareAddr)), nil
+}
+
+// IsPreferredType implements the DoltgresType interface.
+func (b MacAddr8Type) IsPreferredType() bool {
+	return false
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (b MacAddr8Type) IsUnbounded() bool {
+	return false
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (b MacAddr8Type) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	return types.ExtendedTypeSerializedWidth_64K
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (b MacAddr8Type) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return 8
+}
+
+// OID implements the DoltgresType interface.
+func (b MacAddr8Type) OID() uint32 {
+	return uint32(macAddr8Oid)
+}
+
+// Promote implements the DoltgresType interface.
+func (b MacAddr8Type) Promote() sql.Type {
+	return b
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (b MacAddr8Type) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	if len(v1) == 0 && len(v2) == 0 {
+		return 0, nil
+	} else if len(v1) > 0 && len(v2) == 0 {
+		return 1, nil
+	} else if len(v1) == 0 && len(v2) > 0 {
+		return -1, nil
+	}
+	return bytes.Compare(v1, v2), nil
+}
+
+// SQL implements the DoltgresType interface.
+func (b MacAddr8Type) SQL(ctx *sql.Context, dest []byte, v any) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+	value, err := b.IoOutput(ctx, v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return sqltypes.MakeTrusted(sqltypes.Text, types.AppendAndSliceBytes(dest, []byte(value))), nil
+}
+
+// String implements the DoltgresType interface.
+func (b MacAddr8Type) String() string {
+	return "macaddr8"
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (b MacAddr8Type) ToArrayType() DoltgresArrayType {
+	return MacAddr8Array
+}
+
+// Type implements the DoltgresType interface.
+func (b MacAddr8Type) Type() query.Type {
+	return sqltypes.VarBinary
+}
+
+// ValueType implements the DoltgresType interface.
+func (b MacAddr8Type) ValueType() reflect.Type {
+	return reflect.TypeOf(net.HardwareAddr{})
+}
+
+// Zero implements the DoltgresType interface.
+func (b MacAddr8Type) Zero() any {
+	return make(net.HardwareAddr, 8)
+}
+
+// SerializeType implements the DoltgresType interface.
+func (b MacAddr8Type) SerializeType() ([]byte, error) {
+	return SerializationID_MacAddress8.ToByteSlice(0), nil
+}
+
+// deserializeType implements the DoltgresType interface.
+func (b MacAddr8Type) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	switch version {
+	case 0:
+		return MacAddr8, nil
+	default:
+		return nil, fmt.Errorf("version %d is not yet supported for %s", version, b.String())
+	}
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (b MacAddr8Type) SerializeValue(val any) ([]byte, error) {
+	if val == nil {
+		return nil, nil
+	}
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Clone(converted.(net.HardwareAddr)), nil
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (b MacAddr8Type) DeserializeValue(val []byte) (any, error) {
+	if len(val) == 0 {
+		return nil, nil
+	}
+	return net.HardwareAddr(bytes.Clone(val)), nil
+}
+
+// MacAddrToMacAddr8 converts the given 6 byte MAC address to its 8 byte form by inserting FF and FE in the middle of
+// the address. Addresses that are already 8 bytes are returned as-is.
+func MacAddrToMacAddr8(addr net.HardwareAddr) net.HardwareAddr {
+	if len(addr) == 8 {
+		return addr
+	}
+	return net.HardwareAddr{addr[0], addr[1], addr[2], 0xff, 0xfe, addr[3], addr[4], addr[5]}
+}
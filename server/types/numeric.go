@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/lib/pq/oid"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -48,6 +49,11 @@ var (
 // Numeric is a precise and unbounded decimal value.
 var Numeric = NumericType{-1, -1}
 
+// numericBinaryEncodePlan and numericBinaryScanPlan handle the binary representation of numeric values, which is a
+// base-10000 format that we let pgx deal with.
+var numericBinaryEncodePlan = pgtype.NumericCodec{}.PlanEncode(nil, uint32(oid.T_numeric), pgtype.BinaryFormatCode, pgtype.Numeric{})
+var numericBinaryScanPlan = pgtype.NumericCodec{}.PlanScan(nil, uint32(oid.T_numeric), pgtype.BinaryFormatCode, &pgtype.Numeric{})
+
 // NumericType is the extended type implementation of the PostgreSQL numeric.
 type NumericType struct {
 	// TODO: implement precision and scale
@@ -162,6 +168,32 @@ func (b NumericType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return dec.StringFixed(scale), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b NumericType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	var n pgtype.Numeric
+	if err := numericBinaryScanPlan.Scan(data, &n); err != nil {
+		return nil, ErrInvalidBinaryRepresentation.New(b.String())
+	}
+	if !n.Valid || n.NaN || n.InfinityModifier != pgtype.Finite {
+		return nil, fmt.Errorf("%s: special values are not yet supported", b.String())
+	}
+	return decimal.NewFromBigInt(n.Int, n.Exp), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b NumericType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	dec := converted.(decimal.Decimal)
+	if b.Scale != -1 {
+		// The exponent determines the display scale, so this matches the scale of the text output
+		dec = dec.Round(b.Scale)
+	}
+	return numericBinaryEncodePlan.Encode(pgtype.Numeric{Int: dec.Coefficient(), Exp: dec.Exponent(), Valid: true}, nil)
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b NumericType) IsPreferredType() bool {
 	return false
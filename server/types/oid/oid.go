@@ -32,6 +32,7 @@ const (
 	Section_Table                        // Refers to tables
 	Section_View                         // Refers to views
 	Section_ColumnDefault                // Refers to column defaults on tables (the dataIndex is obtained by incrementing through all tables' column defaults)
+	Section_Policy                       // Refers to row-level security policies on tables (the dataIndex is obtained by incrementing through all tables' policies)
 	Section_Invalid                      // Represents an invalid OID
 )
 
@@ -0,0 +1,925 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+var ErrMalformedRangeLiteral = errors.NewKind(`malformed range literal: "%s"`)
+var ErrRangeLowerGreaterThanUpper = errors.NewKind(`range lower bound must be less than or equal to range upper bound`)
+
+// These flags match the flags used by Postgres in the binary format of a range.
+const (
+	rangeFlagEmpty    byte = 0x01
+	rangeFlagLowerInc byte = 0x02
+	rangeFlagUpperInc byte = 0x04
+	rangeFlagLowerInf byte = 0x08
+	rangeFlagUpperInf byte = 0x10
+)
+
+// Int4Range is the range type of integers.
+var Int4Range = RangeType{
+	Oid:      uint32(oid.T_int4range),
+	ArrayOid: uint32(oid.T__int4range),
+	Name:     "int4range",
+	SubType:  Int32,
+}
+
+// Int8Range is the range type of bigints.
+var Int8Range = RangeType{
+	Oid:      uint32(oid.T_int8range),
+	ArrayOid: uint32(oid.T__int8range),
+	Name:     "int8range",
+	SubType:  Int64,
+}
+
+// NumRange is the range type of numerics.
+var NumRange = RangeType{
+	Oid:      uint32(oid.T_numrange),
+	ArrayOid: uint32(oid.T__numrange),
+	Name:     "numrange",
+	SubType:  Numeric,
+}
+
+// TsRange is the range type of timestamps without time zones.
+var TsRange = RangeType{
+	Oid:      uint32(oid.T_tsrange),
+	ArrayOid: uint32(oid.T__tsrange),
+	Name:     "tsrange",
+	SubType:  Timestamp,
+}
+
+// TstzRange is the range type of timestamps with time zones.
+var TstzRange = RangeType{
+	Oid:      uint32(oid.T_tstzrange),
+	ArrayOid: uint32(oid.T__tstzrange),
+	Name:     "tstzrange",
+	SubType:  TimestampTZ,
+}
+
+// DateRange is the range type of dates.
+var DateRange = RangeType{
+	Oid:      uint32(oid.T_daterange),
+	ArrayOid: uint32(oid.T__daterange),
+	Name:     "daterange",
+	SubType:  Date,
+}
+
+// builtInRangeTypes contains all of the built-in range types.
+var builtInRangeTypes = []RangeType{Int4Range, Int8Range, NumRange, TsRange, TstzRange, DateRange}
+
+// RangeValue is the value of a range type. A nil bound represents an infinite (unbounded) bound, which is never
+// inclusive.
+type RangeValue struct {
+	Lower          any
+	Upper          any
+	LowerInclusive bool
+	UpperInclusive bool
+	Empty          bool
+}
+
+// RangeType is the extended type implementation of a PostgreSQL range. This covers the built-in range types, as well as
+// user-defined range types that were created using CREATE TYPE ... AS RANGE. Values are stored as a RangeValue.
+type RangeType struct {
+	Oid      uint32
+	ArrayOid uint32
+	Schema   string
+	Name     string
+	SubType  DoltgresType
+}
+
+var _ DoltgresType = RangeType{}
+
+// NewRangeType creates a new instance of a range Type.
+func NewRangeType(schema string, name string, typeOid uint32, arrayOid uint32, subType DoltgresType, owner string) *Type {
+	return &Type{
+		Oid:           typeOid,
+		Name:          name,
+		Schema:        schema,
+		Owner:         owner,
+		Length:        -1,
+		PassedByVal:   false,
+		TypType:       TypeType_Range,
+		TypCategory:   TypeCategory_RangeTypes,
+		IsPreferred:   false,
+		IsDefined:     true,
+		Delimiter:     ",",
+		RelID:         0,
+		SubscriptFunc: "-",
+		Elem:          0,
+		Array:         arrayOid,
+		InputFunc:     "range_in",
+		OutputFunc:    "range_out",
+		ReceiveFunc:   "range_recv",
+		SendFunc:      "range_send",
+		ModInFunc:     "-",
+		ModOutFunc:    "-",
+		AnalyzeFunc:   "range_typanalyze",
+		Align:         rangeAlignment(subType),
+		Storage:       TypeStorage_Extended,
+		NotNull:       false,
+		BaseTypeOID:   0,
+		TypMod:        -1,
+		NDims:         0,
+		Collation:     0,
+		DefaulBin:     "",
+		Default:       "",
+		Acl:           "",
+		RangeSubType:  subType,
+	}
+}
+
+// RangeTypeFromType returns the RangeType that represents the given range Type.
+func RangeTypeFromType(typ *Type) RangeType {
+	return RangeType{
+		Oid:      typ.Oid,
+		ArrayOid: typ.Array,
+		Schema:   typ.Schema,
+		Name:     typ.Name,
+		SubType:  typ.RangeSubType,
+	}
+}
+
+// GetBuiltInRangeType returns the built-in range type with the given name.
+func GetBuiltInRangeType(name string) (RangeType, bool) {
+	for _, rangeType := range builtInRangeTypes {
+		if rangeType.Name == name {
+			return rangeType, true
+		}
+	}
+	return RangeType{}, false
+}
+
+// GetBuiltInRangeTypes returns all of the built-in range types.
+func GetBuiltInRangeTypes() []RangeType {
+	rangeTypes := make([]RangeType, len(builtInRangeTypes))
+	copy(rangeTypes, builtInRangeTypes)
+	return rangeTypes
+}
+
+// Alignment implements the DoltgresType interface.
+func (r RangeType) Alignment() TypeAlignment {
+	return rangeAlignment(r.SubType)
+}
+
+// BaseID implements the DoltgresType interface.
+func (r RangeType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_Range
+}
+
+// BaseName implements the DoltgresType interface.
+func (r RangeType) BaseName() string {
+	return r.Name
+}
+
+// Category implements the DoltgresType interface.
+func (r RangeType) Category() TypeCategory {
+	return TypeCategory_RangeTypes
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (r RangeType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (r RangeType) Compare(v1 any, v2 any) (int, error) {
+	if v1 == nil && v2 == nil {
+		return 0, nil
+	} else if v1 != nil && v2 == nil {
+		return 1, nil
+	} else if v1 == nil && v2 != nil {
+		return -1, nil
+	}
+
+	ac, _, err := r.Convert(v1)
+	if err != nil {
+		return 0, err
+	}
+	bc, _, err := r.Convert(v2)
+	if err != nil {
+		return 0, err
+	}
+	ab := ac.(RangeValue)
+	bb := bc.(RangeValue)
+	// Empty ranges are considered less than all other ranges
+	if ab.Empty && bb.Empty {
+		return 0, nil
+	} else if ab.Empty {
+		return -1, nil
+	} else if bb.Empty {
+		return 1, nil
+	}
+	res, err := r.compareBounds(ab.lowerBound(), bb.lowerBound())
+	if err != nil || res != 0 {
+		return res, err
+	}
+	return r.compareBounds(ab.upperBound(), bb.upperBound())
+}
+
+// Contains returns whether the first range contains the second range.
+func (r RangeType) Contains(v1 RangeValue, v2 RangeValue) (bool, error) {
+	if v2.Empty {
+		return true, nil
+	} else if v1.Empty {
+		return false, nil
+	}
+	res, err := r.compareBounds(v1.lowerBound(), v2.lowerBound())
+	if err != nil || res > 0 {
+		return false, err
+	}
+	res, err = r.compareBounds(v1.upperBound(), v2.upperBound())
+	if err != nil {
+		return false, err
+	}
+	return res >= 0, nil
+}
+
+// ContainsElement returns whether the range contains the given element, which must be a value of the subtype.
+func (r RangeType) ContainsElement(v RangeValue, elem any) (bool, error) {
+	if v.Empty {
+		return false, nil
+	}
+	if v.Lower != nil {
+		res, err := r.SubType.Compare(v.Lower, elem)
+		if err != nil {
+			return false, err
+		}
+		if res > 0 || (res == 0 && !v.LowerInclusive) {
+			return false, nil
+		}
+	}
+	if v.Upper != nil {
+		res, err := r.SubType.Compare(v.Upper, elem)
+		if err != nil {
+			return false, err
+		}
+		if res < 0 || (res == 0 && !v.UpperInclusive) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Convert implements the DoltgresType interface.
+func (r RangeType) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case RangeValue:
+		return val, sql.InRange, nil
+	case nil:
+		return nil, sql.InRange, nil
+	default:
+		return nil, sql.OutOfRange, fmt.Errorf("%s: unhandled type: %T", r.String(), val)
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (r RangeType) Equals(otherType sql.Type) bool {
+	otherRange, ok := otherType.(RangeType)
+	if !ok || r.Oid != otherRange.Oid || r.ArrayOid != otherRange.ArrayOid || r.Schema != otherRange.Schema ||
+		r.Name != otherRange.Name {
+		return false
+	}
+	return r.SubType.Equals(otherRange.SubType)
+}
+
+// FormatValue implements the DoltgresType interface.
+func (r RangeType) FormatValue(val any) (string, error) {
+	if val == nil {
+		return "", nil
+	}
+	return r.IoOutput(sql.NewEmptyContext(), val)
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (r RangeType) GetSerializationID() SerializationID {
+	switch oid.Oid(r.Oid) {
+	case oid.T_int4range:
+		return SerializationID_Int32Range
+	case oid.T_int8range:
+		return SerializationID_Int64Range
+	case oid.T_numrange:
+		return SerializationID_NumericRange
+	case oid.T_tsrange:
+		return SerializationID_TimestampRange
+	case oid.T_tstzrange:
+		return SerializationID_TimestampTZRange
+	case oid.T_daterange:
+		return SerializationID_DateRange
+	default:
+		return SerializationID_Range
+	}
+}
+
+// IoInput implements the DoltgresType interface.
+func (r RangeType) IoInput(ctx *sql.Context, input string) (any, error) {
+	lower, upper, lowerInc, upperInc, empty, err := parseRangeLiteral(input)
+	if err != nil {
+		return nil, err
+	}
+	if empty {
+		return RangeValue{Empty: true}, nil
+	}
+	var lowerVal, upperVal any
+	if lower != nil {
+		if lowerVal, err = r.SubType.IoInput(ctx, *lower); err != nil {
+			return nil, err
+		}
+	}
+	if upper != nil {
+		if upperVal, err = r.SubType.IoInput(ctx, *upper); err != nil {
+			return nil, err
+		}
+	}
+	return r.MakeRange(lowerVal, upperVal, lowerInc, upperInc)
+}
+
+// IoOutput implements the DoltgresType interface.
+func (r RangeType) IoOutput(ctx *sql.Context, output any) (string, error) {
+	converted, _, err := r.Convert(output)
+	if err != nil {
+		return "", err
+	}
+	val := converted.(RangeValue)
+	if val.Empty {
+		return "empty", nil
+	}
+	sb := strings.Builder{}
+	if val.LowerInclusive {
+		sb.WriteRune('[')
+	} else {
+		sb.WriteRune('(')
+	}
+	if val.Lower != nil {
+		str, err := r.SubType.IoOutput(ctx, val.Lower)
+		if err != nil {
+			return "", err
+		}
+		writeRangeBound(&sb, str)
+	}
+	sb.WriteRune(',')
+	if val.Upper != nil {
+		str, err := r.SubType.IoOutput(ctx, val.Upper)
+		if err != nil {
+			return "", err
+		}
+		writeRangeBound(&sb, str)
+	}
+	if val.UpperInclusive {
+		sb.WriteRune(']')
+	} else {
+		sb.WriteRune(')')
+	}
+	return sb.String(), nil
+}
+
+// IoReceive implements the DoltgresType interface.
+func (r RangeType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if len(data) < 1 {
+		return nil, fmt.Errorf("insufficient data left in message")
+	}
+	flags := data[0]
+	data = data[1:]
+	if flags&rangeFlagEmpty != 0 {
+		return RangeValue{Empty: true}, nil
+	}
+	readBound := func() (any, error) {
+		if len(data) < 4 {
+			return nil, fmt.Errorf("insufficient data left in message")
+		}
+		length := int(int32(binary.BigEndian.Uint32(data)))
+		data = data[4:]
+		if length < 0 || length > len(data) {
+			return nil, fmt.Errorf("insufficient data left in message")
+		}
+		val, err := r.SubType.IoReceive(ctx, data[:length])
+		data = data[length:]
+		return val, err
+	}
+	var lower, upper any
+	var err error
+	if flags&rangeFlagLowerInf == 0 {
+		if lower, err = readBound(); err != nil {
+			return nil, err
+		}
+	}
+	if flags&rangeFlagUpperInf == 0 {
+		if upper, err = readBound(); err != nil {
+			return nil, err
+		}
+	}
+	return r.MakeRange(lower, upper, flags&rangeFlagLowerInc != 0, flags&rangeFlagUpperInc != 0)
+}
+
+// IoSend implements the DoltgresType interface.
+func (r RangeType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := r.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	rangeVal := converted.(RangeValue)
+	flags := rangeVal.flags()
+	output := []byte{flags}
+	if flags&rangeFlagEmpty != 0 {
+		return output, nil
+	}
+	for _, bound := range []any{rangeVal.Lower, rangeVal.Upper} {
+		if bound == nil {
+			continue
+		}
+		data, err := r.SubType.IoSend(ctx, bound)
+		if err != nil {
+			return nil, err
+		}
+		output = binary.BigEndian.AppendUint32(output, uint32(len(data)))
+		output = append(output, data...)
+	}
+	return output, nil
+}
+
+// IsPreferredType implements the DoltgresType interface.
+func (r RangeType) IsPreferredType() bool {
+	return false
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (r RangeType) IsUnbounded() bool {
+	return true
+}
+
+// MakeRange returns a new range from the given bounds, which must be values of the subtype. A nil bound is infinite.
+// Ranges over discrete subtypes are converted to their canonical form, which has an inclusive lower bound and an
+// exclusive upper bound.
+func (r RangeType) MakeRange(lower any, upper any, lowerInc bool, upperInc bool) (RangeValue, error) {
+	if lower == nil {
+		lowerInc = false
+	}
+	if upper == nil {
+		upperInc = false
+	}
+	if lower != nil && upper != nil {
+		res, err := r.SubType.Compare(lower, upper)
+		if err != nil {
+			return RangeValue{}, err
+		}
+		if res > 0 {
+			return RangeValue{}, ErrRangeLowerGreaterThanUpper.New()
+		}
+		if res == 0 && !(lowerInc && upperInc) {
+			return RangeValue{Empty: true}, nil
+		}
+	}
+	var err error
+	if lower != nil && !lowerInc {
+		var ok bool
+		if lower, ok, err = rangeNextDiscrete(r.SubType, lower); err != nil {
+			return RangeValue{}, err
+		} else if ok {
+			lowerInc = true
+		}
+	}
+	if upper != nil && upperInc {
+		var ok bool
+		if upper, ok, err = rangeNextDiscrete(r.SubType, upper); err != nil {
+			return RangeValue{}, err
+		} else if ok {
+			upperInc = false
+		}
+	}
+	// Canonicalization may have made the range empty
+	if lower != nil && upper != nil {
+		res, err := r.SubType.Compare(lower, upper)
+		if err != nil {
+			return RangeValue{}, err
+		}
+		if res > 0 || (res == 0 && !(lowerInc && upperInc)) {
+			return RangeValue{Empty: true}, nil
+		}
+	}
+	return RangeValue{
+		Lower:          lower,
+		Upper:          upper,
+		LowerInclusive: lowerInc,
+		UpperInclusive: upperInc,
+	}, nil
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (r RangeType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	// Values are stored inline so that they may be used as index keys, which are compared using SerializedCompare
+	return types.ExtendedTypeSerializedWidth_64K
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (r RangeType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return math.MaxUint32
+}
+
+// OID implements the DoltgresType interface.
+func (r RangeType) OID() uint32 {
+	return r.Oid
+}
+
+// Overlaps returns whether the two ranges have any points in common.
+func (r RangeType) Overlaps(v1 RangeValue, v2 RangeValue) (bool, error) {
+	if v1.Empty || v2.Empty {
+		return false, nil
+	}
+	res, err := r.compareBounds(v1.lowerBound(), v2.lowerBound())
+	if err != nil {
+		return false, err
+	}
+	if res >= 0 {
+		res, err = r.compareBounds(v1.lowerBound(), v2.upperBound())
+		return res <= 0, err
+	}
+	res, err = r.compareBounds(v2.lowerBound(), v1.upperBound())
+	return res <= 0, err
+}
+
+// Promote implements the DoltgresType interface.
+func (r RangeType) Promote() sql.Type {
+	return r
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (r RangeType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	if len(v1) == 0 && len(v2) == 0 {
+		return 0, nil
+	} else if len(v1) > 0 && len(v2) == 0 {
+		return 1, nil
+	} else if len(v1) == 0 && len(v2) > 0 {
+		return -1, nil
+	}
+	ac, err := r.DeserializeValue(v1)
+	if err != nil {
+		return 0, err
+	}
+	bc, err := r.DeserializeValue(v2)
+	if err != nil {
+		return 0, err
+	}
+	return r.Compare(ac, bc)
+}
+
+// SQL implements the DoltgresType interface.
+func (r RangeType) SQL(ctx *sql.Context, dest []byte, v any) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+	value, err := r.IoOutput(ctx, v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return sqltypes.MakeTrusted(sqltypes.Text, types.AppendAndSliceBytes(dest, []byte(value))), nil
+}
+
+// StrictlyLeft returns whether the first range is strictly to the left of the second range. Empty ranges are never to
+// the left or right of another range.
+func (r RangeType) StrictlyLeft(v1 RangeValue, v2 RangeValue) (bool, error) {
+	if v1.Empty || v2.Empty {
+		return false, nil
+	}
+	res, err := r.compareBounds(v1.upperBound(), v2.lowerBound())
+	return res < 0, err
+}
+
+// String implements the DoltgresType interface.
+func (r RangeType) String() string {
+	return r.Name
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (r RangeType) ToArrayType() DoltgresArrayType {
+	return createArrayType(r, SerializationID_RangeArray, oid.Oid(r.ArrayOid))
+}
+
+// Type implements the DoltgresType interface.
+func (r RangeType) Type() query.Type {
+	// Ranges are not reported as text or binary, so that GMS does not require an index prefix length, and index keys
+	// use the serialized form that is compared using SerializedCompare
+	return query.Type_TUPLE
+}
+
+// ValueType implements the DoltgresType interface.
+func (r RangeType) ValueType() reflect.Type {
+	return reflect.TypeOf(RangeValue{})
+}
+
+// Zero implements the DoltgresType interface.
+func (r RangeType) Zero() any {
+	return RangeValue{Empty: true}
+}
+
+// SerializeType implements the DoltgresType interface.
+func (r RangeType) SerializeType() ([]byte, error) {
+	b := r.GetSerializationID().ToByteSlice(0)
+	serializedSubType, err := r.SubType.SerializeType()
+	if err != nil {
+		return nil, err
+	}
+	writer := utils.NewWriter(256)
+	writer.Uint32(r.Oid)
+	writer.Uint32(r.ArrayOid)
+	writer.String(r.Schema)
+	writer.String(r.Name)
+	writer.ByteSlice(serializedSubType)
+	return append(b, writer.Data()...), nil
+}
+
+// deserializeType implements the DoltgresType interface.
+func (r RangeType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	switch version {
+	case 0:
+		reader := utils.NewReader(metadata)
+		r.Oid = reader.Uint32()
+		r.ArrayOid = reader.Uint32()
+		r.Schema = reader.String()
+		r.Name = reader.String()
+		subType, err := DeserializeType(reader.ByteSlice())
+		if err != nil {
+			return nil, err
+		}
+		r.SubType = subType.(DoltgresType)
+		return r, nil
+	default:
+		return nil, fmt.Errorf("version %d is not yet supported for %s", version, r.String())
+	}
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (r RangeType) SerializeValue(val any) ([]byte, error) {
+	if val == nil {
+		return nil, nil
+	}
+	converted, _, err := r.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	rangeVal := converted.(RangeValue)
+	writer := utils.NewWriter(32)
+	writer.Byte(rangeVal.flags())
+	for _, bound := range []any{rangeVal.Lower, rangeVal.Upper} {
+		if bound == nil {
+			continue
+		}
+		serializedBound, err := r.SubType.SerializeValue(bound)
+		if err != nil {
+			return nil, err
+		}
+		writer.ByteSlice(serializedBound)
+	}
+	return writer.Data(), nil
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (r RangeType) DeserializeValue(val []byte) (any, error) {
+	if len(val) == 0 {
+		return nil, nil
+	}
+	reader := utils.NewReader(val)
+	flags := reader.Byte()
+	if flags&rangeFlagEmpty != 0 {
+		return RangeValue{Empty: true}, nil
+	}
+	rangeVal := RangeValue{
+		LowerInclusive: flags&rangeFlagLowerInc != 0,
+		UpperInclusive: flags&rangeFlagUpperInc != 0,
+	}
+	var err error
+	if flags&rangeFlagLowerInf == 0 {
+		if rangeVal.Lower, err = r.SubType.DeserializeValue(reader.ByteSlice()); err != nil {
+			return nil, err
+		}
+	}
+	if flags&rangeFlagUpperInf == 0 {
+		if rangeVal.Upper, err = r.SubType.DeserializeValue(reader.ByteSlice()); err != nil {
+			return nil, err
+		}
+	}
+	return rangeVal, nil
+}
+
+// rangeBound is a single bound of a range, which is used to compare bounds of differing kinds.
+type rangeBound struct {
+	val       any
+	inclusive bool
+	lower     bool
+}
+
+// compareBounds compares the two bounds, taking into account whether each bound is infinite, inclusive, and whether
+// it's a lower or upper bound. This mirrors range_cmp_bounds in Postgres.
+func (r RangeType) compareBounds(b1 rangeBound, b2 rangeBound) (int, error) {
+	if b1.val == nil && b2.val == nil {
+		if b1.lower == b2.lower {
+			return 0, nil
+		}
+		return boundDirection(b1.lower), nil
+	} else if b1.val == nil {
+		return boundDirection(b1.lower), nil
+	} else if b2.val == nil {
+		return -boundDirection(b2.lower), nil
+	}
+	res, err := r.SubType.Compare(b1.val, b2.val)
+	if err != nil || res != 0 {
+		return res, err
+	}
+	if !b1.inclusive && !b2.inclusive {
+		if b1.lower == b2.lower {
+			return 0, nil
+		}
+		return -boundDirection(b1.lower), nil
+	} else if !b1.inclusive {
+		return -boundDirection(b1.lower), nil
+	} else if !b2.inclusive {
+		return boundDirection(b2.lower), nil
+	}
+	return 0, nil
+}
+
+// boundDirection returns -1 for lower bounds and 1 for upper bounds.
+func boundDirection(lower bool) int {
+	if lower {
+		return -1
+	}
+	return 1
+}
+
+// lowerBound returns the lower bound of the range.
+func (v RangeValue) lowerBound() rangeBound {
+	return rangeBound{val: v.Lower, inclusive: v.LowerInclusive, lower: true}
+}
+
+// upperBound returns the upper bound of the range.
+func (v RangeValue) upperBound() rangeBound {
+	return rangeBound{val: v.Upper, inclusive: v.UpperInclusive, lower: false}
+}
+
+// flags returns the flags that describe the range, as used by the binary format.
+func (v RangeValue) flags() byte {
+	if v.Empty {
+		return rangeFlagEmpty
+	}
+	var flags byte
+	if v.LowerInclusive {
+		flags |= rangeFlagLowerInc
+	}
+	if v.UpperInclusive {
+		flags |= rangeFlagUpperInc
+	}
+	if v.Lower == nil {
+		flags |= rangeFlagLowerInf
+	}
+	if v.Upper == nil {
+		flags |= rangeFlagUpperInf
+	}
+	return flags
+}
+
+// rangeAlignment returns the alignment of a range with the given subtype.
+func rangeAlignment(subType DoltgresType) TypeAlignment {
+	if subType != nil && subType.Alignment() == TypeAlignment_Double {
+		return TypeAlignment_Double
+	}
+	return TypeAlignment_Int
+}
+
+// rangeNextDiscrete returns the value that follows the given value for discrete subtypes, which is used to convert
+// ranges into their canonical form. Returns false if the subtype is not discrete.
+func rangeNextDiscrete(subType DoltgresType, val any) (any, bool, error) {
+	switch subType.BaseID() {
+	case DoltgresTypeBaseID_Int32:
+		v := val.(int32)
+		if v == math.MaxInt32 {
+			return nil, false, fmt.Errorf("integer out of range")
+		}
+		return v + 1, true, nil
+	case DoltgresTypeBaseID_Int64:
+		v := val.(int64)
+		if v == math.MaxInt64 {
+			return nil, false, fmt.Errorf("bigint out of range")
+		}
+		return v + 1, true, nil
+	case DoltgresTypeBaseID_Date:
+		return val.(time.Time).AddDate(0, 0, 1), true, nil
+	default:
+		return val, false, nil
+	}
+}
+
+// parseRangeLiteral parses the given input using Postgres' range syntax, returning the contents of each bound. A nil
+// bound represents an infinite bound.
+func parseRangeLiteral(input string) (lower *string, upper *string, lowerInc bool, upperInc bool, empty bool, err error) {
+	str := strings.TrimSpace(input)
+	if strings.EqualFold(str, "empty") {
+		return nil, nil, false, false, true, nil
+	}
+	if len(str) == 0 || (str[0] != '[' && str[0] != '(') {
+		return nil, nil, false, false, false, ErrMalformedRangeLiteral.New(input)
+	}
+	lowerInc = str[0] == '['
+	str = str[1:]
+	parseBound := func(terminators string) (*string, bool) {
+		// An empty bound (one with no characters at all, including quotes) represents an infinite bound
+		if len(str) > 0 && strings.IndexByte(terminators, str[0]) >= 0 {
+			return nil, true
+		}
+		sb := strings.Builder{}
+		inQuotes := false
+		for len(str) > 0 {
+			ch := str[0]
+			switch {
+			case ch == '\\':
+				if len(str) < 2 {
+					return nil, false
+				}
+				sb.WriteByte(str[1])
+				str = str[2:]
+				continue
+			case ch == '"' && inQuotes && len(str) > 1 && str[1] == '"':
+				sb.WriteByte('"')
+				str = str[2:]
+				continue
+			case ch == '"':
+				inQuotes = !inQuotes
+			case !inQuotes && strings.IndexByte(terminators, ch) >= 0:
+				bound := sb.String()
+				return &bound, true
+			default:
+				sb.WriteByte(ch)
+			}
+			str = str[1:]
+		}
+		return nil, false
+	}
+	var ok bool
+	if lower, ok = parseBound(","); !ok || len(str) == 0 {
+		return nil, nil, false, false, false, ErrMalformedRangeLiteral.New(input)
+	}
+	str = str[1:]
+	if upper, ok = parseBound("])"); !ok || len(str) == 0 {
+		return nil, nil, false, false, false, ErrMalformedRangeLiteral.New(input)
+	}
+	upperInc = str[0] == ']'
+	if len(strings.TrimSpace(str[1:])) > 0 {
+		return nil, nil, false, false, false, ErrMalformedRangeLiteral.New(input)
+	}
+	return lower, upper, lowerInc, upperInc, false, nil
+}
+
+// writeRangeBound writes the given bound output to the builder, quoting it if necessary.
+func writeRangeBound(sb *strings.Builder, str string) {
+	if !rangeBoundRequiresQuotes(str) {
+		sb.WriteString(str)
+		return
+	}
+	sb.WriteRune('"')
+	for _, r := range str {
+		if r == '"' || r == '\\' {
+			sb.WriteRune(r)
+		}
+		sb.WriteRune(r)
+	}
+	sb.WriteRune('"')
+}
+
+// rangeBoundRequiresQuotes returns whether the given bound output must be quoted within a range literal.
+func rangeBoundRequiresQuotes(str string) bool {
+	if len(str) == 0 {
+		return true
+	}
+	for _, r := range str {
+		switch r {
+		case '"', '\\', '(', ')', '[', ']', ',', ' ', '\t', '\n', '\r', '\v', '\f':
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+)
+
+// Record is a pseudo-type that can represent any composite type.
+var Record = RecordType{}
+
+// RecordType is the extended type implementation of the PostgreSQL record.
+type RecordType struct{}
+
+var _ DoltgresType = RecordType{}
+var _ DoltgresPolymorphicType = RecordType{}
+
+// Alignment implements the DoltgresType interface.
+func (r RecordType) Alignment() TypeAlignment {
+	return TypeAlignment_Double
+}
+
+// BaseID implements the DoltgresType interface.
+func (r RecordType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_Record
+}
+
+// BaseName implements the DoltgresType interface.
+func (r RecordType) BaseName() string {
+	return "record"
+}
+
+// Category implements the DoltgresType interface.
+func (r RecordType) Category() TypeCategory {
+	return TypeCategory_PseudoTypes
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (r RecordType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (r RecordType) Compare(v1 any, v2 any) (int, error) {
+	return 0, fmt.Errorf("%s cannot compare values", r.String())
+}
+
+// Convert implements the DoltgresType interface.
+func (r RecordType) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case []any:
+		return nil, sql.OutOfRange, fmt.Errorf("%s: unhandled type: %T", r.String(), val)
+	default:
+		return val, sql.InRange, nil
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (r RecordType) Equals(otherType sql.Type) bool {
+	_, ok := otherType.(RecordType)
+	return ok
+}
+
+// FormatValue implements the DoltgresType interface.
+func (r RecordType) FormatValue(val any) (string, error) {
+	return "", fmt.Errorf("%s cannot format values", r.String())
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (r RecordType) GetSerializationID() SerializationID {
+	return SerializationID_Invalid
+}
+
+// IoInput implements the DoltgresType interface.
+func (r RecordType) IoInput(ctx *sql.Context, input string) (any, error) {
+	return "", fmt.Errorf("%s cannot receive I/O input", r.String())
+}
+
+// IoOutput implements the DoltgresType interface.
+func (r RecordType) IoOutput(ctx *sql.Context, output any) (string, error) {
+	return "", fmt.Errorf("%s cannot produce I/O output", r.String())
+}
+
+// IoReceive implements the DoltgresType interface.
+func (r RecordType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return nil, fmt.Errorf("%s cannot receive binary input", r.String())
+}
+
+// IoSend implements the DoltgresType interface.
+func (r RecordType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot produce binary output", r.String())
+}
+
+// IsPreferredType implements the DoltgresType interface.
+func (r RecordType) IsPreferredType() bool {
+	return false
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (r RecordType) IsUnbounded() bool {
+	return true
+}
+
+// IsValid implements the DoltgresPolymorphicType interface.
+func (r RecordType) IsValid(target DoltgresType) bool {
+	_, ok := target.(CompositeType)
+	return ok
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (r RecordType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	return types.ExtendedTypeSerializedWidth_Unbounded
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (r RecordType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return math.MaxUint32
+}
+
+// OID implements the DoltgresType interface.
+func (r RecordType) OID() uint32 {
+	return uint32(oid.T_record)
+}
+
+// Promote implements the DoltgresType interface.
+func (r RecordType) Promote() sql.Type {
+	return r
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (r RecordType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	return 0, fmt.Errorf("%s cannot compare serialized values", r.String())
+}
+
+// SQL implements the DoltgresType interface.
+func (r RecordType) SQL(ctx *sql.Context, dest []byte, v any) (sqltypes.Value, error) {
+	return sqltypes.Value{}, fmt.Errorf("%s cannot output values in the wire format", r.String())
+}
+
+// String implements the DoltgresType interface.
+func (r RecordType) String() string {
+	return "record"
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (r RecordType) ToArrayType() DoltgresArrayType {
+	return Unknown
+}
+
+// Type implements the DoltgresType interface.
+func (r RecordType) Type() query.Type {
+	return sqltypes.Text
+}
+
+// ValueType implements the DoltgresType interface.
+func (r RecordType) ValueType() reflect.Type {
+	var val any
+	return reflect.TypeOf(val)
+}
+
+// Zero implements the DoltgresType interface.
+func (r RecordType) Zero() any {
+	var val any
+	return val
+}
+
+// SerializeType implements the DoltgresType interface.
+func (r RecordType) SerializeType() ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot be serialized", r.String())
+}
+
+// deserializeType implements the DoltgresType interface.
+func (r RecordType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	return nil, fmt.Errorf("%s cannot be deserialized", r.String())
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (r RecordType) SerializeValue(val any) ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot serialize values", r.String())
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (r RecordType) DeserializeValue(val []byte) (any, error) {
+	return nil, fmt.Errorf("%s cannot deserialize values", r.String())
+}
@@ -16,6 +16,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"reflect"
 
@@ -117,6 +118,23 @@ func (b RegclassType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return Regclass_IoOutput(ctx, converted.(uint32))
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b RegclassType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 4); err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.Uint32(data), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b RegclassType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.AppendUint32(nil, converted.(uint32)), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b RegclassType) IsPreferredType() bool {
 	return false
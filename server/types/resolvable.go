@@ -96,6 +96,16 @@ func (b ResolvableType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	panic("ResolvableType is a placeholder type, but IoOutput() was called")
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b ResolvableType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	panic("ResolvableType is a placeholder type, but IoReceive() was called")
+}
+
+// IoSend implements the DoltgresType interface.
+func (b ResolvableType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	panic("ResolvableType is a placeholder type, but IoSend() was called")
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b ResolvableType) IsPreferredType() bool {
 	panic("ResolvableType is a placeholder type, but IsPreferredType() was called")
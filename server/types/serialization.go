@@ -129,6 +129,10 @@ const (
 	SerializationID_InternalChar          SerializationID = 96
 	SerializationID_InternalCharArray     SerializationID = 97
 	SerializationId_Domain                SerializationID = 98
+	SerializationID_Composite             SerializationID = 99
+	SerializationID_CompositeArray        SerializationID = 100
+	SerializationID_Range                 SerializationID = 101
+	SerializationID_RangeArray            SerializationID = 102
 )
 
 // serializationIDToType is a map from each SerializationID to its matching DoltgresType.
@@ -148,6 +152,13 @@ func init() {
 		serializationIDToType[sID] = t
 	}
 	serializationIDToType[SerializationId_Domain] = DomainType{}
+	serializationIDToType[SerializationID_Enum] = EnumType{}
+	serializationIDToType[SerializationID_Composite] = CompositeType{}
+	for _, sID := range []SerializationID{SerializationID_Range, SerializationID_Int32Range, SerializationID_Int64Range,
+		SerializationID_NumericRange, SerializationID_TimestampRange, SerializationID_TimestampTZRange, SerializationID_DateRange} {
+		serializationIDToType[sID] = RangeType{}
+	}
+	serializationIDToType[SerializationID_RangeArray] = RangeType{}.ToArrayType()
 }
 
 // SerializeType is able to serialize the given extended type into a byte slice. All extended types will be defined
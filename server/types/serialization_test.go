@@ -127,6 +127,10 @@ func TestSerialization(t *testing.T) {
 		{SerializationID_InternalChar, 96, "InternalChar"},
 		{SerializationID_InternalCharArray, 97, "InternalCharArray"},
 		{SerializationId_Domain, 98, "Domain"},
+		{SerializationID_Composite, 99, "Composite"},
+		{SerializationID_CompositeArray, 100, "CompositeArray"},
+		{SerializationID_Range, 101, "Range"},
+		{SerializationID_RangeArray, 102, "RangeArray"},
 	}
 	allIds := make(map[uint16]string)
 	for _, id := range ids {
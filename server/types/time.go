@@ -16,6 +16,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"reflect"
 	"time"
@@ -145,6 +146,23 @@ func (b TimeType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return converted.(time.Time).Format("15:04:05.999999999"), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b TimeType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 8); err != nil {
+		return nil, err
+	}
+	return timeofday.FromInt(int64(binary.BigEndian.Uint64(data))).ToTime(), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b TimeType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.AppendUint64(nil, uint64(timeofday.FromTime(converted.(time.Time)))), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b TimeType) IsPreferredType() bool {
 	return false
@@ -16,6 +16,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"reflect"
 	"time"
@@ -144,6 +145,26 @@ func (b TimestampType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return converted.(time.Time).Format("2006-01-02 15:04:05.999999999"), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b TimestampType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 8); err != nil {
+		return nil, err
+	}
+	return postgresMicrosToTime(int64(binary.BigEndian.Uint64(data))), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b TimestampType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	// Timestamps without a time zone are sent using their wall clock time, regardless of their location
+	t := converted.(time.Time)
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	return binary.BigEndian.AppendUint64(nil, uint64(timeToPostgresMicros(t))), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b TimestampType) IsPreferredType() bool {
 	return false
@@ -16,6 +16,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"reflect"
 	"time"
@@ -158,6 +159,23 @@ func (b TimestampTZType) IoOutput(ctx *sql.Context, output any) (string, error)
 	}
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b TimestampTZType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 8); err != nil {
+		return nil, err
+	}
+	return postgresMicrosToTime(int64(binary.BigEndian.Uint64(data))), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b TimestampTZType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.AppendUint64(nil, uint64(timeToPostgresMicros(converted.(time.Time)))), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b TimestampTZType) IsPreferredType() bool {
 	return true
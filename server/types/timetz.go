@@ -16,11 +16,13 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"reflect"
 	"time"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/postgres/parser/timeofday"
 	"github.com/dolthub/doltgresql/postgres/parser/timetz"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -151,6 +153,27 @@ func (b TimeTZType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return timetz.MakeTimeTZFromTime(t).String(), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b TimeTZType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	// The binary format is the time of day in microseconds, followed by the zone offset in seconds west of UTC
+	if err := checkBinaryLength(b, data, 12); err != nil {
+		return nil, err
+	}
+	t := timetz.MakeTimeTZ(timeofday.FromInt(int64(binary.BigEndian.Uint64(data))), int32(binary.BigEndian.Uint32(data[8:])))
+	return t.ToTime(), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b TimeTZType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	t := timetz.MakeTimeTZFromTime(converted.(time.Time))
+	data := binary.BigEndian.AppendUint64(nil, uint64(t.TimeOfDay))
+	return binary.BigEndian.AppendUint32(data, uint32(t.OffsetSecs)), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b TimeTZType) IsPreferredType() bool {
 	return false
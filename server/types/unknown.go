@@ -108,6 +108,20 @@ func (u UnknownType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return output.(string), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (u UnknownType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return u.IoInput(ctx, string(data))
+}
+
+// IoSend implements the DoltgresType interface.
+func (u UnknownType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	str, err := u.IoOutput(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(str), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b UnknownType) IsPreferredType() bool {
 	return false
@@ -116,3 +116,30 @@ func GetServerLocation(ctx *sql.Context) (*time.Location, error) {
 	_, offsetSecsUnconverted := t.Zone()
 	return time.FixedZone(fmt.Sprintf("fixed offset:%d", offsetSecsUnconverted), -offsetSecsUnconverted), nil
 }
+
+// postgresEpoch is the epoch used by the binary representations of Postgres' date and time types.
+var postgresEpoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// microsecondsPerDay is the number of microseconds in a single day.
+const microsecondsPerDay = 24 * 60 * 60 * 1000000
+
+// timeToPostgresMicros returns the number of microseconds between the Postgres epoch and the given time.
+func timeToPostgresMicros(t time.Time) int64 {
+	return (t.Unix()-postgresEpoch.Unix())*1000000 + int64(t.Nanosecond()/1000)
+}
+
+// postgresMicrosToTime returns the UTC time that is the given number of microseconds after the Postgres epoch. The
+// microseconds are split into whole seconds and the remainder, as the full range of Postgres' timestamps does not fit
+// within an int64 of nanoseconds.
+func postgresMicrosToTime(micros int64) time.Time {
+	return time.Unix(postgresEpoch.Unix()+micros/1000000, (micros%1000000)*1000).UTC()
+}
+
+// checkBinaryLength returns an error if the given binary representation of a value does not have the length that is
+// expected by the given type.
+func checkBinaryLength(typ DoltgresType, data []byte, length int) error {
+	if len(data) != length {
+		return ErrInvalidBinaryRepresentation.New(typ.String())
+	}
+	return nil
+}
@@ -132,6 +132,23 @@ func (b UuidType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return converted.(uuid.UUID).String(), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b UuidType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, uuid.Size); err != nil {
+		return nil, err
+	}
+	return uuid.FromBytes(data)
+}
+
+// IoSend implements the DoltgresType interface.
+func (b UuidType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return converted.(uuid.UUID).GetBytes(), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b UuidType) IsPreferredType() bool {
 	return false
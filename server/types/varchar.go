@@ -181,6 +181,20 @@ func (b VarCharType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return str, nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b VarCharType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	return b.IoInput(ctx, string(data))
+}
+
+// IoSend implements the DoltgresType interface.
+func (b VarCharType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	str, err := b.IoOutput(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(str), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b VarCharType) IsPreferredType() bool {
 	return false
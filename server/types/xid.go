@@ -118,6 +118,23 @@ func (b XidType) IoOutput(ctx *sql.Context, output any) (string, error) {
 	return strconv.FormatUint(uint64(converted.(uint32)), 10), nil
 }
 
+// IoReceive implements the DoltgresType interface.
+func (b XidType) IoReceive(ctx *sql.Context, data []byte) (any, error) {
+	if err := checkBinaryLength(b, data, 4); err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.Uint32(data), nil
+}
+
+// IoSend implements the DoltgresType interface.
+func (b XidType) IoSend(ctx *sql.Context, val any) ([]byte, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.AppendUint32(nil, converted.(uint32)), nil
+}
+
 // IsPreferredType implements the DoltgresType interface.
 func (b XidType) IsPreferredType() bool {
 	return false
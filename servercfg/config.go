@@ -91,6 +91,13 @@ type PostgresReplicationConfig struct {
 	PostgresDatabase      *string `yaml:"postgres_database,omitempty" minver:"0.7.4"`
 	PostgresPort          *int    `yaml:"postgres_port,omitempty" minver:"0.7.4"`
 	SlotName              *string `yaml:"slot_name,omitempty" minver:"0.7.4"`
+	// DoltCommit creates a Dolt commit for every transaction that is replicated from the primary. The LSN and
+	// transaction ID of each replicated transaction are recorded in the commit message.
+	DoltCommit *bool `yaml:"dolt_commit,omitempty" minver:"TBD"`
+	// DoltCommitAuthor is the author of the replicated Dolt commits, in the "Name <email>" format.
+	DoltCommitAuthor *string `yaml:"dolt_commit_author,omitempty" minver:"TBD"`
+	// DoltCommitMessage is the message of the replicated Dolt commits.
+	DoltCommitMessage *string `yaml:"dolt_commit_message,omitempty" minver:"TBD"`
 }
 
 // BehaviorYAMLConfig contains server configuration regarding how the server should behave
@@ -123,6 +130,9 @@ type DoltgresListenerConfig struct {
 	TLSKey *string `yaml:"tls_key,omitempty" minver:"0.7.4"`
 	// TLSCert is a file system path to a TLS certificate chain in PEM format.
 	TLSCert *string `yaml:"tls_cert,omitempty" minver:"0.7.4"`
+	// TLSCA is a file system path to the certificate authorities in PEM format that are used to verify client
+	// certificates. Clients are only asked for a certificate when this is set.
+	TLSCA *string `yaml:"tls_ca,omitempty" minver:"TBD"`
 	// RequireSecureTransport can enable a mode where non-TLS connections are turned away.
 	RequireSecureTransport *bool `yaml:"require_secure_transport,omitempty" minver:"0.7.4"`
 	// AllowCleartextPasswords enables use of cleartext passwords.
@@ -147,6 +157,27 @@ type DoltgresRemotesapiConfig struct {
 	ReadOnly *bool `yaml:"read_only,omitempty" minver:"0.7.4"`
 }
 
+// DoltgresHostBasedAuthRule is a single host-based authentication rule, equivalent to a line in Postgres' pg_hba.conf.
+type DoltgresHostBasedAuthRule struct {
+	// Type is the connection type, which is one of "local", "host", "hostssl" or "hostnossl".
+	Type *string `yaml:"type,omitempty" minver:"TBD"`
+	// Databases are the database names that the rule matches, or the keywords "all", "sameuser" or "samerole".
+	Databases []string `yaml:"databases,omitempty" minver:"TBD"`
+	// Users are the role names that the rule matches, or the keyword "all". A name prefixed with "+" matches all
+	// members of that role.
+	Users []string `yaml:"users,omitempty" minver:"TBD"`
+	// Address is the client address in CIDR notation, an IP address combined with Netmask, or the keyword "all". This
+	// is not used by local rules.
+	Address *string `yaml:"address,omitempty" minver:"TBD"`
+	// Netmask is the netmask for an Address that does not use CIDR notation.
+	Netmask *string `yaml:"netmask,omitempty" minver:"TBD"`
+	// Method is the authentication method, which is one of "trust", "reject", "scram-sha-256", "md5", "password" or
+	// "cert".
+	Method *string `yaml:"method,omitempty" minver:"TBD"`
+	// Options are additional options for the authentication method.
+	Options map[string]string `yaml:"options,omitempty" minver:"TBD"`
+}
+
 type DoltgresUserSessionVars struct {
 	Name string            `yaml:"name"`
 	Vars map[string]string `yaml:"vars,omitempty"`
@@ -174,6 +205,11 @@ type DoltgresConfig struct {
 	GoldenMysqlConn *string                   `yaml:"golden_mysql_conn,omitempty" minver:"0.7.4"`
 
 	PostgresReplicationConfig *PostgresReplicationConfig `yaml:"postgres_replication,omitempty" minver:"0.7.4"`
+
+	// HostBasedAuth contains the rules that determine how each connection is authenticated, depending on the
+	// connection type, database, user and address. Rules are evaluated in order, and the first matching rule is used.
+	// When no rules are given, all connections are authenticated using SCRAM-SHA-256.
+	HostBasedAuth []DoltgresHostBasedAuthRule `yaml:"host_based_auth,omitempty" minver:"TBD"`
 }
 
 // Ptr is a helper function that returns a pointer to the value passed in. This is necessary to e.g. get a pointer to
@@ -319,6 +355,14 @@ func (cfg *DoltgresConfig) TLSCert() string {
 	return *cfg.ListenerConfig.TLSCert
 }
 
+func (cfg *DoltgresConfig) TLSCA() string {
+	if cfg.ListenerConfig == nil || cfg.ListenerConfig.TLSCA == nil {
+		return ""
+	}
+
+	return *cfg.ListenerConfig.TLSCA
+}
+
 func (cfg *DoltgresConfig) RequireSecureTransport() bool {
 	if cfg.ListenerConfig == nil || cfg.ListenerConfig.RequireSecureTransport == nil {
 		return false
@@ -21,8 +21,8 @@ func TestAlterDatabase(t *testing.T) {
 		Parses("ALTER DATABASE name"),
 		Parses("ALTER DATABASE name ALLOW_CONNECTIONS allowconn"),
 		Parses("ALTER DATABASE name WITH ALLOW_CONNECTIONS allowconn"),
-		Parses("ALTER DATABASE name CONNECTION LIMIT -1"),
-		Parses("ALTER DATABASE name WITH CONNECTION LIMIT -1"),
+		Converts("ALTER DATABASE name CONNECTION LIMIT -1"),
+		Converts("ALTER DATABASE name WITH CONNECTION LIMIT -1"),
 		Parses("ALTER DATABASE name IS_TEMPLATE istemplate"),
 		Parses("ALTER DATABASE name WITH IS_TEMPLATE istemplate"),
 		Parses("ALTER DATABASE name ALLOW_CONNECTIONS allowconn ALLOW_CONNECTIONS allowconn"),
@@ -33,8 +33,8 @@ func TestAlterDatabase(t *testing.T) {
 		Parses("ALTER DATABASE name WITH IS_TEMPLATE istemplate ALLOW_CONNECTIONS allowconn"),
 		Parses("ALTER DATABASE name ALLOW_CONNECTIONS allowconn CONNECTION LIMIT -1"),
 		Parses("ALTER DATABASE name WITH ALLOW_CONNECTIONS allowconn CONNECTION LIMIT -1"),
-		Parses("ALTER DATABASE name CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
-		Parses("ALTER DATABASE name WITH CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
+		Converts("ALTER DATABASE name CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
+		Converts("ALTER DATABASE name WITH CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
 		Parses("ALTER DATABASE name IS_TEMPLATE istemplate CONNECTION LIMIT -1"),
 		Parses("ALTER DATABASE name WITH IS_TEMPLATE istemplate CONNECTION LIMIT -1"),
 		Parses("ALTER DATABASE name ALLOW_CONNECTIONS allowconn IS_TEMPLATE istemplate"),
@@ -627,13 +627,13 @@ func TestAlterType(t *testing.T) {
 		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
 		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
 		Parses("ALTER TYPE name ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT , ALTER ATTRIBUTE attribute_name SET DATA TYPE data_type COLLATE en_US RESTRICT"),
-		Parses("ALTER TYPE name ADD VALUE '1'"),
-		Parses("ALTER TYPE name ADD VALUE IF NOT EXISTS '1'"),
-		Parses("ALTER TYPE name ADD VALUE '1' BEFORE '1'"),
-		Parses("ALTER TYPE name ADD VALUE IF NOT EXISTS '1' BEFORE '1'"),
-		Parses("ALTER TYPE name ADD VALUE '1' AFTER '1'"),
-		Parses("ALTER TYPE name ADD VALUE IF NOT EXISTS '1' AFTER '1'"),
-		Parses("ALTER TYPE name RENAME VALUE '1' TO '1'"),
+		Converts("ALTER TYPE name ADD VALUE '1'"),
+		Converts("ALTER TYPE name ADD VALUE IF NOT EXISTS '1'"),
+		Converts("ALTER TYPE name ADD VALUE '1' BEFORE '1'"),
+		Converts("ALTER TYPE name ADD VALUE IF NOT EXISTS '1' BEFORE '1'"),
+		Converts("ALTER TYPE name ADD VALUE '1' AFTER '1'"),
+		Converts("ALTER TYPE name ADD VALUE IF NOT EXISTS '1' AFTER '1'"),
+		Converts("ALTER TYPE name RENAME VALUE '1' TO '1'"),
 		Parses("ALTER TYPE name SET ( RECEIVE = receive_function )"),
 		Parses("ALTER TYPE name SET ( SEND = send_function )"),
 		Parses("ALTER TYPE name SET ( TYPMOD_IN = type_modifier_input_function )"),
@@ -18,2238 +18,2238 @@ import "testing"
 
 func TestCreatePolicy(t *testing.T) {
 	tests := []QueryParses{
-		Unimplemented("CREATE POLICY name ON table_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , role_name"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , PUBLIC"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_USER"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , SESSION_USER"),
-		Unimplemented("CREATE POLICY name ON table_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , role_name USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , PUBLIC USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
-		Unimplemented("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE"),
+		Converts("CREATE POLICY name ON table_name FOR ALL"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE"),
+		Converts("CREATE POLICY name ON table_name TO role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , role_name"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , role_name"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , role_name"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , role_name"),
+		Converts("CREATE POLICY name ON table_name TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , PUBLIC"),
+		Converts("CREATE POLICY name ON table_name TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE"),
+		Converts("CREATE POLICY name ON table_name TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_USER"),
+		Converts("CREATE POLICY name ON table_name TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , SESSION_USER"),
+		Converts("CREATE POLICY name ON table_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , role_name USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , PUBLIC USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , SESSION_USER USING ( using_expression )"),
+		Converts("CREATE POLICY name ON table_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , role_name WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , PUBLIC WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , SESSION_USER WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , role_name USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , PUBLIC USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_ROLE USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , CURRENT_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO role_name , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO PUBLIC , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_ROLE , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO CURRENT_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR ALL TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR ALL TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR ALL TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR SELECT TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR SELECT TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR SELECT TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR INSERT TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR INSERT TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR INSERT TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR UPDATE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR UPDATE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR UPDATE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name FOR DELETE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS PERMISSIVE FOR DELETE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
+		Converts("CREATE POLICY name ON table_name AS RESTRICTIVE FOR DELETE TO SESSION_USER , SESSION_USER USING ( using_expression ) WITH CHECK ( check_expression )"),
 	}
 	RunTests(t, tests)
 }
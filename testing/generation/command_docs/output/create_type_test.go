@@ -19,7 +19,7 @@ import "testing"
 func TestCreateType(t *testing.T) {
 	tests := []QueryParses{
 		Parses("CREATE TYPE name AS RANGE ( SUBTYPE = subtype , CANONICAL = canonical_function , SUBTYPE_DIFF = subtype_diff_function )"),
-		Parses("CREATE TYPE name AS RANGE ( SUBTYPE = subtype , COLLATION = en_US , SUBTYPE_DIFF = subtype_diff_function , MULTIRANGE_TYPE_NAME = multirange_type_name )"),
+		Converts("CREATE TYPE name AS RANGE ( SUBTYPE = subtype , COLLATION = en_US , SUBTYPE_DIFF = subtype_diff_function , MULTIRANGE_TYPE_NAME = multirange_type_name )"),
 		Parses("CREATE TYPE name ( INPUT = input_function , OUTPUT = output_function , RECEIVE = receive_function , TYPMOD_IN = type_modifier_input_function , TYPMOD_OUT = type_modifier_output_function )"),
 		Parses("CREATE TYPE name ( INPUT = input_function , OUTPUT = output_function , RECEIVE = receive_function , TYPMOD_IN = type_modifier_input_function , TYPMOD_OUT = type_modifier_output_function , ANALYZE = analyze_function )"),
 		Parses("CREATE TYPE name ( INPUT = input_function , OUTPUT = output_function , SEND = send_function , TYPMOD_IN = type_modifier_input_function , SUBSCRIPT = subscript_function )"),
@@ -18,12 +18,12 @@ import "testing"
 
 func TestDropPolicy(t *testing.T) {
 	tests := []QueryParses{
-		Unimplemented("DROP POLICY name ON table_name"),
-		Unimplemented("DROP POLICY IF EXISTS name ON table_name"),
-		Unimplemented("DROP POLICY name ON table_name CASCADE"),
-		Unimplemented("DROP POLICY IF EXISTS name ON table_name CASCADE"),
-		Unimplemented("DROP POLICY name ON table_name RESTRICT"),
-		Unimplemented("DROP POLICY IF EXISTS name ON table_name RESTRICT"),
+		Converts("DROP POLICY name ON table_name"),
+		Converts("DROP POLICY IF EXISTS name ON table_name"),
+		Parses("DROP POLICY name ON table_name CASCADE"),
+		Parses("DROP POLICY IF EXISTS name ON table_name CASCADE"),
+		Converts("DROP POLICY name ON table_name RESTRICT"),
+		Converts("DROP POLICY IF EXISTS name ON table_name RESTRICT"),
 	}
 	RunTests(t, tests)
 }
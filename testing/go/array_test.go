@@ -0,0 +1,240 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestArrays(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "multidimensional arrays",
+			SetUpScript: []string{
+				`CREATE TABLE t (id int primary key, m int[]);`,
+				`INSERT INTO t VALUES (1, '{{1,2,3},{4,5,6}}'), (2, ARRAY[[7,8],[9,NULL]]);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT ARRAY[[1,2],[3,4]]::text, ARRAY[ARRAY[1.5,2],ARRAY[3,4]]::text, ARRAY[[[1],[2]],[[3],[4]]]::text;`,
+					Expected: []sql.Row{{"{{1,2},{3,4}}", "{{1.5,2},{3,4}}", "{{{1},{2}},{{3},{4}}}"}},
+				},
+				{
+					Query:    `SELECT '{ {1, 2} , {3,4} }'::int[]::text, '{{},{}}'::int[]::text, '{{a,"b c"},{"\\", NULL}}'::text[]::text;`,
+					Expected: []sql.Row{{"{{1,2},{3,4}}", "{}", `{{a,"b c"},{"\\",NULL}}`}},
+				},
+				{
+					Query:    `SELECT ARRAY['', 'a', NULL]::text, ARRAY['', 'a', NULL], '{"",b}'::text[];`,
+					Expected: []sql.Row{{`{"",a,NULL}`, `{"",a,NULL}`, `{"",b}`}},
+				},
+				{
+					Query:    `SELECT m::text FROM t ORDER BY id;`,
+					Expected: []sql.Row{{"{{1,2,3},{4,5,6}}"}, {"{{7,8},{9,NULL}}"}},
+				},
+				{
+					Query:    `SELECT m::text[]::text, to_jsonb(m) FROM t WHERE id = 1;`,
+					Expected: []sql.Row{{"{{1,2,3},{4,5,6}}", "[[1, 2, 3], [4, 5, 6]]"}},
+				},
+				{
+					Query:    `SELECT unnest(m) FROM t WHERE id = 1;`,
+					Expected: []sql.Row{{1}, {2}, {3}, {4}, {5}, {6}},
+				},
+				{
+					Query:    `SELECT id, 5 = ANY(m), 3 = ANY(m) FROM t ORDER BY id;`,
+					Expected: []sql.Row{{1, "t", "t"}, {2, nil, nil}},
+				},
+				{
+					Query:       `SELECT '{{1,2},{3}}'::int[];`,
+					ExpectedErr: `malformed array literal: "{{1,2},{3}}"`,
+				},
+				{
+					Query:       `SELECT ARRAY[[1,2],[3]];`,
+					ExpectedErr: `multidimensional arrays must have array expressions with matching dimensions`,
+				},
+			},
+		},
+		{
+			Name: "array subscripts",
+			SetUpScript: []string{
+				`CREATE TABLE t (id int primary key, a int[], m int[]);`,
+				`INSERT INTO t VALUES (1, '{10,20,30,40}', '{{1,2,3},{4,5,6}}'), (2, NULL, NULL);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT a[1], a[4], a[5], a[0], a[NULL], m[2][3], m[1] FROM t ORDER BY id;`,
+					Expected: []sql.Row{{10, 40, nil, nil, nil, 6, nil}, {nil, nil, nil, nil, nil, nil, nil}},
+				},
+				{
+					Query:    `SELECT a[2:3]::text, a[:2]::text, a[3:]::text, a[3:2]::text, a[-5:1]::text FROM t WHERE id = 1;`,
+					Expected: []sql.Row{{"{20,30}", "{10,20}", "{30,40}", "{}", "{10}"}},
+				},
+				{
+					Query:    `SELECT m[1:2][2:3]::text, m[2][1:2]::text, m[:][1]::text, m[2:2]::text FROM t WHERE id = 1;`,
+					Expected: []sql.Row{{"{{2,3},{5,6}}", "{{1,2},{4,5}}", "{{1},{4}}", "{{4,5,6}}"}},
+				},
+				{
+					Query:    `SELECT (ARRAY[1,2,3])[2], ('{a,b,c}'::text[])[3], a[1.0], a['2'] FROM t WHERE id = 1;`,
+					Expected: []sql.Row{{2, "c", 10, 20}},
+				},
+				{
+					Query:    `SELECT id FROM t WHERE a[2] = 20;`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:       `SELECT id[1] FROM t;`,
+					ExpectedErr: `cannot subscript type integer because it does not support subscripting`,
+				},
+				{
+					Query:       `SELECT a['x'] FROM t;`,
+					ExpectedErr: `invalid input syntax for type integer`,
+				},
+			},
+		},
+		{
+			Name: "array dimension functions",
+			SetUpScript: []string{
+				`CREATE TABLE t (id int primary key, a int[], m int[]);`,
+				`INSERT INTO t VALUES (1, '{10,20,30,40}', '{{1,2,3},{4,5,6}}'), (2, NULL, NULL), (3, '{}', '{7}');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT array_length(a, 1), array_length(m, 2), array_length(m, 3), array_lower(m, 1), array_upper(m, 2), cardinality(m), cardinality(a) FROM t ORDER BY id;`,
+					Expected: []sql.Row{
+						{4, 3, nil, 1, 3, 6, 4},
+						{nil, nil, nil, nil, nil, nil, nil},
+						{nil, nil, nil, 1, nil, 1, 0},
+					},
+				},
+				{
+					Query:    `SELECT generate_subscripts(m, 2) FROM t WHERE id = 1;`,
+					Expected: []sql.Row{{1}, {2}, {3}},
+				},
+			},
+		},
+		{
+			Name: "array search and modification functions",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT array_position(ARRAY['a','b','c','b'], 'b'), array_position(ARRAY['a','b','c','b'], 'b', 3), array_position(ARRAY[1,NULL,3], NULL), array_position(ARRAY[1,2], 5);`,
+					Expected: []sql.Row{{2, 4, 2, nil}},
+				},
+				{
+					Query:    `SELECT array_positions(ARRAY[1,2,1], 1)::text, array_positions(ARRAY[1,2], 3)::text, array_positions(NULL::int[], 1);`,
+					Expected: []sql.Row{{"{1,3}", "{}", nil}},
+				},
+				{
+					Query:       `SELECT array_position(ARRAY[[1,2],[3,4]], 1);`,
+					ExpectedErr: `searching for elements in multidimensional arrays is not supported`,
+				},
+				{
+					Query:    `SELECT array_remove(ARRAY[1,2,3,2], 2)::text, array_remove(ARRAY[1,NULL,3], NULL)::text, array_remove(ARRAY['a','b'], 'a')::text;`,
+					Expected: []sql.Row{{"{1,3}", "{1,3}", "{b}"}},
+				},
+				{
+					Query:    `SELECT array_replace(ARRAY[1,2,5,4], 5, 3)::text, array_replace(ARRAY[[1,2],[2,1]], 2, 9)::text, array_replace(ARRAY[1,NULL], NULL, 0)::text;`,
+					Expected: []sql.Row{{"{1,2,3,4}", "{{1,9},{9,1}}", "{1,0}"}},
+				},
+				{
+					Query:    `SELECT array_cat(ARRAY[1,2], ARRAY[3,4])::text, array_cat(ARRAY[[1,2]], ARRAY[3,4])::text, array_cat(ARRAY[1,2], NULL)::text, array_cat('{}'::int[], ARRAY[5])::text;`,
+					Expected: []sql.Row{{"{1,2,3,4}", "{{1,2},{3,4}}", "{1,2}", "{5}"}},
+				},
+				{
+					Query:       `SELECT array_cat(ARRAY[[1,2],[3,4]], ARRAY[[1,2,3]]);`,
+					ExpectedErr: `cannot concatenate incompatible arrays`,
+				},
+				{
+					Query:    `SELECT array_prepend(1, ARRAY[2,3])::text, array_append(ARRAY[1,2], 3)::text, array_append(NULL::int[], 1)::text, array_append(ARRAY[1], '2')::text;`,
+					Expected: []sql.Row{{"{1,2,3}", "{1,2,3}", "{1}", "{1,2}"}},
+				},
+				{
+					Query:    `SELECT string_to_array('xx~~yy~~zz', '~~', 'yy')::text, string_to_array('abc', NULL)::text, string_to_array('abc', '')::text, string_to_array('', ',')::text, string_to_array(NULL, ',');`,
+					Expected: []sql.Row{{"{xx,NULL,zz}", "{a,b,c}", "{abc}", "{}", nil}},
+				},
+				{
+					Query:    `SELECT string_to_array('a,,b', ',')::text, cardinality(string_to_array('a,,b', ',')), array_remove(ARRAY['', 'a'], 'a')::text;`,
+					Expected: []sql.Row{{`{a,"",b}`, 3, `{""}`}},
+				},
+			},
+		},
+		{
+			Name: "array operators",
+			SetUpScript: []string{
+				`CREATE TABLE t (id int primary key, a int[]);`,
+				`INSERT INTO t VALUES (1, '{1,2,3}'), (2, '{3,4}'), (3, NULL);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT ARRAY[1,4,3] @> ARRAY[3,1,3], ARRAY[2,2,7] <@ ARRAY[1,7,4,2,6], ARRAY[1,4,3] && ARRAY[2,1], ARRAY[1,2] && ARRAY[3];`,
+					Expected: []sql.Row{{"t", "t", "t", "f"}},
+				},
+				{
+					Query:    `SELECT ARRAY[1,NULL] @> ARRAY[NULL]::int[], ARRAY[[1,2],[3,4]] @> ARRAY[4], ARRAY[1] @> '{}'::int[], ARRAY['a','b'] && '{b,c}';`,
+					Expected: []sql.Row{{"f", "t", "t", "t"}},
+				},
+				{
+					Query:    `SELECT id FROM t WHERE a @> ARRAY[3] ORDER BY id;`,
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    `SELECT id FROM t WHERE a && '{4,5}' ORDER BY id;`,
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    `SELECT (ARRAY[1,2,3] || ARRAY[4,5])::text, (ARRAY[1,2,3] || ARRAY[[4,5,6],[7,8,9]])::text, (3 || ARRAY[4,5])::text, (ARRAY[4,5] || 6)::text;`,
+					Expected: []sql.Row{{"{1,2,3,4,5}", "{{1,2,3},{4,5,6},{7,8,9}}", "{3,4,5}", "{4,5,6}"}},
+				},
+				{
+					Query:    `SELECT ('a'::text || ARRAY['b'])::text, (ARRAY['a'] || 'b'::text)::text, 'a' || 'b', 'a'::text || 1;`,
+					Expected: []sql.Row{{"{a,b}", "{a,b}", "ab", "a1"}},
+				},
+			},
+		},
+		{
+			Name: "array_agg",
+			SetUpScript: []string{
+				`CREATE TABLE t (id int primary key, a int[], s text);`,
+				`INSERT INTO t VALUES (1, '{1,2}', 'x'), (2, NULL, 'y'), (3, '{3,4}', 'x');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT array_agg(id)::text, array_agg(id + 1)::text, cardinality(array_agg(s)) FROM t;`,
+					Expected: []sql.Row{{"{1,2,3}", "{2,3,4}", 3}},
+				},
+				{
+					Query:    `SELECT s, array_agg(id)::text FROM t GROUP BY s ORDER BY s;`,
+					Expected: []sql.Row{{"x", "{1,3}"}, {"y", "{2}"}},
+				},
+				{
+					Query:    `SELECT array_agg(a)::text FROM t WHERE a IS NOT NULL;`,
+					Expected: []sql.Row{{"{{1,2},{3,4}}"}},
+				},
+				{
+					Query:    `SELECT (array_agg(id))[2], array_length(array_agg(id), 1) FROM t WHERE id > 1;`,
+					Expected: []sql.Row{{3, 2}},
+				},
+				{
+					Query:    `SELECT array_agg(id) FROM t WHERE id > 10;`,
+					Expected: []sql.Row{{nil}},
+				},
+				{
+					Query:       `SELECT array_agg(a) FROM t;`,
+					ExpectedErr: `cannot accumulate null arrays`,
+				},
+			},
+		},
+	})
+}
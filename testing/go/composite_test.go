@@ -0,0 +1,279 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestCompositeTypes(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "create composite type",
+			SetUpScript: []string{
+				`CREATE TYPE inventory_item AS (name text, supplier_id integer, price numeric);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `CREATE TYPE inventory_item AS (a int);`,
+					ExpectedErr: `type "inventory_item" already exists`,
+				},
+				{
+					Query:       `CREATE TYPE dup AS (a int, a text);`,
+					ExpectedErr: `column "a" specified more than once`,
+				},
+				{
+					Query:       `CREATE TYPE missing AS (a no_such_type);`,
+					ExpectedErr: `type "no_such_type" does not exist`,
+				},
+				{
+					Query:    `SELECT '("fuzzy dice",42,1.99)'::inventory_item;`,
+					Expected: []sql.Row{{`("fuzzy dice",42,1.99)`}},
+				},
+				{
+					Query:    `SELECT ROW('fuzzy dice', 42, 1.99)::inventory_item;`,
+					Expected: []sql.Row{{`("fuzzy dice",42,1.99)`}},
+				},
+				{
+					Query:    `SELECT ('dice', 7, 2.5)::inventory_item;`,
+					Expected: []sql.Row{{`(dice,7,2.5)`}},
+				},
+				{
+					Query:    `SELECT '(,,)'::inventory_item, '("",,)'::inventory_item;`,
+					Expected: []sql.Row{{`(,,)`, `("",,)`}},
+				},
+				{
+					Query:    `SELECT '("a \"quoted\" (name)",1,2)'::inventory_item;`,
+					Expected: []sql.Row{{`("a ""quoted"" (name)",1,2)`}},
+				},
+				{
+					Query:       `SELECT '(a,1)'::inventory_item;`,
+					ExpectedErr: `malformed record literal`,
+				},
+				{
+					Query:       `SELECT 'a,1,2'::inventory_item;`,
+					ExpectedErr: `malformed record literal`,
+				},
+				{
+					Query:       `SELECT '(a,b,2)'::inventory_item;`,
+					ExpectedErr: `invalid input syntax for type integer`,
+				},
+				{
+					Query:    `SELECT ROW(1, 'a')::text;`,
+					Expected: []sql.Row{{`(1,a)`}},
+				},
+				{
+					Query:    `SELECT ROW('fuzzy dice', 42, 1.99)::inventory_item::text;`,
+					Expected: []sql.Row{{`("fuzzy dice",42,1.99)`}},
+				},
+			},
+		},
+		{
+			Name: "composite columns",
+			SetUpScript: []string{
+				`CREATE TYPE inventory_item AS (name text, supplier_id integer, price numeric);`,
+				`CREATE TABLE on_hand (id int primary key, item inventory_item, count integer);`,
+				`INSERT INTO on_hand VALUES (1, ROW('fuzzy dice', 42, 1.99), 1000);`,
+				`INSERT INTO on_hand VALUES (2, '(widget,7,0.50)', 25), (3, NULL, 0);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT * FROM on_hand ORDER BY id;`,
+					Expected: []sql.Row{
+						{1, `("fuzzy dice",42,1.99)`, 1000},
+						{2, `(widget,7,0.50)`, 25},
+						{3, nil, 0},
+					},
+				},
+				{
+					Query: `SELECT (item).name, (item).price * count FROM on_hand ORDER BY id;`,
+					Expected: []sql.Row{
+						{"fuzzy dice", Numeric("1990.00")},
+						{"widget", Numeric("12.50")},
+						{nil, nil},
+					},
+				},
+				{
+					Query: `SELECT (on_hand.item).supplier_id FROM on_hand WHERE (item).price > 1 ORDER BY id;`,
+					Expected: []sql.Row{
+						{42},
+					},
+				},
+				{
+					Query: `SELECT id, (item).* FROM on_hand ORDER BY id;`,
+					Expected: []sql.Row{
+						{1, "fuzzy dice", 42, Numeric("1.99")},
+						{2, "widget", 7, Numeric("0.50")},
+						{3, nil, nil, nil},
+					},
+				},
+				{
+					Query:       `SELECT (item).weight FROM on_hand;`,
+					ExpectedErr: `column "weight" not found in data type inventory_item`,
+				},
+				{
+					Query:       `SELECT (count).name FROM on_hand;`,
+					ExpectedErr: `which is not a composite type`,
+				},
+				{
+					Query:    `UPDATE on_hand SET item = ROW('widget', 8, 0.75) WHERE id = 2;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT item FROM on_hand WHERE id = 2;`,
+					Expected: []sql.Row{{`(widget,8,0.75)`}},
+				},
+				{
+					Query:    `SELECT id FROM on_hand WHERE item IS NOT NULL ORDER BY item DESC;`,
+					Expected: []sql.Row{{2}, {1}},
+				},
+				{
+					Query:       `DROP TYPE inventory_item;`,
+					ExpectedErr: `cannot drop type inventory_item because other objects depend on it`,
+				},
+				{
+					Query:    `DROP TABLE on_hand;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `DROP TYPE inventory_item;`,
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "nested composite types",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');`,
+				`CREATE TYPE complex AS (r float8, i float8);`,
+				`CREATE TYPE reading AS (label varchar(10), value complex, feeling mood);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT '(first,"(1.5,2)",happy)'::reading;`,
+					Expected: []sql.Row{{`(first,"(1.5,2)",happy)`}},
+				},
+				{
+					Query:    `SELECT (('(first,"(1.5,2)",happy)'::reading).value).r;`,
+					Expected: []sql.Row{{1.5}},
+				},
+				{
+					Query:       `SELECT '(first,"(1.5,2)",angry)'::reading;`,
+					ExpectedErr: `invalid input value for enum mood: "angry"`,
+				},
+				{
+					Query:       `DROP TYPE complex;`,
+					ExpectedErr: `cannot drop type complex because other objects depend on it`,
+				},
+				{
+					Query:       `DROP TYPE mood;`,
+					ExpectedErr: `cannot drop type mood because other objects depend on it`,
+				},
+			},
+		},
+		{
+			Name: "row comparisons",
+			SetUpScript: []string{
+				`CREATE TYPE pair AS (a int, b text);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT ROW(1, 'a') = ROW(1, 'a'), ROW(1, 'a') <> ROW(1, 'b'), ROW(1, 'b') < ROW(2, 'a'), ROW(2, 'a') >= ROW(1, 'z');`,
+					Expected: []sql.Row{{"t", "t", "t", "t"}},
+				},
+				{
+					Query:    `SELECT ROW(1, NULL) = ROW(1, NULL), ROW(1, NULL) = ROW(2, NULL), ROW(1, NULL) < ROW(2, NULL);`,
+					Expected: []sql.Row{{nil, "f", "t"}},
+				},
+				{
+					Query:    `SELECT '(1,a)'::pair = ROW(1, 'a')::pair, '(1,a)'::pair > '(1,b)'::pair;`,
+					Expected: []sql.Row{{"t", "f"}},
+				},
+			},
+		},
+		{
+			Name: "indexed composite columns",
+			SetUpScript: []string{
+				`CREATE TYPE pair AS (a int, b text);`,
+				`CREATE TABLE c1 (id int primary key, p pair);`,
+				`CREATE INDEX c1i ON c1 (p);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `INSERT INTO c1 VALUES (1, ROW(2, 'x')), (2, ROW(1, 'y')), (3, ROW(1, 'a')), (4, NULL);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT id FROM c1 WHERE p = ROW(1, 'y')::pair;`,
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    `SELECT id FROM c1 WHERE p < '(2,a)' ORDER BY p;`,
+					Expected: []sql.Row{{3}, {2}},
+				},
+				{
+					Query:    `SELECT id, p FROM c1 WHERE p IS NOT NULL ORDER BY p;`,
+					Expected: []sql.Row{{3, "(1,a)"}, {2, "(1,y)"}, {1, "(2,x)"}},
+				},
+				{
+					Query:    `UPDATE c1 SET p = ROW(0, 'z') WHERE id = 1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT id FROM c1 WHERE p <= '(1,a)' ORDER BY p;`,
+					Expected: []sql.Row{{1}, {3}},
+				},
+				{
+					Query:    `CREATE TABLE c2 (p pair primary key);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `INSERT INTO c2 VALUES ('(1,b)'), ('(1,a)');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `INSERT INTO c2 VALUES ('(1,a)');`,
+					ExpectedErr: `duplicate`,
+				},
+				{
+					Query:    `SELECT * FROM c2;`,
+					Expected: []sql.Row{{"(1,a)"}, {"(1,b)"}},
+				},
+			},
+		},
+		{
+			Name: "composite catalog tables",
+			SetUpScript: []string{
+				`CREATE TYPE inventory_item AS (name text, supplier_id integer);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT typname, typtype, typcategory, typlen, typinput FROM pg_catalog.pg_type WHERE typname = 'inventory_item';`,
+					Expected: []sql.Row{{"inventory_item", "c", "C", -1, "record_in"}},
+				},
+				{
+					Query:    `SELECT c.relname, c.relkind, c.relnatts FROM pg_catalog.pg_class c JOIN pg_catalog.pg_type t ON c.oid = t.typrelid WHERE t.typname = 'inventory_item';`,
+					Expected: []sql.Row{{"inventory_item", "c", 2}},
+				},
+				{
+					Query:    `SELECT a.attname, a.attnum FROM pg_catalog.pg_attribute a JOIN pg_catalog.pg_class c ON a.attrelid = c.oid WHERE c.relname = 'inventory_item' ORDER BY a.attnum;`,
+					Expected: []sql.Row{{"name", 1}, {"supplier_id", 2}},
+				},
+			},
+		},
+	})
+}
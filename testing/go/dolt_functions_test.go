@@ -261,7 +261,7 @@ func TestDoltFunctions(t *testing.T) {
 				},
 				{
 					Query:    "SELECT DOLT_CHECKOUT('t1');",
-					Expected: []sql.Row{{"{0}"}},
+					Expected: []sql.Row{{`{0,""}`}},
 				},
 				{
 					Query:    "SELECT * FROM dolt.status;",
@@ -565,14 +565,26 @@ func TestUserSpaceDoltTables(t *testing.T) {
 					Query:    `SELECT dolt_conflicts.table FROM dolt_conflicts`,
 					Expected: []sql.Row{{"test"}},
 				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM public.conflicts`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM conflicts`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:    `CREATE TABLE conflicts (id INT PRIMARY KEY)`,
 					Expected: []sql.Row{},
@@ -653,14 +665,30 @@ func TestUserSpaceDoltTables(t *testing.T) {
 					Query:    `SELECT dolt_conflicts_test.their_col1 FROM public.dolt_conflicts_test`,
 					Expected: []sql.Row{{"a"}},
 				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM other.dolt_conflicts_test`,
 					ExpectedErr: "database schema not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM public.dolt_conflicts_none`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:    `DELETE FROM public.dolt_conflicts_test`,
 					Expected: []sql.Row{},
@@ -697,22 +725,46 @@ func TestUserSpaceDoltTables(t *testing.T) {
 					Query:    `SELECT * FROM dolt_conflicts_test_sch`,
 					Expected: []sql.Row{},
 				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM dolt_conflicts_test`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:    `SELECT * FROM public.dolt_conflicts_test`,
 					Expected: []sql.Row{},
 				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT id FROM public.dolt_conflicts_test_sch`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM newschema.dolt_conflicts_test`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					// Same name as table in public schema
 					Query:    `CREATE TABLE test (id INT PRIMARY KEY)`,
@@ -774,14 +826,30 @@ func TestUserSpaceDoltTables(t *testing.T) {
 					Query:    `SELECT dolt_constraint_violations.table FROM dolt_constraint_violations`,
 					Expected: []sql.Row{{"test"}},
 				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM public.constraint_violations`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM constraint_violations`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:    `CREATE TABLE constraint_violations (id INT PRIMARY KEY)`,
 					Expected: []sql.Row{},
@@ -866,14 +934,30 @@ func TestUserSpaceDoltTables(t *testing.T) {
 					Query:    `SELECT dolt_constraint_violations_test.violation_type FROM public.dolt_constraint_violations_test`,
 					Expected: []sql.Row{{"unique index"}, {"unique index"}},
 				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM other.dolt_constraint_violations_test`,
 					ExpectedErr: "database schema not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM public.dolt_constraint_violations_none`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:    `DELETE FROM public.dolt_constraint_violations_test`,
 					Expected: []sql.Row{},
@@ -910,22 +994,46 @@ func TestUserSpaceDoltTables(t *testing.T) {
 					Query:    `SELECT * FROM dolt_constraint_violations_test_sch`,
 					Expected: []sql.Row{},
 				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM dolt_constraint_violations_test`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:    `SELECT * FROM public.dolt_constraint_violations_test`,
 					Expected: []sql.Row{},
 				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT id FROM public.dolt_constraint_violations_test_sch`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM newschema.dolt_constraint_violations_test`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					// Same name as table in public schema
 					Query:    `CREATE TABLE test (id INT PRIMARY KEY)`,
@@ -1839,14 +1947,30 @@ func TestUserSpaceDoltTables(t *testing.T) {
 					Query:    `SELECT dolt_schema_conflicts.table_name FROM dolt_schema_conflicts`,
 					Expected: []sql.Row{{"test"}},
 				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM public.schema_conflicts`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:       `SELECT * FROM schema_conflicts`,
 					ExpectedErr: "table not found",
 				},
+				{
+					Query:    `ROLLBACK TO SAVEPOINT sp1`,
+					Expected: []sql.Row{},
+				},
 				{
 					Query:    `CREATE TABLE schema_conflicts (id INT PRIMARY KEY)`,
 					Expected: []sql.Row{},
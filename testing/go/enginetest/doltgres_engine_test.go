@@ -326,17 +326,18 @@ func TestInsertInto(t *testing.T) {
 		"sql_mode=NO_auto_value_ON_ZERO",                                                                                                          // unsupported
 		"explicit DEFAULT",                                                                                                                        // enum type unsupported
 		// "Try INSERT IGNORE with primary key, non null, and single row violations", // insert ignore not supported
-		"Insert on duplicate key references table in subquery",           // bad translation?
-		"Insert on duplicate key references table in aliased subquery",   // bad translation?
-		"Insert on duplicate key references table in cte",                // CTE not supported
-		"insert on duplicate key with incorrect row alias",               // column "c" could not be found in any table in scope
-		"insert on duplicate key update errors",                          // failing
-		"Insert on duplicate key references table in subquery with join", // untranslated
-		"INSERT INTO ... SELECT works properly with ENUM",                // enum unsupported
-		"INSERT INTO ... SELECT works properly with SET",                 // set unsupported
-		"INSERT INTO ... SELECT with TEXT types",                         // typecasts needed
-		"check IN TUPLE constraint with duplicate key update",            // error not being thrown
-		"INSERT IGNORE works with FK Violations",                         // ignore not supported
+		"Insert on duplicate key references table in subquery",               // bad translation?
+		"Insert on duplicate key references table in aliased subquery",       // bad translation?
+		"Insert on duplicate key references table in cte",                    // CTE not supported
+		"insert on duplicate key with incorrect row alias",                   // column "c" could not be found in any table in scope
+		"insert on duplicate key update errors",                              // failing
+		"Insert on duplicate key references table in subquery with join",     // untranslated
+		"INSERT INTO ... SELECT works properly with ENUM",                    // enum unsupported
+		"INSERT INTO ... SELECT works properly with SET",                     // set unsupported
+		"INSERT INTO ... SELECT with TEXT types",                             // typecasts needed
+		"check IN TUPLE constraint with duplicate key update",                // error not being thrown
+		"INSERT IGNORE works with FK Violations",                             // ignore not supported
+		"insert duplicate key doesn't prevent other updates, autocommit off", // errors abort the transaction block in Postgres
 	})
 	defer h.Close()
 	enginetest.TestInsertInto(t, h)
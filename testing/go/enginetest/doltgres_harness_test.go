@@ -481,11 +481,9 @@ func convertExpectedResultsForDoltProcedures(t *testing.T, q string, widenedExpe
 				switch v := val.(type) {
 				case string:
 					// Quoting here is wrong in several ways, but we need to match the current output
-					if len(v) > 0 {
-						sb.WriteString("\"")
-						sb.WriteString(v)
-						sb.WriteString("\"")
-					}
+					sb.WriteString("\"")
+					sb.WriteString(v)
+					sb.WriteString("\"")
 				case int64, uint64:
 					sb.WriteString(fmt.Sprintf("%d", v))
 				case float64:
@@ -636,7 +634,8 @@ func (d *DoltgresQueryEngine) Query(ctx *sql.Context, query string) (sql.Schema,
 	)
 
 	for _, query := range queries {
-		rows, err := db.Query(context.Background(), query)
+		// The results are scanned into database/sql types, which expect the text format
+		rows, err := db.Query(context.Background(), query, pgx.QueryResultFormats{pgx.TextFormatCode})
 		if err != nil {
 			return nil, nil, nil, err
 		}
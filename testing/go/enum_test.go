@@ -0,0 +1,347 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestEnum(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "create enum type",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `CREATE TYPE mood AS ENUM ('a');`,
+					ExpectedErr: `type "mood" already exists`,
+				},
+				{
+					Query:       `CREATE TYPE dup AS ENUM ('a', 'b', 'a');`,
+					ExpectedErr: `enum label "a" used more than once`,
+				},
+				{
+					Query:    `CREATE TYPE empty_enum AS ENUM ();`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT 'happy'::mood;`,
+					Expected: []sql.Row{{"happy"}},
+				},
+				{
+					Query:       `SELECT 'angry'::mood;`,
+					ExpectedErr: `invalid input value for enum mood: "angry"`,
+				},
+				{
+					Query:    `SELECT 'ok'::mood < 'happy'::mood, 'ok'::mood > 'sad'::mood, 'ok'::mood = 'ok'::mood;`,
+					Expected: []sql.Row{{"t", "t", "t"}},
+				},
+				{
+					Query:    `SELECT 'sad'::mood >= 'happy'::mood, 'sad'::mood <> 'sad'::mood, 'sad'::mood <= 'happy'::mood;`,
+					Expected: []sql.Row{{"f", "f", "t"}},
+				},
+				{
+					Query:    `SELECT 'happy'::mood::text;`,
+					Expected: []sql.Row{{"happy"}},
+				},
+			},
+		},
+		{
+			Name: "enum columns",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');`,
+				`CREATE TABLE person (name varchar(20) primary key, current_mood mood);`,
+				`INSERT INTO person VALUES ('Moe', 'happy'), ('Larry', 'sad'), ('Curly', 'ok');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT * FROM person ORDER BY current_mood, name;`,
+					Expected: []sql.Row{
+						{"Larry", "sad"},
+						{"Curly", "ok"},
+						{"Moe", "happy"},
+					},
+				},
+				{
+					Query:    `SELECT name FROM person WHERE current_mood > 'sad' ORDER BY name;`,
+					Expected: []sql.Row{{"Curly"}, {"Moe"}},
+				},
+				{
+					Query:    `SELECT name FROM person WHERE current_mood = (SELECT MIN(current_mood) FROM person);`,
+					Expected: []sql.Row{{"Larry"}},
+				},
+				{
+					Query:       `INSERT INTO person VALUES ('Joe', 'angry');`,
+					ExpectedErr: `invalid input value for enum mood: "angry"`,
+				},
+				{
+					Query:    `CREATE INDEX mood_idx ON person (current_mood);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT name FROM person WHERE current_mood >= 'ok' ORDER BY current_mood;`,
+					Expected: []sql.Row{{"Curly"}, {"Moe"}},
+				},
+				{
+					Query:    `SELECT current_mood FROM person ORDER BY current_mood;`,
+					Expected: []sql.Row{{"sad"}, {"ok"}, {"happy"}},
+				},
+				{
+					Query:    `SELECT current_mood FROM person ORDER BY current_mood DESC;`,
+					Expected: []sql.Row{{"happy"}, {"ok"}, {"sad"}},
+				},
+				{
+					Query:    `SELECT name FROM person WHERE current_mood < 'happy' ORDER BY current_mood;`,
+					Expected: []sql.Row{{"Larry"}, {"Curly"}},
+				},
+				{
+					Query:    `SELECT name FROM person WHERE current_mood > 'sad' AND current_mood <= 'ok';`,
+					Expected: []sql.Row{{"Curly"}},
+				},
+				{
+					Query:    `SELECT name FROM person WHERE current_mood = 'happy';`,
+					Expected: []sql.Row{{"Moe"}},
+				},
+				{
+					Query:       `DROP TYPE mood;`,
+					ExpectedErr: `cannot drop type mood because other objects depend on it`,
+				},
+				{
+					Query:    `DROP TABLE person;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `DROP TYPE mood;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `SELECT 'ok'::mood;`,
+					ExpectedErr: `type "mood" does not exist`,
+				},
+				{
+					Query:    `DROP TYPE IF EXISTS mood;`,
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "alter type add value",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'happy');`,
+				`CREATE TABLE person (id int primary key, current_mood mood);`,
+				`INSERT INTO person VALUES (1, 'happy'), (2, 'sad');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `ALTER TYPE mood ADD VALUE 'ecstatic';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `ALTER TYPE mood ADD VALUE 'ok' BEFORE 'happy';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `ALTER TYPE mood ADD VALUE 'miserable' BEFORE 'sad';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `ALTER TYPE mood ADD VALUE 'meh' AFTER 'ok';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `ALTER TYPE mood ADD VALUE 'ok';`,
+					ExpectedErr: `enum label "ok" already exists`,
+				},
+				{
+					Query:    `ALTER TYPE mood ADD VALUE IF NOT EXISTS 'ok';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `ALTER TYPE mood ADD VALUE 'angry' AFTER 'furious';`,
+					ExpectedErr: `"furious" is not an existing enum label`,
+				},
+				{
+					Query:    `SELECT enum_range(NULL::mood);`,
+					Expected: []sql.Row{{"{miserable,sad,ok,meh,happy,ecstatic}"}},
+				},
+				{
+					Query:    `INSERT INTO person VALUES (3, 'meh'), (4, 'ecstatic'), (5, 'miserable');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT id FROM person ORDER BY current_mood;`,
+					Expected: []sql.Row{{5}, {2}, {3}, {1}, {4}},
+				},
+			},
+		},
+		{
+			Name: "alter type rename value",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');`,
+				`CREATE TABLE person (id int primary key, current_mood mood);`,
+				`INSERT INTO person VALUES (1, 'happy'), (2, 'sad'), (3, 'ok');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       `ALTER TYPE mood RENAME VALUE 'angry' TO 'furious';`,
+					ExpectedErr: `"angry" is not an existing enum label`,
+				},
+				{
+					Query:       `ALTER TYPE mood RENAME VALUE 'ok' TO 'happy';`,
+					ExpectedErr: `enum label "happy" already exists`,
+				},
+				{
+					Query:    `ALTER TYPE mood RENAME VALUE 'ok' TO 'fine';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM person ORDER BY current_mood;`,
+					Expected: []sql.Row{{2, "sad"}, {3, "fine"}, {1, "happy"}},
+				},
+				{
+					Query:       `SELECT 'ok'::mood;`,
+					ExpectedErr: `invalid input value for enum mood: "ok"`,
+				},
+			},
+		},
+		{
+			Name: "alter type rename value used by other types",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');`,
+				`CREATE DOMAIN dmood AS mood;`,
+				`CREATE TYPE reading AS (label text, feeling mood);`,
+				`CREATE TYPE mood_range AS RANGE (subtype = mood);`,
+				`CREATE TABLE person (id int primary key, current_mood mood, usual_mood dmood, last_reading reading, moods mood_range);`,
+				`CREATE INDEX person_mood_idx ON person (current_mood);`,
+				`CREATE INDEX person_usual_mood_idx ON person (usual_mood);`,
+				`INSERT INTO person VALUES (1, 'ok', 'ok', '(first,ok)', '[sad,ok]'), (2, 'happy', 'sad', '(second,happy)', '[ok,happy]');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `ALTER TYPE mood RENAME VALUE 'ok' TO 'fine';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query: `SELECT * FROM person ORDER BY id;`,
+					Expected: []sql.Row{
+						{1, "fine", "fine", "(first,fine)", "[sad,fine]"},
+						{2, "happy", "sad", "(second,happy)", "[fine,happy]"},
+					},
+				},
+				{
+					Query:    `SELECT id FROM person WHERE current_mood = 'fine';`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `SELECT id FROM person WHERE usual_mood > 'sad';`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `SELECT 'fine'::dmood, '(third,fine)'::reading, '[fine,happy)'::mood_range;`,
+					Expected: []sql.Row{{"fine", "(third,fine)", "[fine,happy)"}},
+				},
+				{
+					Query:       `SELECT '(third,ok)'::reading;`,
+					ExpectedErr: `invalid input value for enum mood: "ok"`,
+				},
+				{
+					Query:    `INSERT INTO person VALUES (3, 'fine', 'fine', '(third,fine)', '[fine,fine]');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT id FROM person ORDER BY usual_mood, id;`,
+					Expected: []sql.Row{{2}, {1}, {3}},
+				},
+				{
+					Query:    `ALTER TYPE mood ADD VALUE 'ecstatic';`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT 'ecstatic'::dmood, '(fourth,ecstatic)'::reading, '[happy,ecstatic]'::mood_range;`,
+					Expected: []sql.Row{{"ecstatic", "(fourth,ecstatic)", "[happy,ecstatic]"}},
+				},
+				{
+					Query:       `DROP TYPE mood;`,
+					ExpectedErr: `cannot drop type mood because other objects depend on it`,
+				},
+				{
+					Query:    `DROP TABLE person;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `DROP TYPE mood;`,
+					ExpectedErr: `cannot drop type mood because other objects depend on it`,
+				},
+				{
+					Query:       `CREATE DOMAIN dmood2 AS dmood;`,
+					ExpectedErr: `domains over domains are not yet supported`,
+				},
+			},
+		},
+		{
+			Name: "enum functions",
+			SetUpScript: []string{
+				`CREATE TYPE rainbow AS ENUM ('red', 'orange', 'yellow', 'green', 'blue', 'purple');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT enum_first(null::rainbow), enum_last(null::rainbow);`,
+					Expected: []sql.Row{{"red", "purple"}},
+				},
+				{
+					Query:    `SELECT enum_range(null::rainbow);`,
+					Expected: []sql.Row{{"{red,orange,yellow,green,blue,purple}"}},
+				},
+				{
+					Query:    `SELECT enum_range('orange'::rainbow, 'green'::rainbow);`,
+					Expected: []sql.Row{{"{orange,yellow,green}"}},
+				},
+				{
+					Query:    `SELECT enum_range(NULL, 'green'::rainbow);`,
+					Expected: []sql.Row{{"{red,orange,yellow,green}"}},
+				},
+				{
+					Query:    `SELECT enum_range('orange'::rainbow, NULL);`,
+					Expected: []sql.Row{{"{orange,yellow,green,blue,purple}"}},
+				},
+			},
+		},
+		{
+			Name: "enum catalog tables",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');`,
+				`ALTER TYPE mood ADD VALUE 'meh' BEFORE 'ok';`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT enumlabel, enumsortorder FROM pg_catalog.pg_enum ORDER BY enumsortorder;`,
+					Expected: []sql.Row{{"sad", 1.0}, {"meh", 1.5}, {"ok", 2.0}, {"happy", 3.0}},
+				},
+				{
+					Query:    `SELECT typname, typtype, typcategory, typlen FROM pg_catalog.pg_type WHERE typname = 'mood';`,
+					Expected: []sql.Row{{"mood", "e", "E", 4}},
+				},
+				{
+					Query:    `SELECT count(*) FROM pg_catalog.pg_enum e JOIN pg_catalog.pg_type t ON e.enumtypid = t.oid WHERE t.typname = 'mood';`,
+					Expected: []sql.Row{{4}},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorCodes(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "constraint violations",
+			SetUpScript: []string{
+				"CREATE TABLE parent (id INT PRIMARY KEY, u INT UNIQUE);",
+				"CREATE TABLE child (id INT PRIMARY KEY, parent_id INT NOT NULL, v INT CHECK (v > 0), FOREIGN KEY (parent_id) REFERENCES parent(id));",
+				"INSERT INTO parent VALUES (1, 1);",
+				"INSERT INTO child VALUES (1, 1, 1);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "INSERT INTO parent VALUES (1, 2);",
+					ExpectedErr: "(SQLSTATE 23505)",
+				},
+				{
+					Query:       "INSERT INTO parent VALUES (2, 1);",
+					ExpectedErr: "(SQLSTATE 23505)",
+				},
+				{
+					Query:       "INSERT INTO child VALUES (2, 5, 1);",
+					ExpectedErr: "(SQLSTATE 23503)",
+				},
+				{
+					Query:       "DELETE FROM parent WHERE id = 1;",
+					ExpectedErr: "(SQLSTATE 23503)",
+				},
+				{
+					Query:       "INSERT INTO child VALUES (2, NULL, 1);",
+					ExpectedErr: "(SQLSTATE 23502)",
+				},
+				{
+					Query:       "INSERT INTO child VALUES (2, 1, -1);",
+					ExpectedErr: "(SQLSTATE 23514)",
+				},
+			},
+		},
+		{
+			Name: "undefined objects",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT PRIMARY KEY);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SELECT * FROM does_not_exist;",
+					ExpectedErr: "(SQLSTATE 42P01)",
+				},
+				{
+					Query:       "SELECT does_not_exist FROM test;",
+					ExpectedErr: "(SQLSTATE 42703)",
+				},
+				{
+					Query:       "CREATE TABLE test (pk INT PRIMARY KEY);",
+					ExpectedErr: "(SQLSTATE 42P07)",
+				},
+				{
+					Query:       "SELECT * FROM;",
+					ExpectedErr: "(SQLSTATE 42601)",
+				},
+			},
+		},
+		{
+			Name: "data exceptions",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SELECT 'abc'::int4;",
+					ExpectedErr: "(SQLSTATE 22P02)",
+				},
+				{
+					Query:       "SELECT 99999::int2;",
+					ExpectedErr: "(SQLSTATE 22003)",
+				},
+				{
+					Query:       "SELECT 2147483647::int4 + 1;",
+					ExpectedErr: "(SQLSTATE 22003)",
+				},
+				{
+					Query:       "SELECT make_date(2023, 2, 29);",
+					ExpectedErr: "(SQLSTATE 22008)",
+				},
+				{
+					Query:       "SELECT to_timestamp('2000-13-01', 'YYYY-MM-DD');",
+					ExpectedErr: "(SQLSTATE 22008)",
+				},
+			},
+		},
+	})
+}
+
+func TestErrorResponseFields(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	for _, query := range []string{
+		"CREATE TABLE parent (id INT PRIMARY KEY);",
+		"CREATE TABLE child (id INT PRIMARY KEY, parent_id INT NOT NULL, v INT CONSTRAINT v_positive CHECK (v > 0), CONSTRAINT child_parent_fk FOREIGN KEY (parent_id) REFERENCES parent(id));",
+		"INSERT INTO parent VALUES (1);",
+	} {
+		_, err := conn.Exec(ctx, query)
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		query    string
+		expected pgconn.PgError
+	}{
+		{
+			query: "INSERT INTO parent VALUES (1);",
+			expected: pgconn.PgError{
+				Code:   "23505",
+				Detail: "Key (1) already exists.",
+			},
+		},
+		{
+			query: "INSERT INTO child VALUES (1, 2, 1);",
+			expected: pgconn.PgError{
+				Code:           "23503",
+				Detail:         `Key (2) is not present in table "parent".`,
+				TableName:      "child",
+				ConstraintName: "child_parent_fk",
+			},
+		},
+		{
+			query: "INSERT INTO child VALUES (1, NULL, 1);",
+			expected: pgconn.PgError{
+				Code:       "23502",
+				ColumnName: "parent_id",
+			},
+		},
+		{
+			query: "INSERT INTO child VALUES (1, 1, 0);",
+			expected: pgconn.PgError{
+				Code:           "23514",
+				ConstraintName: "v_positive",
+			},
+		},
+		{
+			query: "SELECT * FROM missing_table;",
+			expected: pgconn.PgError{
+				Code:      "42P01",
+				TableName: "missing_table",
+			},
+		},
+		{
+			query: "SELECT 1 FROM WHERE;",
+			expected: pgconn.PgError{
+				Code:     "42601",
+				Position: 15,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			_, err := conn.Exec(ctx, test.query)
+			require.Error(t, err)
+			var pgErr *pgconn.PgError
+			require.True(t, errors.As(err, &pgErr))
+			assert.Equal(t, "ERROR", pgErr.Severity)
+			assert.Equal(t, test.expected.Code, pgErr.Code)
+			if len(test.expected.Detail) > 0 {
+				assert.Equal(t, test.expected.Detail, pgErr.Detail)
+			}
+			assert.Equal(t, test.expected.TableName, pgErr.TableName)
+			assert.Equal(t, test.expected.ColumnName, pgErr.ColumnName)
+			assert.Equal(t, test.expected.ConstraintName, pgErr.ConstraintName)
+			assert.Equal(t, test.expected.Position, pgErr.Position)
+		})
+	}
+}
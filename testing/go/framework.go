@@ -48,6 +48,9 @@ import (
 // rather than starting a doltgres server.
 const runOnPostgres = false
 
+// firstUserOid is the first OID that is assigned to user-defined objects.
+const firstUserOid = 16384
+
 // ScriptTest defines a consistent structure for testing queries.
 type ScriptTest struct {
 	// Name of the script.
@@ -259,6 +262,16 @@ func ptr[T any](val T) *T {
 
 var testServerLogLevel = "info"
 
+// serverDefaultTimeZone is the default value of the server's TimeZone setting, which is used to display timestamptz
+// values that are received in the binary format.
+var serverDefaultTimeZone = func() *time.Location {
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}()
+
 func init() {
 	if logLevel, ok := os.LookupEnv("TEST_SERVER_LOG_LEVEL"); ok {
 		testServerLogLevel = logLevel
@@ -348,6 +361,18 @@ func NormalizeRows(fds []pgconn.FieldDescription, rows []sql.Row, normalize bool
 	return newRows
 }
 
+// oidToDoltgresType returns the type matching the given OID. User-defined types, such as enums, are returned as text,
+// since clients receive their values in the same format.
+func oidToDoltgresType(typeOid uint32) (types.DoltgresType, bool) {
+	if dt, ok := types.OidToBuildInDoltgresType[typeOid]; ok {
+		return dt, true
+	}
+	if typeOid >= firstUserOid {
+		return types.Text, true
+	}
+	return nil, false
+}
+
 // NormalizeRow normalizes each value's type, as the tests only want to compare values.
 // Returns a new row.
 func NormalizeRow(fds []pgconn.FieldDescription, row sql.Row, normalize bool) sql.Row {
@@ -356,7 +381,7 @@ func NormalizeRow(fds []pgconn.FieldDescription, row sql.Row, normalize bool) sq
 	}
 	newRow := make(sql.Row, len(row))
 	for i := range row {
-		dt, ok := types.OidToBuildInDoltgresType[fds[i].DataTypeOID]
+		dt, ok := oidToDoltgresType(fds[i].DataTypeOID)
 		if !ok {
 			panic(fmt.Sprintf("unhandled oid type: %v", fds[i].DataTypeOID))
 		}
@@ -380,7 +405,7 @@ func NormalizeExpectedRow(fds []pgconn.FieldDescription, rows []sql.Row) []sql.R
 		} else {
 			newRow := make(sql.Row, len(row))
 			for i := range row {
-				dt, ok := types.OidToBuildInDoltgresType[fds[i].DataTypeOID]
+				dt, ok := oidToDoltgresType(fds[i].DataTypeOID)
 				if !ok {
 					panic(fmt.Sprintf("unhandled oid type: %v", fds[i].DataTypeOID))
 				}
@@ -483,13 +508,50 @@ func NormalizeValToString(dt types.DoltgresType, v any) any {
 			panic(err)
 		}
 		return tVal
+	case types.RangeType:
+		if v == nil {
+			return nil
+		}
+		// Ranges are received as pgtype.Range, so the bounds are converted and the range is formatted by Doltgres
+		rv := v.(pgtype.Range[any])
+		rangeVal := types.RangeValue{Empty: rv.LowerType == pgtype.Empty}
+		if !rangeVal.Empty {
+			if rv.LowerType != pgtype.Unbounded {
+				rangeVal.Lower = NormalizeVal(t.SubType, rv.Lower)
+				rangeVal.LowerInclusive = rv.LowerType == pgtype.Inclusive
+			}
+			if rv.UpperType != pgtype.Unbounded {
+				rangeVal.Upper = NormalizeVal(t.SubType, rv.Upper)
+				rangeVal.UpperInclusive = rv.UpperType == pgtype.Inclusive
+			}
+		}
+		str, err := t.IoOutput(nil, rangeVal)
+		if err != nil {
+			panic(err)
+		}
+		return str
+	case types.InetType, types.CidrType, types.MacAddrType, types.MacAddr8Type:
+		// Network addresses are received as netip.Prefix and net.HardwareAddr, which Doltgres uses internally as well
+		if v == nil {
+			return nil
+		}
+		if str, ok := v.(string); ok {
+			return str
+		}
+		str, err := dt.IoOutput(nil, v)
+		if err != nil {
+			panic(err)
+		}
+		return str
 	case types.TimestampTZType:
-		// timestamptz returns a value in server timezone
-		_, offset := v.(time.Time).Zone()
+		// timestamptz is received in the binary format, which only conveys the instant in time, so it is displayed
+		// using the server's default time zone
+		tm := v.(time.Time).In(serverDefaultTimeZone)
+		_, offset := tm.Zone()
 		if offset%3600 != 0 {
-			return v.(time.Time).Format("2006-01-02 15:04:05.999999999-07:00")
+			return tm.Format("2006-01-02 15:04:05.999999999-07:00")
 		} else {
-			return v.(time.Time).Format("2006-01-02 15:04:05.999999999-07")
+			return tm.Format("2006-01-02 15:04:05.999999999-07")
 		}
 	}
 
@@ -414,7 +414,7 @@ func TestSystemInformationFunctions(t *testing.T) {
 				},
 				{
 					Query:       `SELECT current_catalog();`,
-					ExpectedErr: `ERROR: at or near "(": syntax error (SQLSTATE XX000)`,
+					ExpectedErr: `ERROR: at or near "(": syntax error (SQLSTATE 42601)`,
 				},
 				// // TODO: Implement table function for current_catalog
 				{
@@ -426,7 +426,7 @@ func TestSystemInformationFunctions(t *testing.T) {
 				},
 				{
 					Query:       `SELECT * FROM current_catalog();`,
-					ExpectedErr: `ERROR: at or near "(": syntax error (SQLSTATE XX000)`,
+					ExpectedErr: `ERROR: at or near "(": syntax error (SQLSTATE 42601)`,
 				},
 			},
 		},
@@ -520,6 +520,10 @@ func TestSystemInformationFunctions(t *testing.T) {
 						{"{public}"},
 					},
 				},
+				{ // The default search_path ends with a comma, which must not produce an empty schema name
+					Query:    `SELECT array_length(current_schemas(false), 1), cardinality(current_schemas(true));`,
+					Expected: []sql.Row{{1, 2}},
+				},
 				{
 					Query:    "CREATE SCHEMA test_schema;",
 					Expected: []sql.Row{},
@@ -920,7 +924,6 @@ func TestArrayFunctions(t *testing.T) {
 			},
 			Assertions: []ScriptTestAssertion{
 				{
-					Skip:     true, // TODO: Should return no rows instead of empty row
 					Query:    `SELECT unnest(val1) FROM testing WHERE id=1;`,
 					Cols:     []string{"unnest"},
 					Expected: []sql.Row{},
@@ -931,7 +934,6 @@ func TestArrayFunctions(t *testing.T) {
 					Expected: []sql.Row{{1}},
 				},
 				{
-					Skip:     true, // TODO: Support unnesting multiple values
 					Query:    `SELECT unnest(val1) FROM testing WHERE id=3;`,
 					Expected: []sql.Row{{1}, {2}},
 				},
@@ -1804,6 +1806,261 @@ func TestDateAndTimeFunction(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "current date and time with transaction semantics",
+			SetUpScript: []string{
+				`CREATE TABLE times (id int primary key, v timestamptz);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT now() = transaction_timestamp(), now() = current_timestamp, now() = statement_timestamp(), clock_timestamp() >= now();`,
+					Expected: []sql.Row{{"t", "t", "t", "t"}},
+				},
+				{
+					Query:    `SELECT date_part('microseconds', current_timestamp(0)) = date_part('second', current_timestamp(0)) * 1000000;`,
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:    `SELECT current_date = now()::date, localtimestamp = now()::timestamp, localtime = now()::time;`,
+					Expected: []sql.Row{{"t", "t", "t"}},
+				},
+				{
+					Query:    `BEGIN;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `INSERT INTO times VALUES (1, now());`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `INSERT INTO times VALUES (2, now());`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT now() = v, transaction_timestamp() = v, statement_timestamp() > v, clock_timestamp() > v FROM times WHERE id = 1;`,
+					Expected: []sql.Row{{"t", "t", "t", "t"}},
+				},
+				{
+					Query:    `SELECT count(DISTINCT v) FROM times;`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `COMMIT;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT now() > v FROM times WHERE id = 1;`,
+					Expected: []sql.Row{{"t"}},
+				},
+			},
+		},
+		{
+			Name: "date_trunc",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT date_trunc('hour', timestamp '2001-02-16 20:38:40.123456'), date_trunc('millisecond', timestamp '2001-02-16 20:38:40.123456');`,
+					Expected: []sql.Row{{"2001-02-16 20:00:00", "2001-02-16 20:38:40.123"}},
+				},
+				{
+					Query:    `SELECT date_trunc('week', timestamp '2020-03-15 12:34:56'), date_trunc('month', timestamp '2020-03-15 12:34:56'), date_trunc('quarter', timestamp '2020-05-15');`,
+					Expected: []sql.Row{{"2020-03-09 00:00:00", "2020-03-01 00:00:00", "2020-04-01 00:00:00"}},
+				},
+				{
+					Query:    `SELECT date_trunc('year', timestamp '2019-05-15'), date_trunc('decade', timestamp '2019-05-15'), date_trunc('century', timestamp '2000-05-15'), date_trunc('millennium', timestamp '2001-05-15');`,
+					Expected: []sql.Row{{"2019-01-01 00:00:00", "2010-01-01 00:00:00", "1901-01-01 00:00:00", "2001-01-01 00:00:00"}},
+				},
+				{
+					Query:    `SELECT date_trunc('day', timestamptz '2001-02-16 20:38:40+00');`,
+					Expected: []sql.Row{{"2001-02-16 00:00:00-08"}},
+				},
+				{
+					Query:    `SELECT date_trunc('day', timestamptz '2001-02-16 20:38:40+00', 'Australia/Sydney'), date_trunc('day', timestamptz '2001-02-16 20:38:40+00', 'UTC');`,
+					Expected: []sql.Row{{"2001-02-16 05:00:00-08", "2001-02-15 16:00:00-08"}},
+				},
+				{
+					Query:    `SELECT date_trunc('hour', interval '3 days 02:47:33'), date_trunc('year', interval '1 year 5 months 3 days');`,
+					Expected: []sql.Row{{"3 days 02:00:00", "1 year"}},
+				},
+				{
+					Query:       `SELECT date_trunc('fortnight', timestamp '2020-03-15');`,
+					ExpectedErr: `unit "fortnight" not recognized for type timestamp without time zone`,
+				},
+			},
+		},
+		{
+			Name: "date_part",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT date_part('year', date '2020-03-15'), date_part('hour', timestamp '2001-02-16 20:38:40'), date_part('second', time '12:34:56.5');`,
+					Expected: []sql.Row{{float64(2020), float64(20), 56.5}},
+				},
+				{
+					Query:    `SELECT date_part('month', interval '2 years 3 months'), date_part('epoch', interval '1 day');`,
+					Expected: []sql.Row{{float64(3), float64(86400)}},
+				},
+			},
+		},
+		{
+			Name: "date_bin",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT date_bin('15 minutes', timestamp '2020-02-11 15:44:17', timestamp '2001-01-01'), date_bin('15 minutes', timestamp '2020-02-11 15:44:17', timestamp '2001-01-01 00:02:30');`,
+					Expected: []sql.Row{{"2020-02-11 15:30:00", "2020-02-11 15:32:30"}},
+				},
+				{
+					Query:    `SELECT date_bin('1 hour', timestamp '1999-12-31 23:30', timestamp '2001-01-01');`,
+					Expected: []sql.Row{{"1999-12-31 23:00:00"}},
+				},
+				{
+					Query:    `SELECT date_bin('1 day', timestamptz '2020-02-11 15:00+00', timestamptz '2001-01-01 00:00+00');`,
+					Expected: []sql.Row{{"2020-02-10 16:00:00-08"}},
+				},
+				{
+					Query:       `SELECT date_bin('1 month', timestamp '2020-02-11', timestamp '2001-01-01');`,
+					ExpectedErr: `timestamps cannot be binned into intervals containing months or years`,
+				},
+				{
+					Query:       `SELECT date_bin('0 minutes', timestamp '2020-02-11', timestamp '2001-01-01');`,
+					ExpectedErr: `stride must be greater than zero`,
+				},
+			},
+		},
+		{
+			Name: "make functions",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT make_date(2013, 7, 15), make_time(8, 15, 23.5), make_timestamp(2013, 7, 15, 8, 15, 23.5);`,
+					Expected: []sql.Row{{"2013-07-15", "08:15:23.5", "2013-07-15 08:15:23.5"}},
+				},
+				{
+					Query:    `SELECT make_timestamptz(2013, 7, 15, 8, 15, 23.5), make_timestamptz(2013, 7, 15, 8, 15, 23.5, 'America/New_York');`,
+					Expected: []sql.Row{{"2013-07-15 08:15:23.5-07", "2013-07-15 05:15:23.5-07"}},
+				},
+				{
+					Query:    `SELECT make_interval(), make_interval(1), make_interval(0, 0, 0, 10), make_interval(1, 2, 3, 4, 5, 6, 7.5);`,
+					Expected: []sql.Row{{"00:00:00", "1 year", "10 days", "1 year 2 mons 25 days 05:06:07.5"}},
+				},
+				{
+					Query:    `SELECT make_interval(days => 10), make_interval(hours => 2, mins => 3), make_interval(1, secs := 1.5);`,
+					Expected: []sql.Row{{"10 days", "02:03:00", "1 year 00:00:01.5"}},
+				},
+				{
+					Query:    `SELECT make_date(day => 15, month => 7, year => 2013), make_time(8, 15, sec => 23.5);`,
+					Expected: []sql.Row{{"2013-07-15", "08:15:23.5"}},
+				},
+				{
+					Query:       `SELECT make_interval(days => 1, days => 2);`,
+					ExpectedErr: `argument name "days" used more than once`,
+				},
+				{
+					Query:       `SELECT make_interval(days => 1, 2);`,
+					ExpectedErr: `positional argument cannot follow named argument`,
+				},
+				{
+					Query:       `SELECT make_interval(dayz => 1);`,
+					ExpectedErr: `function make_interval(dayz => 1) does not exist`,
+				},
+				{
+					Query:       `SELECT make_interval(days => );`,
+					ExpectedErr: `syntax error`,
+				},
+				{
+					Query:       `SELECT make_date(year => 2013, month => 7);`,
+					ExpectedErr: `function make_date(integer, integer) does not exist`,
+				},
+				{
+					Query:       `SELECT make_date(2013, 2, 30);`,
+					ExpectedErr: `date field value out of range: 2013-02-30`,
+				},
+				{
+					Query:       `SELECT make_date(0, 7, 15);`,
+					ExpectedErr: `date field value out of range: 0-07-15`,
+				},
+				{
+					Query:       `SELECT make_time(25, 0, 0);`,
+					ExpectedErr: `time field value out of range: 25:00:00`,
+				},
+			},
+		},
+		{
+			Name: "to_timestamp and to_date",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT to_timestamp(1284352323), to_timestamp(1284352323.5), isfinite(to_timestamp('Infinity'::float8));`,
+					Expected: []sql.Row{{"2010-09-12 21:32:03-07", "2010-09-12 21:32:03.5-07", "f"}},
+				},
+				{
+					Query:    `SELECT to_timestamp('05 Dec 2000', 'DD Mon YYYY'), to_timestamp('2000-12-05 14:30:15.123', 'YYYY-MM-DD HH24:MI:SS.MS'), to_timestamp('20001205', 'YYYYMMDD');`,
+					Expected: []sql.Row{{"2000-12-05 00:00:00-08", "2000-12-05 14:30:15.123-08", "2000-12-05 00:00:00-08"}},
+				},
+				{
+					Query:    `SELECT to_timestamp('2011-12-18 11:38 PM', 'YYYY-MM-DD HH12:MI PM'), to_timestamp('2011-12-18 11:38 +05:30', 'YYYY-MM-DD HH24:MI TZH:TZM');`,
+					Expected: []sql.Row{{"2011-12-18 23:38:00-08", "2011-12-17 22:08:00-08"}},
+				},
+				{
+					Query:    `SELECT to_date('05 Dec 2000', 'DD Mon YYYY'), to_date('20001205', 'YYYYMMDD'), to_date('2000 JUN', 'YYYY MON'), to_date('15 March 2021', 'DD Month YYYY');`,
+					Expected: []sql.Row{{"2000-12-05", "2000-12-05", "2000-06-01", "2021-03-15"}},
+				},
+				{
+					Query:    `SELECT to_date('1,234-05-06', 'Y,YYY-MM-DD'), to_date('99-01-01', 'YY-MM-DD'), to_date('2000-12-05 garbage', 'YYYY-MM-DD');`,
+					Expected: []sql.Row{{"1234-05-06", "1999-01-01", "2000-12-05"}},
+				},
+				{
+					Query:       `SELECT to_date('2000-13-05', 'YYYY-MM-DD');`,
+					ExpectedErr: `date/time field value out of range: "2000-13-05"`,
+				},
+				{
+					Query:       `SELECT to_date('abcd-12-05', 'YYYY-MM-DD');`,
+					ExpectedErr: `invalid value "abcd" for "YYYY"`,
+				},
+			},
+		},
+		{
+			Name: "justify functions and isfinite",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT justify_days(interval '35 days'), justify_days(interval '-35 days'), justify_hours(interval '27 hours');`,
+					Expected: []sql.Row{{"1 mon 5 days", "-1 mons -5 days", "1 day 03:00:00"}},
+				},
+				{
+					Query:    `SELECT justify_interval(interval '1 mon -1 hour'), justify_interval(interval '-1 mon 1 hour');`,
+					Expected: []sql.Row{{"29 days 23:00:00", "-29 days -23:00:00"}},
+				},
+				{
+					Query:    `SELECT isfinite(date '2001-02-16'), isfinite(timestamp '2001-02-16 21:28:30'), isfinite(timestamp 'infinity'), isfinite(timestamptz '-infinity'), isfinite(interval '4 hours');`,
+					Expected: []sql.Row{{"t", "t", "f", "f", "t"}},
+				},
+			},
+		},
+		{
+			Name: "overlaps",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT (DATE '2001-02-16', DATE '2001-12-21') OVERLAPS (DATE '2001-10-30', DATE '2002-10-30'), (DATE '2001-02-16', INTERVAL '100 days') OVERLAPS (DATE '2001-10-30', DATE '2002-10-30');`,
+					Expected: []sql.Row{{"t", "f"}},
+				},
+				{
+					Query:    `SELECT (DATE '2001-10-29', DATE '2001-10-30') OVERLAPS (DATE '2001-10-30', DATE '2001-10-31'), (DATE '2001-10-30', DATE '2001-10-30') OVERLAPS (DATE '2001-10-30', DATE '2001-10-31');`,
+					Expected: []sql.Row{{"f", "t"}},
+				},
+				{
+					Query:    `SELECT (timestamp '2001-02-16', interval '300 days') OVERLAPS (timestamp '2001-10-30', interval '1 day'), (timestamp '2001-12-21', timestamp '2001-02-16') OVERLAPS (timestamp '2001-10-30', timestamp '2002-10-30');`,
+					Expected: []sql.Row{{"t", "t"}},
+				},
+				{
+					Query:    `SELECT (timestamptz '2001-02-16', NULL::timestamptz) OVERLAPS (timestamptz '2001-10-30', timestamptz '2002-10-30'), (NULL::timestamptz, timestamptz '2001-12-01') OVERLAPS (timestamptz '2001-10-30', timestamptz '2002-10-30');`,
+					Expected: []sql.Row{{nil, "t"}},
+				},
+				{
+					Query:    `SELECT (time '01:00', time '03:00') OVERLAPS (time '02:00', time '04:00');`,
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:       `SELECT (1, 2, 3) OVERLAPS (4, 5);`,
+					ExpectedErr: `wrong number of parameters on left side of OVERLAPS expression`,
+				},
+			},
+		},
 	})
 }
 
@@ -0,0 +1,301 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestJsonFunctions(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "to_json and to_jsonb",
+			SetUpScript: []string{
+				`CREATE TYPE pair AS (b text, a int);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT to_jsonb(1), to_jsonb('a"b'::text), to_jsonb(true), to_jsonb(1.50::numeric);`,
+					Expected: []sql.Row{{`1`, `"a\"b"`, `true`, `1.5`}},
+				},
+				{
+					Query:    `SELECT to_jsonb(ARRAY[1, NULL, 3]), to_json(ARRAY['a', 'b']);`,
+					Expected: []sql.Row{{`[1, null, 3]`, `["a","b"]`}},
+				},
+				{
+					Query:    `SELECT to_jsonb('2024-01-02'::date), to_jsonb('2024-01-02 03:04:05.5'::timestamp);`,
+					Expected: []sql.Row{{`"2024-01-02"`, `"2024-01-02T03:04:05.5"`}},
+				},
+				{
+					Query:    `SELECT to_jsonb(ROW('x', 1)::pair), to_json(ROW('x', 1)::pair);`,
+					Expected: []sql.Row{{`{"a": 1, "b": "x"}`, `{"b":"x","a":1}`}},
+				},
+				{
+					Query:    `SELECT to_jsonb('{"a": [1, 2]}'::jsonb), to_json('{"a" : 1}'::json);`,
+					Expected: []sql.Row{{`{"a": [1, 2]}`, `{"a" : 1}`}},
+				},
+				{
+					Query:    `SELECT jsonb_typeof(to_jsonb(NULL::int)), jsonb_typeof(to_jsonb('null'::jsonb));`,
+					Expected: []sql.Row{{nil, "null"}},
+				},
+				{
+					Query:    `SELECT row_to_json(ROW('x', 1)::pair), row_to_json(ROW('x', 1)::pair, true);`,
+					Expected: []sql.Row{{`{"b":"x","a":1}`, "{\"b\":\"x\",\n \"a\":1}"}},
+				},
+			},
+		},
+		{
+			Name: "build functions",
+			SetUpScript: []string{
+				`CREATE TABLE t (id int primary key, name text, tags text[]);`,
+				`INSERT INTO t VALUES (1, 'one', '{a,b}'), (2, 'two', NULL);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT jsonb_build_object('b', 1, 'a', 'x', 'c', NULL), jsonb_build_object();`,
+					Expected: []sql.Row{{`{"a": "x", "b": 1, "c": null}`, `{}`}},
+				},
+				{
+					Query:    `SELECT jsonb_build_array(1, 'a', true, NULL, ARRAY[1, 2]), jsonb_build_array();`,
+					Expected: []sql.Row{{`[1, "a", true, null, [1, 2]]`, `[]`}},
+				},
+				{
+					Query:    `SELECT json_build_object('a', 1, 'b', 'two')::text, json_build_array(1, 'two', NULL)::text;`,
+					Expected: []sql.Row{{`{"a" : 1, "b" : "two"}`, `[1, "two", null]`}},
+				},
+				{
+					Query:    `SELECT jsonb_build_object('id', id, 'name', name, 'tags', tags) FROM t ORDER BY id;`,
+					Expected: []sql.Row{{`{"id": 1, "name": "one", "tags": ["a", "b"]}`}, {`{"id": 2, "name": "two", "tags": null}`}},
+				},
+				{
+					Query:    `SELECT jsonb_build_object('inner', jsonb_build_object('x', 1), 'list', jsonb_build_array('y'));`,
+					Expected: []sql.Row{{`{"list": ["y"], "inner": {"x": 1}}`}},
+				},
+				{
+					Query:       `SELECT jsonb_build_object('a', 1, 'b');`,
+					ExpectedErr: `argument list must have even number of elements`,
+				},
+				{
+					Query:       `SELECT jsonb_build_object(NULL, 1);`,
+					ExpectedErr: `argument 1 cannot be null`,
+				},
+			},
+		},
+		{
+			Name: "aggregate functions",
+			SetUpScript: []string{
+				`CREATE TABLE t (id int primary key, grp text, v int);`,
+				`INSERT INTO t VALUES (1, 'a', 10), (2, 'a', NULL), (3, 'b', 30);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT jsonb_agg(v) FROM t;`,
+					Expected: []sql.Row{{`[10, null, 30]`}},
+				},
+				{
+					Query:    `SELECT grp, jsonb_agg(id) FROM t GROUP BY grp ORDER BY grp;`,
+					Expected: []sql.Row{{"a", `[1, 2]`}, {"b", `[3]`}},
+				},
+				{
+					Query:    `SELECT json_agg(v)::text FROM t WHERE id < 3;`,
+					Expected: []sql.Row{{`[10, null]`}},
+				},
+				{
+					Query:    `SELECT jsonb_object_agg(grp || id, v) FROM t;`,
+					Expected: []sql.Row{{`{"a1": 10, "a2": null, "b3": 30}`}},
+				},
+				{
+					Query:    `SELECT jsonb_agg(jsonb_build_object('id', id)) FROM t WHERE grp = 'b';`,
+					Expected: []sql.Row{{`[{"id": 3}]`}},
+				},
+				{
+					Query:    `SELECT jsonb_typeof(jsonb_agg(v)), jsonb_typeof(jsonb_object_agg(grp, v)) FROM t WHERE id > 10;`,
+					Expected: []sql.Row{{nil, nil}},
+				},
+			},
+		},
+		{
+			Name: "jsonb_set, jsonb_insert and #-",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT jsonb_set('{"a": {"b": 1}}', '{a,b}', '2');`,
+					Expected: []sql.Row{{`{"a": {"b": 2}}`}},
+				},
+				{
+					Query:    `SELECT jsonb_set('{"a": {"b": 1}}', '{a,c}', '2'), jsonb_set('{"a": {"b": 1}}', '{a,c}', '2', false);`,
+					Expected: []sql.Row{{`{"a": {"b": 1, "c": 2}}`, `{"a": {"b": 1}}`}},
+				},
+				{
+					Query:    `SELECT jsonb_set('[1, 2, 3]', '{-1}', '"x"'), jsonb_set('[1, 2, 3]', '{10}', '4'), jsonb_set('[1, 2, 3]', '{-10}', '0');`,
+					Expected: []sql.Row{{`[1, 2, "x"]`, `[1, 2, 3, 4]`, `[0, 1, 2, 3]`}},
+				},
+				{
+					Query:       `SELECT jsonb_set('[1, 2, 3]', '{a}', '4');`,
+					ExpectedErr: `path element at position 1 is not an integer: "a"`,
+				},
+				{
+					Query:       `SELECT jsonb_set('1', '{a}', '4');`,
+					ExpectedErr: `cannot set path in scalar`,
+				},
+				{
+					Query:    `SELECT jsonb_insert('{"a": [0, 1, 2]}', '{a,1}', '"new"'), jsonb_insert('{"a": [0, 1, 2]}', '{a,1}', '"new"', true);`,
+					Expected: []sql.Row{{`{"a": [0, "new", 1, 2]}`, `{"a": [0, 1, "new", 2]}`}},
+				},
+				{
+					Query:       `SELECT jsonb_insert('{"a": 1}', '{a}', '2');`,
+					ExpectedErr: `cannot replace existing key`,
+				},
+				{
+					Query:    `SELECT '{"a": 1, "b": [1, 2], "c": {"d": 2}}'::jsonb #- '{b,-1}', '{"a": 1, "c": {"d": 2}}'::jsonb #- '{c,d}';`,
+					Expected: []sql.Row{{`{"a": 1, "b": [1], "c": {"d": 2}}`, `{"a": 1, "c": {}}`}},
+				},
+			},
+		},
+		{
+			Name: "jsonb_strip_nulls, jsonb_pretty and jsonb_typeof",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT jsonb_strip_nulls('{"a": null, "b": [1, null], "c": {"d": null}}');`,
+					Expected: []sql.Row{{`{"b": [1, null], "c": {}}`}},
+				},
+				{
+					Query:    `SELECT jsonb_pretty('{"a": [1, {"b": null}], "c": {}}');`,
+					Expected: []sql.Row{{"{\n    \"a\": [\n        1,\n        {\n            \"b\": null\n        }\n    ],\n    \"c\": {\n    }\n}"}},
+				},
+				{
+					Query:    `SELECT jsonb_typeof('{}'), jsonb_typeof('[]'), jsonb_typeof('"a"'), jsonb_typeof('1.5'), jsonb_typeof('true'), jsonb_typeof('null'), json_typeof('[1]');`,
+					Expected: []sql.Row{{"object", "array", "string", "number", "boolean", "null", "array"}},
+				},
+			},
+		},
+		{
+			Name: "containment and deletion operators",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT '{"a": 1, "b": {"c": [1, 2]}}'::jsonb @> '{"b": {"c": [2]}}', '{"a": 1}'::jsonb @> '{"a": 2}', '[1, [2, 3]]'::jsonb @> '[[3]]';`,
+					Expected: []sql.Row{{"t", "f", "t"}},
+				},
+				{
+					Query:    `SELECT '["a", "b"]'::jsonb @> '"a"', '{"a": 1}'::jsonb <@ '{"a": 1, "b": 2}', '[1]'::jsonb @> '{}';`,
+					Expected: []sql.Row{{"t", "t", "f"}},
+				},
+				{
+					Query:    `SELECT '{"a": 1, "b": 2}'::jsonb - 'a', '["a", "b", "a"]'::jsonb - 'a', '{"a": 1, "b": 2, "c": 3}'::jsonb - '{a,c}'::text[];`,
+					Expected: []sql.Row{{`{"b": 2}`, `["b"]`, `{"b": 2}`}},
+				},
+				{
+					Query:    `SELECT '[1, 2, 3]'::jsonb - 1, '[1, 2, 3]'::jsonb - -1, '[1, 2, 3]'::jsonb - 5;`,
+					Expected: []sql.Row{{`[1, 3]`, `[1, 2]`, `[1, 2, 3]`}},
+				},
+				{
+					Query:       `SELECT '{"a": 1}'::jsonb - 0;`,
+					ExpectedErr: `cannot delete from object using integer index`,
+				},
+				{
+					Query:    `SELECT '{"a": 1}'::jsonb || '{"b": 2}', '[1]'::jsonb || '[2]';`,
+					Expected: []sql.Row{{`{"a": 1, "b": 2}`, `[1, 2]`}},
+				},
+			},
+		},
+		{
+			Name: "SQL/JSON path",
+			SetUpScript: []string{
+				`CREATE TABLE docs (id int primary key, doc jsonb);`,
+				`INSERT INTO docs VALUES (1, '{"a": [1, 2, 3], "b": "xyz"}'), (2, '{"a": [5], "b": "abc"}'), (3, '{"c": null}');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT jsonb_path_query('{"a": [1, 2, 3, 4]}', '$.a[*] ? (@ > 2)');`,
+					Expected: []sql.Row{{`3`}, {`4`}},
+				},
+				{
+					Query:    `SELECT jsonb_path_query_array('{"a": [1, 2, 3, 4]}', '$.a[1 to last]'), jsonb_path_query_first('{"a": [1, 2]}', '$.a[*]');`,
+					Expected: []sql.Row{{`[2, 3, 4]`, `1`}},
+				},
+				{
+					Query:    `SELECT jsonb_path_query_array('{"a": {"b": [{"c": 1}, {"c": 2}]}}', 'strict $.**.c'), jsonb_path_query_array('{"x": 1, "y": 2}', '$.*');`,
+					Expected: []sql.Row{{`[1, 2]`, `[1, 2]`}},
+				},
+				{
+					// Lax mode unwraps the array that .** also visits, so each value is selected twice
+					Query:    `SELECT jsonb_path_query_array('{"a": {"b": [{"c": 1}, {"c": 2}]}}', '$.**.c');`,
+					Expected: []sql.Row{{`[1, 2, 1, 2]`}},
+				},
+				{
+					Query:    `SELECT jsonb_path_query_array('[1, "a", null, [2], {}]', '$[*].type()'), jsonb_path_query_first('[1, 2, 3]', '$.size()');`,
+					Expected: []sql.Row{{`["number", "string", "null", "array", "object"]`, `3`}},
+				},
+				{
+					Query:    `SELECT jsonb_path_query_first('{"a": 2.5}', '$.a * 2 + 1'), jsonb_path_query_first('{"a": -2.5}', '$.a.abs().floor()');`,
+					Expected: []sql.Row{{`6`, `2`}},
+				},
+				{
+					Query:    `SELECT jsonb_path_query_array('[1, 2, 3, 4]', '$[*] ? (@ >= $min && @ <= $max)', '{"min": 2, "max": 3}');`,
+					Expected: []sql.Row{{`[2, 3]`}},
+				},
+				{
+					Query:    `SELECT jsonb_path_exists('{"a": [1, 2]}', '$.a ? (@ == 2)'), jsonb_path_exists('{"a": [1, 2]}', '$.b');`,
+					Expected: []sql.Row{{"t", "f"}},
+				},
+				{
+					Query:       `SELECT jsonb_path_exists('{"a": 1}', 'strict $.b');`,
+					ExpectedErr: `JSON object does not contain key "b"`,
+				},
+				{
+					Query:    `SELECT jsonb_path_exists('{"a": 1}', 'strict $.b', '{}', true);`,
+					Expected: []sql.Row{{nil}},
+				},
+				{
+					Query:    `SELECT jsonb_path_match('{"a": 1}', '$.a == 1'), jsonb_path_match('{"a": "x"}', '$.a == 1'), jsonb_path_match('{"a": "x"}', '($.a == 1) is unknown');`,
+					Expected: []sql.Row{{"t", nil, "t"}},
+				},
+				{
+					Query:       `SELECT jsonb_path_match('{"a": 1}', '$.a');`,
+					ExpectedErr: `single boolean result is expected`,
+				},
+				{
+					Query:    `SELECT jsonb_path_query_array('["abc", "abd", "xyz", "ABC"]', '$[*] ? (@ starts with "ab")'), jsonb_path_query_array('["abc", "abd", "xyz", "ABC"]', '$[*] ? (@ like_regex "^ab" flag "i")');`,
+					Expected: []sql.Row{{`["abc", "abd"]`, `["abc", "abd", "ABC"]`}},
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE doc @? '$.a[*] ? (@ > 2)' ORDER BY id;`,
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE doc @@ '$.b starts with "a"' ORDER BY id;`,
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    `SELECT id, doc @@ 'exists($.c)' FROM docs ORDER BY id;`,
+					Expected: []sql.Row{{1, "f"}, {2, "f"}, {3, "t"}},
+				},
+				{
+					Query:    `SELECT '{"a": 1}'::jsonb @? 'strict $.b', '{"a": 1}'::jsonb @@ '$.a';`,
+					Expected: []sql.Row{{nil, nil}},
+				},
+				{
+					Query:       `SELECT jsonb_path_query('{}', '$.a +');`,
+					ExpectedErr: `syntax error`,
+				},
+				{
+					Query:       `SELECT jsonb_path_query('{}', '$x');`,
+					ExpectedErr: `could not find jsonpath variable "x"`,
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,244 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestNetworkAddressTypes(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "inet and cidr input and output",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT '192.168.1.5'::inet, '192.168.1.5/24'::inet, '192.168.1.0/24'::inet, '::1'::inet, '2001:db8::1/64'::inet;`,
+					Expected: []sql.Row{{"192.168.1.5", "192.168.1.5/24", "192.168.1.0/24", "::1", "2001:db8::1/64"}},
+				},
+				{
+					Query:    `SELECT '192.168.1.0/24'::cidr, '10'::cidr, '128.1'::cidr, '192.168.1'::cidr, '2001:db8::/32'::cidr;`,
+					Expected: []sql.Row{{"192.168.1.0/24", "10.0.0.0/8", "128.1.0.0/16", "192.168.1.0/24", "2001:db8::/32"}},
+				},
+				{
+					Query:       `SELECT '192.168.1.5/24'::cidr;`,
+					ExpectedErr: `invalid cidr value: "192.168.1.5/24"`,
+				},
+				{
+					Query:       `SELECT '192.168.1.300'::inet;`,
+					ExpectedErr: `invalid input syntax for type inet: "192.168.1.300"`,
+				},
+				{
+					Query:       `SELECT '192.168.1.5/33'::inet;`,
+					ExpectedErr: `invalid input syntax for type inet`,
+				},
+				{
+					Query:    `SELECT '{192.168.1.5/24,::1}'::inet[];`,
+					Expected: []sql.Row{{"{192.168.1.5/24,::1}"}},
+				},
+				{
+					Query:    `SELECT '192.168.1.5'::inet::text, '192.168.1.0/24'::cidr::text, '192.168.1.5/24'::inet::cidr;`,
+					Expected: []sql.Row{{"192.168.1.5/32", "192.168.1.0/24", "192.168.1.0/24"}},
+				},
+			},
+		},
+		{
+			Name: "inet and cidr columns",
+			SetUpScript: []string{
+				`CREATE TABLE audit (id int primary key, client inet, subnet cidr);`,
+				`CREATE INDEX audit_client_idx ON audit (client);`,
+				`INSERT INTO audit VALUES (1, '192.168.1.5/24', '192.168.1.0/24'), (2, '10.0.0.1', '10.0.0.0/8'), (3, '::1', '::/0'), (4, '192.168.0.255', '192.168.0.0/16');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT id, client, subnet FROM audit ORDER BY client, id;`,
+					Expected: []sql.Row{
+						{2, "10.0.0.1", "10.0.0.0/8"},
+						{4, "192.168.0.255", "192.168.0.0/16"},
+						{1, "192.168.1.5/24", "192.168.1.0/24"},
+						{3, "::1", "::/0"},
+					},
+				},
+				{
+					Query:    `SELECT id FROM audit WHERE client = '10.0.0.1';`,
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    `SELECT id FROM audit WHERE client > '192.168.0.0' ORDER BY id;`,
+					Expected: []sql.Row{{1}, {3}, {4}},
+				},
+				{
+					Query:    `SELECT id FROM audit WHERE client << subnet ORDER BY id;`,
+					Expected: []sql.Row{{2}, {3}, {4}},
+				},
+				{
+					Query:    `SELECT id FROM audit WHERE client <<= subnet ORDER BY id;`,
+					Expected: []sql.Row{{1}, {2}, {3}, {4}},
+				},
+				{
+					Query:    `SELECT id FROM audit WHERE subnet >> '192.168.1.77' ORDER BY id;`,
+					Expected: []sql.Row{{1}, {4}},
+				},
+				{
+					Query:       `INSERT INTO audit VALUES (6, 'not an address', NULL);`,
+					ExpectedErr: `invalid input syntax for type inet`,
+				},
+				{
+					Query:    `INSERT INTO audit VALUES (6, '172.16.0.1/12'::inet, '172.16.0.1/12'::inet);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT client, subnet FROM audit WHERE id = 6;`,
+					Expected: []sql.Row{{"172.16.0.1/12", "172.16.0.0/12"}},
+				},
+			},
+		},
+		{
+			Name: "inet comparisons",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT '10.0.0.1'::inet < '10.0.0.2'::inet, '10.0.0.0/8'::inet < '10.0.0.0/16'::inet, '255.255.255.255'::inet < '::'::inet, '10.0.0.1/8'::inet = '10.0.0.1/16'::inet;`,
+					Expected: []sql.Row{{"t", "t", "t", "f"}},
+				},
+				{
+					Query:    `SELECT '192.168.1.0/24'::cidr = '192.168.1.0/24'::inet, '192.168.1.0/24'::cidr <> '192.168.1.1/24'::inet, '10.1.0.0/16'::inet >= '10.0.0.0/8'::inet;`,
+					Expected: []sql.Row{{"t", "t", "t"}},
+				},
+			},
+		},
+		{
+			Name: "inet containment operators",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT '192.168.1.5'::inet << '192.168.1.0/24'::inet, '192.168.1.0/24'::inet << '192.168.1.0/24'::inet, '192.168.1.0/24'::inet <<= '192.168.1.0/24'::inet;`,
+					Expected: []sql.Row{{"t", "f", "t"}},
+				},
+				{
+					Query:    `SELECT '192.168.1.0/24'::inet >> '192.168.1.5'::inet, '192.168.1.0/24'::inet >>= '192.168.1.0/24'::inet, '192.168.1.0/24'::inet >> '192.168.2.5'::inet;`,
+					Expected: []sql.Row{{"t", "t", "f"}},
+				},
+				{
+					Query:    `SELECT '192.168.1.0/24'::inet && '192.168.1.128/25'::inet, '192.168.1.0/24'::inet && '192.168.2.0/24'::inet, '::/0'::inet && '10.0.0.0/8'::inet;`,
+					Expected: []sql.Row{{"t", "f", "f"}},
+				},
+				{
+					Query:    `SELECT '2001:db8::1'::inet << '2001:db8::/32'::cidr;`,
+					Expected: []sql.Row{{"t"}},
+				},
+			},
+		},
+		{
+			Name: "inet functions",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT host('192.168.1.5/24'::inet), masklen('192.168.1.5/24'::inet), family('192.168.1.5'::inet), family('::1'::inet);`,
+					Expected: []sql.Row{{"192.168.1.5", 24, 4, 6}},
+				},
+				{
+					Query:    `SELECT network('192.168.1.5/24'::inet), broadcast('192.168.1.5/24'::inet), netmask('192.168.1.5/24'::inet), hostmask('192.168.1.5/24'::inet);`,
+					Expected: []sql.Row{{"192.168.1.0/24", "192.168.1.255/24", "255.255.255.0", "0.0.0.255"}},
+				},
+				{
+					Query:    `SELECT set_masklen('192.168.1.5/24'::inet, 16), set_masklen('192.168.1.0/24'::cidr, 16), set_masklen('192.168.1.5/24'::inet, -1);`,
+					Expected: []sql.Row{{"192.168.1.5/16", "192.168.0.0/16", "192.168.1.5"}},
+				},
+				{
+					Query:       `SELECT set_masklen('192.168.1.5'::inet, 33);`,
+					ExpectedErr: `invalid mask length: 33`,
+				},
+				{
+					Query:    `SELECT abbrev('10.1.0.0/16'::inet), abbrev('10.1.0.0/16'::cidr), abbrev('10.0.0.0/12'::cidr), abbrev('10.1.0.1'::inet);`,
+					Expected: []sql.Row{{"10.1.0.0/16", "10.1/16", "10.0/12", "10.1.0.1"}},
+				},
+				{
+					Query:    `SELECT host('2001:db8::1/64'::inet), masklen('2001:db8::/32'::cidr), network('2001:db8::1/64'::inet);`,
+					Expected: []sql.Row{{"2001:db8::1", 32, "2001:db8::/64"}},
+				},
+				{
+					Query:    `SELECT inet_same_family('10.0.0.1'::inet, '::1'::inet), inet_same_family('10.0.0.1'::inet, '10.0.0.2'::inet);`,
+					Expected: []sql.Row{{"f", "t"}},
+				},
+				{
+					Query:    `SELECT host(NULL::inet), masklen(NULL::inet);`,
+					Expected: []sql.Row{{nil, nil}},
+				},
+			},
+		},
+		{
+			Name: "macaddr and macaddr8",
+			SetUpScript: []string{
+				`CREATE TABLE devices (id int primary key, mac macaddr, mac8 macaddr8);`,
+				`INSERT INTO devices VALUES (1, '08:00:2b:01:02:03', '08:00:2b:01:02:03:04:05'), (2, '08-00-2B-01-02-01', '08002b0102030405'), (3, '0800.2b01.0204', '08:00:2b:01:02:03');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT id, mac, mac8 FROM devices ORDER BY mac;`,
+					Expected: []sql.Row{
+						{2, "08:00:2b:01:02:01", "08:00:2b:01:02:03:04:05"},
+						{1, "08:00:2b:01:02:03", "08:00:2b:01:02:03:04:05"},
+						{3, "08:00:2b:01:02:04", "08:00:2b:ff:fe:01:02:03"},
+					},
+				},
+				{
+					Query:    `SELECT id FROM devices WHERE mac = '08:00:2b:01:02:03';`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `SELECT id FROM devices WHERE mac8 < '08:00:2b:ff:00:00:00:00' ORDER BY id;`,
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    `SELECT trunc(mac) FROM devices WHERE id = 1;`,
+					Expected: []sql.Row{{"08:00:2b:00:00:00"}},
+				},
+				{
+					Query:    `SELECT '08:00:2b:01:02:03'::macaddr::macaddr8, '08:00:2b:ff:fe:01:02:03'::macaddr8::macaddr;`,
+					Expected: []sql.Row{{"08:00:2b:ff:fe:01:02:03", "08:00:2b:01:02:03"}},
+				},
+				{
+					Query:       `SELECT '08:00:2b:01:02:03:04:05'::macaddr8::macaddr;`,
+					ExpectedErr: `macaddr8 data out of range to convert to macaddr`,
+				},
+				{
+					Query:       `SELECT '08:00:2b:01:02'::macaddr;`,
+					ExpectedErr: `invalid input syntax for type macaddr`,
+				},
+				{
+					Query:       `SELECT '08:00:2b:01-02:03'::macaddr;`,
+					ExpectedErr: `invalid input syntax for type macaddr`,
+				},
+			},
+		},
+		{
+			Name: "network address catalogs",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT typname, typlen, typbyval, typcategory, typinput, typstorage FROM pg_catalog.pg_type WHERE typname IN ('inet', 'cidr', 'macaddr', 'macaddr8') ORDER BY typname;`,
+					Expected: []sql.Row{
+						{"cidr", -1, "f", "I", "cidr_in", "m"},
+						{"inet", -1, "f", "I", "inet_in", "m"},
+						{"macaddr", 6, "f", "U", "macaddr_in", "p"},
+						{"macaddr8", 8, "f", "U", "macaddr8_in", "p"},
+					},
+				},
+				{
+					Query:    `SELECT count(*) FROM pg_catalog.pg_stat_activity WHERE client_addr << '0.0.0.0/0'::inet OR client_addr IS NULL;`,
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+	})
+}
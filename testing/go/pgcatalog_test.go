@@ -766,6 +766,34 @@ func TestPgDatabase(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "pg_database connection settings",
+			SetUpScript: []string{
+				`CREATE DATABASE limited WITH ALLOW_CONNECTIONS false CONNECTION LIMIT 5;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT datname, datallowconn, datconnlimit FROM pg_catalog.pg_database WHERE datname='limited';",
+					Expected: []sql.Row{
+						{"limited", "f", 5},
+					},
+				},
+				{
+					Query:    "ALTER DATABASE limited WITH ALLOW_CONNECTIONS true CONNECTION LIMIT -1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT datname, datallowconn, datconnlimit FROM pg_catalog.pg_database WHERE datname='limited';",
+					Expected: []sql.Row{
+						{"limited", "t", -1},
+					},
+				},
+				{
+					Query:       "CREATE DATABASE bad_limit WITH CONNECTION LIMIT -2;",
+					ExpectedErr: "invalid connection limit",
+				},
+			},
+		},
 	})
 }
 
@@ -1087,8 +1115,11 @@ func TestPgHbaFileRules(t *testing.T) {
 			Name: "pg_hba_file_rules",
 			Assertions: []ScriptTestAssertion{
 				{
-					Query:    `SELECT * FROM "pg_catalog"."pg_hba_file_rules";`,
-					Expected: []sql.Row{},
+					Query: `SELECT * FROM "pg_catalog"."pg_hba_file_rules";`,
+					Expected: []sql.Row{
+						{1, "local", "{all}", "{all}", nil, nil, "scram-sha-256", nil, nil},
+						{2, "host", "{all}", "{all}", "all", nil, "scram-sha-256", nil, nil},
+					},
 				},
 				{ // Different cases and quoted, so it fails
 					Query:       `SELECT * FROM "PG_catalog"."pg_hba_file_rules";`,
@@ -1100,7 +1131,7 @@ func TestPgHbaFileRules(t *testing.T) {
 				},
 				{ // Different cases but non-quoted, so it works
 					Query:    "SELECT line_number FROM PG_catalog.pg_HBA_FILE_RULES ORDER BY line_number;",
-					Expected: []sql.Row{},
+					Expected: []sql.Row{{1}, {2}},
 				},
 			},
 		},
@@ -1687,8 +1718,18 @@ func TestPgProc(t *testing.T) {
 			Name: "pg_proc",
 			Assertions: []ScriptTestAssertion{
 				{
-					Query:    `SELECT * FROM "pg_catalog"."pg_proc";`,
-					Expected: []sql.Row{},
+					Query: `SELECT proname, pronargs, proargtypes, prokind, proacl FROM "pg_catalog"."pg_proc" WHERE proname = 'abs' ORDER BY proargtypes::text;`,
+					Expected: []sql.Row{
+						{"abs", 1, "{1700}", "f", nil},
+						{"abs", 1, "{20}", "f", nil},
+						{"abs", 1, "{21}", "f", nil},
+						{"abs", 1, "{23}", "f", nil},
+						{"abs", 1, "{701}", "f", nil},
+					},
+				},
+				{
+					Query:    `SELECT count(*) FROM "pg_catalog"."pg_proc" p JOIN "pg_catalog"."pg_namespace" n ON p.pronamespace = n.oid WHERE n.nspname <> 'pg_catalog';`,
+					Expected: []sql.Row{{0}},
 				},
 				{ // Different cases and quoted, so it fails
 					Query:       `SELECT * FROM "PG_catalog"."pg_proc";`,
@@ -1699,8 +1740,8 @@ func TestPgProc(t *testing.T) {
 					ExpectedErr: "not",
 				},
 				{ // Different cases but non-quoted, so it works
-					Query:    "SELECT proname FROM PG_catalog.pg_PROC ORDER BY proname;",
-					Expected: []sql.Row{},
+					Query:    "SELECT DISTINCT proname FROM PG_catalog.pg_PROC WHERE proname IN ('nextval', 'setval') ORDER BY proname;",
+					Expected: []sql.Row{{"nextval"}, {"setval"}},
 				},
 			},
 		},
@@ -1817,8 +1858,15 @@ func TestPgRange(t *testing.T) {
 			Name: "pg_range",
 			Assertions: []ScriptTestAssertion{
 				{
-					Query:    `SELECT * FROM "pg_catalog"."pg_range";`,
-					Expected: []sql.Row{},
+					Query: `SELECT * FROM "pg_catalog"."pg_range";`,
+					Expected: []sql.Row{
+						{3904, 23, 4451, 0, 0, "int4range_canonical", "int4range_subdiff"},
+						{3926, 20, 4536, 0, 0, "int8range_canonical", "int8range_subdiff"},
+						{3906, 1700, 4532, 0, 0, "-", "numrange_subdiff"},
+						{3908, 1114, 4533, 0, 0, "-", "tsrange_subdiff"},
+						{3910, 1184, 4534, 0, 0, "-", "tstzrange_subdiff"},
+						{3912, 1082, 4535, 0, 0, "daterange_canonical", "daterange_subdiff"},
+					},
 				},
 				{ // Different cases and quoted, so it fails
 					Query:       `SELECT * FROM "PG_catalog"."pg_range";`,
@@ -1830,7 +1878,7 @@ func TestPgRange(t *testing.T) {
 				},
 				{ // Different cases but non-quoted, so it works
 					Query:    "SELECT rngtypid FROM PG_catalog.pg_RANGE ORDER BY rngtypid;",
-					Expected: []sql.Row{},
+					Expected: []sql.Row{{3904}, {3906}, {3908}, {3910}, {3912}, {3926}},
 				},
 			},
 		},
@@ -16,8 +16,11 @@ package _go
 
 import (
 	"testing"
+	"time"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqlserver"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -561,3 +564,290 @@ func RunScriptN(t *testing.T, script ScriptTest, n int) {
 		})
 	}
 }
+
+func TestPreparedBinaryFormats(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	pgConn := conn.Default.PgConn()
+
+	_, err := conn.Exec(ctx, "CREATE TABLE test (pk INT8 PRIMARY KEY, v1 TEXT, v2 BYTEA, v3 INT4[]);")
+	require.NoError(t, err)
+
+	// Binary parameters, where int8 is 8 bytes in network byte order and int4[] is a one-dimensional array header
+	// followed by its length-prefixed elements.
+	params := [][]byte{
+		{0, 0, 0, 0, 0, 0, 0, 1},
+		[]byte("abc"),
+		{0xDE, 0xAD},
+		{0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 23, 0, 0, 0, 2, 0, 0, 0, 1, 0, 0, 0, 4, 0, 0, 0, 7, 0, 0, 0, 4, 0, 0, 0, 9},
+	}
+	result := pgConn.ExecParams(ctx, "INSERT INTO test VALUES ($1, $2, $3, $4);", params,
+		[]uint32{20, 25, 17, 1007}, []int16{1}, nil).Read()
+	require.NoError(t, result.Err)
+
+	// A single result format code applies to every column
+	result = pgConn.ExecParams(ctx, "SELECT pk, v1, v2, v3 FROM test;", nil, nil, nil, []int16{1}).Read()
+	require.NoError(t, result.Err)
+	require.Len(t, result.Rows, 1)
+	for _, field := range result.FieldDescriptions {
+		assert.Equal(t, int16(1), field.Format)
+	}
+	assert.Equal(t, params, result.Rows[0])
+
+	// Result format codes may also be given per column
+	result = pgConn.ExecParams(ctx, "SELECT pk, v1, v2, v3 FROM test;", nil, nil, nil, []int16{0, 1, 0, 0}).Read()
+	require.NoError(t, result.Err)
+	require.Len(t, result.Rows, 1)
+	assert.Equal(t, [][]byte{[]byte("1"), []byte("abc"), []byte(`\xdead`), []byte("{7,9}")}, result.Rows[0])
+
+	// Text parameters may be mixed with binary parameters
+	result = pgConn.ExecParams(ctx, "SELECT v1 FROM test WHERE pk = $1 AND v1 = $2;",
+		[][]byte{[]byte("1"), []byte("abc")}, []uint32{20, 25}, []int16{0, 1}, nil).Read()
+	require.NoError(t, result.Err)
+	assert.Equal(t, [][][]byte{{[]byte("abc")}}, result.Rows)
+
+	// Malformed binary data is rejected
+	result = pgConn.ExecParams(ctx, "SELECT v1 FROM test WHERE pk = $1;",
+		[][]byte{{0, 1}}, []uint32{20}, []int16{1}, nil).Read()
+	require.Error(t, result.Err)
+	assert.Contains(t, result.Err.Error(), "incorrect binary data format")
+
+	result = pgConn.ExecParams(ctx, "SELECT v1 FROM test;", nil, nil, nil, []int16{2}).Read()
+	require.Error(t, result.Err)
+	assert.Contains(t, result.Err.Error(), "unsupported format code: 2")
+
+	// The number of result format codes must match the number of columns when there's more than one
+	result = pgConn.ExecParams(ctx, "SELECT pk, v1, v2 FROM test;", nil, nil, nil, []int16{1, 0}).Read()
+	require.Error(t, result.Err)
+	assert.Contains(t, result.Err.Error(), "bind message has 2 result formats but query has 3 columns (SQLSTATE 08P01)")
+
+	// Columns that do not use our own types, such as the result of count(*), are also sent using binary
+	result = pgConn.ExecParams(ctx, "SELECT count(*) FROM test;", nil, nil, nil, []int16{1}).Read()
+	require.NoError(t, result.Err)
+	for _, field := range result.FieldDescriptions {
+		assert.Equal(t, int16(1), field.Format)
+	}
+	assert.Equal(t, [][][]byte{{{0, 0, 0, 0, 0, 0, 0, 1}}}, result.Rows)
+}
+
+func TestPreparedPortalSuspension(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	_, err := conn.Exec(ctx, "CREATE TABLE test (pk INT8 PRIMARY KEY);")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "INSERT INTO test VALUES (1), (2), (3), (4), (5);")
+	require.NoError(t, err)
+
+	frontend := conn.Default.PgConn().Frontend()
+	// roundTrip sends the given messages followed by a Sync, returning the responses that precede the ReadyForQuery
+	roundTrip := func(t *testing.T, messages ...pgproto3.FrontendMessage) []pgproto3.BackendMessage {
+		for _, message := range messages {
+			frontend.Send(message)
+		}
+		frontend.Send(&pgproto3.Sync{})
+		require.NoError(t, frontend.Flush())
+		var responses []pgproto3.BackendMessage
+		for {
+			response, err := frontend.Receive()
+			require.NoError(t, err)
+			if _, ok := response.(*pgproto3.ReadyForQuery); ok {
+				return responses
+			}
+			// The frontend reuses its messages, so we record a description of each one instead
+			switch response := response.(type) {
+			case *pgproto3.DataRow:
+				responses = append(responses, &pgproto3.DataRow{Values: [][]byte{[]byte(string(response.Values[0]))}})
+			case *pgproto3.CommandComplete:
+				responses = append(responses, &pgproto3.CommandComplete{CommandTag: []byte(string(response.CommandTag))})
+			case *pgproto3.ErrorResponse:
+				responses = append(responses, &pgproto3.ErrorResponse{Code: response.Code, Message: response.Message})
+			default:
+				responses = append(responses, response)
+			}
+		}
+	}
+	row := func(val string) *pgproto3.DataRow {
+		return &pgproto3.DataRow{Values: [][]byte{[]byte(val)}}
+	}
+
+	t.Run("unnamed portal", func(t *testing.T) {
+		responses := roundTrip(t,
+			&pgproto3.Parse{Query: "SELECT pk FROM test ORDER BY pk;"},
+			&pgproto3.Bind{},
+			&pgproto3.Execute{MaxRows: 2},
+			&pgproto3.Execute{MaxRows: 2},
+			&pgproto3.Execute{},
+		)
+		assert.Equal(t, []pgproto3.BackendMessage{
+			&pgproto3.ParseComplete{},
+			&pgproto3.BindComplete{},
+			row("1"), row("2"),
+			&pgproto3.PortalSuspended{},
+			row("3"), row("4"),
+			&pgproto3.PortalSuspended{},
+			row("5"),
+			&pgproto3.CommandComplete{CommandTag: []byte("SELECT 1")},
+		}, responses)
+	})
+	t.Run("unnamed portal is destroyed by Sync outside of a transaction", func(t *testing.T) {
+		responses := roundTrip(t,
+			&pgproto3.Parse{Query: "SELECT pk FROM test ORDER BY pk;"},
+			&pgproto3.Bind{},
+			&pgproto3.Execute{MaxRows: 4},
+		)
+		assert.Equal(t, []pgproto3.BackendMessage{
+			&pgproto3.ParseComplete{},
+			&pgproto3.BindComplete{},
+			row("1"), row("2"), row("3"), row("4"),
+			&pgproto3.PortalSuspended{},
+		}, responses)
+		responses = roundTrip(t, &pgproto3.Execute{MaxRows: 4})
+		require.Len(t, responses, 1)
+		require.IsType(t, &pgproto3.ErrorResponse{}, responses[0])
+		assert.Contains(t, responses[0].(*pgproto3.ErrorResponse).Message, "portal  does not exist")
+	})
+	t.Run("named portal lasts until the end of the transaction", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "BEGIN;")
+		require.NoError(t, err)
+		responses := roundTrip(t,
+			&pgproto3.Parse{Name: "stmt", Query: "SELECT pk FROM test ORDER BY pk;"},
+			&pgproto3.Bind{DestinationPortal: "portal", PreparedStatement: "stmt"},
+			&pgproto3.Execute{Portal: "portal", MaxRows: 3},
+		)
+		assert.Equal(t, []pgproto3.BackendMessage{
+			&pgproto3.ParseComplete{},
+			&pgproto3.BindComplete{},
+			row("1"), row("2"), row("3"),
+			&pgproto3.PortalSuspended{},
+		}, responses)
+		_, err = conn.Exec(ctx, "INSERT INTO test VALUES (6);")
+		require.NoError(t, err)
+		responses = roundTrip(t, &pgproto3.Execute{Portal: "portal", MaxRows: 3})
+		assert.Equal(t, []pgproto3.BackendMessage{
+			row("4"), row("5"),
+			&pgproto3.CommandComplete{CommandTag: []byte("SELECT 2")},
+		}, responses)
+		responses = roundTrip(t, &pgproto3.Execute{Portal: "portal", MaxRows: 3})
+		assert.Equal(t, []pgproto3.BackendMessage{
+			&pgproto3.CommandComplete{CommandTag: []byte("SELECT 0")},
+		}, responses)
+		responses = roundTrip(t,
+			&pgproto3.Bind{DestinationPortal: "portal", PreparedStatement: "stmt"},
+			&pgproto3.Execute{Portal: "portal", MaxRows: 1},
+		)
+		assert.Equal(t, []pgproto3.BackendMessage{
+			&pgproto3.BindComplete{},
+			row("1"),
+			&pgproto3.PortalSuspended{},
+		}, responses)
+		_, err = conn.Exec(ctx, "COMMIT;")
+		require.NoError(t, err)
+		responses = roundTrip(t, &pgproto3.Execute{Portal: "portal", MaxRows: 1})
+		require.Len(t, responses, 1)
+		require.IsType(t, &pgproto3.ErrorResponse{}, responses[0])
+		assert.Contains(t, responses[0].(*pgproto3.ErrorResponse).Message, "portal portal does not exist")
+	})
+	t.Run("a suspended portal does not hold the connection's query", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "BEGIN;")
+		require.NoError(t, err)
+		query := "SELECT pk FROM test WHERE pk > 0 ORDER BY pk;"
+		responses := roundTrip(t,
+			&pgproto3.Parse{Name: "suspended", Query: query},
+			&pgproto3.Bind{DestinationPortal: "suspended", PreparedStatement: "suspended"},
+			&pgproto3.Execute{Portal: "suspended", MaxRows: 1},
+		)
+		assert.Equal(t, []pgproto3.BackendMessage{
+			&pgproto3.ParseComplete{},
+			&pgproto3.BindComplete{},
+			row("1"),
+			&pgproto3.PortalSuspended{},
+		}, responses)
+		// The suspended portal's query is only in the process list while its rows are being read
+		processList := sqlserver.GetRunningServer().Engine.ProcessList
+		for _, process := range processList.Processes() {
+			assert.NotEqual(t, query, process.Query)
+		}
+		// Other statements may run between reads, and killing the connection's query does not affect the portal
+		_, err = conn.Exec(ctx, "SELECT pk FROM test;")
+		require.NoError(t, err)
+		for _, process := range processList.Processes() {
+			processList.Kill(process.Connection)
+		}
+		responses = roundTrip(t, &pgproto3.Execute{Portal: "suspended", MaxRows: 1})
+		assert.Equal(t, []pgproto3.BackendMessage{
+			row("2"),
+			&pgproto3.PortalSuspended{},
+		}, responses)
+		_, err = conn.Exec(ctx, "ROLLBACK;")
+		require.NoError(t, err)
+	})
+}
+
+func TestPreparedBinaryTimestamps(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	_, err := conn.Exec(ctx, "CREATE TABLE test (pk INT4 PRIMARY KEY, ts TIMESTAMP, tz TIMESTAMPTZ);")
+	require.NoError(t, err)
+
+	// These are outside the range of an int64 of nanoseconds from the Postgres epoch, which covers roughly 1708 to 2292
+	times := []time.Time{
+		time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1500, 6, 15, 12, 30, 45, 123456000, time.UTC),
+		time.Date(1707, 12, 31, 23, 59, 59, 999999000, time.UTC),
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2293, 1, 1, 0, 0, 0, 1000, time.UTC),
+		time.Date(9999, 12, 31, 23, 59, 59, 999999000, time.UTC),
+	}
+	texts := []string{
+		"1000-01-01 00:00:00",
+		"1500-06-15 12:30:45.123456",
+		"1707-12-31 23:59:59.999999",
+		"2000-01-01 00:00:00",
+		"2293-01-01 00:00:00.000001",
+		"9999-12-31 23:59:59.999999",
+	}
+	_, err = conn.Exec(ctx, "SET TIME ZONE 'UTC';")
+	require.NoError(t, err)
+	// pgx sends time.Time parameters using the binary format
+	for i, tm := range times {
+		_, err = conn.Exec(ctx, "INSERT INTO test VALUES ($1, $2, $3);", i, tm, tm)
+		require.NoError(t, err)
+	}
+
+	// The text output shows that the binary parameters were decoded correctly
+	rows, err := conn.Query(ctx, "SELECT ts::text, tz::text FROM test ORDER BY pk;")
+	require.NoError(t, err)
+	readRows, err := ReadRows(rows, true)
+	require.NoError(t, err)
+	require.Len(t, readRows, len(texts))
+	for i, text := range texts {
+		assert.Equal(t, sql.Row{text, text + "+00"}, readRows[i])
+	}
+
+	// pgx requests the binary format for timestamp results, so the scanned values show that they were encoded correctly
+	rows, err = conn.Query(ctx, "SELECT ts, tz FROM test ORDER BY pk;")
+	require.NoError(t, err)
+	i := 0
+	for rows.Next() {
+		var ts, tz time.Time
+		require.NoError(t, rows.Scan(&ts, &tz))
+		assert.True(t, times[i].Equal(ts), "expected %s but got %s", times[i], ts)
+		assert.True(t, times[i].Equal(tz), "expected %s but got %s", times[i], tz)
+		i++
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, len(times), i)
+}
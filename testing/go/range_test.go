@@ -0,0 +1,344 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestRangeTypes(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "range input and output",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT '[1,10)'::int4range, '(1,10]'::int4range, '[1,10]'::int8range, '(,5)'::int4range, '(,)'::int4range;`,
+					Expected: []sql.Row{{"[1,10)", "[2,11)", "[1,11)", "(,5)", "(,)"}},
+				},
+				{
+					Query:    `SELECT '[1,1)'::int4range, '(1,2)'::int4range, 'empty'::int4range, ' [ 3 , 7 ) '::int4range;`,
+					Expected: []sql.Row{{"empty", "empty", "empty", "[3,7)"}},
+				},
+				{
+					Query:    `SELECT '[1.5,2.25]'::numrange, '(1.5,1.5)'::numrange, '[1.5,1.5]'::numrange;`,
+					Expected: []sql.Row{{"[1.5,2.25]", "empty", "[1.5,1.5]"}},
+				},
+				{
+					Query:    `SELECT '[2024-01-01,2024-01-31]'::daterange, '(2024-01-01,)'::daterange;`,
+					Expected: []sql.Row{{"[2024-01-01,2024-02-01)", "[2024-01-02,)"}},
+				},
+				{
+					Query:    `SELECT '["2024-01-01 10:00","2024-01-01 12:00")'::tsrange;`,
+					Expected: []sql.Row{{`["2024-01-01 10:00:00","2024-01-01 12:00:00")`}},
+				},
+				{
+					Query:    `SELECT '[1,10)'::int4range::text, '[1,10]'::int4range::text;`,
+					Expected: []sql.Row{{"[1,10)", "[1,11)"}},
+				},
+				{
+					Query:       `SELECT '[10,1)'::int4range;`,
+					ExpectedErr: `range lower bound must be less than or equal to range upper bound`,
+				},
+				{
+					Query:       `SELECT '[1,10'::int4range;`,
+					ExpectedErr: `malformed range literal`,
+				},
+				{
+					Query:       `SELECT '[a,10)'::int4range;`,
+					ExpectedErr: `invalid input syntax for type integer`,
+				},
+			},
+		},
+		{
+			Name: "range constructors",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT int4range(1, 10), int4range(1, 10, '[]'), int4range(1, 10, '()'), int4range(NULL, 10), int4range(5, 5);`,
+					Expected: []sql.Row{{"[1,10)", "[1,11)", "[2,10)", "(,10)", "empty"}},
+				},
+				{
+					Query:    `SELECT int8range(1, 10, '(]'), numrange(1.5, 2.5, '(]'), daterange('2024-01-01', '2024-01-10', '[]');`,
+					Expected: []sql.Row{{"[2,11)", "(1.5,2.5]", "[2024-01-01,2024-01-11)"}},
+				},
+				{
+					Query:    `SELECT tsrange('2024-01-01 10:00', NULL)::text;`,
+					Expected: []sql.Row{{`["2024-01-01 10:00:00",)`}},
+				},
+				{
+					Query:       `SELECT int4range(1, 10, '[');`,
+					ExpectedErr: `invalid range bound flags`,
+				},
+				{
+					Query:       `SELECT int4range(10, 1);`,
+					ExpectedErr: `range lower bound must be less than or equal to range upper bound`,
+				},
+			},
+		},
+		{
+			Name: "range functions",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT lower(int4range(1, 10)), upper(int4range(1, 10)), lower(numrange(1.5, 2.5)), upper('[2024-01-01,2024-01-31]'::daterange);`,
+					Expected: []sql.Row{{1, 10, Numeric("1.5"), "2024-02-01"}},
+				},
+				{
+					Query:    `SELECT lower('(,5)'::int4range), upper('(,5)'::int4range), lower('empty'::int4range);`,
+					Expected: []sql.Row{{nil, 5, nil}},
+				},
+				{
+					Query:    `SELECT isempty('empty'::int4range), isempty(int4range(1, 2)), isempty(int4range(1, 1));`,
+					Expected: []sql.Row{{"t", "f", "t"}},
+				},
+				{
+					Query:    `SELECT lower_inc('[1,2)'::numrange), upper_inc('[1,2)'::numrange), lower_inc('(1,2]'::numrange), upper_inc('(1,2]'::numrange);`,
+					Expected: []sql.Row{{"t", "f", "f", "t"}},
+				},
+				{
+					Query:    `SELECT lower_inf('(,2)'::int4range), upper_inf('(,2)'::int4range), lower_inf('empty'::int4range), upper_inf('[1,)'::int4range);`,
+					Expected: []sql.Row{{"t", "f", "f", "t"}},
+				},
+				{
+					Query:    `SELECT lower('ABC');`,
+					Expected: []sql.Row{{"abc"}},
+				},
+			},
+		},
+		{
+			Name: "range operators",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT int4range(1, 5) && int4range(4, 8), int4range(1, 5) && int4range(5, 8), int4range(1, 5) && 'empty'::int4range;`,
+					Expected: []sql.Row{{"t", "f", "f"}},
+				},
+				{
+					Query:    `SELECT int4range(1, 10) @> int4range(2, 5), int4range(1, 10) @> int4range(5, 15), int4range(1, 10) @> 'empty'::int4range;`,
+					Expected: []sql.Row{{"t", "f", "t"}},
+				},
+				{
+					Query:    `SELECT int4range(2, 5) <@ int4range(1, 10), int4range(1, 10) @> 5, int4range(1, 10) @> 10, 10 <@ int4range(1, 10, '[]');`,
+					Expected: []sql.Row{{"t", "t", "f", "t"}},
+				},
+				{
+					Query:    `SELECT '[2024-01-01,2024-02-01)'::daterange @> '2024-01-15'::date, numrange(1.5, 2.5) @> 2.0;`,
+					Expected: []sql.Row{{"t", "t"}},
+				},
+				{
+					Query:    `SELECT int4range(1, 5) << int4range(5, 8), int4range(1, 6) << int4range(5, 8), int4range(5, 8) >> int4range(1, 5);`,
+					Expected: []sql.Row{{"t", "f", "t"}},
+				},
+				{
+					Query:    `SELECT int4range(1, 5) = '[1,4]'::int4range, int4range(1, 5) <> int4range(1, 6), int4range(1, 5) < int4range(1, 6), int4range(2, 3) > int4range(1, 10), 'empty'::int4range < int4range(1, 2);`,
+					Expected: []sql.Row{{"t", "t", "t", "t", "t"}},
+				},
+				{
+					Query:    `SELECT '(,5)'::int4range < '[1,5)'::int4range, '[1,)'::int4range > '[1,5)'::int4range, int4range(1, 5) <= int4range(1, 5), int4range(1, 5) >= int4range(1, 6);`,
+					Expected: []sql.Row{{"t", "t", "t", "f"}},
+				},
+				{
+					Query:       `SELECT isempty('empty');`,
+					ExpectedErr: `could not determine polymorphic type because input has type unknown`,
+				},
+			},
+		},
+		{
+			Name: "range columns",
+			SetUpScript: []string{
+				`CREATE TABLE bookings (id int primary key, room text, during tstzrange, nights daterange);`,
+				`INSERT INTO bookings VALUES (1, 'a', '[2024-01-01 14:00+00,2024-01-03 10:00+00)', '[2024-01-01,2024-01-03)');`,
+				`INSERT INTO bookings VALUES (2, 'a', tstzrange('2024-01-05 14:00+00', '2024-01-07 10:00+00'), daterange('2024-01-05', '2024-01-07'));`,
+				`INSERT INTO bookings VALUES (3, 'b', '[2024-01-02 14:00+00,2024-01-04 10:00+00)', '[2024-01-02,2024-01-04]'), (4, 'b', NULL, 'empty');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT id, nights FROM bookings ORDER BY id;`,
+					Expected: []sql.Row{{1, "[2024-01-01,2024-01-03)"}, {2, "[2024-01-05,2024-01-07)"}, {3, "[2024-01-02,2024-01-05)"}, {4, "empty"}},
+				},
+				{
+					Query:    `SELECT id FROM bookings WHERE during && tstzrange('2024-01-02 00:00+00', '2024-01-02 12:00+00') ORDER BY id;`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `SELECT id FROM bookings WHERE during @> '2024-01-03 09:00+00'::timestamptz ORDER BY id;`,
+					Expected: []sql.Row{{1}, {3}},
+				},
+				{
+					Query:    `SELECT id FROM bookings WHERE nights @> '2024-01-04'::date ORDER BY id;`,
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:    `SELECT id, lower(nights), upper(nights), isempty(nights) FROM bookings ORDER BY id;`,
+					Expected: []sql.Row{{1, "2024-01-01", "2024-01-03", "f"}, {2, "2024-01-05", "2024-01-07", "f"}, {3, "2024-01-02", "2024-01-05", "f"}, {4, nil, nil, "t"}},
+				},
+				{
+					Query:    `SELECT id FROM bookings ORDER BY nights, id;`,
+					Expected: []sql.Row{{4}, {1}, {3}, {2}},
+				},
+				{
+					Query:    `UPDATE bookings SET nights = daterange(lower(nights), '2024-01-08') WHERE id = 2;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT nights FROM bookings WHERE id = 2;`,
+					Expected: []sql.Row{{"[2024-01-05,2024-01-08)"}},
+				},
+				{
+					Query:    `SELECT upper(during) = '2024-01-03 10:00+00'::timestamptz, lower_inc(during), upper_inc(during) FROM bookings WHERE id = 1;`,
+					Expected: []sql.Row{{"t", "t", "f"}},
+				},
+			},
+		},
+		{
+			Name: "indexed range columns",
+			SetUpScript: []string{
+				`CREATE TABLE windows (id int primary key, room text, during tstzrange);`,
+				`CREATE INDEX windows_during ON windows (during);`,
+				`CREATE TABLE r3 (r int4range primary key);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `INSERT INTO windows VALUES (1, 'a', '[2024-01-05 14:00+00,2024-01-07 10:00+00)'), (2, 'a', '[2024-01-01 14:00+00,2024-01-03 10:00+00)'), (3, 'b', 'empty'), (4, 'b', NULL);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT id FROM windows WHERE during = '[2024-01-01 14:00+00,2024-01-03 10:00+00)';`,
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    `SELECT id FROM windows WHERE during IS NOT NULL ORDER BY during;`,
+					Expected: []sql.Row{{3}, {2}, {1}},
+				},
+				{
+					Query:    `SELECT id FROM windows WHERE during > '[2024-01-02 00:00+00,2024-01-02 12:00+00)' ORDER BY during;`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `SELECT id FROM windows WHERE during && tstzrange('2024-01-02 00:00+00', '2024-01-06 00:00+00') ORDER BY id;`,
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    `UPDATE windows SET during = '[2024-01-08 14:00+00,2024-01-09 10:00+00)' WHERE id = 1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT id FROM windows WHERE during >= '[2024-01-08 14:00+00,2024-01-09 10:00+00)';`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `INSERT INTO r3 VALUES ('[5,10)'), ('[1,3)'), ('(0,3]'), ('empty');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `INSERT INTO r3 VALUES ('[1,4)');`,
+					ExpectedErr: `duplicate`,
+				},
+				{
+					Query:    `SELECT * FROM r3;`,
+					Expected: []sql.Row{{"empty"}, {"[1,3)"}, {"[1,4)"}, {"[5,10)"}},
+				},
+				{
+					Query:    `SELECT * FROM r3 WHERE r < '[5,10)' ORDER BY r DESC;`,
+					Expected: []sql.Row{{"[1,4)"}, {"[1,3)"}, {"empty"}},
+				},
+			},
+		},
+		{
+			Name: "create range type",
+			SetUpScript: []string{
+				`CREATE TYPE floatrange AS RANGE (subtype = float8);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT '[1.5,2.5)'::floatrange::text, '(1.5,1.5)'::floatrange::text;`,
+					Expected: []sql.Row{{"[1.5,2.5)", "empty"}},
+				},
+				{
+					Query:    `SELECT '[1.5,2.5)'::floatrange @> 2.0::float8, '[1.5,2.5)'::floatrange && '[2,3]'::floatrange, upper('[1.5,2.5)'::floatrange);`,
+					Expected: []sql.Row{{"t", "t", 2.5}},
+				},
+				{
+					Query:       `CREATE TYPE floatrange AS RANGE (subtype = float4);`,
+					ExpectedErr: `type "floatrange" already exists`,
+				},
+				{
+					Query:       `CREATE TYPE badrange AS RANGE (subtype = no_such_type);`,
+					ExpectedErr: `type "no_such_type" does not exist`,
+				},
+				{
+					Query:    `CREATE TABLE measurements (id int primary key, span floatrange);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `INSERT INTO measurements VALUES (1, '[0,1]'), (2, '(,0)');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT id, span::text FROM measurements ORDER BY span;`,
+					Expected: []sql.Row{{2, "(,0)"}, {1, "[0,1]"}},
+				},
+				{
+					Query:       `DROP TYPE floatrange;`,
+					ExpectedErr: `cannot drop type floatrange because other objects depend on it`,
+				},
+				{
+					Query:    `DROP TABLE measurements;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `DROP TYPE floatrange;`,
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "range subtype dependencies",
+			SetUpScript: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');`,
+				`CREATE TYPE moodrange AS RANGE (subtype = mood);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT '[sad,happy)'::moodrange::text, '[ok,happy)'::moodrange @> 'ok'::mood;`,
+					Expected: []sql.Row{{"[sad,happy)", "t"}},
+				},
+				{
+					Query:       `DROP TYPE mood;`,
+					ExpectedErr: `cannot drop type mood because other objects depend on it`,
+				},
+			},
+		},
+		{
+			Name: "range catalog tables",
+			SetUpScript: []string{
+				`CREATE TYPE floatrange AS RANGE (subtype = float8);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT typname, typtype, typcategory, typinput, typoutput FROM pg_catalog.pg_type WHERE typname IN ('int4range', 'floatrange') ORDER BY typname;`,
+					Expected: []sql.Row{{"floatrange", "r", "R", "range_in", "range_out"}, {"int4range", "r", "R", "range_in", "range_out"}},
+				},
+				{
+					Query:    `SELECT t.typname, r.rngsubtype::regtype::text, r.rngcanonical::text FROM pg_catalog.pg_range r JOIN pg_catalog.pg_type t ON r.rngtypid = t.oid WHERE t.typname IN ('int4range', 'daterange') ORDER BY t.typname;`,
+					Expected: []sql.Row{{"daterange", "date", "daterange_canonical"}, {"int4range", "integer", "int4range_canonical"}},
+				},
+				{
+					Query:    `SELECT r.rngsubtype::regtype::text FROM pg_catalog.pg_range r JOIN pg_catalog.pg_type t ON r.rngtypid = t.oid WHERE t.typname = 'floatrange';`,
+					Expected: []sql.Row{{"double precision"}},
+				},
+			},
+		},
+	})
+}
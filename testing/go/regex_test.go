@@ -0,0 +1,219 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestRegex(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "regular expression operators",
+			SetUpScript: []string{
+				`CREATE TABLE t (id int primary key, v text);`,
+				`INSERT INTO t VALUES (1, 'apple'), (2, 'Banana'), (3, 'cherry pie'), (4, NULL);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT 'abc' ~ 'b', 'abc' ~ '^b', 'abc' ~* '^A', 'abc' !~ 'd', 'abc' !~* 'B';`,
+					Expected: []sql.Row{{"t", "f", "t", "t", "f"}},
+				},
+				{
+					Query:    `SELECT id FROM t WHERE v ~ '^[a-z]+$' ORDER BY id;`,
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    `SELECT id FROM t WHERE v ~* '^b' ORDER BY id;`,
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    `SELECT id FROM t WHERE v !~ 'an' ORDER BY id;`,
+					Expected: []sql.Row{{1}, {3}},
+				},
+				{
+					Query:    `SELECT 'foo bar' ~ '\mbar\M', 'foobar' ~ '\ybar', 'a.c' ~ 'a\.c', 'abc' ~ 'a\.c';`,
+					Expected: []sql.Row{{"t", "f", "t", "f"}},
+				},
+				{
+					Query:    `SELECT 'abc' ~ NULL, NULL ~ 'abc';`,
+					Expected: []sql.Row{{nil, nil}},
+				},
+				{
+					Query:       `SELECT 'abc' ~ '(';`,
+					ExpectedErr: `invalid regular expression`,
+				},
+			},
+		},
+		{
+			Name: "ILIKE and SIMILAR TO",
+			SetUpScript: []string{
+				`CREATE TABLE t (id int primary key, v text);`,
+				`INSERT INTO t VALUES (1, 'apple'), (2, 'Banana'), (3, 'cherry pie'), (4, '50%');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT id FROM t WHERE v ILIKE 'b%' ORDER BY id;`,
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    `SELECT id FROM t WHERE v NOT ILIKE '%A%' ORDER BY id;`,
+					Expected: []sql.Row{{3}, {4}},
+				},
+				{
+					Query:    `SELECT 'ABC' ILIKE 'a_c', 'ABC' NOT ILIKE 'a_c', 'ABC' ILIKE 'a_';`,
+					Expected: []sql.Row{{"t", "f", "f"}},
+				},
+				{
+					Query:    `SELECT id FROM t WHERE v ILIKE '%#%' ESCAPE '#' ORDER BY id;`,
+					Expected: []sql.Row{{4}},
+				},
+				{
+					Query:    `SELECT id FROM t WHERE v LIKE '%!%' ESCAPE '!' ORDER BY id;`,
+					Expected: []sql.Row{{4}},
+				},
+				{
+					Query:    `SELECT id FROM t WHERE v SIMILAR TO '(apple|cherry)%' ORDER BY id;`,
+					Expected: []sql.Row{{1}, {3}},
+				},
+				{
+					Query:    `SELECT id FROM t WHERE v NOT SIMILAR TO '%(a|e)' ORDER BY id;`,
+					Expected: []sql.Row{{4}},
+				},
+				{
+					Query:    `SELECT 'abc' SIMILAR TO 'abc', 'abc' SIMILAR TO 'a', 'abc' SIMILAR TO '%(b|d)%', 'abc' SIMILAR TO '(b|c)%';`,
+					Expected: []sql.Row{{"t", "f", "t", "f"}},
+				},
+				{
+					Query:    `SELECT '50%' SIMILAR TO '50#%' ESCAPE '#', '500' SIMILAR TO '50#%' ESCAPE '#';`,
+					Expected: []sql.Row{{"t", "f"}},
+				},
+				{
+					Query:    `SELECT similar_to_escape('a%b_c'), like_escape('a#%b', '#');`,
+					Expected: []sql.Row{{"^(?:a.*b.c)$", `a\%b`}},
+				},
+			},
+		},
+		{
+			Name: "regexp functions",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT regexp_match('foobarbequebaz', 'bar.*que'), regexp_match('foobarbequebaz', '(bar)(beque)');`,
+					Expected: []sql.Row{{"{barbeque}", "{bar,beque}"}},
+				},
+				{
+					Query:    `SELECT regexp_match('abc', 'd'), regexp_match('ABC', 'b', 'i');`,
+					Expected: []sql.Row{{nil, "{B}"}},
+				},
+				{
+					Query:       `SELECT regexp_match('abc', 'b', 'g');`,
+					ExpectedErr: `regexp_match() does not support the "global" option`,
+				},
+				{
+					Query:    `SELECT regexp_replace('Thomas', '.[mN]a.', 'M'), regexp_replace('foobarbaz', 'b..', 'X', 'g');`,
+					Expected: []sql.Row{{"ThM", "fooXX"}},
+				},
+				{
+					Query:    `SELECT regexp_replace('foobarbaz', 'b(..)', 'X\1Y', 'g'), regexp_replace('abc', 'b', '[\&]');`,
+					Expected: []sql.Row{{"fooXarYXazY", "a[b]c"}},
+				},
+				{
+					Query:    `SELECT regexp_replace('A PostgreSQL function', 'a|e|i|o|u', 'X', 'gi');`,
+					Expected: []sql.Row{{"X PXstgrXSQL fXnctXXn"}},
+				},
+				{
+					Query:    `SELECT regexp_replace('abc', 'b  # the letter b', 'X', 'x');`,
+					Expected: []sql.Row{{"aXc"}},
+				},
+				{
+					Query:    `SELECT regexp_replace(E'a\nb', '^b', 'X'), regexp_replace(E'a\nb', '^b', 'X', 'n'), regexp_replace(E'a\nb', 'a.b', 'X', 'n');`,
+					Expected: []sql.Row{{"a\nb", "a\nX", "a\nb"}},
+				},
+				{
+					Query:       `SELECT regexp_replace('abc', 'b', 'X', 'z');`,
+					ExpectedErr: `invalid regular expression option: "z"`,
+				},
+				{
+					Query:    `SELECT regexp_split_to_array('hello world', '\s+'), regexp_split_to_array('hello', '');`,
+					Expected: []sql.Row{{"{hello,world}", "{h,e,l,l,o}"}},
+				},
+				{
+					Query:    `SELECT regexp_split_to_array('aXbxc', 'x', 'i');`,
+					Expected: []sql.Row{{"{a,b,c}"}},
+				},
+				{
+					Query:    `SELECT regexp_split_to_table('the quick  brown fox', '\s+');`,
+					Expected: []sql.Row{{"the"}, {"quick"}, {"brown"}, {"fox"}},
+				},
+				{
+					Query:    `SELECT x FROM regexp_split_to_table('a,b,c', ',') AS x ORDER BY x DESC;`,
+					Expected: []sql.Row{{"c"}, {"b"}, {"a"}},
+				},
+				{
+					Query:    `SELECT regexp_count('ABCABCAXYaxy', 'A.'), regexp_count('ABCABCAXYaxy', 'A.', 1, 'i'), regexp_count('ABCABCAXYaxy', 'A.', 4);`,
+					Expected: []sql.Row{{3, 4, 2}},
+				},
+				{
+					Query:       `SELECT regexp_count('abc', 'a', 0);`,
+					ExpectedErr: `invalid value for parameter "start": 0`,
+				},
+				{
+					Query:    `SELECT regexp_like('Hello World', 'world'), regexp_like('Hello World', 'world', 'i');`,
+					Expected: []sql.Row{{"f", "t"}},
+				},
+			},
+		},
+		{
+			Name: "substring",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT substring('Thomas' from 2 for 3), substring('Thomas' from 3), substring('Thomas' for 2), substring('Thomas', 2, 3);`,
+					Expected: []sql.Row{{"hom", "omas", "Th", "hom"}},
+				},
+				{
+					Query:    `SELECT substring('Thomas' from '...$'), substring('Thomas' from 'o(.)a'), substring('Thomas' from 'z');`,
+					Expected: []sql.Row{{"mas", "m", nil}},
+				},
+				{
+					Query:    `SELECT substring('Thomas' similar '%#"o_a#"_' escape '#'), substring('Thomas' from '%#"o_a#"_' for '#');`,
+					Expected: []sql.Row{{"oma", "oma"}},
+				},
+				{
+					Query:    `SELECT substring('Thomas' similar 'T%' escape '#'), substring('Thomas' similar 'x%' escape '#');`,
+					Expected: []sql.Row{{"Thomas", nil}},
+				},
+			},
+		},
+		{
+			Name: "psql pattern lookups",
+			SetUpScript: []string{
+				`CREATE TABLE foo (id int primary key);`,
+				`CREATE TABLE foobar (id int primary key);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT c.relname FROM pg_catalog.pg_class c WHERE c.relname OPERATOR(pg_catalog.~) '^(foo)$' COLLATE pg_catalog.default;`,
+					Expected: []sql.Row{{"foo"}},
+				},
+				{
+					Query:    `SELECT c.relname FROM pg_catalog.pg_class c WHERE c.relname OPERATOR(pg_catalog.~) '^(foo.*)$' COLLATE pg_catalog.default ORDER BY 1;`,
+					Expected: []sql.Row{{"foo"}, {"foo_pkey"}, {"foobar"}, {"foobar_pkey"}},
+				},
+			},
+		},
+	})
+}
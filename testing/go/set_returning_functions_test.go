@@ -0,0 +1,296 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestSetReturningFunctions(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "set-returning functions in the select list",
+			SetUpScript: []string{
+				`CREATE TABLE t (id int primary key, arr int[]);`,
+				`INSERT INTO t VALUES (1, ARRAY[1, 2]), (2, ARRAY[3]), (3, ARRAY[]::int[]), (4, NULL);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT unnest(ARRAY[1, 2, 3]) * 2 AS x, 5;`,
+					Expected: []sql.Row{{2, 5}, {4, 5}, {6, 5}},
+				},
+				{
+					Query:    `SELECT id, unnest(arr) FROM t ORDER BY id;`,
+					Expected: []sql.Row{{1, 1}, {1, 2}, {2, 3}},
+				},
+				{
+					Query:    `SELECT unnest(ARRAY[1, 2, 3]), unnest(ARRAY['a', 'b']);`,
+					Expected: []sql.Row{{1, "a"}, {2, "b"}, {3, nil}},
+				},
+				{
+					Query:    `SELECT id, unnest(arr) AS x FROM t ORDER BY x DESC;`,
+					Expected: []sql.Row{{2, 3}, {1, 2}, {1, 1}},
+				},
+				{
+					Query:    `SELECT DISTINCT unnest(ARRAY[1, 1, 2]) ORDER BY 1;`,
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    `SELECT unnest(ARRAY[1, 2, 3]) LIMIT 2;`,
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    `SELECT * FROM (SELECT unnest(ARRAY[3, 1, 2]) AS x) s WHERE x > 1 ORDER BY x;`,
+					Expected: []sql.Row{{2}, {3}},
+				},
+				{
+					Query:    `SELECT id, (SELECT max(x) FROM (SELECT unnest(t.arr) AS x) s) FROM t ORDER BY id;`,
+					Expected: []sql.Row{{1, 2}, {2, 3}, {3, nil}, {4, nil}},
+				},
+				{
+					Query:       `SELECT id FROM t WHERE unnest(arr) = 1;`,
+					ExpectedErr: `set-valued function called in context that cannot accept a set`,
+				},
+				{
+					Query:    `INSERT INTO t SELECT unnest(ARRAY[10, 11]), ARRAY[1];`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT id, arr FROM t WHERE id >= 10 ORDER BY id;`,
+					Expected: []sql.Row{{10, "{1}"}, {11, "{1}"}},
+				},
+			},
+		},
+		{
+			Name: "set-returning functions in the FROM clause",
+			SetUpScript: []string{
+				`CREATE TABLE t (id int primary key, arr int[]);`,
+				`INSERT INTO t VALUES (1, ARRAY[1, 2]), (2, ARRAY[3]), (3, ARRAY[]::int[]);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT * FROM unnest(ARRAY[1, 2, 3]);`,
+					Cols:     []string{"unnest"},
+					Expected: []sql.Row{{1}, {2}, {3}},
+				},
+				{
+					Query:    `SELECT * FROM unnest(ARRAY[1, 2, 3]) AS u;`,
+					Cols:     []string{"u"},
+					Expected: []sql.Row{{1}, {2}, {3}},
+				},
+				{
+					Query:    `SELECT u.x FROM unnest(ARRAY[1, 2, 3]) AS u(x) WHERE x > 1;`,
+					Cols:     []string{"x"},
+					Expected: []sql.Row{{2}, {3}},
+				},
+				{
+					Query:    `SELECT * FROM unnest(ARRAY['a', 'b']) WITH ORDINALITY;`,
+					Cols:     []string{"unnest", "ordinality"},
+					Expected: []sql.Row{{"a", 1}, {"b", 2}},
+				},
+				{
+					Query:    `SELECT * FROM unnest(ARRAY['a', 'b']) WITH ORDINALITY AS u(v, n);`,
+					Cols:     []string{"v", "n"},
+					Expected: []sql.Row{{"a", 1}, {"b", 2}},
+				},
+				{
+					Query:    `SELECT * FROM unnest(ARRAY[1, 2, 3], ARRAY['a', 'b']) AS u(n, s);`,
+					Cols:     []string{"n", "s"},
+					Expected: []sql.Row{{1, "a"}, {2, "b"}, {3, nil}},
+				},
+				{
+					Query:    `SELECT * FROM ROWS FROM (generate_series(1, 3), unnest(ARRAY['a', 'b'])) AS r(n, s);`,
+					Expected: []sql.Row{{1, "a"}, {2, "b"}, {3, nil}},
+				},
+				{
+					Query:    `SELECT t.id, u.x FROM t, unnest(t.arr) AS u(x) ORDER BY 1, 2;`,
+					Expected: []sql.Row{{1, 1}, {1, 2}, {2, 3}},
+				},
+				{
+					Query:    `SELECT t.id, u.x, u.o FROM t CROSS JOIN LATERAL unnest(t.arr) WITH ORDINALITY AS u(x, o) ORDER BY 1, 2;`,
+					Expected: []sql.Row{{1, 1, 1}, {1, 2, 2}, {2, 3, 1}},
+				},
+				{
+					Query:    `SELECT * FROM unnest(ARRAY[1, 2]) a, unnest(ARRAY[3, 4]) b ORDER BY 1, 2;`,
+					Expected: []sql.Row{{1, 3}, {1, 4}, {2, 3}, {2, 4}},
+				},
+				{
+					Query:    `SELECT * FROM unnest(NULL::int[]);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM abs(-5);`,
+					Cols:     []string{"abs"},
+					Expected: []sql.Row{{5}},
+				},
+				{
+					Query:       `SELECT * FROM unnest(ARRAY[1, 2, 3]) AS u(a, b);`,
+					ExpectedErr: `table "u" has 1 columns available but 2 columns specified`,
+				},
+			},
+		},
+		{
+			Name: "generate_series",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT generate_series(1, 3);`,
+					Cols:     []string{"generate_series"},
+					Expected: []sql.Row{{1}, {2}, {3}},
+				},
+				{
+					Query:    `SELECT * FROM generate_series(1, 10, 3);`,
+					Expected: []sql.Row{{1}, {4}, {7}, {10}},
+				},
+				{
+					Query:    `SELECT * FROM generate_series(5, 1, -2) AS g;`,
+					Cols:     []string{"g"},
+					Expected: []sql.Row{{5}, {3}, {1}},
+				},
+				{
+					Query:    `SELECT * FROM generate_series(3, 1);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT * FROM generate_series(1::int8, 3::int8);`,
+					Expected: []sql.Row{{1}, {2}, {3}},
+				},
+				{
+					Query:    `SELECT * FROM generate_series(2147483646, 2147483647);`,
+					Expected: []sql.Row{{2147483646}, {2147483647}},
+				},
+				{
+					Query:       `SELECT * FROM generate_series(1, 3, 0);`,
+					ExpectedErr: `step size cannot equal zero`,
+				},
+				{
+					Query:    `SELECT * FROM generate_series(0.1, 0.5, 0.2);`,
+					Expected: []sql.Row{{Numeric("0.1")}, {Numeric("0.3")}, {Numeric("0.5")}},
+				},
+				{
+					Query:    `SELECT * FROM generate_series('2024-01-01 00:00'::timestamp, '2024-01-02 00:00', '12 hours');`,
+					Expected: []sql.Row{{"2024-01-01 00:00:00"}, {"2024-01-01 12:00:00"}, {"2024-01-02 00:00:00"}},
+				},
+				{
+					Query:    `SELECT * FROM generate_series('2024-01-31'::timestamp, '2024-04-30', '1 month');`,
+					Expected: []sql.Row{{"2024-01-31 00:00:00"}, {"2024-02-29 00:00:00"}, {"2024-03-29 00:00:00"}, {"2024-04-29 00:00:00"}},
+				},
+				{
+					Query:       `SELECT * FROM generate_series('2024-01-01'::timestamp, '2024-01-02', '0 days');`,
+					ExpectedErr: `step size cannot equal zero`,
+				},
+				{
+					Query:    `SELECT * FROM generate_series(1, 3) WITH ORDINALITY AS g(v, n);`,
+					Cols:     []string{"v", "n"},
+					Expected: []sql.Row{{1, 1}, {2, 2}, {3, 3}},
+				},
+			},
+		},
+		{
+			Name: "generate_subscripts",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT generate_subscripts(ARRAY['a', 'b', 'c'], 1);`,
+					Expected: []sql.Row{{1}, {2}, {3}},
+				},
+				{
+					Query:    `SELECT * FROM generate_subscripts(ARRAY['a', 'b', 'c'], 1, true);`,
+					Expected: []sql.Row{{3}, {2}, {1}},
+				},
+				{
+					Query:    `SELECT generate_subscripts(ARRAY['a', 'b', 'c'], 2);`,
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "regexp_matches",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT regexp_matches('foobarbequebaz', '(bar)(beque)');`,
+					Expected: []sql.Row{{"{bar,beque}"}},
+				},
+				{
+					Query:    `SELECT regexp_matches('foobarbequebazilbarfbonk', '(b[^b]+)(b[^b]+)', 'g');`,
+					Expected: []sql.Row{{"{bar,beque}"}, {"{bazil,barf}"}},
+				},
+				{
+					Query:    `SELECT regexp_matches('abc', 'B', 'i');`,
+					Expected: []sql.Row{{"{b}"}},
+				},
+				{
+					Query:    `SELECT regexp_matches('abc', 'x');`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT regexp_matches('a1b2', '([a-z])(x)?', 'g');`,
+					Expected: []sql.Row{{"{a,NULL}"}, {"{b,NULL}"}},
+				},
+				{
+					Query:    `SELECT regexp_matches('abc', 'a b # comment', 'x');`,
+					Expected: []sql.Row{{"{ab}"}},
+				},
+				{
+					Query:       `SELECT regexp_matches('abc', 'a', 'z');`,
+					ExpectedErr: `invalid regular expression option: "z"`,
+				},
+			},
+		},
+		{
+			Name: "jsonb_each and jsonb_array_elements",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT * FROM jsonb_each('{"a": 1, "b": [true]}'::jsonb);`,
+					Cols:     []string{"key", "value"},
+					Expected: []sql.Row{{"a", "1"}, {"b", "[true]"}},
+				},
+				{
+					Query:    `SELECT key, value FROM jsonb_each('{"a": 1, "b": "x"}'::jsonb) WHERE key = 'b';`,
+					Expected: []sql.Row{{"b", `"x"`}},
+				},
+				{
+					Query:    `SELECT (jsonb_each('{"a": 1, "b": 2}'::jsonb)).key;`,
+					Expected: []sql.Row{{"a"}, {"b"}},
+				},
+				{
+					Query:    `SELECT jsonb_each('{"a": 1}'::jsonb)::text;`,
+					Expected: []sql.Row{{"(a,1)"}},
+				},
+				{
+					Query:       `SELECT * FROM jsonb_each('[1]'::jsonb);`,
+					ExpectedErr: `cannot call jsonb_each on a non-object`,
+				},
+				{
+					Query:    `SELECT jsonb_array_elements('[1, "a", null, {"b": 2}]'::jsonb);`,
+					Expected: []sql.Row{{"1"}, {`"a"`}, {"null"}, {`{"b": 2}`}},
+				},
+				{
+					Query:    `SELECT * FROM jsonb_array_elements('[1, 2]'::jsonb) WITH ORDINALITY;`,
+					Cols:     []string{"jsonb_array_elements", "ordinality"},
+					Expected: []sql.Row{{"1", 1}, {"2", 2}},
+				},
+				{
+					Query:       `SELECT jsonb_array_elements('{"a": 1}'::jsonb);`,
+					ExpectedErr: `cannot extract elements from an object`,
+				},
+				{
+					Query:       `SELECT jsonb_array_elements('1'::jsonb);`,
+					ExpectedErr: `cannot extract elements from a scalar`,
+				},
+			},
+		},
+	})
+}
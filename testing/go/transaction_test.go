@@ -0,0 +1,191 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailedTransactions(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "statements are rejected until ROLLBACK",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT PRIMARY KEY);",
+				"INSERT INTO test VALUES (1);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO test VALUES (1);",
+					ExpectedErr: "(SQLSTATE 23505)",
+				},
+				{
+					Query:       "SELECT * FROM test;",
+					ExpectedErr: "current transaction is aborted, commands ignored until end of transaction block (SQLSTATE 25P02)",
+				},
+				{
+					Query:       "INSERT INTO test VALUES (3);",
+					ExpectedErr: "(SQLSTATE 25P02)",
+				},
+				{
+					Query:    "ROLLBACK;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{{1}},
+				},
+			},
+		},
+		{
+			Name: "COMMIT of a failed transaction rolls back",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT PRIMARY KEY);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM does_not_exist;",
+					ExpectedErr: "(SQLSTATE 42P01)",
+				},
+				{
+					Query:       "COMMIT;",
+					ExpectedTag: "ROLLBACK",
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "ROLLBACK TO SAVEPOINT recovers a failed transaction",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT PRIMARY KEY);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SAVEPOINT sp;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO test VALUES (1);",
+					ExpectedErr: "(SQLSTATE 23505)",
+				},
+				{
+					Query:       "INSERT INTO test VALUES (2);",
+					ExpectedErr: "(SQLSTATE 25P02)",
+				},
+				{
+					Query:    "ROLLBACK TO SAVEPOINT sp;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1}, {2}},
+				},
+			},
+		},
+		{
+			Name: "errors outside of a transaction block do not fail the session",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT PRIMARY KEY);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SELECT * FROM does_not_exist;",
+					ExpectedErr: "(SQLSTATE 42P01)",
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	})
+}
+
+func TestReadyForQueryTransactionStatus(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	pgConn := conn.Default.PgConn()
+
+	_, err := conn.Exec(ctx, "CREATE TABLE test (pk INT PRIMARY KEY);")
+	require.NoError(t, err)
+	assert.Equal(t, byte('I'), pgConn.TxStatus())
+
+	_, err = conn.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	assert.Equal(t, byte('T'), pgConn.TxStatus())
+
+	_, err = conn.Exec(ctx, "INSERT INTO test VALUES (1);")
+	require.NoError(t, err)
+	assert.Equal(t, byte('T'), pgConn.TxStatus())
+
+	_, err = conn.Exec(ctx, "INSERT INTO test VALUES (1);")
+	require.Error(t, err)
+	assert.Equal(t, byte('E'), pgConn.TxStatus())
+
+	_, err = conn.Exec(ctx, "SELECT 1;")
+	require.Error(t, err)
+	assert.Equal(t, byte('E'), pgConn.TxStatus())
+
+	_, err = conn.Exec(ctx, "ROLLBACK;")
+	require.NoError(t, err)
+	assert.Equal(t, byte('I'), pgConn.TxStatus())
+
+	_, err = conn.Exec(ctx, "SELECT * FROM does_not_exist;")
+	require.Error(t, err)
+	assert.Equal(t, byte('I'), pgConn.TxStatus())
+}
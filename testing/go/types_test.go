@@ -597,7 +597,6 @@ var typesTests = []ScriptTest{
 	},
 	{
 		Name: "Cidr type",
-		Skip: true,
 		SetUpScript: []string{
 			"CREATE TABLE t_cidr (id INTEGER primary key, v1 CIDR);",
 			"INSERT INTO t_cidr VALUES (1, '192.168.1.0/24'), (2, '10.0.0.0/8');",
@@ -764,7 +763,6 @@ var typesTests = []ScriptTest{
 	},
 	{
 		Name: "Inet type",
-		Skip: true,
 		SetUpScript: []string{
 			"CREATE TABLE t_inet (id INTEGER primary key, v1 INET);",
 			"INSERT INTO t_inet VALUES (1, '192.168.1.1'), (2, '10.0.0.1');",
@@ -1283,7 +1281,6 @@ var typesTests = []ScriptTest{
 	},
 	{
 		Name: "Macaddr type",
-		Skip: true,
 		SetUpScript: []string{
 			"CREATE TABLE t_macaddr (id INTEGER primary key, v1 MACADDR);",
 			"INSERT INTO t_macaddr VALUES (1, '08:00:2b:01:02:03'), (2, '00:11:22:33:44:55');",
@@ -2625,36 +2622,49 @@ var typesTests = []ScriptTest{
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				// timezone representation is reported via local time, need to account for that in testing
+				// Results use the binary format, which only conveys the instant in time
+				Query: "SELECT * FROM t_timestamp_with_zone ORDER BY id;",
+				Expected: []sql.Row{
+					{1, "2022-01-01 04:34:56-08"},
+					{2, "2022-02-01 20:45:01-08"},
+				},
+			},
+			{
+				// The session's time zone is only reflected in the text format, so these cast to text
 				Query:    "SET timezone TO '-04:25'",
 				Expected: []sql.Row{},
 			},
 			{
-				Query: "SELECT * FROM t_timestamp_with_zone ORDER BY id;",
+				Query: "SELECT id, v1::text FROM t_timestamp_with_zone ORDER BY id;",
 				Expected: []sql.Row{
 					{1, "2022-01-01 16:59:56+04:25"},
 					{2, "2022-02-02 09:10:01+04:25"},
 				},
 			},
 			{
-				Query: "SELECT '2000-01-01'::timestamptz;",
+				Query: "SELECT '2000-01-01'::timestamptz::text;",
 				Expected: []sql.Row{
 					{"2000-01-01 00:00:00+04:25"},
 				},
 			},
 			{
-				Query: `SELECT '2000-01-01 00:00:00'::timestamptz;`,
+				Query: `SELECT '2000-01-01 00:00:00'::timestamptz::text;`,
 				Expected: []sql.Row{
 					{"2000-01-01 00:00:00+04:25"},
 				},
 			},
 			{
-				// timezone representation is reported via local time, need to account for that in testing
+				Query: `SELECT '2000-01-01 00:00:00'::timestamptz;`,
+				Expected: []sql.Row{
+					{"1999-12-31 11:35:00-08"},
+				},
+			},
+			{
 				Query:    "SET timezone TO '-06:00'",
 				Expected: []sql.Row{},
 			},
 			{
-				Query: "SELECT * FROM t_timestamp_with_zone ORDER BY id;",
+				Query: "SELECT id, v1::text FROM t_timestamp_with_zone ORDER BY id;",
 				Expected: []sql.Row{
 					{1, "2022-01-01 18:34:56+06"},
 					{2, "2022-02-02 10:45:01+06"},